@@ -27,6 +27,7 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	ibctestingtypes "github.com/cosmos/ibc-go/v7/testing/types"
 	"github.com/spf13/cast"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
@@ -124,6 +125,7 @@ import (
 	"github.com/hypersign-protocol/hid-node/x/ssi"
 	ssikeeper "github.com/hypersign-protocol/hid-node/x/ssi/keeper"
 	ssitypes "github.com/hypersign-protocol/hid-node/x/ssi/types"
+	ssiwasmbinding "github.com/hypersign-protocol/hid-node/x/ssi/wasmbinding"
 )
 
 const appName = "HypersignApp"
@@ -260,6 +262,7 @@ type App struct {
 	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
 	ScopedIBCFeeKeeper        capabilitykeeper.ScopedKeeper
 	ScopedWasmKeeper          capabilitykeeper.ScopedKeeper
+	ScopedSsiKeeper           capabilitykeeper.ScopedKeeper
 
 	// the module manager
 	mm *module.Manager
@@ -352,6 +355,7 @@ func NewHypersignApp(
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibcexported.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedWasmKeeper := app.CapabilityKeeper.ScopeToModule(wasmtypes.ModuleName)
+	scopedSsiKeeper := app.CapabilityKeeper.ScopeToModule(ssitypes.ModuleName)
 	app.CapabilityKeeper.Seal()
 
 	// add keepers
@@ -410,6 +414,7 @@ func NewHypersignApp(
 		keys[ssitypes.StoreKey],
 		keys[ssitypes.MemStoreKey],
 		app.getSubspace(ssitypes.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	invCheckPeriod := cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod))
@@ -460,6 +465,11 @@ func NewHypersignApp(
 		return fromVM, nil
 	})
 
+	app.UpgradeKeeper.SetUpgradeHandler("v040", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("v0.4.0 upgrade")
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec,
 		keys[ibcexported.StoreKey],
@@ -546,8 +556,10 @@ func NewHypersignApp(
 	}
 
 	// The last arguments can contain custom message handlers, and custom query handlers,
-	// if we want to allow any custom callbacks
-	availableCapabilities := strings.Join(wasmapp.AllCapabilities(), ",")
+	// if we want to allow any custom callbacks. Contracts query the x/ssi state through
+	// custom queries, and can require the "hypersign" capability to do so.
+	availableCapabilities := strings.Join(append(wasmapp.AllCapabilities(), "hypersign"), ",")
+	wasmOpts = append(wasmOpts, ssiwasmbinding.RegisterCustomPlugins(&app.SsiKeeper, appCodec)...)
 	app.WasmKeeper = wasmkeeper.NewKeeper(
 		appCodec,
 		keys[wasmtypes.StoreKey],
@@ -569,6 +581,15 @@ func NewHypersignApp(
 		wasmOpts...,
 	)
 
+	// DID Documents can be controlled by CosmWasm contracts
+	app.SsiKeeper.SetContractKeeper(app.WasmKeeper, app.BankKeeper)
+
+	// Counterparty chains resolve DIDs and Credential Statuses through the ssi IBC application
+	app.SsiKeeper.SetIBCKeepers(&app.IBCKeeper.PortKeeper, scopedSsiKeeper)
+
+	// DID Documents can be resolved along with their Merkle proofs
+	app.SsiKeeper.SetStoreQuerier(app.BaseApp)
+
 	// Set legacy router for backwards compatibility with gov v1beta1
 	app.GovKeeper.SetLegacyRouter(govRouter)
 
@@ -582,10 +603,16 @@ func NewHypersignApp(
 	wasmStack = wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCFeeKeeper)
 	wasmStack = ibcfee.NewIBCMiddleware(wasmStack, app.IBCFeeKeeper)
 
+	// Create fee enabled ssi ibc Stack
+	var ssiStack porttypes.IBCModule
+	ssiStack = ssi.NewIBCModule(app.SsiKeeper)
+	ssiStack = ibcfee.NewIBCMiddleware(ssiStack, app.IBCFeeKeeper)
+
 	// Create static IBC router, add app routes, then set and seal it
 	ibcRouter := porttypes.NewRouter().
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
-		AddRoute(wasmtypes.ModuleName, wasmStack)
+		AddRoute(wasmtypes.ModuleName, wasmStack).
+		AddRoute(ssitypes.ModuleName, ssiStack)
 
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -743,6 +770,7 @@ func NewHypersignApp(
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
 	app.ScopedWasmKeeper = scopedWasmKeeper
+	app.ScopedSsiKeeper = scopedSsiKeeper
 
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
 	// antehandlers, but are run _after_ the `runMsgs` execution. They are also
@@ -995,6 +1023,33 @@ func (app *App) ModuleManager() *module.Manager {
 	return app.mm
 }
 
+// The following methods implement the TestingApp interface of ibc-go testing framework
+
+// GetBaseApp returns the base app of the application
+func (app *App) GetBaseApp() *baseapp.BaseApp {
+	return app.BaseApp
+}
+
+// GetStakingKeeper returns the staking keeper of the application
+func (app *App) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.StakingKeeper
+}
+
+// GetIBCKeeper returns the IBC keeper of the application
+func (app *App) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper returns the capability keeper scoped to IBC module
+func (app *App) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig returns the TxConfig of the application
+func (app *App) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // initParamsKeeper init params keeper and its subspaces
 func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey storetypes.StoreKey) paramskeeper.Keeper {
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
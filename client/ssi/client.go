@@ -0,0 +1,171 @@
+package ssi
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// Client submits SSI transactions to a hid-node and queries its x/ssi state. Every query of the
+// x/ssi module is available through the embedded QueryClient.
+type Client struct {
+	types.QueryClient
+
+	clientCtx client.Context
+	txFactory tx.Factory
+}
+
+// NewClient returns a Client which queries the node of clientCtx, and broadcasts transactions signed by
+// the key of clientCtx.FromName with the txFactory. The fees of txFactory must cover the SSI fee of the
+// submitted messages.
+func NewClient(clientCtx client.Context, txFactory tx.Factory) *Client {
+	return &Client{
+		QueryClient: types.NewQueryClient(clientCtx),
+		clientCtx:   clientCtx,
+		txFactory:   txFactory,
+	}
+}
+
+// TxAuthor returns the address of the account which signs the transactions
+func (c *Client) TxAuthor() string {
+	return c.clientCtx.GetFromAddress().String()
+}
+
+// BroadcastMsgs signs a transaction carrying the messages and broadcasts it as per the broadcast mode of
+// the client context. The gas limit is estimated by simulation if the tx factory is configured for it.
+// An error is returned along with the response if the transaction is rejected by the node.
+func (c *Client) BroadcastMsgs(msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	txf, err := c.txFactory.Prepare(c.clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjustedGas, err := tx.CalculateGas(c.clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(adjustedGas)
+	}
+
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(txf, c.clientCtx.GetFromName(), txBuilder, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := c.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return res, errors.ABCIError(res.Codespace, res.Code, res.RawLog)
+	}
+	return res, nil
+}
+
+// RegisterDID registers the DID Document, signed by the input proofs
+func (c *Client) RegisterDID(didDocument *types.DidDocument, proofs ...*types.DocumentProof) (*sdk.TxResponse, error) {
+	return c.BroadcastMsgs(&types.MsgRegisterDID{
+		DidDocument:       didDocument,
+		DidDocumentProofs: proofs,
+		TxAuthor:          c.TxAuthor(),
+	})
+}
+
+// UpdateDID replaces the latest version of the DID Document with the input DID Document, signed by the input proofs
+func (c *Client) UpdateDID(ctx context.Context, didDocument *types.DidDocument, proofs ...*types.DocumentProof) (*sdk.TxResponse, error) {
+	versionId, err := c.getDidDocumentVersionId(ctx, didDocument.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.BroadcastMsgs(&types.MsgUpdateDID{
+		DidDocument:       didDocument,
+		DidDocumentProofs: proofs,
+		VersionId:         versionId,
+		TxAuthor:          c.TxAuthor(),
+	})
+}
+
+// DeactivateDID deactivates the DID. The proofs are created over the latest version of its DID Document.
+func (c *Client) DeactivateDID(ctx context.Context, didId string, proofs ...*types.DocumentProof) (*sdk.TxResponse, error) {
+	versionId, err := c.getDidDocumentVersionId(ctx, didId)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.BroadcastMsgs(&types.MsgDeactivateDID{
+		DidDocumentId:     didId,
+		DidDocumentProofs: proofs,
+		VersionId:         versionId,
+		TxAuthor:          c.TxAuthor(),
+	})
+}
+
+// RegisterCredentialSchema registers the Credential Schema, signed by its author
+func (c *Client) RegisterCredentialSchema(credentialSchema *types.CredentialSchemaDocument, proof *types.DocumentProof) (*sdk.TxResponse, error) {
+	return c.BroadcastMsgs(&types.MsgRegisterCredentialSchema{
+		CredentialSchemaDocument: credentialSchema,
+		CredentialSchemaProof:    proof,
+		TxAuthor:                 c.TxAuthor(),
+	})
+}
+
+// UpdateCredentialSchema registers a new version of the Credential Schema, signed by its author
+func (c *Client) UpdateCredentialSchema(credentialSchema *types.CredentialSchemaDocument, proof *types.DocumentProof) (*sdk.TxResponse, error) {
+	return c.BroadcastMsgs(&types.MsgUpdateCredentialSchema{
+		CredentialSchemaDocument: credentialSchema,
+		CredentialSchemaProof:    proof,
+		TxAuthor:                 c.TxAuthor(),
+	})
+}
+
+// UpdateCredentialSchemaStatus changes the status of a Credential Schema version, signed by its author
+func (c *Client) UpdateCredentialSchemaStatus(schemaStatus *types.CredentialSchemaStatusDocument, proof *types.DocumentProof) (*sdk.TxResponse, error) {
+	return c.BroadcastMsgs(&types.MsgUpdateCredentialSchemaStatus{
+		CredentialSchemaStatusDocument: schemaStatus,
+		CredentialSchemaStatusProof:    proof,
+		TxAuthor:                       c.TxAuthor(),
+	})
+}
+
+// RegisterCredentialStatus registers the Credential Status, signed by the issuer of the Credential
+func (c *Client) RegisterCredentialStatus(credentialStatus *types.CredentialStatusDocument, proof *types.DocumentProof) (*sdk.TxResponse, error) {
+	return c.BroadcastMsgs(&types.MsgRegisterCredentialStatus{
+		CredentialStatusDocument: credentialStatus,
+		CredentialStatusProof:    proof,
+		TxAuthor:                 c.TxAuthor(),
+	})
+}
+
+// UpdateCredentialStatus updates the Credential Status, signed by the issuer of the Credential
+func (c *Client) UpdateCredentialStatus(credentialStatus *types.CredentialStatusDocument, proof *types.DocumentProof) (*sdk.TxResponse, error) {
+	return c.BroadcastMsgs(&types.MsgUpdateCredentialStatus{
+		CredentialStatusDocument: credentialStatus,
+		CredentialStatusProof:    proof,
+		TxAuthor:                 c.TxAuthor(),
+	})
+}
+
+func (c *Client) getDidDocumentVersionId(ctx context.Context, didId string) (string, error) {
+	res, err := c.DidDocumentByID(ctx, &types.QueryDidDocumentRequest{DidId: didId})
+	if err != nil {
+		return "", err
+	}
+	if res.DidDocumentMetadata == nil {
+		return "", fmt.Errorf("did document %v has no metadata", didId)
+	}
+	return res.DidDocumentMetadata.VersionId, nil
+}
@@ -0,0 +1,105 @@
+package ssi
+
+import (
+	"encoding/json"
+	"time"
+
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+const credentialSchemaType = "https://w3c-ccg.github.io/vc-json-schemas/v1/schema/1.0/schema.json"
+const credentialSchemaModelVersion = "1.0"
+const jsonSchemaDraft07 = "http://json-schema.org/draft-07/schema"
+const jsonSchemaObjectType = "https://schema.org/object"
+
+// NewCredentialSchemaId returns the id of a Credential Schema version in the input chain namespace. An
+// empty namespace denotes the mainnet chain.
+func NewCredentialSchemaId(namespace string, methodSpecificId string, version string) string {
+	return newDocumentId("sch", namespace, methodSpecificId) + ":" + version
+}
+
+// CredentialSchemaBuilder builds Credential Schema Documents
+type CredentialSchemaBuilder struct {
+	credentialSchema *types.CredentialSchemaDocument
+	properties       map[string]interface{}
+}
+
+// NewCredentialSchemaBuilder returns a builder of a JSON Schema based Credential Schema, authored
+// by the input DID, whose proof is created by a Verification Method of type signerVmType
+func NewCredentialSchemaBuilder(schemaId string, name string, author string, signerVmType string) *CredentialSchemaBuilder {
+	return &CredentialSchemaBuilder{
+		credentialSchema: &types.CredentialSchemaDocument{
+			Context:      appendContexts([]string{ldcontext.CredentialSchemaContext}, GetVmContexts(signerVmType)...),
+			Type:         credentialSchemaType,
+			ModelVersion: credentialSchemaModelVersion,
+			Id:           schemaId,
+			Name:         name,
+			Author:       author,
+			Authored:     time.Now().UTC().Format(time.RFC3339),
+			Schema: &types.CredentialSchemaProperty{
+				Schema: jsonSchemaDraft07,
+				Type:   jsonSchemaObjectType,
+			},
+		},
+		properties: map[string]interface{}{},
+	}
+}
+
+// SetDescription sets the description of the Credential Schema
+func (b *CredentialSchemaBuilder) SetDescription(description string) *CredentialSchemaBuilder {
+	b.credentialSchema.Schema.Description = description
+	return b
+}
+
+// SetAuthored sets the authoring date of the Credential Schema
+func (b *CredentialSchemaBuilder) SetAuthored(authored time.Time) *CredentialSchemaBuilder {
+	b.credentialSchema.Authored = authored.UTC().Format(time.RFC3339)
+	return b
+}
+
+// AddProperty adds a claim of the input JSON type to the Credential Schema
+func (b *CredentialSchemaBuilder) AddProperty(name string, jsonType string, required bool) *CredentialSchemaBuilder {
+	b.properties[name] = map[string]interface{}{"type": jsonType}
+	if required {
+		b.credentialSchema.Schema.Required = append(b.credentialSchema.Schema.Required, name)
+	}
+	return b
+}
+
+// SetAdditionalProperties sets whether Credentials may carry claims not described by the Credential Schema
+func (b *CredentialSchemaBuilder) SetAdditionalProperties(additionalProperties bool) *CredentialSchemaBuilder {
+	b.credentialSchema.Schema.AdditionalProperties = additionalProperties
+	return b
+}
+
+// Build returns the Credential Schema Document
+func (b *CredentialSchemaBuilder) Build() (*types.CredentialSchemaDocument, error) {
+	if _, _, err := types.SplitSchemaId(b.credentialSchema.Id); err != nil {
+		return nil, err
+	}
+
+	propertiesBytes, err := json.Marshal(b.properties)
+	if err != nil {
+		return nil, err
+	}
+	b.credentialSchema.Schema.Properties = string(propertiesBytes)
+
+	return b.credentialSchema, nil
+}
+
+// NewCredentialSchemaStatus returns the Credential Schema Status Document which moves the input Credential
+// Schema version to the input status ("active", "deprecated" or "revoked"), and whose proof is created by a
+// Verification Method of type signerVmType
+func NewCredentialSchemaStatus(schemaId string, status string, remarks string, signerVmType string) (*types.CredentialSchemaStatusDocument, error) {
+	schemaStatus := &types.CredentialSchemaStatusDocument{
+		Context: appendContexts([]string{ldcontext.CredentialSchemaStatusContext}, GetVmContexts(signerVmType)...),
+		Id:      schemaId,
+		Status:  status,
+		Remarks: remarks,
+	}
+	if err := schemaStatus.Validate(); err != nil {
+		return nil, err
+	}
+	return schemaStatus, nil
+}
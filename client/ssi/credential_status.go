@@ -0,0 +1,116 @@
+package ssi
+
+import (
+	"fmt"
+	"time"
+
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// NewCredentialId returns the id of a Verifiable Credential in the input chain namespace. An empty
+// namespace denotes the mainnet chain.
+func NewCredentialId(namespace string, methodSpecificId string) string {
+	return newDocumentId("vc", namespace, methodSpecificId)
+}
+
+// CredentialStatusBuilder builds Credential Status Documents
+type CredentialStatusBuilder struct {
+	credentialStatus *types.CredentialStatusDocument
+}
+
+// NewCredentialStatusBuilder returns a builder of the status of a Credential issued by the input DID, whose
+// proof is created by a Verification Method of type signerVmType. credentialMerkleRootHash is the hex encoded
+// hash which commits to the claims of the Credential.
+func NewCredentialStatusBuilder(credentialId string, issuer string, credentialMerkleRootHash string, signerVmType string) *CredentialStatusBuilder {
+	return &CredentialStatusBuilder{
+		credentialStatus: &types.CredentialStatusDocument{
+			Context:                  appendContexts([]string{ldcontext.CredentialStatusContext}, GetVmContexts(signerVmType)...),
+			Id:                       credentialId,
+			Issuer:                   issuer,
+			IssuanceDate:             time.Now().UTC().Format(time.RFC3339),
+			CredentialMerkleRootHash: credentialMerkleRootHash,
+			Remarks:                  "Live",
+		},
+	}
+}
+
+// NewCredentialStatusBuilderForCredential returns a builder of the status of a Verifiable Credential, which
+// commits to the JSON-LD Merkle root of the credential claims
+func NewCredentialStatusBuilderForCredential(credential map[string]interface{}, signerVmType string) (*CredentialStatusBuilder, error) {
+	credentialId, _ := credential["id"].(string)
+	issuer, _ := credential["issuer"].(string)
+
+	credentialMerkleRootHash, err := ldcontext.GetCredentialMerkleRootHash(credential)
+	if err != nil {
+		return nil, err
+	}
+
+	b := NewCredentialStatusBuilder(credentialId, issuer, credentialMerkleRootHash, signerVmType)
+	if issuanceDate, ok := credential["issuanceDate"].(string); ok {
+		b.credentialStatus.IssuanceDate = issuanceDate
+	}
+	return b, nil
+}
+
+// SetIssuanceDate sets the issuance date of the Credential
+func (b *CredentialStatusBuilder) SetIssuanceDate(issuanceDate time.Time) *CredentialStatusBuilder {
+	b.credentialStatus.IssuanceDate = issuanceDate.UTC().Format(time.RFC3339)
+	return b
+}
+
+// SetExpirationDate sets the date after which the Credential is no longer valid. The built document
+// then carries the versioned Credential Status context, which defines the expiration date.
+func (b *CredentialStatusBuilder) SetExpirationDate(expirationDate time.Time) *CredentialStatusBuilder {
+	b.credentialStatus.ExpirationDate = expirationDate.UTC().Format(time.RFC3339)
+	return b
+}
+
+// SetCredentialSchemaId sets the Credential Schema of the Credential. The built document then carries
+// the versioned Credential Status context, which defines the Credential Schema.
+func (b *CredentialStatusBuilder) SetCredentialSchemaId(schemaId string) *CredentialStatusBuilder {
+	b.credentialStatus.CredentialSchemaId = schemaId
+	return b
+}
+
+// SetRemarks sets the remarks of the Credential Status
+func (b *CredentialStatusBuilder) SetRemarks(remarks string) *CredentialStatusBuilder {
+	b.credentialStatus.Remarks = remarks
+	return b
+}
+
+// Build returns the Credential Status Document
+func (b *CredentialStatusBuilder) Build() (*types.CredentialStatusDocument, error) {
+	if b.credentialStatus.Id == "" {
+		return nil, fmt.Errorf("credential status id cannot be empty")
+	}
+	if b.credentialStatus.Issuer == "" {
+		return nil, fmt.Errorf("issuer of credential %v cannot be empty", b.credentialStatus.Id)
+	}
+
+	// Expiration date and Credential Schema are defined only by the versioned Credential Status context
+	if b.credentialStatus.ExpirationDate != "" || b.credentialStatus.CredentialSchemaId != "" {
+		for i, context := range b.credentialStatus.Context {
+			if context == ldcontext.CredentialStatusContext {
+				b.credentialStatus.Context[i] = ldcontext.CredentialStatusV2Context
+			}
+		}
+	}
+	return b.credentialStatus, nil
+}
+
+// SuspendCredentialStatus returns a copy of the Credential Status Document which suspends the Credential
+func SuspendCredentialStatus(credentialStatus *types.CredentialStatusDocument, remarks string) *types.CredentialStatusDocument {
+	updatedCredentialStatus := *credentialStatus
+	updatedCredentialStatus.Suspended = true
+	updatedCredentialStatus.Remarks = remarks
+	return &updatedCredentialStatus
+}
+
+// RevokeCredentialStatus returns a copy of the Credential Status Document which revokes the Credential
+func RevokeCredentialStatus(credentialStatus *types.CredentialStatusDocument, remarks string) *types.CredentialStatusDocument {
+	updatedCredentialStatus := *credentialStatus
+	updatedCredentialStatus.Revoked = true
+	updatedCredentialStatus.Remarks = remarks
+	return &updatedCredentialStatus
+}
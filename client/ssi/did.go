@@ -0,0 +1,178 @@
+package ssi
+
+import (
+	"fmt"
+
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// VerificationRelationship is a verification relationship between a DID and a Verification Method
+type VerificationRelationship string
+
+const (
+	Authentication       VerificationRelationship = "authentication"
+	AssertionMethod      VerificationRelationship = "assertionMethod"
+	KeyAgreement         VerificationRelationship = "keyAgreement"
+	CapabilityInvocation VerificationRelationship = "capabilityInvocation"
+	CapabilityDelegation VerificationRelationship = "capabilityDelegation"
+)
+
+// NewDidId returns the DID of the method-specific-id in the input chain namespace. An empty
+// namespace denotes the mainnet chain.
+func NewDidId(namespace string, methodSpecificId string) string {
+	return newDocumentId(types.DocumentIdentifierDid, namespace, methodSpecificId)
+}
+
+func newDocumentId(docIdentifier string, namespace string, methodSpecificId string) string {
+	if namespace == "" {
+		return docIdentifier + ":" + types.DidMethod + ":" + methodSpecificId
+	}
+	return docIdentifier + ":" + types.DidMethod + ":" + namespace + ":" + methodSpecificId
+}
+
+// GetVmContexts returns the JSON-LD contexts which SSI documents, referring to or signed by a
+// Verification Method of the input type, must include
+func GetVmContexts(vmType string) []string {
+	switch vmType {
+	case types.Ed25519VerificationKey2020:
+		return []string{ldcontext.Ed25519Context2020}
+	case types.EcdsaSecp256k1VerificationKey2019:
+		return []string{ldcontext.Secp256k12019Context}
+	case types.EcdsaSecp256k1RecoveryMethod2020:
+		return []string{ldcontext.Secp256k1Recovery2020Context}
+	case types.Bls12381G2Key2020:
+		return []string{ldcontext.BbsSignature2020Context}
+	case types.BabyJubJubKey2021:
+		return []string{ldcontext.BabyJubJubKey2021Context, ldcontext.BJJSignature2021Context}
+	case types.X25519KeyAgreementKey2020:
+		return []string{ldcontext.X25519KeyAgreement2020Context}
+	case types.X25519KeyAgreementKeyEIP5630:
+		return []string{ldcontext.X25519KeyAgreementKeyEIP5630Context}
+	case types.CosmWasmContractMethod2024:
+		return []string{ldcontext.CosmWasmContractMethod2024Context}
+	default:
+		return nil
+	}
+}
+
+// NewVerificationMethod returns the Verification Method of the key pair. EcdsaSecp256k1RecoveryMethod2020
+// key pairs are identified by the CAIP-10 account of their Ethereum address on the Ethereum mainnet.
+func NewVerificationMethod(vmId string, controller string, keyPair *KeyPair) *types.VerificationMethod {
+	vm := &types.VerificationMethod{
+		Id:                 vmId,
+		Type:               keyPair.Type,
+		Controller:         controller,
+		PublicKeyMultibase: keyPair.PublicKeyMultibase,
+	}
+	if keyPair.Type == types.EcdsaSecp256k1RecoveryMethod2020 {
+		vm.BlockchainAccountId = types.EthereumCAIP10Prefix + ":1:" + keyPair.EthereumAddress
+	}
+	return vm
+}
+
+// DidDocumentBuilder builds DID Documents. Every Verification Method added to the builder
+// brings along the JSON-LD contexts of its type.
+type DidDocumentBuilder struct {
+	didDocument *types.DidDocument
+}
+
+// NewDidDocumentBuilder returns a builder of the DID Document of the input DID
+func NewDidDocumentBuilder(didId string) *DidDocumentBuilder {
+	return &DidDocumentBuilder{
+		didDocument: &types.DidDocument{
+			Context:    []string{ldcontext.DidContext},
+			Id:         didId,
+			Controller: []string{},
+		},
+	}
+}
+
+// AddKeyPair adds the Verification Method of the key pair, controlled by the DID, under the next
+// "#key-<n>" fragment of the DID
+func (b *DidDocumentBuilder) AddKeyPair(keyPair *KeyPair, relationships ...VerificationRelationship) *DidDocumentBuilder {
+	vmId := fmt.Sprintf("%s#key-%d", b.didDocument.Id, len(b.didDocument.VerificationMethod)+1)
+	return b.AddVerificationMethod(NewVerificationMethod(vmId, b.didDocument.Id, keyPair), relationships...)
+}
+
+// AddVerificationMethod adds the Verification Method and lists its id under the input verification relationships
+func (b *DidDocumentBuilder) AddVerificationMethod(vm *types.VerificationMethod, relationships ...VerificationRelationship) *DidDocumentBuilder {
+	b.didDocument.VerificationMethod = append(b.didDocument.VerificationMethod, vm)
+	b.AddContext(GetVmContexts(vm.Type)...)
+
+	for _, relationship := range relationships {
+		switch relationship {
+		case Authentication:
+			b.didDocument.Authentication = append(b.didDocument.Authentication, vm.Id)
+		case AssertionMethod:
+			b.didDocument.AssertionMethod = append(b.didDocument.AssertionMethod, vm.Id)
+		case KeyAgreement:
+			b.didDocument.KeyAgreement = append(b.didDocument.KeyAgreement, vm.Id)
+		case CapabilityInvocation:
+			b.didDocument.CapabilityInvocation = append(b.didDocument.CapabilityInvocation, vm.Id)
+		case CapabilityDelegation:
+			b.didDocument.CapabilityDelegation = append(b.didDocument.CapabilityDelegation, vm.Id)
+		}
+	}
+	return b
+}
+
+// AddContext adds the JSON-LD contexts which are not already present in the DID Document
+func (b *DidDocumentBuilder) AddContext(contexts ...string) *DidDocumentBuilder {
+	b.didDocument.Context = appendContexts(b.didDocument.Context, contexts...)
+	return b
+}
+
+// AddController adds controllers of the DID Document
+func (b *DidDocumentBuilder) AddController(controllers ...string) *DidDocumentBuilder {
+	b.didDocument.Controller = append(b.didDocument.Controller, controllers...)
+	return b
+}
+
+// AddService adds a service endpoint of the DID
+func (b *DidDocumentBuilder) AddService(serviceId string, serviceType string, serviceEndpoint string) *DidDocumentBuilder {
+	b.didDocument.Service = append(b.didDocument.Service, &types.Service{
+		Id:              serviceId,
+		Type:            serviceType,
+		ServiceEndpoint: serviceEndpoint,
+	})
+	return b
+}
+
+// AddAlsoKnownAs adds alternate identifiers of the DID subject
+func (b *DidDocumentBuilder) AddAlsoKnownAs(identifiers ...string) *DidDocumentBuilder {
+	b.didDocument.AlsoKnownAs = append(b.didDocument.AlsoKnownAs, identifiers...)
+	return b
+}
+
+// Build validates and returns the DID Document
+func (b *DidDocumentBuilder) Build() (*types.DidDocument, error) {
+	if err := b.didDocument.ValidateDidDocument(); err != nil {
+		return nil, err
+	}
+	return b.didDocument, nil
+}
+
+// NewDidDocument returns the DID Document of a DID in the input chain namespace, whose method-specific-id
+// is derived from the key pair, and whose only Verification Method "#key-1" is that of the key pair
+func NewDidDocument(namespace string, keyPair *KeyPair) (*types.DidDocument, error) {
+	return NewDidDocumentBuilder(NewDidId(namespace, keyPair.MethodSpecificId())).
+		AddKeyPair(keyPair, Authentication, AssertionMethod).
+		Build()
+}
+
+func appendContexts(contexts []string, newContexts ...string) []string {
+	for _, newContext := range newContexts {
+		found := false
+		for _, context := range contexts {
+			if context == newContext {
+				found = true
+				break
+			}
+		}
+		if !found {
+			contexts = append(contexts, newContext)
+		}
+	}
+	return contexts
+}
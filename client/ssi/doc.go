@@ -0,0 +1,20 @@
+/*
+Package ssi is the Go client SDK of the x/ssi module of hid-node. It builds DID Documents, Credential
+Schemas and Credential Statuses, signs them with the same ld-context normalizers which hid-node uses to
+verify their proofs, and submits them to a hid-node.
+
+Key pairs of every supported Verification Method type are generated with GenerateKeyPair, and keys of a
+Cosmos SDK keyring are used through KeyringSigner. Both implement Signer, which CreateDocumentProof uses
+to sign SSI documents for any supported proof type and client spec:
+
+	keyPair, err := ssi.GenerateKeyPair(types.Ed25519VerificationKey2020)
+	didDocument, err := ssi.NewDidDocument("testnet", keyPair)
+	proof, err := ssi.CreateDocumentProof(didDocument, didDocument.VerificationMethod[0], keyPair, nil)
+
+	client := ssi.NewClient(clientCtx, txFactory)
+	res, err := client.RegisterDID(didDocument, proof)
+
+Cosmos ADR-036 and Ethereum personal_sign proofs are created by setting the ClientSpecType of ProofOptions,
+given that the Verification Method carries the blockchainAccountId of the signer.
+*/
+package ssi
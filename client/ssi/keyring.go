@@ -0,0 +1,110 @@
+package ssi
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/multiformats/go-multibase"
+)
+
+// KeyringSigner signs SSI documents with a secp256k1 key of a Cosmos SDK keyring, and creates
+// EcdsaSecp256k1Signature2019 proofs. The account which submits transactions to hid-node can thereby
+// control DIDs without exporting its private key.
+type KeyringSigner struct {
+	keyring keyring.Keyring
+	uid     string
+	pubKey  *secp256k1.PubKey
+}
+
+var _ Signer = &KeyringSigner{}
+
+// NewKeyringSigner returns the signer of the secp256k1 key stored under uid in the keyring
+func NewKeyringSigner(kr keyring.Keyring, uid string) (*KeyringSigner, error) {
+	record, err := kr.Key(uid)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	secp256k1PubKey, ok := pubKey.(*secp256k1.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("key %v is of type %v, expected a secp256k1 key", uid, pubKey.Type())
+	}
+
+	return &KeyringSigner{
+		keyring: kr,
+		uid:     uid,
+		pubKey:  secp256k1PubKey,
+	}, nil
+}
+
+// ProofType returns the type of the proofs created by the keyring signer
+func (s *KeyringSigner) ProofType() string {
+	return types.EcdsaSecp256k1Signature2019
+}
+
+// Sign signs the message with the keyring key and returns the base64 encoded signature
+func (s *KeyringSigner) Sign(message []byte) (string, error) {
+	signatureBytes, _, err := s.keyring.Sign(s.uid, message)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signatureBytes), nil
+}
+
+// Address returns the account address of the keyring key
+func (s *KeyringSigner) Address() sdk.AccAddress {
+	return sdk.AccAddress(s.pubKey.Address())
+}
+
+// PublicKeyMultibase returns the multibase encoded public key of the keyring key
+func (s *KeyringSigner) PublicKeyMultibase() (string, error) {
+	return multibase.Encode(multibase.Base58BTC, s.pubKey.Bytes())
+}
+
+// VerificationMethod returns the EcdsaSecp256k1VerificationKey2019 Verification Method of the keyring key. If
+// chainId is not empty, the Verification Method carries the CAIP-10 account of the key on that Cosmos chain,
+// which is required by Cosmos ADR-036 proofs.
+func (s *KeyringSigner) VerificationMethod(vmId string, controller string, chainId string) (*types.VerificationMethod, error) {
+	publicKeyMultibase, err := s.PublicKeyMultibase()
+	if err != nil {
+		return nil, err
+	}
+
+	vm := &types.VerificationMethod{
+		Id:                 vmId,
+		Type:               types.EcdsaSecp256k1VerificationKey2019,
+		Controller:         controller,
+		PublicKeyMultibase: publicKeyMultibase,
+	}
+	if chainId != "" {
+		vm.BlockchainAccountId, err = NewCosmosBlockchainAccountId(chainId, s.Address())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return vm, nil
+}
+
+// NewCosmosBlockchainAccountId returns the CAIP-10 account of the address on a supported Cosmos chain, whose
+// address is encoded with the bech32 prefix of that chain
+func NewCosmosBlockchainAccountId(chainId string, address sdk.AccAddress) (string, error) {
+	bech32Prefix, supported := types.CosmosCAIP10ChainIdBech32PrefixMap[chainId]
+	if !supported {
+		return "", fmt.Errorf("unsupported cosmos chain-id %v, supported chain-ids: %v", chainId, types.SupportedCAIP10CosmosChainIds)
+	}
+
+	bech32Address, err := bech32.ConvertAndEncode(bech32Prefix, address)
+	if err != nil {
+		return "", err
+	}
+	return types.CosmosCAIP10Prefix + ":" + chainId + ":" + bech32Address, nil
+}
@@ -0,0 +1,206 @@
+package ssi
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/multiformats/go-multibase"
+
+	ethercrypto "github.com/ethereum/go-ethereum/crypto"
+
+	bbs "github.com/hyperledger/aries-framework-go/component/kmscrypto/crypto/primitive/bbs12381g2pub"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+)
+
+// Signer creates the proof values of SSI documents
+type Signer interface {
+	// ProofType returns the type of the proofs created by the signer
+	ProofType() string
+	// Sign returns the encoded signature over the message
+	Sign(message []byte) (string, error)
+}
+
+// KeyPair is the key pair of a Verification Method. The private key is encoded the way the signing
+// function of its proof type expects it: base64 for Ed25519VerificationKey2020, EcdsaSecp256k1VerificationKey2019
+// and Bls12381G2Key2020 keys, and hex for EcdsaSecp256k1RecoveryMethod2020 and BabyJubJubKey2021 keys.
+type KeyPair struct {
+	// Type is the Verification Method type of the key pair
+	Type string
+	// PublicKeyMultibase is the multibase encoded public key. It is empty for EcdsaSecp256k1RecoveryMethod2020
+	// key pairs, which are identified by their Ethereum address.
+	PublicKeyMultibase string
+	// PrivateKey is the encoded private key
+	PrivateKey string
+	// EthereumAddress is the address of EcdsaSecp256k1RecoveryMethod2020 key pairs
+	EthereumAddress string
+}
+
+var _ Signer = &KeyPair{}
+
+// ProofType returns the type of the proofs created by the key pair
+func (kp *KeyPair) ProofType() string {
+	proofType, _ := GetSignatureTypeFromVmType(kp.Type)
+	return proofType
+}
+
+// Sign signs the message with the private key of the key pair
+func (kp *KeyPair) Sign(message []byte) (string, error) {
+	proofType, err := GetSignatureTypeFromVmType(kp.Type)
+	if err != nil {
+		return "", err
+	}
+	return GetSignature(proofType, kp.PrivateKey, message)
+}
+
+// MethodSpecificId returns the method-specific-id of a DID controlled by the key pair
+func (kp *KeyPair) MethodSpecificId() string {
+	if kp.EthereumAddress != "" {
+		return kp.EthereumAddress
+	}
+	return kp.PublicKeyMultibase
+}
+
+// CosmosAddress returns the Cosmos account address of EcdsaSecp256k1VerificationKey2019 key pairs
+func (kp *KeyPair) CosmosAddress() (sdk.AccAddress, error) {
+	if kp.Type != types.EcdsaSecp256k1VerificationKey2019 {
+		return nil, fmt.Errorf("key pair of type %v has no cosmos address", kp.Type)
+	}
+
+	_, publicKeyBytes, err := multibase.Decode(kp.PublicKeyMultibase)
+	if err != nil {
+		return nil, err
+	}
+	if len(publicKeyBytes) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("invalid secp256k1 public key length %v", len(publicKeyBytes))
+	}
+	return sdk.AccAddress(secp256k1.PubKey(publicKeyBytes).Address()), nil
+}
+
+// GenerateKeyPair generates a random key pair for the input Verification Method type
+func GenerateKeyPair(vmType string) (*KeyPair, error) {
+	switch vmType {
+	case types.Ed25519VerificationKey2020:
+		return GenerateEd25519KeyPair()
+	case types.EcdsaSecp256k1VerificationKey2019:
+		return GenerateSecp256k1KeyPair()
+	case types.EcdsaSecp256k1RecoveryMethod2020:
+		return GenerateSecp256k1RecoveryKeyPair()
+	case types.Bls12381G2Key2020:
+		return GenerateBbsBlsKeyPair()
+	case types.BabyJubJubKey2021:
+		return GenerateBabyJubJubKeyPair()
+	default:
+		return nil, fmt.Errorf("key pair generation is not supported for verification method type %v", vmType)
+	}
+}
+
+// GenerateEd25519KeyPair generates a random Ed25519VerificationKey2020 key pair
+func GenerateEd25519KeyPair() (*KeyPair, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ed25519 public keys are prefixed with their multicodec header
+	publicKeyWithHeader := append([]byte{0xed, 0x01}, publicKey...)
+
+	publicKeyMultibase, err := multibase.Encode(multibase.Base58BTC, publicKeyWithHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyPair{
+		Type:               types.Ed25519VerificationKey2020,
+		PublicKeyMultibase: publicKeyMultibase,
+		PrivateKey:         base64.StdEncoding.EncodeToString(privateKey),
+	}, nil
+}
+
+// GenerateSecp256k1KeyPair generates a random EcdsaSecp256k1VerificationKey2019 key pair
+func GenerateSecp256k1KeyPair() (*KeyPair, error) {
+	privateKey := secp256k1.GenPrivKey()
+
+	publicKeyMultibase, err := multibase.Encode(multibase.Base58BTC, privateKey.PubKey().Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyPair{
+		Type:               types.EcdsaSecp256k1VerificationKey2019,
+		PublicKeyMultibase: publicKeyMultibase,
+		PrivateKey:         base64.StdEncoding.EncodeToString(privateKey),
+	}, nil
+}
+
+// GenerateSecp256k1RecoveryKeyPair generates a random EcdsaSecp256k1RecoveryMethod2020 key pair
+func GenerateSecp256k1RecoveryKeyPair() (*KeyPair, error) {
+	privateKey := secp256k1.GenPrivKey()
+
+	publicKeyUncompressed, err := ethercrypto.DecompressPubkey(privateKey.PubKey().Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyPair{
+		Type:            types.EcdsaSecp256k1RecoveryMethod2020,
+		PrivateKey:      hex.EncodeToString(privateKey.Bytes()),
+		EthereumAddress: ethercrypto.PubkeyToAddress(*publicKeyUncompressed).Hex(),
+	}, nil
+}
+
+// GenerateBbsBlsKeyPair generates a random Bls12381G2Key2020 key pair
+func GenerateBbsBlsKeyPair() (*KeyPair, error) {
+	pubKey, privKey, err := bbs.GenerateKeyPair(sha256.New, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeyBytes, err := pubKey.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	publicKeyMultibase, err := multibase.Encode(multibase.Base58BTC, pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	privKeyBytes, err := privKey.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyPair{
+		Type:               types.Bls12381G2Key2020,
+		PublicKeyMultibase: publicKeyMultibase,
+		PrivateKey:         base64.StdEncoding.EncodeToString(privKeyBytes),
+	}, nil
+}
+
+// GenerateBabyJubJubKeyPair generates a random BabyJubJubKey2021 key pair
+func GenerateBabyJubJubKeyPair() (*KeyPair, error) {
+	privKey := babyjub.NewRandPrivKey()
+
+	pubKeyBytes, err := hex.DecodeString(privKey.Public().Compress().String())
+	if err != nil {
+		return nil, err
+	}
+	publicKeyMultibase, err := multibase.Encode(multibase.Base58BTC, pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var privKeyBytes [32]byte = privKey
+	return &KeyPair{
+		Type:               types.BabyJubJubKey2021,
+		PublicKeyMultibase: publicKeyMultibase,
+		PrivateKey:         hex.EncodeToString(privKeyBytes[:]),
+	}, nil
+}
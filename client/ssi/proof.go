@@ -0,0 +1,98 @@
+package ssi
+
+import (
+	"time"
+
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/verification"
+)
+
+const defaultProofPurpose = "assertionMethod"
+
+// ProofOptions are the optional attributes of Document Proofs. The zero value creates an
+// "assertionMethod" proof at the current time, over the canonical form of the document.
+type ProofOptions struct {
+	// Created is the creation date of the proof, which defaults to the current time
+	Created time.Time
+	// ProofPurpose is the purpose of the proof, which defaults to "assertionMethod"
+	ProofPurpose string
+	// ClientSpecType is the client specification, such as Cosmos ADR-036 or Ethereum personal_sign, by which the
+	// canonical form of the document is wrapped before signing it
+	ClientSpecType types.ClientSpecType
+}
+
+func newDocumentProof(vmId string, signer Signer, opts *ProofOptions) *types.DocumentProof {
+	if opts == nil {
+		opts = &ProofOptions{}
+	}
+
+	created := opts.Created
+	if created.IsZero() {
+		created = time.Now()
+	}
+	proofPurpose := opts.ProofPurpose
+	if proofPurpose == "" {
+		proofPurpose = defaultProofPurpose
+	}
+
+	return &types.DocumentProof{
+		Type:               signer.ProofType(),
+		Created:            created.UTC().Format(time.RFC3339),
+		VerificationMethod: vmId,
+		ProofPurpose:       proofPurpose,
+		ClientSpecType:     opts.ClientSpecType,
+	}
+}
+
+// CreateDocumentProof returns the proof of an SSI document created by the signer of the Verification Method.
+// The signed bytes are produced by the same ld-context normalizers, and client spec wrappers, which hid-node
+// uses to verify the proof. Cosmos ADR-036 proofs require the blockchainAccountId of the Verification Method.
+func CreateDocumentProof(doc types.SsiMsg, vm *types.VerificationMethod, signer Signer, opts *ProofOptions) (*types.DocumentProof, error) {
+	proof := newDocumentProof(vm.Id, signer, opts)
+
+	signingInput, err := verification.GetDocumentSigningInput(doc, vm, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	proof.ProofValue, err = signer.Sign(signingInput)
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// CreateCredentialProof returns the proof of a Verifiable Credential created by the signer of the Verification Method.
+// The proof attribute of the credential, if any, is not signed.
+func CreateCredentialProof(credential map[string]interface{}, vmId string, signer Signer, opts *ProofOptions) (*types.DocumentProof, error) {
+	proof := newDocumentProof(vmId, signer, opts)
+
+	signingInput, err := ldcontext.NormalizeCredentialByProofType(credential, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	proof.ProofValue, err = signer.Sign(signingInput)
+	if err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// SignCredential creates the proof of a Verifiable Credential and sets it as the proof attribute of the credential
+func SignCredential(credential map[string]interface{}, vmId string, signer Signer, opts *ProofOptions) error {
+	proof, err := CreateCredentialProof(credential, vmId, signer, opts)
+	if err != nil {
+		return err
+	}
+
+	credential["proof"] = map[string]interface{}{
+		"type":               proof.Type,
+		"created":            proof.Created,
+		"verificationMethod": proof.VerificationMethod,
+		"proofPurpose":       proof.ProofPurpose,
+		"proofValue":         proof.ProofValue,
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package ssi
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	secp256k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/multiformats/go-multibase"
+
+	etheraccounts "github.com/ethereum/go-ethereum/accounts"
+	etherhexutil "github.com/ethereum/go-ethereum/common/hexutil"
+	ethercrypto "github.com/ethereum/go-ethereum/crypto"
+
+	bbs "github.com/hyperledger/aries-framework-go/component/kmscrypto/crypto/primitive/bbs12381g2pub"
+
+	"github.com/iden3/go-iden3-crypto/babyjub"
+)
+
+// GetSignature signs a message with the private key, as per the input proof type, and returns the encoded signature
+func GetSignature(proofType string, privateKey string, message []byte) (string, error) {
+	switch proofType {
+	case types.Ed25519Signature2020:
+		return GetEd25519Signature2020(privateKey, message)
+	case types.EcdsaSecp256k1Signature2019:
+		return GetEcdsaSecp256k1Signature2019(privateKey, message)
+	case types.EcdsaSecp256k1RecoverySignature2020:
+		return GetEcdsaSecp256k1RecoverySignature2020(privateKey, message)
+	case types.BbsBlsSignature2020:
+		return GetBbsBlsSignature2020(privateKey, message)
+	case types.BJJSignature2021:
+		return GetBJJSignature2021(privateKey, message)
+	default:
+		return "", fmt.Errorf(
+			"unsupported proof type %v, supported proof types are: [%v, %v, %v, %v, %v]",
+			proofType,
+			types.Ed25519Signature2020,
+			types.EcdsaSecp256k1Signature2019,
+			types.EcdsaSecp256k1RecoverySignature2020,
+			types.BbsBlsSignature2020,
+			types.BJJSignature2021,
+		)
+	}
+}
+
+// GetSignatureTypeFromVmType returns the proof type of signatures created by keys of the input verification method type
+func GetSignatureTypeFromVmType(vmType string) (string, error) {
+	proofType, supported := types.VerificationKeySignatureMap[vmType]
+	if !supported || proofType == "" {
+		return "", fmt.Errorf("verification method type %v cannot be used to sign SSI documents", vmType)
+	}
+	return proofType, nil
+}
+
+// GetBbsBlsSignature2020 signs a message with a base64 encoded private key and returns a base64 encoded BBS signature
+func GetBbsBlsSignature2020(privateKey string, message []byte) (string, error) {
+	privKeyBytes, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	bbsObj := bbs.New()
+
+	signatureBytes, err := bbsObj.Sign([][]byte{message}, privKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(signatureBytes), nil
+}
+
+// GetBJJSignature2021 signs a message with a hex encoded BabyJubJub private key and returns a multibase
+// encoded Poseidon signature
+func GetBJJSignature2021(privateKey string, message []byte) (string, error) {
+	// Decode private key from hex
+	privateKeyBytes, err := hex.DecodeString(privateKey)
+	if err != nil {
+		return "", err
+	}
+	var privateKeyBytes32 [32]byte
+	copy(privateKeyBytes32[:], privateKeyBytes)
+
+	var privKeyObj babyjub.PrivateKey = privateKeyBytes32
+
+	msgBigInt := new(big.Int).SetBytes(message)
+
+	// Get Signature
+	signatureObj := privKeyObj.SignPoseidon(msgBigInt)
+	signatureHex := signatureObj.Compress().String()
+
+	// Convert Signature to multibase base58
+	signatureBytes, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return "", err
+	}
+
+	return multibase.Encode(multibase.Base58BTC, signatureBytes)
+}
+
+// GetEcdsaSecp256k1RecoverySignature2020 signs a message with a hex encoded secp256k1 private key, the way
+// Ethereum's personal_sign does, and returns a hex encoded recoverable signature
+func GetEcdsaSecp256k1RecoverySignature2020(privateKey string, message []byte) (string, error) {
+	// Decode key into bytes
+	privKeyBytes, err := hex.DecodeString(privateKey)
+	if err != nil {
+		return "", err
+	}
+	privKeyObject, err := ethercrypto.ToECDSA(privKeyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	// Hash the message
+	msgHash := etheraccounts.TextHash(message)
+
+	// Sign Message
+	sigBytes, err := ethercrypto.Sign(msgHash, privKeyObject)
+	if err != nil {
+		return "", err
+	}
+
+	return etherhexutil.Encode(sigBytes), nil
+}
+
+// GetEcdsaSecp256k1Signature2019 signs a message with a base64 encoded secp256k1 private key and returns a
+// base64 encoded signature
+func GetEcdsaSecp256k1Signature2019(privateKey string, message []byte) (string, error) {
+	// Decode key into bytes
+	privKeyBytes, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	// Convert private key string to Secp256k1 object
+	var privKeyObject secp256k1.PrivKey = privKeyBytes
+
+	// Sign Message
+	signature, err := privKeyObject.Sign(message)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// GetEd25519Signature2020 signs a message with a base64 encoded Ed25519 private key and returns a multibase
+// encoded signature
+func GetEd25519Signature2020(privateKey string, message []byte) (string, error) {
+	// Decode key into bytes
+	privKeyBytes, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil {
+		return "", err
+	}
+	if len(privKeyBytes) != ed25519.PrivateKeySize {
+		return "", fmt.Errorf("invalid Ed25519 private key length %v", len(privKeyBytes))
+	}
+
+	// Sign Message
+	signatureBytes := ed25519.Sign(privKeyBytes, message)
+
+	return multibase.Encode(multibase.Base58BTC, signatureBytes)
+}
@@ -3,7 +3,7 @@
 # Submit the proposal
 echo "Submitting the proposal"
 echo ""
-hid-noded tx gov submit-proposal param-change ./param-change-upgrade-no-vote.json --from node1 --yes
+hid-noded tx gov submit-proposal ./param-change-upgrade-no-vote.json --from node1 --yes
 sleep 7
 echo ""
 echo "Proposal is submitted"
@@ -22,13 +22,13 @@ echo "Waiting for the voting period to end...."
 echo ""
 sleep 65
 
-# Check if the slashing_downtime_fraction changes remains 0.365
-EXPECTED_SLASH_FRACTION_DOWNTIME='"'0.365000000000000000'"'
-ACTUAL_SLASH_FRACTION_DOWNTIME=$(hid-noded q slashing params --output json | jq '.slash_fraction_downtime')
+# Check if the register DID fee remains 8000uhid
+EXPECTED_REGISTER_DID_FEE='"'8000'"'
+ACTUAL_REGISTER_DID_FEE=$(hid-noded q ssi params --output json | jq '.params.register_did_fee.amount')
 
-if [ $ACTUAL_SLASH_FRACTION_DOWNTIME != $EXPECTED_SLASH_FRACTION_DOWNTIME ]; then
-    echo "Slash Fraction Downtime got updated to ${ACTUAL_SLASH_FRACTION_DOWNTIME}, it was supposed to be ${ACTUAL_SLASH_FRACTION_DOWNTIME}"
+if [ $ACTUAL_REGISTER_DID_FEE != $EXPECTED_REGISTER_DID_FEE ]; then
+    echo "Register DID Fee got updated to ${ACTUAL_REGISTER_DID_FEE}, it was supposed to be ${EXPECTED_REGISTER_DID_FEE}"
     exit 1
 else
-    echo "Updated Slash Fraction Remained same because of No Vote: ${ACTUAL_SLASH_FRACTION_DOWNTIME}"
+    echo "Register DID Fee remained same because of No Vote: ${ACTUAL_REGISTER_DID_FEE}"
 fi
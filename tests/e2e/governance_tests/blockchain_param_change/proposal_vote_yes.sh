@@ -3,7 +3,7 @@
 # Submit the proposal
 echo "Submitting the proposal"
 echo ""
-hid-noded tx gov submit-proposal param-change ./param-change-upgrade-yes-vote.json --from node1 --yes
+hid-noded tx gov submit-proposal ./param-change-upgrade-yes-vote.json --from node1 --yes
 sleep 7
 echo ""
 echo "Proposal is submitted"
@@ -22,13 +22,13 @@ echo "Waiting for the voting period to end...."
 echo ""
 sleep 65
 
-# Check if the slashing_downtime_fraction changes from 0.01 to 0.365
-EXPECTED_SLASH_FRACTION_DOWNTIME='"'0.365000000000000000'"'
-ACTUAL_SLASH_FRACTION_DOWNTIME=$(hid-noded q slashing params --output json | jq '.slash_fraction_downtime')
+# Check if the register DID fee changes from 4000uhid to 8000uhid
+EXPECTED_REGISTER_DID_FEE='"'8000'"'
+ACTUAL_REGISTER_DID_FEE=$(hid-noded q ssi params --output json | jq '.params.register_did_fee.amount')
 
-if [ $ACTUAL_SLASH_FRACTION_DOWNTIME != $EXPECTED_SLASH_FRACTION_DOWNTIME ]; then
-    echo "Slash Fraction Downtime did not update to ${EXPECTED_SLASH_FRACTION_DOWNTIME}, got ${ACTUAL_SLASH_FRACTION_DOWNTIME}"
+if [ $ACTUAL_REGISTER_DID_FEE != $EXPECTED_REGISTER_DID_FEE ]; then
+    echo "Register DID Fee did not update to ${EXPECTED_REGISTER_DID_FEE}, got ${ACTUAL_REGISTER_DID_FEE}"
     exit 1
 else
-    echo "Updated Slash Fraction Downtime: ${ACTUAL_SLASH_FRACTION_DOWNTIME}"
+    echo "Updated Register DID Fee: ${ACTUAL_REGISTER_DID_FEE}"
 fi
@@ -0,0 +1,23 @@
+#!/bin/bash
+
+# Broadcasts a transaction, waits for it to be included in a block and prints the JSON result
+# of the transaction. The result has a non-zero code if the transaction could not be found.
+broadcast_tx() {
+    # $1 binary | $2 node | $3... tx command and its arguments
+
+    local BINARY=$1
+    local NODE=$2
+    shift 2
+
+    local TX_HASH=$(${BINARY} tx "$@" --node ${NODE} --broadcast-mode sync --output json --yes | jq -r '.txhash')
+    for i in $(seq 1 30); do
+        sleep 1
+        local TX_RESULT=$(${BINARY} q tx ${TX_HASH} --node ${NODE} --output json 2> /dev/null)
+        if [ -n "${TX_RESULT}" ]; then
+            echo ${TX_RESULT}
+            return 0
+        fi
+    done
+
+    echo "{\"code\":1,\"txhash\":\"${TX_HASH}\"}"
+}
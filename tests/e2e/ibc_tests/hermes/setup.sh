@@ -1,5 +1,6 @@
 #!/bin/bash
 
+. ./common.sh
 
 # Copy config.toml to hermes config directory 
 HERMES_HOME="$HOME/.hermes"
@@ -15,8 +16,8 @@ echo "Add osmosis ibc relayer key"
 hermes keys add --key-file ./hermes/test_keys/ibc_relayer_osmosis.json --chain osmosischain
 
 # Provide some tokens to relayer accounts ( $1 - hid-node relayer ; $2 - osmosis relayer )
-hid-noded tx bank send $1 hid18t0uj2t9us7ufny0pdk94jvjt9mjtj9p72uzuq 1000000uhid --broadcast-mode block  --keyring-backend test --chain-id hidnode --yes
-osmosisd tx bank send $2 osmo15w294mm9jm68ty5edw6l9wdr0nx8eswyg5fr66 1000000uosmo --broadcast-mode block --node tcp://localhost:36657 --yes
+broadcast_tx hid-noded tcp://localhost:26657 bank send $1 hid18t0uj2t9us7ufny0pdk94jvjt9mjtj9p72uzuq 1000000uhid --keyring-backend test --chain-id hidnode
+broadcast_tx osmosisd tcp://localhost:36657 bank send $2 osmo15w294mm9jm68ty5edw6l9wdr0nx8eswyg5fr66 1000000uosmo
 
 echo ""
 echo "Create hermes channel"
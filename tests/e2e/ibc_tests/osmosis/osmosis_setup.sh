@@ -31,6 +31,9 @@ cat $HOME/.osmosisd/config/genesis.json | jq '.app_state["txfees"]["basedenom"]=
 # update epochs genesis
 cat $HOME/.osmosisd/config/genesis.json | jq '.app_state["epochs"]["epochs"][1]["duration"]="60s"' > $HOME/.osmosisd/config/tmp_genesis.json && mv $HOME/.osmosisd/config/tmp_genesis.json $HOME/.osmosisd/config/genesis.json
 
+# update wasm genesis, so that the ssi query contract can be uploaded
+cat $HOME/.osmosisd/config/genesis.json | jq '.app_state["wasm"]["params"]["code_upload_access"]["permission"]="Everybody"' > $HOME/.osmosisd/config/tmp_genesis.json && mv $HOME/.osmosisd/config/tmp_genesis.json $HOME/.osmosisd/config/genesis.json
+
 # create validator node with tokens
 osmosisd add-genesis-account $(osmosisd keys show osmonode1 -a --keyring-backend=test --home=$HOME/.osmosisd) 100000000000uosmo --home=$HOME/.osmosisd
 osmosisd gentx osmonode1 500000000uosmo --keyring-backend=test --home=$HOME/.osmosisd --chain-id=osmosischain
@@ -1,5 +1,24 @@
 #!/bin/bash
 
+. ./common.sh
+
+HID_NODE_RPC=tcp://localhost:26657
+OSMOSIS_RPC=tcp://localhost:36657
+
+# Build the ssi query contract, which is the counterparty of the ssi port of hid-node
+echo "Building ssi query contract"
+echo ""
+docker run --rm -v "$(pwd)/ssi_query_contract":/code \
+  --mount type=volume,source=ssi_query_contract_cache,target=/target \
+  --mount type=volume,source=registry_cache,target=/usr/local/cargo/registry \
+  cosmwasm/optimizer:0.15.0
+SSI_QUERY_CONTRACT_WASM=./ssi_query_contract/artifacts/ssi_query_contract.wasm
+if [ ! -f ${SSI_QUERY_CONTRACT_WASM} ]; then
+  echo "ssi query contract could not be built, exiting...."
+  exit 1
+fi
+echo ""
+
 # Run HID-Node Chain
 echo "Setting up hid-node chain"
 echo ""
@@ -60,7 +79,7 @@ echo ""
 
 echo "Transferring tokens from HID Node to Osmosis"
 echo ""
-IBC_TRANSFER_RESULT=$(hid-noded tx ibc-transfer transfer transfer channel-0 ${OSMOSIS_VALIDATOR_WALLET} 1234uhid --broadcast-mode block --from ${HID_NODE_VALIDATOR_WALLET} --output json --keyring-backend test --chain-id hidnode --yes)
+IBC_TRANSFER_RESULT=$(broadcast_tx hid-noded ${HID_NODE_RPC} ibc-transfer transfer transfer channel-0 ${OSMOSIS_VALIDATOR_WALLET} 1234uhid --from ${HID_NODE_VALIDATOR_WALLET} --keyring-backend test --chain-id hidnode)
 
 CODE=$(echo ${IBC_TRANSFER_RESULT} | jq '.code')
 TXHASH=$(echo ${IBC_TRANSFER_RESULT} | jq '.txhash')
@@ -72,6 +91,149 @@ else
   exit 1
 fi
 
+# Register a DID and a Credential Status on HID Node, which are queried over the ssi channel
+echo "Registering DID and Credential Status on HID Node"
+echo ""
+ISSUER_KEYS=$(hid-noded debug ed25519 random)
+ISSUER_PUBLIC_KEY=$(echo ${ISSUER_KEYS} | jq -r '.pub_key_multibase')
+ISSUER_PRIVATE_KEY=$(echo ${ISSUER_KEYS} | jq -r '.priv_key_base_64')
+DID_ID="did:hid:devnet:${ISSUER_PUBLIC_KEY}"
+VERIFICATION_METHOD_ID="${DID_ID}#key-1"
+
+DID_DOCUMENT=$(jq -nc --arg id ${DID_ID} --arg vm ${VERIFICATION_METHOD_ID} --arg key ${ISSUER_PUBLIC_KEY} '{
+  "@context": ["https://www.w3.org/ns/did/v1", "https://w3id.org/security/suites/ed25519-2020/v1"],
+  "id": $id,
+  "controller": [],
+  "verificationMethod": [{"id": $vm, "type": "Ed25519VerificationKey2020", "controller": $id, "publicKeyMultibase": $key}]
+}')
+DOCUMENT_PROOF=$(jq -nc --arg vm ${VERIFICATION_METHOD_ID} '{
+  "type": "Ed25519Signature2020",
+  "created": "2023-08-16T09:37:12Z",
+  "verificationMethod": $vm,
+  "proofPurpose": "assertionMethod"
+}')
+DID_DOCUMENT_SIGNATURE=$(hid-noded debug sign-ssi-doc did-doc "${DID_DOCUMENT}" ${ISSUER_PRIVATE_KEY} "${DOCUMENT_PROOF}")
+DID_DOCUMENT_PROOF=$(echo ${DOCUMENT_PROOF} | jq -c --arg signature ${DID_DOCUMENT_SIGNATURE} '.proofValue=$signature')
+
+REGISTER_DID_RESULT=$(broadcast_tx hid-noded ${HID_NODE_RPC} ssi register-did "${DID_DOCUMENT}" "${DID_DOCUMENT_PROOF}" --from ${HID_NODE_VALIDATOR_WALLET} --fees 4000uhid --keyring-backend test --chain-id hidnode)
+if [ $(echo ${REGISTER_DID_RESULT} | jq '.code') -ne 0 ]; then
+  echo "DID could not be registered on HID Node. Result: ${REGISTER_DID_RESULT}"
+  exit 1
+fi
+
+CREDENTIAL_ID="vc:hid:devnet:${ISSUER_PUBLIC_KEY}"
+CREDENTIAL_STATUS=$(jq -nc --arg id ${CREDENTIAL_ID} --arg issuer ${DID_ID} --arg hash $(echo -n "Hash1234" | sha256sum | cut -d " " -f 1) '{
+  "@context": ["https://raw.githubusercontent.com/hypersign-protocol/hypersign-contexts/main/CredentialStatus.jsonld", "https://w3id.org/security/suites/ed25519-2020/v1"],
+  "id": $id,
+  "revoked": false,
+  "suspended": false,
+  "remarks": "Live",
+  "issuer": $issuer,
+  "issuanceDate": "2022-04-10T04:07:12Z",
+  "credentialMerkleRootHash": $hash
+}')
+CREDENTIAL_STATUS_SIGNATURE=$(hid-noded debug sign-ssi-doc cred-status-doc "${CREDENTIAL_STATUS}" ${ISSUER_PRIVATE_KEY} "${DOCUMENT_PROOF}")
+CREDENTIAL_STATUS_PROOF=$(echo ${DOCUMENT_PROOF} | jq -c --arg signature ${CREDENTIAL_STATUS_SIGNATURE} '.proofValue=$signature')
+
+REGISTER_CREDENTIAL_STATUS_RESULT=$(broadcast_tx hid-noded ${HID_NODE_RPC} ssi register-credential-status "${CREDENTIAL_STATUS}" "${CREDENTIAL_STATUS_PROOF}" --from ${HID_NODE_VALIDATOR_WALLET} --fees 2000uhid --keyring-backend test --chain-id hidnode)
+if [ $(echo ${REGISTER_CREDENTIAL_STATUS_RESULT} | jq '.code') -ne 0 ]; then
+  echo "Credential Status could not be registered on HID Node. Result: ${REGISTER_CREDENTIAL_STATUS_RESULT}"
+  exit 1
+fi
+echo "DID ${DID_ID} and Credential Status ${CREDENTIAL_ID} are registered"
+echo ""
+
+# Deploy the ssi query contract on Osmosis. Its IBC port speaks the ssi-1 version of the ssi port of hid-node.
+echo "Deploying ssi query contract on Osmosis"
+echo ""
+STORE_CODE_RESULT=$(broadcast_tx osmosisd ${OSMOSIS_RPC} wasm store ${SSI_QUERY_CONTRACT_WASM} --from osmonode1 --gas auto --gas-adjustment 1.5)
+if [ $(echo ${STORE_CODE_RESULT} | jq '.code') -ne 0 ]; then
+  echo "ssi query contract could not be uploaded to Osmosis. Result: ${STORE_CODE_RESULT}"
+  exit 1
+fi
+CODE_ID=$(osmosisd q wasm list-code --node ${OSMOSIS_RPC} --output json | jq -r '.code_infos[-1].code_id')
+
+INSTANTIATE_RESULT=$(broadcast_tx osmosisd ${OSMOSIS_RPC} wasm instantiate ${CODE_ID} '{}' --label ssi-query-contract --no-admin --from osmonode1 --gas auto --gas-adjustment 1.5)
+if [ $(echo ${INSTANTIATE_RESULT} | jq '.code') -ne 0 ]; then
+  echo "ssi query contract could not be instantiated on Osmosis. Result: ${INSTANTIATE_RESULT}"
+  exit 1
+fi
+SSI_QUERY_CONTRACT=$(osmosisd q wasm list-contract-by-code ${CODE_ID} --node ${OSMOSIS_RPC} --output json | jq -r '.contracts[-1]')
+SSI_QUERY_CONTRACT_PORT=$(osmosisd q wasm contract ${SSI_QUERY_CONTRACT} --node ${OSMOSIS_RPC} --output json | jq -r '.contract_info.ibc_port_id')
+echo "ssi query contract ${SSI_QUERY_CONTRACT} is bound to port ${SSI_QUERY_CONTRACT_PORT}"
+echo ""
+
+echo "Creating ssi channel between Osmosis and HID Node"
+echo ""
+hermes create channel --a-chain osmosischain --a-connection connection-0 --a-port ${SSI_QUERY_CONTRACT_PORT} --b-port ssi --order unordered --channel-version ssi-1
+
+HID_NODE_SSI_CHANNEL=$(hid-noded q ibc channel channels --node ${HID_NODE_RPC} --output json | jq -c '.channels[] | select(.port_id=="ssi")')
+OSMOSIS_SSI_CHANNEL=$(osmosisd q ibc channel channels --node ${OSMOSIS_RPC} --output json | jq -c --arg port ${SSI_QUERY_CONTRACT_PORT} '.channels[] | select(.port_id==$port)')
+if [ "$(echo ${HID_NODE_SSI_CHANNEL} | jq -r '.state')" == "STATE_OPEN" ] && \
+  [ "$(echo ${HID_NODE_SSI_CHANNEL} | jq -r '.version')" == "ssi-1" ] && \
+  [ "$(echo ${HID_NODE_SSI_CHANNEL} | jq -r '.counterparty.port_id')" == "${SSI_QUERY_CONTRACT_PORT}" ] && \
+  [ "$(echo ${OSMOSIS_SSI_CHANNEL} | jq -r '.state')" == "STATE_OPEN" ] && \
+  [ "$(echo ${OSMOSIS_SSI_CHANNEL} | jq -r '.version')" == "ssi-1" ]; then
+  echo "ssi channel is open on both HID Node and Osmosis"
+  echo ""
+else
+  echo "ssi channel handshake did not complete. HID Node channel: ${HID_NODE_SSI_CHANNEL} Osmosis channel: ${OSMOSIS_SSI_CHANNEL}"
+  exit 1
+fi
+
+echo "Sending DID resolution and Credential Status packets from Osmosis"
+echo ""
+RESOLVE_DID_MSG=$(jq -nc --arg id ${DID_ID} '{"resolve_did": {"did_id": $id}}')
+RESOLVE_DID_RESULT=$(broadcast_tx osmosisd ${OSMOSIS_RPC} wasm execute ${SSI_QUERY_CONTRACT} "${RESOLVE_DID_MSG}" --from osmonode1 --gas auto --gas-adjustment 1.5)
+if [ $(echo ${RESOLVE_DID_RESULT} | jq '.code') -ne 0 ]; then
+  echo "DID resolution packet could not be sent. Result: ${RESOLVE_DID_RESULT}"
+  exit 1
+fi
+
+CREDENTIAL_STATUS_MSG=$(jq -nc --arg id ${CREDENTIAL_ID} '{"query_credential_status": {"cred_id": $id}}')
+CREDENTIAL_STATUS_PACKET_RESULT=$(broadcast_tx osmosisd ${OSMOSIS_RPC} wasm execute ${SSI_QUERY_CONTRACT} "${CREDENTIAL_STATUS_MSG}" --from osmonode1 --gas auto --gas-adjustment 1.5)
+if [ $(echo ${CREDENTIAL_STATUS_PACKET_RESULT} | jq '.code') -ne 0 ]; then
+  echo "Credential Status packet could not be sent. Result: ${CREDENTIAL_STATUS_PACKET_RESULT}"
+  exit 1
+fi
+
+# Hermes relays both packets to HID Node, and their acknowledgements back to the contract
+echo "Waiting for the acknowledgements of HID Node"
+echo ""
+for i in $(seq 1 60); do
+  ACKNOWLEDGEMENTS=$(osmosisd q wasm contract-state smart ${SSI_QUERY_CONTRACT} '{"acknowledgements":{}}' --node ${OSMOSIS_RPC} --output json | jq -c '.data.acknowledgements')
+  if [ "$(echo ${ACKNOWLEDGEMENTS} | jq 'length')" == "2" ]; then
+    break
+  fi
+  sleep 1
+done
+
+DID_RESOLUTION_ACK=$(echo ${ACKNOWLEDGEMENTS} | jq -c '.[] | select(.packet_type=="did_resolution")')
+if [ "$(echo ${DID_RESOLUTION_ACK} | jq -r '.error')" == "null" ] && \
+  [ "$(echo ${DID_RESOLUTION_ACK} | jq -r '.result | fromjson | .didResolution.didDocument.id')" == "${DID_ID}" ] && \
+  [ "$(echo ${DID_RESOLUTION_ACK} | jq -r '.result | fromjson | .didResolution.didDocument.verificationMethod[0].publicKeyMultibase')" == "${ISSUER_PUBLIC_KEY}" ] && \
+  [ "$(echo ${DID_RESOLUTION_ACK} | jq -r '.result | fromjson | .didResolution.didResolutionMetadata.error')" == "" ] && \
+  [ "$(echo ${DID_RESOLUTION_ACK} | jq -r '.result | fromjson | .height | tonumber > 0')" == "true" ]; then
+  echo "DID ${DID_ID} is resolved over IBC"
+  echo ""
+else
+  echo "DID resolution acknowledgement does not have the registered DID. Acknowledgements: ${ACKNOWLEDGEMENTS}"
+  exit 1
+fi
+
+CREDENTIAL_STATUS_ACK=$(echo ${ACKNOWLEDGEMENTS} | jq -c '.[] | select(.packet_type=="credential_status")')
+if [ "$(echo ${CREDENTIAL_STATUS_ACK} | jq -r '.error')" == "null" ] && \
+  [ "$(echo ${CREDENTIAL_STATUS_ACK} | jq -r '.result | fromjson | .credentialStatus.credentialStatusDocument.id')" == "${CREDENTIAL_ID}" ] && \
+  [ "$(echo ${CREDENTIAL_STATUS_ACK} | jq -r '.result | fromjson | .credentialStatus.credentialStatusDocument.issuer')" == "${DID_ID}" ] && \
+  [ "$(echo ${CREDENTIAL_STATUS_ACK} | jq -r '.result | fromjson | .credentialStatus.credentialStatusDocument.revoked')" == "false" ] && \
+  [ "$(echo ${CREDENTIAL_STATUS_ACK} | jq -r '.result | fromjson | .height | tonumber > 0')" == "true" ]; then
+  echo "Credential Status ${CREDENTIAL_ID} is queried over IBC"
+  echo ""
+else
+  echo "Credential Status acknowledgement does not have the registered Credential Status. Acknowledgements: ${ACKNOWLEDGEMENTS}"
+  exit 1
+fi
+
 echo "Stopping hid-node chain"
 echo ""
 kill -9 $(lsof -t -i:26657)
@@ -0,0 +1,247 @@
+//! Counterparty of the hid-node ssi IBC application. The contract opens an `ssi-1` channel with the
+//! ssi port of hid-node, sends DID resolution and credential status packets over it, and records the
+//! acknowledgements, so that the IBC e2e tests can assert on them.
+
+use cosmwasm_std::{
+    entry_point, from_json, to_json_binary, Binary, Deps, DepsMut, Env, Ibc3ChannelOpenResponse,
+    IbcBasicResponse, IbcChannelCloseMsg, IbcChannelConnectMsg, IbcChannelOpenMsg,
+    IbcChannelOpenResponse, IbcMsg, IbcOrder, IbcPacketAckMsg, IbcPacketReceiveMsg,
+    IbcPacketTimeoutMsg, IbcReceiveResponse, IbcTimeout, MessageInfo, Order, Response, StdError,
+    StdResult,
+};
+use cw_storage_plus::{Item, Map};
+use serde::{Deserialize, Serialize};
+
+/// Version of the hid-node ssi IBC application
+pub const SSI_VERSION: &str = "ssi-1";
+
+/// Packets which are not relayed within this duration time out
+const PACKET_LIFETIME_SECONDS: u64 = 600;
+
+const CHANNEL: Item<String> = Item::new("channel");
+const ACKNOWLEDGEMENTS: Map<u64, AcknowledgementRecord> = Map::new("acknowledgements");
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+pub struct InstantiateMsg {}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+#[serde(rename_all = "snake_case")]
+pub enum ExecuteMsg {
+    /// Sends a DID resolution packet to hid-node
+    ResolveDid { did_id: String },
+    /// Sends a credential status packet to hid-node
+    QueryCredentialStatus { cred_id: String },
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+#[serde(rename_all = "snake_case")]
+pub enum QueryMsg {
+    /// Returns the id of the connected ssi channel
+    Channel {},
+    /// Returns the acknowledgements received from hid-node, ordered by packet sequence
+    Acknowledgements {},
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+pub struct AcknowledgementsResponse {
+    pub acknowledgements: Vec<AcknowledgementRecord>,
+}
+
+/// Outcome of a packet sent to hid-node. `result` holds the JSON encoded DidResolutionPacketAck or
+/// CredentialStatusPacketAck of a successful acknowledgement.
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+pub struct AcknowledgementRecord {
+    pub sequence: u64,
+    pub packet_type: String,
+    pub result: Option<String>,
+    pub error: Option<String>,
+}
+
+/// JSON encoding of hypersign.ssi.v1.SsiPacketData
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+enum SsiPacketData {
+    #[serde(rename = "didResolutionPacket")]
+    DidResolutionPacket {
+        #[serde(rename = "didId")]
+        did_id: String,
+    },
+    #[serde(rename = "credentialStatusPacket")]
+    CredentialStatusPacket {
+        #[serde(rename = "credId")]
+        cred_id: String,
+    },
+}
+
+impl SsiPacketData {
+    fn packet_type(&self) -> &'static str {
+        match self {
+            SsiPacketData::DidResolutionPacket { .. } => "did_resolution",
+            SsiPacketData::CredentialStatusPacket { .. } => "credential_status",
+        }
+    }
+}
+
+/// JSON encoding of the ibc-go channel acknowledgement
+#[derive(Deserialize)]
+struct Acknowledgement {
+    result: Option<Binary>,
+    error: Option<String>,
+}
+
+#[entry_point]
+pub fn instantiate(
+    _deps: DepsMut,
+    _env: Env,
+    _info: MessageInfo,
+    _msg: InstantiateMsg,
+) -> StdResult<Response> {
+    Ok(Response::new().add_attribute("action", "instantiate"))
+}
+
+#[entry_point]
+pub fn execute(deps: DepsMut, env: Env, _info: MessageInfo, msg: ExecuteMsg) -> StdResult<Response> {
+    let packet = match msg {
+        ExecuteMsg::ResolveDid { did_id } => SsiPacketData::DidResolutionPacket { did_id },
+        ExecuteMsg::QueryCredentialStatus { cred_id } => {
+            SsiPacketData::CredentialStatusPacket { cred_id }
+        }
+    };
+    let channel_id = CHANNEL
+        .may_load(deps.storage)?
+        .ok_or_else(|| StdError::generic_err("ssi channel is not connected"))?;
+
+    Ok(Response::new()
+        .add_attribute("action", "send_ssi_packet")
+        .add_attribute("packet_type", packet.packet_type())
+        .add_message(IbcMsg::SendPacket {
+            channel_id,
+            data: to_json_binary(&packet)?,
+            timeout: IbcTimeout::with_timestamp(env.block.time.plus_seconds(PACKET_LIFETIME_SECONDS)),
+        }))
+}
+
+#[entry_point]
+pub fn query(deps: Deps, _env: Env, msg: QueryMsg) -> StdResult<Binary> {
+    match msg {
+        QueryMsg::Channel {} => to_json_binary(&CHANNEL.may_load(deps.storage)?),
+        QueryMsg::Acknowledgements {} => {
+            let acknowledgements = ACKNOWLEDGEMENTS
+                .range(deps.storage, None, None, Order::Ascending)
+                .map(|item| item.map(|(_, record)| record))
+                .collect::<StdResult<Vec<_>>>()?;
+            to_json_binary(&AcknowledgementsResponse { acknowledgements })
+        }
+    }
+}
+
+#[entry_point]
+pub fn ibc_channel_open(
+    _deps: DepsMut,
+    _env: Env,
+    msg: IbcChannelOpenMsg,
+) -> StdResult<IbcChannelOpenResponse> {
+    let channel = msg.channel();
+    if channel.order != IbcOrder::Unordered {
+        return Err(StdError::generic_err("ssi channels must be unordered"));
+    }
+    if channel.version != SSI_VERSION {
+        return Err(StdError::generic_err(format!(
+            "invalid channel version: got {}, expected {}",
+            channel.version, SSI_VERSION
+        )));
+    }
+    if let Some(version) = msg.counterparty_version() {
+        if version != SSI_VERSION {
+            return Err(StdError::generic_err(format!(
+                "invalid counterparty version: got {}, expected {}",
+                version, SSI_VERSION
+            )));
+        }
+    }
+
+    Ok(Some(Ibc3ChannelOpenResponse {
+        version: SSI_VERSION.to_string(),
+    }))
+}
+
+#[entry_point]
+pub fn ibc_channel_connect(
+    deps: DepsMut,
+    _env: Env,
+    msg: IbcChannelConnectMsg,
+) -> StdResult<IbcBasicResponse> {
+    let channel_id = msg.channel().endpoint.channel_id.clone();
+    CHANNEL.save(deps.storage, &channel_id)?;
+
+    Ok(IbcBasicResponse::new()
+        .add_attribute("action", "ibc_channel_connect")
+        .add_attribute("channel_id", channel_id))
+}
+
+#[entry_point]
+pub fn ibc_channel_close(
+    deps: DepsMut,
+    _env: Env,
+    _msg: IbcChannelCloseMsg,
+) -> StdResult<IbcBasicResponse> {
+    CHANNEL.remove(deps.storage);
+    Ok(IbcBasicResponse::new().add_attribute("action", "ibc_channel_close"))
+}
+
+/// hid-node does not send packets over the ssi port
+#[entry_point]
+pub fn ibc_packet_receive(
+    _deps: DepsMut,
+    _env: Env,
+    _msg: IbcPacketReceiveMsg,
+) -> StdResult<IbcReceiveResponse> {
+    Ok(IbcReceiveResponse::new()
+        .set_ack(br#"{"error":"ssi query contract does not receive packets"}"#.to_vec())
+        .add_attribute("action", "ibc_packet_receive"))
+}
+
+#[entry_point]
+pub fn ibc_packet_ack(
+    deps: DepsMut,
+    _env: Env,
+    msg: IbcPacketAckMsg,
+) -> StdResult<IbcBasicResponse> {
+    let packet: SsiPacketData = from_json(&msg.original_packet.data)?;
+    let ack: Acknowledgement = from_json(&msg.acknowledgement.data)?;
+    let result = ack
+        .result
+        .map(|result| String::from_utf8(result.to_vec()))
+        .transpose()
+        .map_err(|err| StdError::invalid_utf8(err.to_string()))?;
+
+    let record = AcknowledgementRecord {
+        sequence: msg.original_packet.sequence,
+        packet_type: packet.packet_type().to_string(),
+        result,
+        error: ack.error,
+    };
+    ACKNOWLEDGEMENTS.save(deps.storage, record.sequence, &record)?;
+
+    Ok(IbcBasicResponse::new()
+        .add_attribute("action", "ibc_packet_ack")
+        .add_attribute("packet_type", record.packet_type))
+}
+
+#[entry_point]
+pub fn ibc_packet_timeout(
+    deps: DepsMut,
+    _env: Env,
+    msg: IbcPacketTimeoutMsg,
+) -> StdResult<IbcBasicResponse> {
+    let packet: SsiPacketData = from_json(&msg.packet.data)?;
+
+    let record = AcknowledgementRecord {
+        sequence: msg.packet.sequence,
+        packet_type: packet.packet_type().to_string(),
+        result: None,
+        error: Some("packet timed out".to_string()),
+    };
+    ACKNOWLEDGEMENTS.save(deps.storage, record.sequence, &record)?;
+
+    Ok(IbcBasicResponse::new().add_attribute("action", "ibc_packet_timeout"))
+}
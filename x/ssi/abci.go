@@ -0,0 +1,20 @@
+package ssi
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+)
+
+// EndBlocker marks the Credential Statuses whose expiration date has passed as expired, and executes
+// the pending DID recoveries which have taken effect
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if err := k.ExpireCredentialStatuses(ctx); err != nil {
+		panic(fmt.Sprintf("failed to expire credential statuses: %v", err))
+	}
+
+	if err := k.ExecuteDidRecoveries(ctx); err != nil {
+		panic(fmt.Sprintf("failed to execute DID recoveries: %v", err))
+	}
+}
@@ -3,6 +3,7 @@ package ante
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	ssitypes "github.com/hypersign-protocol/hid-node/x/ssi/types"
 )
 
 type AccountKeeper interface {
@@ -21,5 +22,5 @@ type FeegrantKeeper interface {
 }
 
 type SsiKeeper interface {
-	GetFeeParams(ctx sdk.Context, ssiParamStoreKey []byte) sdk.Coin
+	GetParams(ctx sdk.Context) ssitypes.Params
 }
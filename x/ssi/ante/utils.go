@@ -25,14 +25,30 @@ func isSSIMsg(msg sdk.Msg) bool {
 		return true
 	case *ssitypes.MsgDeactivateDID:
 		return true
+	case *ssitypes.MsgInitiateDidRecovery:
+		return true
+	case *ssitypes.MsgCancelDidRecovery:
+		return true
+	case *ssitypes.MsgMarkVerificationMethodCompromised:
+		return true
 	case *ssitypes.MsgRegisterCredentialSchema:
 		return true
 	case *ssitypes.MsgUpdateCredentialSchema:
 		return true
+	case *ssitypes.MsgUpdateCredentialSchemaStatus:
+		return true
 	case *ssitypes.MsgRegisterCredentialStatus:
 		return true
 	case *ssitypes.MsgUpdateCredentialStatus:
 		return true
+	case *ssitypes.MsgRegisterCredentialStatusBatch:
+		return true
+	case *ssitypes.MsgRegisterCredentialStatusList:
+		return true
+	case *ssitypes.MsgUpdateCredentialStatusList:
+		return true
+	case *ssitypes.MsgRegisterAccreditation:
+		return true
 	default:
 		return false
 	}
@@ -21,8 +21,25 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdGetSchema())
 	cmd.AddCommand(CmdResolveDID())
+	cmd.AddCommand(CmdW3CResolveDID())
+	cmd.AddCommand(CmdDereferenceDIDUrl())
+	cmd.AddCommand(CmdResolveDIDVersions())
+	cmd.AddCommand(CmdGetDIDsByController())
+	cmd.AddCommand(CmdGetDIDByBlockchainAccountId())
+	cmd.AddCommand(CmdGetPendingDidRecovery())
+	cmd.AddCommand(CmdGetPendingDidRecoveries())
+	cmd.AddCommand(CmdGetSchemasByAuthor())
 	cmd.AddCommand(CmdGetCredentialStatus())
+	cmd.AddCommand(CmdGetCredentialStatusesByIssuer())
+	cmd.AddCommand(CmdGetCredentialStatusBatch())
+	cmd.AddCommand(CmdGetCredentialStatusList())
+	cmd.AddCommand(CmdGetAccreditation())
+	cmd.AddCommand(CmdGetIssuerAccreditation())
+	cmd.AddCommand(CmdVerifyDocumentProof())
+	cmd.AddCommand(CmdValidateSSIMsg())
+	cmd.AddCommand(CmdVerifyCredential())
 	cmd.AddCommand(cmdListFees())
+	cmd.AddCommand(CmdQueryParams())
 
 	return cmd
 }
@@ -1,16 +1,29 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/hypersign-protocol/hid-node/x/ssi/types"
 	"github.com/spf13/cobra"
 )
 
 var _ = strconv.Itoa(0)
 
+const (
+	versionIdFlag   = "version-id"
+	versionTimeFlag = "version-time"
+	acceptFlag      = "accept"
+	proveFlag       = "prove"
+
+	schemaVersionFlag = "version"
+	latestFlag        = "latest"
+)
+
 func cmdListFees() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-fees",
@@ -36,6 +49,31 @@ func cmdListFees() *cobra.Command {
 	return cmd
 }
 
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current SSI module params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdGetSchema() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "schema [schema-id]",
@@ -51,7 +89,21 @@ func CmdGetSchema() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryCredentialSchemaRequest{SchemaId: argSchemaId}
+			version, err := cmd.Flags().GetString(schemaVersionFlag)
+			if err != nil {
+				return err
+			}
+
+			latest, err := cmd.Flags().GetBool(latestFlag)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryCredentialSchemaRequest{
+				SchemaId: argSchemaId,
+				Version:  version,
+				Latest:   latest,
+			}
 
 			res, err := queryClient.CredentialSchemaByID(cmd.Context(), params)
 			if err != nil {
@@ -62,6 +114,8 @@ func CmdGetSchema() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(schemaVersionFlag, "", "query the specified version of schema, if schema id has no version")
+	cmd.Flags().Bool(latestFlag, false, "query the latest version of schema, if schema id has no version")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -82,7 +136,27 @@ func CmdResolveDID() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryDidDocumentRequest{DidId: argDidDocId}
+			versionId, err := cmd.Flags().GetString(versionIdFlag)
+			if err != nil {
+				return err
+			}
+
+			versionTime, err := cmd.Flags().GetString(versionTimeFlag)
+			if err != nil {
+				return err
+			}
+
+			prove, err := cmd.Flags().GetBool(proveFlag)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDidDocumentRequest{
+				DidId:       argDidDocId,
+				VersionId:   versionId,
+				VersionTime: versionTime,
+				Prove:       prove,
+			}
 
 			res, err := queryClient.DidDocumentByID(cmd.Context(), params)
 			if err != nil {
@@ -93,11 +167,365 @@ func CmdResolveDID() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(versionIdFlag, "", "resolve the version of DID Document with the specified version id")
+	cmd.Flags().String(versionTimeFlag, "", "resolve the version of DID Document which was active at the specified time (RFC3339)")
+	cmd.Flags().Bool(proveFlag, false, "include the Merkle proof of the latest DID Document against the app hash of query height")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdW3CResolveDID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve [did-id]",
+		Short: "Resolve a DID as per W3C DID Resolution specification",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDidId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			versionId, err := cmd.Flags().GetString(versionIdFlag)
+			if err != nil {
+				return err
+			}
+
+			versionTime, err := cmd.Flags().GetString(versionTimeFlag)
+			if err != nil {
+				return err
+			}
+
+			accept, err := cmd.Flags().GetString(acceptFlag)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryResolveDidRequest{
+				DidId:       argDidId,
+				VersionId:   versionId,
+				VersionTime: versionTime,
+				Accept:      accept,
+			}
+
+			res, err := queryClient.ResolveDid(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(versionIdFlag, "", "resolve the version of DID Document with the specified version id")
+	cmd.Flags().String(versionTimeFlag, "", "resolve the version of DID Document which was active at the specified time (RFC3339)")
+	cmd.Flags().String(acceptFlag, types.DidLdJsonContentType, "media type of the DID Document representation")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDereferenceDIDUrl() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dereference [did-url]",
+		Short: "Dereference a DID URL to a DID Document, Verification Method, Service or service endpoint URL",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDidUrl := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			accept, err := cmd.Flags().GetString(acceptFlag)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryDereferenceDidUrlRequest{
+				DidUrl: argDidUrl,
+				Accept: accept,
+			}
+
+			res, err := queryClient.DereferenceDidUrl(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(acceptFlag, types.DidLdJsonContentType, "media type of the dereferenced resource")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
 
+func CmdResolveDIDVersions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "did-versions [didDoc-id]",
+		Short: "Query every version of DidDoc for a given didDoc id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDidDocId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDidDocumentVersionsRequest{
+				DidId:      argDidDocId,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DidDocumentVersions(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "did-versions")
+
+	return cmd
+}
+
+func CmdGetDIDsByController() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dids-by-controller [controller-did-id]",
+		Short: "Query DidDocs controlled by a given DID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argController := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDidDocumentsByControllerRequest{
+				Controller: argController,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DidDocumentsByController(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "dids-by-controller")
+
+	return cmd
+}
+
+func CmdGetSchemasByAuthor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schemas-by-author [author-did-id]",
+		Short: "Query Schemas authored by a given DID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argAuthor := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryCredentialSchemasByAuthorRequest{
+				Author:     argAuthor,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.CredentialSchemasByAuthor(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "schemas-by-author")
+
+	return cmd
+}
+
+func CmdGetCredentialStatusesByIssuer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "credential-statuses-by-issuer [issuer-did-id]",
+		Short: "Query credential statuses issued by a given DID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argIssuer := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryCredentialStatusesByIssuerRequest{
+				Issuer:     argIssuer,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.CredentialStatusesByIssuer(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "credential-statuses-by-issuer")
+
+	return cmd
+}
+
+func CmdGetDIDByBlockchainAccountId() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "did-by-blockchain-account [blockchain-account-id]",
+		Short: "Query DidDoc which owns a given CAIP-10 blockchain account id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBlockchainAccountId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDidDocumentByBlockchainAccountIdRequest{BlockchainAccountId: argBlockchainAccountId}
+
+			res, err := queryClient.DidDocumentByBlockchainAccountId(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetPendingDidRecovery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "did-recovery [did-id]",
+		Short: "Query the pending recovery of a DID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDidId := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingDidRecoveryRequest{DidId: argDidId}
+
+			res, err := queryClient.PendingDidRecovery(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetPendingDidRecoveries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "did-recoveries",
+		Short: "Query the list of pending DID recoveries",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingDidRecoveriesRequest{Pagination: pageReq}
+
+			res, err := queryClient.PendingDidRecoveries(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "did-recoveries")
+
+	return cmd
+}
+
 func CmdGetCredentialStatus() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "credential-status [credential-id]",
@@ -128,3 +556,262 @@ func CmdGetCredentialStatus() *cobra.Command {
 
 	return cmd
 }
+
+func CmdGetCredentialStatusBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "credential-status-batch [credential-status-batch-id]",
+		Short: "Query a credential status batch through which credential statuses were registered",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argBatchId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryCredentialStatusBatchRequest{Id: argBatchId}
+
+			res, err := queryClient.CredentialStatusBatchByID(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetCredentialStatusList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "credential-status-list [credential-status-list-id]",
+		Short: "Query a credential status list as a BitstringStatusListCredential",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryCredentialStatusListRequest{Id: argId}
+
+			res, err := queryClient.CredentialStatusListByID(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdVerifyDocumentProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-document-proof [did|schema|credential-status] [document] [document-proof]",
+		Short: "Verify the proof of a DidDoc, Credential Schema or Credential Status without submitting a transaction",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDocumentType := args[0]
+			argDocument := args[1]
+			argDocumentProof := args[2]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryVerifyDocumentProofRequest{}
+
+			switch argDocumentType {
+			case "did":
+				params.DidDocument = &types.DidDocument{}
+				err = clientCtx.Codec.UnmarshalJSON([]byte(argDocument), params.DidDocument)
+			case "schema":
+				params.CredentialSchemaDocument = &types.CredentialSchemaDocument{}
+				err = clientCtx.Codec.UnmarshalJSON([]byte(argDocument), params.CredentialSchemaDocument)
+			case "credential-status":
+				params.CredentialStatusDocument = &types.CredentialStatusDocument{}
+				err = clientCtx.Codec.UnmarshalJSON([]byte(argDocument), params.CredentialStatusDocument)
+			default:
+				return fmt.Errorf("unsupported document type %v, expected one of: did, schema, credential-status", argDocumentType)
+			}
+			if err != nil {
+				return err
+			}
+
+			documentProofs, err := getDocumentProofs(clientCtx, []string{argDocumentProof})
+			if err != nil {
+				return err
+			}
+			params.DocumentProof = documentProofs[0]
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VerifyDocumentProof(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValidateSSIMsg() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-ssi-msg [msg]",
+		Short: "Validate a x/ssi module message, such as MsgRegisterDID, against the current state without submitting a transaction",
+		Long: `Validate a x/ssi module message against the current state without submitting a transaction.
+The message is expected in its JSON representation along with its type url. For example:
+{"@type": "/hypersign.ssi.v1.MsgRegisterDID", "didDocument": {...}, "didDocumentProofs": [...], "txAuthor": "hid1..."}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argMsg := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var msg sdk.Msg
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(argMsg), &msg); err != nil {
+				return err
+			}
+
+			msgAny, err := codectypes.NewAnyWithValue(msg)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidateSSIMsg(cmd.Context(), &types.QueryValidateSSIMsgRequest{Msg: msgAny})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdVerifyCredential() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-credential [credential]",
+		Short: "Verify a Verifiable Credential against its issuer DID, credential status and credential schema",
+		Long: `Verify a JSON encoded W3C Verifiable Credential along with its proof. The issuer DID must be active,
+the proof must be created by one of its assertion methods, the registered credential status must carry the
+merkle root hash of the credential and be neither revoked, suspended nor expired, and the credentialSubject
+must be valid as per the referred credential schema.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argCredential := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VerifyCredential(cmd.Context(), &types.QueryVerifyCredentialRequest{Credential: argCredential})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetAccreditation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accreditation [accreditation-id]",
+		Short: "Query an accreditation of the trust registry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argId := args[0]
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAccreditationRequest{Id: argId}
+
+			res, err := queryClient.AccreditationByID(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetIssuerAccreditation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issuer-accreditation [issuer-did] [credential-schema-id] ([time])",
+		Short: "Check whether an issuer is accredited for a Credential Schema at the given RFC3339 time, or at the latest block time",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryIssuerAccreditationRequest{
+				Issuer:             args[0],
+				CredentialSchemaId: args[1],
+			}
+			if len(args) == 3 {
+				params.Time = args[2]
+			}
+
+			res, err := queryClient.IssuerAccreditation(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
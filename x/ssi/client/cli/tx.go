@@ -22,9 +22,17 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdateDID())
 	cmd.AddCommand(CmdCreateSchema())
 	cmd.AddCommand(CmdUpdateSchema())
+	cmd.AddCommand(CmdUpdateSchemaStatus())
 	cmd.AddCommand(CmdDeactivateDID())
+	cmd.AddCommand(CmdInitiateDidRecovery())
+	cmd.AddCommand(CmdCancelDidRecovery())
+	cmd.AddCommand(CmdMarkVerificationMethodCompromised())
 	cmd.AddCommand(CmdRegisterCredentialStatus())
 	cmd.AddCommand(CmdUpdateCredentialStatus())
+	cmd.AddCommand(CmdRegisterCredentialStatusBatch())
+	cmd.AddCommand(CmdRegisterCredentialStatusList())
+	cmd.AddCommand(CmdUpdateCredentialStatusList())
+	cmd.AddCommand(CmdRegisterAccreditation())
 
 	return cmd
 }
@@ -278,6 +278,52 @@ func CmdUpdateSchema() *cobra.Command {
 	return cmd
 }
 
+func CmdUpdateSchemaStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-schema-status [schema-status-doc] [schema-status-proof]",
+		Short: "Changes the status of Credential Schema to active, deprecated or revoked",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argSchemaStatusDoc := args[0]
+			argSchemaStatusProof := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal Schema Status Document
+			var schemaStatusDoc types.CredentialSchemaStatusDocument
+			err = clientCtx.Codec.UnmarshalJSON([]byte(argSchemaStatusDoc), &schemaStatusDoc)
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal Schema Status Proof
+			var schemaStatusProof types.DocumentProof
+			err = clientCtx.Codec.UnmarshalJSON([]byte(argSchemaStatusProof), &schemaStatusProof)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateCredentialSchemaStatus{
+				CredentialSchemaStatusDocument: &schemaStatusDoc,
+				CredentialSchemaStatusProof:    &schemaStatusProof,
+				TxAuthor:                       clientCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdDeactivateDID() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "deactivate-did [did-id] [version-id] ([did-document-proof-1], [did-document-proof-2] .... [did-document-proof-N])",
@@ -323,6 +369,132 @@ func CmdDeactivateDID() *cobra.Command {
 	return cmd
 }
 
+func CmdInitiateDidRecovery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "initiate-did-recovery [did-doc] [version-id] ([did-document-proof-1], [did-document-proof-2] .... [did-document-proof-N])",
+		Short: "Initiates the replacement of Did Document, signed by its recovery",
+		Args:  cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDidDoc := args[0]
+			argVersionId := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal DidDocString
+			var didDoc types.DidDocument
+			err = clientCtx.Codec.UnmarshalJSON([]byte(argDidDoc), &didDoc)
+			if err != nil {
+				return err
+			}
+
+			didDocumentProofs, err := getDocumentProofs(clientCtx, args[2:])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgInitiateDidRecovery{
+				DidDocument:       &didDoc,
+				VersionId:         argVersionId,
+				DidDocumentProofs: didDocumentProofs,
+				TxAuthor:          clientCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdCancelDidRecovery() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-did-recovery [did-id] ([did-document-proof-1], [did-document-proof-2] .... [did-document-proof-N])",
+		Short: "Cancels the pending recovery of Did Document",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argDidId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			didDocumentProofs, err := getDocumentProofs(clientCtx, args[1:])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelDidRecovery{
+				DidDocumentId:     argDidId,
+				DidDocumentProofs: didDocumentProofs,
+				TxAuthor:          clientCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdMarkVerificationMethodCompromised() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mark-vm-compromised [compromise-doc] [version-id] ([document-proof-1], [document-proof-2] .... [document-proof-N])",
+		Short: "Marks a Verification Method of Did Document as compromised since the given time",
+		Args:  cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argCompromiseDoc := args[0]
+			argVersionId := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal Verification Method Compromise Document
+			var compromiseDoc types.VerificationMethodCompromiseDocument
+			err = clientCtx.Codec.UnmarshalJSON([]byte(argCompromiseDoc), &compromiseDoc)
+			if err != nil {
+				return err
+			}
+
+			documentProofs, err := getDocumentProofs(clientCtx, args[2:])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgMarkVerificationMethodCompromised{
+				CompromiseDocument:       &compromiseDoc,
+				CompromiseDocumentProofs: documentProofs,
+				VersionId:                argVersionId,
+				TxAuthor:                 clientCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdRegisterCredentialStatus() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register-credential-status [credential-status] [proof]",
@@ -372,6 +544,62 @@ func CmdRegisterCredentialStatus() *cobra.Command {
 	return cmd
 }
 
+func CmdRegisterCredentialStatusBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-credential-status-batch [credential-status-batch-file] [proof]",
+		Short: "Registers the statuses of many Verifiable Credentials of an issuer",
+		Long: `Registers the statuses of many Verifiable Credentials of an issuer under a single proof. The Credential Status
+Batch Document is read from a JSON file, since it is usually too large to be passed as an argument.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argCredStatusBatchFile := args[0]
+			argProof := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal Credential Status Batch
+			var (
+				credentialStatusBatch types.CredentialStatusBatchDocument
+				proof                 types.DocumentProof
+			)
+
+			credStatusBatchBytes, err := os.ReadFile(argCredStatusBatchFile)
+			if err != nil {
+				return err
+			}
+
+			err = clientCtx.Codec.UnmarshalJSON(credStatusBatchBytes, &credentialStatusBatch)
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal Proof
+			err = clientCtx.Codec.UnmarshalJSON([]byte(argProof), &proof)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRegisterCredentialStatusBatch{
+				CredentialStatusBatchDocument: &credentialStatusBatch,
+				CredentialStatusBatchProof:    &proof,
+				TxAuthor:                      clientCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdUpdateCredentialStatus() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-credential-status [credential-status] [proof]",
@@ -420,3 +648,147 @@ func CmdUpdateCredentialStatus() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdRegisterCredentialStatusList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-credential-status-list [credential-status-list] [proof]",
+		Short: "Registers a Bitstring Status List of Verifiable Credentials",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argCredStatusList := args[0]
+			argProof := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal Credential Status List
+			var (
+				credentialStatusList types.CredentialStatusListDocument
+				proof                types.DocumentProof
+			)
+
+			err = clientCtx.Codec.UnmarshalJSON([]byte(argCredStatusList), &credentialStatusList)
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal Proof
+			err = clientCtx.Codec.UnmarshalJSON([]byte(argProof), &proof)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRegisterCredentialStatusList{
+				CredentialStatusListDocument: &credentialStatusList,
+				CredentialStatusListProof:    &proof,
+				TxAuthor:                     clientCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUpdateCredentialStatusList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-credential-status-list [credential-status-list] [proof]",
+		Short: "Updates a Bitstring Status List of Verifiable Credentials",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argCredStatusList := args[0]
+			argProof := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal Credential Status List
+			var (
+				credentialStatusList types.CredentialStatusListDocument
+				proof                types.DocumentProof
+			)
+
+			err = clientCtx.Codec.UnmarshalJSON([]byte(argCredStatusList), &credentialStatusList)
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal Proof
+			err = clientCtx.Codec.UnmarshalJSON([]byte(argProof), &proof)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateCredentialStatusList{
+				CredentialStatusListDocument: &credentialStatusList,
+				CredentialStatusListProof:    &proof,
+				TxAuthor:                     clientCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRegisterAccreditation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-accreditation [accreditation-doc] [accreditation-proof]",
+		Short: "Accredits an issuer for a Credential Schema",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argAccreditationDoc := args[0]
+			argAccreditationProof := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal Accreditation Document
+			var accreditationDoc types.AccreditationDocument
+			err = clientCtx.Codec.UnmarshalJSON([]byte(argAccreditationDoc), &accreditationDoc)
+			if err != nil {
+				return err
+			}
+
+			// Unmarshal Accreditation Proof
+			var accreditationProof types.DocumentProof
+			err = clientCtx.Codec.UnmarshalJSON([]byte(argAccreditationProof), &accreditationProof)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRegisterAccreditation{
+				AccreditationDocument: &accreditationDoc,
+				AccreditationProof:    &accreditationProof,
+				TxAuthor:              clientCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
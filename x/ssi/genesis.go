@@ -1,6 +1,8 @@
 package ssi
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
 	"github.com/hypersign-protocol/hid-node/x/ssi/types"
@@ -11,13 +13,56 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetChainNamespace(&ctx, genState.ChainNamespace)
 
-	k.SetFeeParam(ctx, *genState.Params.RegisterDidFee, types.ParamStoreKeyRegisterDidFee)
-	k.SetFeeParam(ctx, *genState.Params.UpdateDidFee, types.ParamStoreKeyUpdateDidFee)
-	k.SetFeeParam(ctx, *genState.Params.DeactivateDidFee, types.ParamStoreKeyDeactivateDidFee)
-	k.SetFeeParam(ctx, *genState.Params.RegisterCredentialSchemaFee, types.ParamStoreKeyRegisterCredentialSchemaFee)
-	k.SetFeeParam(ctx, *genState.Params.UpdateCredentialSchemaFee, types.ParamStoreKeyUpdateCredentialSchemaFee)
-	k.SetFeeParam(ctx, *genState.Params.RegisterCredentialStatusFee, types.ParamStoreKeyRegisterCredentialStatusFee)
-	k.SetFeeParam(ctx, *genState.Params.UpdateCredentialStatusFee, types.ParamStoreKeyUpdateCredentialStatusFee)
+	// Bind the port of ssi IBC application, if it is enabled
+	if k.IsIBCEnabled() && !k.IsBound(ctx, types.PortID) {
+		if err := k.BindPort(ctx, types.PortID); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+
+	params := genState.Params
+	if params == nil {
+		params = types.DefaultParams()
+	}
+	if err := k.SetParams(ctx, *params); err != nil {
+		panic(err)
+	}
+
+	for _, didDocumentState := range genState.DidDocuments {
+		k.SetDidDocumentState(ctx, didDocumentState)
+	}
+	for _, didDocumentVersion := range genState.DidDocumentVersions {
+		k.AppendDidDocumentVersion(ctx, didDocumentVersion)
+	}
+	for _, recovery := range genState.PendingDidRecoveries {
+		k.SetPendingDidRecovery(ctx, recovery)
+	}
+	for _, didRecoveryProof := range genState.DidRecoveryProofs {
+		k.SetDidRecoveryProof(ctx, didRecoveryProof)
+	}
+	for _, credentialSchemaState := range genState.CredentialSchemas {
+		k.SetCredentialSchemaState(ctx, credentialSchemaState)
+	}
+	for _, credentialStatusBatchState := range genState.CredentialStatusBatches {
+		k.SetCredentialStatusBatchState(ctx, credentialStatusBatchState)
+	}
+	for _, credentialStatusState := range genState.CredentialStatuses {
+		k.SetCredentialStatusState(ctx, credentialStatusState)
+	}
+	for _, credentialStatusListState := range genState.CredentialStatusLists {
+		k.SetCredentialStatusListState(ctx, credentialStatusListState)
+	}
+	for _, accreditation := range genState.Accreditations {
+		k.SetAccreditationState(ctx, accreditation)
+	}
+	for _, blockchainAccountIdEntry := range genState.BlockchainAccountIds {
+		k.SetBlockchainAccountId(ctx, blockchainAccountIdEntry)
+	}
+
+	// Counters default to the number of imported documents if they are not provided
+	k.SetDidDocumentCount(ctx, getGenesisCount(genState.DidDocumentCount, len(genState.DidDocuments)))
+	k.SetCredentialSchemaCount(ctx, getGenesisCount(genState.CredentialSchemaCount, len(genState.CredentialSchemas)))
+	k.SetCredentialStatusCount(ctx, getGenesisCount(genState.CredentialStatusCount, len(genState.CredentialStatuses)))
 }
 
 // ExportGenesis returns the ssi module's exported genesis.
@@ -25,21 +70,32 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.ChainNamespace = k.GetChainNamespace(&ctx)
 
-	registerDidFee := k.GetFeeParams(ctx, types.ParamStoreKeyRegisterDidFee)
-	updateDidFee := k.GetFeeParams(ctx, types.ParamStoreKeyUpdateDidFee)
-	deactivateDidFee := k.GetFeeParams(ctx, types.ParamStoreKeyDeactivateDidFee)
-	registerCredentialSchemaFee := k.GetFeeParams(ctx, types.ParamStoreKeyRegisterCredentialSchemaFee)
-	updateCredentialSchemaFee := k.GetFeeParams(ctx, types.ParamStoreKeyUpdateCredentialSchemaFee)
-	registerCredentialStatusFee := k.GetFeeParams(ctx, types.ParamStoreKeyRegisterCredentialStatusFee)
-	updateCredentialStatusFee := k.GetFeeParams(ctx, types.ParamStoreKeyUpdateCredentialStatusFee)
-
-	genesis.Params.RegisterDidFee = &registerDidFee
-	genesis.Params.UpdateDidFee = &updateDidFee
-	genesis.Params.DeactivateDidFee = &deactivateDidFee
-	genesis.Params.RegisterCredentialSchemaFee = &registerCredentialSchemaFee
-	genesis.Params.UpdateCredentialSchemaFee = &updateCredentialSchemaFee
-	genesis.Params.RegisterCredentialStatusFee = &registerCredentialStatusFee
-	genesis.Params.UpdateCredentialStatusFee = &updateCredentialStatusFee
+	params := k.GetParams(ctx)
+	genesis.Params = &params
+
+	genesis.DidDocuments = k.GetAllDidDocumentStates(ctx)
+	genesis.DidDocumentVersions = k.GetAllDidDocumentVersions(ctx)
+	genesis.PendingDidRecoveries = k.GetAllPendingDidRecoveries(ctx)
+	genesis.DidRecoveryProofs = k.GetAllDidRecoveryProofs(ctx)
+	genesis.CredentialSchemas = k.GetAllCredentialSchemaStates(ctx)
+	genesis.CredentialStatuses = k.GetAllCredentialStatusStates(ctx)
+	genesis.CredentialStatusBatches = k.GetAllCredentialStatusBatchStates(ctx)
+	genesis.CredentialStatusLists = k.GetAllCredentialStatusListStates(ctx)
+	genesis.Accreditations = k.GetAllAccreditationStates(ctx)
+	genesis.BlockchainAccountIds = k.GetAllBlockchainAccountIds(ctx)
+
+	genesis.DidDocumentCount = k.GetDidDocumentCount(ctx)
+	genesis.CredentialSchemaCount = k.GetCredentialSchemaCount(ctx)
+	genesis.CredentialStatusCount = k.GetCredentialStatusCount(ctx)
 
 	return genesis
 }
+
+// getGenesisCount returns the counter from genesis state, falling back to the
+// number of imported documents when the counter is unset
+func getGenesisCount(count uint64, numOfDocuments int) uint64 {
+	if count == 0 {
+		return uint64(numOfDocuments)
+	}
+	return count
+}
@@ -26,18 +26,42 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgDeactivateDID:
 			res, err := msgServer.DeactivateDID(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgInitiateDidRecovery:
+			res, err := msgServer.InitiateDidRecovery(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelDidRecovery:
+			res, err := msgServer.CancelDidRecovery(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgMarkVerificationMethodCompromised:
+			res, err := msgServer.MarkVerificationMethodCompromised(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgRegisterCredentialSchema:
 			res, err := msgServer.RegisterCredentialSchema(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgUpdateCredentialSchema:
 			res, err := msgServer.UpdateCredentialSchema(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateCredentialSchemaStatus:
+			res, err := msgServer.UpdateCredentialSchemaStatus(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgRegisterCredentialStatus:
 			res, err := msgServer.RegisterCredentialStatus(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgUpdateCredentialStatus:
 			res, err := msgServer.UpdateCredentialStatus(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRegisterCredentialStatusBatch:
+			res, err := msgServer.RegisterCredentialStatusBatch(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRegisterCredentialStatusList:
+			res, err := msgServer.RegisterCredentialStatusList(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateCredentialStatusList:
+			res, err := msgServer.UpdateCredentialStatusList(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRegisterAccreditation:
+			res, err := msgServer.RegisterAccreditation(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, errors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
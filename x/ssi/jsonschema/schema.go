@@ -0,0 +1,411 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// Limits on the JSON Schema, which are in place as the schema is processed by every node of the network
+const (
+	// MaxSchemaSize is the maximum size, in bytes, of the `properties` attribute of Credential Schema
+	MaxSchemaSize = 16 * 1024
+	// MaxSchemaDepth is the maximum nesting depth of subschemas
+	MaxSchemaDepth = 8
+)
+
+// JSON Schema types
+const (
+	typeString  = "string"
+	typeNumber  = "number"
+	typeInteger = "integer"
+	typeBoolean = "boolean"
+	typeObject  = "object"
+	typeArray   = "array"
+	typeNull    = "null"
+)
+
+var validTypes = map[string]bool{
+	typeString:  true,
+	typeNumber:  true,
+	typeInteger: true,
+	typeBoolean: true,
+	typeObject:  true,
+	typeArray:   true,
+	typeNull:    true,
+}
+
+// Keywords which carry no validation, and are accepted as they are
+var annotationKeywords = map[string]bool{
+	"$comment":    true,
+	"title":       true,
+	"description": true,
+	"default":     true,
+	"examples":    true,
+	"deprecated":  true,
+	"readOnly":    true,
+	"writeOnly":   true,
+}
+
+// Schema is a compiled JSON Schema. The supported subset of draft 2020-12 consists of the keywords:
+//   - Applicators: properties, additionalProperties, items, allOf, anyOf, oneOf, not
+//   - Validation: type, enum, const, required, minimum, maximum, exclusiveMinimum, exclusiveMaximum, multipleOf,
+//     minLength, maxLength, pattern, minItems, maxItems, uniqueItems, minProperties, maxProperties
+//   - Core: $ref and $defs, where $ref is a JSON Pointer within the same schema, such as `#/$defs/address`
+//   - Format: date-time, date, email and uri are asserted, while other formats are treated as annotations
+//
+// Every other keyword is rejected, so that a schema is never silently accepted with a constraint which is not enforced.
+// The `pattern` keyword is evaluated as a Go regular expression (RE2), which is a subset of ECMA-262 regular expressions.
+type Schema struct {
+	// Set for the boolean schemas `true` and `false`
+	boolean *bool
+
+	ref          string
+	resolvedRef  *Schema
+	types        []string
+	enum         []interface{}
+	constValue   interface{}
+	hasConst     bool
+	format       string
+	pattern      *regexp.Regexp
+	uniqueItems  bool
+	required     []string
+	numericLimit map[string]float64
+	countLimit   map[string]int
+
+	properties           map[string]*Schema
+	additionalProperties *Schema
+	items                *Schema
+	allOf                []*Schema
+	anyOf                []*Schema
+	oneOf                []*Schema
+	not                  *Schema
+}
+
+// Compile compiles a JSON Schema document
+func Compile(schemaDocument []byte) (*Schema, error) {
+	if len(schemaDocument) > MaxSchemaSize {
+		return nil, fmt.Errorf("schema size %v exceeds the maximum size of %v bytes", len(schemaDocument), MaxSchemaSize)
+	}
+
+	var rawSchema interface{}
+	if err := json.Unmarshal(schemaDocument, &rawSchema); err != nil {
+		return nil, err
+	}
+	return compileRoot(rawSchema)
+}
+
+// NewCredentialSchemaValidator compiles the schema of Credential Schema, against which the credential subjects are
+// validated. The `properties` attribute is a JSON object, whose every property is a JSON Schema which must specify
+// its `type` or refer to another schema through `$ref`.
+func NewCredentialSchemaValidator(schemaProperty *types.CredentialSchemaProperty) (*Schema, error) {
+	if schemaProperty == nil {
+		return nil, fmt.Errorf("schema property cannot be empty")
+	}
+	if len(schemaProperty.Properties) > MaxSchemaSize {
+		return nil, fmt.Errorf(
+			"properties size %v exceeds the maximum size of %v bytes",
+			len(schemaProperty.Properties),
+			MaxSchemaSize,
+		)
+	}
+
+	var properties map[string]interface{}
+	if err := json.Unmarshal([]byte(schemaProperty.Properties), &properties); err != nil {
+		return nil, err
+	}
+
+	var required []interface{}
+	for _, requiredProperty := range schemaProperty.Required {
+		required = append(required, requiredProperty)
+	}
+
+	rawSchema := map[string]interface{}{
+		"type":                 typeObject,
+		"properties":           properties,
+		"additionalProperties": schemaProperty.AdditionalProperties,
+	}
+	if len(required) != 0 {
+		rawSchema["required"] = required
+	}
+	return compileRoot(rawSchema)
+}
+
+func compileRoot(rawSchema interface{}) (*Schema, error) {
+	c := &compiler{schemas: map[string]*Schema{}}
+	schema, err := c.compile(rawSchema, "#", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, refSchema := range c.refs {
+		resolvedRef, ok := c.schemas[refSchema.ref]
+		if !ok {
+			return nil, fmt.Errorf("unresolvable $ref %v", refSchema.ref)
+		}
+		refSchema.resolvedRef = resolvedRef
+	}
+	return schema, nil
+}
+
+// compiler keeps track of every compiled subschema by its JSON Pointer, in order to resolve the references
+type compiler struct {
+	schemas map[string]*Schema
+	refs    []*Schema
+}
+
+func (c *compiler) compile(rawSchema interface{}, pointer string, depth int) (*Schema, error) {
+	if depth > MaxSchemaDepth {
+		return nil, fmt.Errorf("%v: schema exceeds the maximum nesting depth of %v", pointer, MaxSchemaDepth)
+	}
+
+	schema := &Schema{}
+	c.schemas[pointer] = schema
+
+	if boolean, ok := rawSchema.(bool); ok {
+		schema.boolean = &boolean
+		return schema, nil
+	}
+
+	obj, ok := rawSchema.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%v: schema must be a JSON object or a boolean", pointer)
+	}
+
+	// Keywords are processed in a sorted order, so that the same error is reported by every node
+	for _, keyword := range sortedKeys(obj) {
+		if annotationKeywords[keyword] {
+			continue
+		}
+		if err := c.compileKeyword(schema, keyword, obj[keyword], pointer, depth); err != nil {
+			return nil, fmt.Errorf("%v: invalid keyword %v: %v", pointer, keyword, err)
+		}
+	}
+
+	return schema, nil
+}
+
+func (c *compiler) compileKeyword(schema *Schema, keyword string, value interface{}, pointer string, depth int) error {
+	keywordPointer := pointer + "/" + escapePointerToken(keyword)
+
+	switch keyword {
+	case "$ref":
+		ref, ok := value.(string)
+		if !ok || !strings.HasPrefix(ref, "#") {
+			return fmt.Errorf("only the references within the same schema, starting with #, are supported")
+		}
+		schema.ref = ref
+		c.refs = append(c.refs, schema)
+	case "$defs":
+		defs, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected a JSON object")
+		}
+		for _, name := range sortedKeys(defs) {
+			if _, err := c.compile(defs[name], keywordPointer+"/"+escapePointerToken(name), depth+1); err != nil {
+				return err
+			}
+		}
+	case "type":
+		schemaTypes, err := getTypes(value)
+		if err != nil {
+			return err
+		}
+		schema.types = schemaTypes
+	case "enum":
+		enum, ok := value.([]interface{})
+		if !ok || len(enum) == 0 {
+			return fmt.Errorf("expected a non-empty array")
+		}
+		schema.enum = enum
+	case "const":
+		schema.constValue = value
+		schema.hasConst = true
+	case "format":
+		format, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected a string")
+		}
+		schema.format = format
+	case "pattern":
+		pattern, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected a string")
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return err
+		}
+		schema.pattern = re
+	case "uniqueItems":
+		uniqueItems, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("expected a boolean")
+		}
+		schema.uniqueItems = uniqueItems
+	case "required":
+		required, err := getStrings(value)
+		if err != nil {
+			return err
+		}
+		schema.required = required
+	case "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf":
+		limit, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("expected a number")
+		}
+		if keyword == "multipleOf" && limit <= 0 {
+			return fmt.Errorf("expected a number greater than 0")
+		}
+		if schema.numericLimit == nil {
+			schema.numericLimit = map[string]float64{}
+		}
+		schema.numericLimit[keyword] = limit
+	case "minLength", "maxLength", "minItems", "maxItems", "minProperties", "maxProperties":
+		limit, ok := value.(float64)
+		if !ok || limit < 0 || limit != float64(int(limit)) {
+			return fmt.Errorf("expected a non-negative integer")
+		}
+		if schema.countLimit == nil {
+			schema.countLimit = map[string]int{}
+		}
+		schema.countLimit[keyword] = int(limit)
+	case "properties":
+		properties, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected a JSON object")
+		}
+		schema.properties = map[string]*Schema{}
+		for _, name := range sortedKeys(properties) {
+			rawProperty := properties[name]
+			propertyPointer := keywordPointer + "/" + escapePointerToken(name)
+			if err := requireTypedSchema(rawProperty, propertyPointer); err != nil {
+				return err
+			}
+			property, err := c.compile(rawProperty, propertyPointer, depth+1)
+			if err != nil {
+				return err
+			}
+			schema.properties[name] = property
+		}
+	case "items":
+		if err := requireTypedSchema(value, keywordPointer); err != nil {
+			return err
+		}
+		items, err := c.compile(value, keywordPointer, depth+1)
+		if err != nil {
+			return err
+		}
+		schema.items = items
+	case "additionalProperties":
+		additionalProperties, err := c.compile(value, keywordPointer, depth+1)
+		if err != nil {
+			return err
+		}
+		schema.additionalProperties = additionalProperties
+	case "not":
+		not, err := c.compile(value, keywordPointer, depth+1)
+		if err != nil {
+			return err
+		}
+		schema.not = not
+	case "allOf", "anyOf", "oneOf":
+		rawSubschemas, ok := value.([]interface{})
+		if !ok || len(rawSubschemas) == 0 {
+			return fmt.Errorf("expected a non-empty array")
+		}
+		var subschemas []*Schema
+		for i, rawSubschema := range rawSubschemas {
+			subschema, err := c.compile(rawSubschema, fmt.Sprintf("%v/%v", keywordPointer, i), depth+1)
+			if err != nil {
+				return err
+			}
+			subschemas = append(subschemas, subschema)
+		}
+		switch keyword {
+		case "allOf":
+			schema.allOf = subschemas
+		case "anyOf":
+			schema.anyOf = subschemas
+		case "oneOf":
+			schema.oneOf = subschemas
+		}
+	default:
+		return fmt.Errorf("unsupported keyword")
+	}
+	return nil
+}
+
+// requireTypedSchema checks if the schema of a property or array item specifies its `type`, or refers to another schema
+func requireTypedSchema(rawSchema interface{}, pointer string) error {
+	obj, ok := rawSchema.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%v: schema must be a JSON object", pointer)
+	}
+	_, hasType := obj["type"]
+	_, hasRef := obj["$ref"]
+	if !hasType && !hasRef {
+		return fmt.Errorf("%v: schema is missing the required keyword `type`", pointer)
+	}
+	return nil
+}
+
+func getTypes(value interface{}) ([]string, error) {
+	var schemaTypes []string
+	switch value := value.(type) {
+	case string:
+		schemaTypes = []string{value}
+	case []interface{}:
+		var err error
+		if schemaTypes, err = getStrings(value); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("expected a string or an array of strings")
+	}
+
+	if len(schemaTypes) == 0 {
+		return nil, fmt.Errorf("atleast one type must be specified")
+	}
+	for _, t := range schemaTypes {
+		if !validTypes[t] {
+			return nil, fmt.Errorf("invalid type %v", t)
+		}
+	}
+	return schemaTypes, nil
+}
+
+func getStrings(value interface{}) ([]string, error) {
+	values, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected an array of strings")
+	}
+
+	var strs []string
+	for _, v := range values {
+		str, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected an array of strings")
+		}
+		strs = append(strs, str)
+	}
+	return strs, nil
+}
+
+func sortedKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// escapePointerToken escapes a JSON Pointer reference token as per RFC 6901
+func escapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,175 @@
+package jsonschema_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hypersign-protocol/hid-node/x/ssi/jsonschema"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		schema string
+		valid  bool
+	}{
+		{
+			desc:   "nested object with array items",
+			schema: `{"type":"object","properties":{"degrees":{"type":"array","items":{"type":"object","properties":{"name":{"type":"string"}}}}}}`,
+			valid:  true,
+		},
+		{
+			desc:   "local reference to $defs",
+			schema: `{"$defs":{"name":{"type":"string"}},"type":"object","properties":{"name":{"$ref":"#/$defs/name"}}}`,
+			valid:  true,
+		},
+		{
+			desc:   "unsupported keyword",
+			schema: `{"type":"object","dependentRequired":{"a":["b"]}}`,
+			valid:  false,
+		},
+		{
+			desc:   "remote reference",
+			schema: `{"type":"object","properties":{"name":{"$ref":"https://example.com/schema.json"}}}`,
+			valid:  false,
+		},
+		{
+			desc:   "property without type",
+			schema: `{"type":"object","properties":{"name":{"format":"email"}}}`,
+			valid:  false,
+		},
+		{
+			desc:   "invalid type",
+			schema: `{"type":"date"}`,
+			valid:  false,
+		},
+		{
+			desc:   "invalid pattern",
+			schema: `{"type":"string","pattern":"(["}`,
+			valid:  false,
+		},
+		{
+			desc:   "schema exceeding the maximum size",
+			schema: `{"type":"string","description":"` + strings.Repeat("a", jsonschema.MaxSchemaSize) + `"}`,
+			valid:  false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := jsonschema.Compile([]byte(tc.schema))
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	schema, err := jsonschema.NewCredentialSchemaValidator(&types.CredentialSchemaProperty{
+		Properties: `{
+			"name": {"type": "string", "minLength": 1},
+			"grade": {"type": "string", "enum": ["A", "B", "C"]},
+			"age": {"type": "integer", "minimum": 0},
+			"email": {"type": "string", "format": "email"},
+			"address": {
+				"type": "object",
+				"$defs": {"postalCode": {"type": "string", "pattern": "^[0-9]{6}$"}},
+				"properties": {
+					"street": {"type": "string"},
+					"postalCode": {"$ref": "#/properties/address/$defs/postalCode"}
+				},
+				"required": ["street"],
+				"additionalProperties": false
+			},
+			"subjects": {"type": "array", "items": {"type": "string"}, "uniqueItems": true}
+		}`,
+		Required:             []string{"name"},
+		AdditionalProperties: false,
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc     string
+		instance string
+		valid    bool
+	}{
+		{
+			desc:     "valid instance",
+			instance: `{"name":"Alice","grade":"A","age":21,"email":"alice@example.com","address":{"street":"Main Street","postalCode":"560001"},"subjects":["Maths","Physics"]}`,
+			valid:    true,
+		},
+		{
+			desc:     "missing required property",
+			instance: `{"grade":"A"}`,
+			valid:    false,
+		},
+		{
+			desc:     "property not defined in schema",
+			instance: `{"name":"Alice","nickname":"Al"}`,
+			valid:    false,
+		},
+		{
+			desc:     "value not in enum",
+			instance: `{"name":"Alice","grade":"F"}`,
+			valid:    false,
+		},
+		{
+			desc:     "non-integer number",
+			instance: `{"name":"Alice","age":21.5}`,
+			valid:    false,
+		},
+		{
+			desc:     "invalid email format",
+			instance: `{"name":"Alice","email":"alice"}`,
+			valid:    false,
+		},
+		{
+			desc:     "nested property not matching the referenced pattern",
+			instance: `{"name":"Alice","address":{"street":"Main Street","postalCode":"56A001"}}`,
+			valid:    false,
+		},
+		{
+			desc:     "nested object missing required property",
+			instance: `{"name":"Alice","address":{"postalCode":"560001"}}`,
+			valid:    false,
+		},
+		{
+			desc:     "duplicate array items",
+			instance: `{"name":"Alice","subjects":["Maths","Maths"]}`,
+			valid:    false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := schema.ValidateJSON([]byte(tc.instance))
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				t.Log(err)
+			}
+		})
+	}
+}
+
+func TestValidateRecursiveReference(t *testing.T) {
+	schema, err := jsonschema.Compile([]byte(`{"$ref":"#"}`))
+	require.NoError(t, err)
+	require.Error(t, schema.ValidateJSON([]byte(`{}`)))
+}
+
+func TestValidateBranchingReference(t *testing.T) {
+	schema, err := jsonschema.NewCredentialSchemaValidator(&types.CredentialSchemaProperty{
+		Properties: `{"x":{"$ref":"#/properties/x/$defs/a","$defs":{"a":{"anyOf":[{"$ref":"#/properties/x/$defs/a"},{"$ref":"#/properties/x/$defs/a"}]}}}}`,
+	})
+	require.NoError(t, err)
+
+	start := time.Now()
+	err = schema.ValidateJSON([]byte(`{"x":1}`))
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 5*time.Second)
+	t.Log(err)
+}
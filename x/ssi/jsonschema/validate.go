@@ -0,0 +1,323 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxValidationDepth bounds the evaluation of subschemas, which otherwise would not terminate for
+// a schema referring to itself without descending into the instance
+const maxValidationDepth = 64
+
+// maxSubschemaEvaluations bounds the total number of subschema evaluations of a single validation. Unlike the depth,
+// it also bounds the schemas whose applicators, such as anyOf, branch into the same schema repeatedly, and whose
+// evaluation is exponential in their depth.
+const maxSubschemaEvaluations = 50000
+
+// evaluationBudget is the number of subschema evaluations left for a validation
+type evaluationBudget struct {
+	remaining int
+}
+
+// ValidateJSON validates a JSON encoded instance against the schema
+func (s *Schema) ValidateJSON(instanceJson []byte) error {
+	var instance interface{}
+	if err := json.Unmarshal(instanceJson, &instance); err != nil {
+		return err
+	}
+	return s.Validate(instance)
+}
+
+// Validate validates a decoded JSON instance, as returned by json.Unmarshal into an interface{}, against the schema
+func (s *Schema) Validate(instance interface{}) error {
+	budget := &evaluationBudget{remaining: maxSubschemaEvaluations}
+	err := s.validate(instance, "", 0, budget)
+
+	// Applicators such as not and anyOf discard the errors of their subschemas, and hence an exhausted budget
+	// is checked irrespective of the validation result
+	if budget.remaining < 0 {
+		return validationError("", "schema evaluation exceeds the maximum of %v subschema evaluations", maxSubschemaEvaluations)
+	}
+	return err
+}
+
+func (s *Schema) validate(instance interface{}, instancePath string, depth int, budget *evaluationBudget) error {
+	if depth > maxValidationDepth {
+		return validationError(instancePath, "schema evaluation exceeds the maximum depth of %v", maxValidationDepth)
+	}
+	budget.remaining--
+	if budget.remaining < 0 {
+		return validationError(instancePath, "schema evaluation exceeds the maximum of %v subschema evaluations", maxSubschemaEvaluations)
+	}
+
+	if s.boolean != nil {
+		if !*s.boolean {
+			return validationError(instancePath, "no value is allowed")
+		}
+		return nil
+	}
+
+	if s.resolvedRef != nil {
+		if err := s.resolvedRef.validate(instance, instancePath, depth+1, budget); err != nil {
+			return err
+		}
+	}
+
+	if len(s.types) != 0 && !hasAnyType(instance, s.types) {
+		return validationError(instancePath, "expected value of type %v", strings.Join(s.types, " or "))
+	}
+
+	if len(s.enum) != 0 && !containsValue(s.enum, instance) {
+		return validationError(instancePath, "value must be one of %v", s.enum)
+	}
+
+	if s.hasConst && !reflect.DeepEqual(s.constValue, instance) {
+		return validationError(instancePath, "value must be %v", s.constValue)
+	}
+
+	var err error
+	switch instance := instance.(type) {
+	case string:
+		err = s.validateString(instance, instancePath)
+	case float64:
+		err = s.validateNumber(instance, instancePath)
+	case []interface{}:
+		err = s.validateArray(instance, instancePath, depth, budget)
+	case map[string]interface{}:
+		err = s.validateObject(instance, instancePath, depth, budget)
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.validateSubschemas(instance, instancePath, depth, budget)
+}
+
+func (s *Schema) validateString(instance string, instancePath string) error {
+	length := utf8.RuneCountInString(instance)
+	if limit, ok := s.countLimit["minLength"]; ok && length < limit {
+		return validationError(instancePath, "length must be atleast %v", limit)
+	}
+	if limit, ok := s.countLimit["maxLength"]; ok && length > limit {
+		return validationError(instancePath, "length must be atmost %v", limit)
+	}
+
+	if s.pattern != nil && !s.pattern.MatchString(instance) {
+		return validationError(instancePath, "value must match the pattern %v", s.pattern.String())
+	}
+
+	if !isValidFormat(instance, s.format) {
+		return validationError(instancePath, "value must be of format %v", s.format)
+	}
+	return nil
+}
+
+func (s *Schema) validateNumber(instance float64, instancePath string) error {
+	if limit, ok := s.numericLimit["minimum"]; ok && instance < limit {
+		return validationError(instancePath, "value must be greater than or equal to %v", limit)
+	}
+	if limit, ok := s.numericLimit["maximum"]; ok && instance > limit {
+		return validationError(instancePath, "value must be less than or equal to %v", limit)
+	}
+	if limit, ok := s.numericLimit["exclusiveMinimum"]; ok && instance <= limit {
+		return validationError(instancePath, "value must be greater than %v", limit)
+	}
+	if limit, ok := s.numericLimit["exclusiveMaximum"]; ok && instance >= limit {
+		return validationError(instancePath, "value must be less than %v", limit)
+	}
+	if divisor, ok := s.numericLimit["multipleOf"]; ok {
+		if quotient := instance / divisor; quotient != math.Trunc(quotient) {
+			return validationError(instancePath, "value must be a multiple of %v", divisor)
+		}
+	}
+	return nil
+}
+
+func (s *Schema) validateArray(instance []interface{}, instancePath string, depth int, budget *evaluationBudget) error {
+	if limit, ok := s.countLimit["minItems"]; ok && len(instance) < limit {
+		return validationError(instancePath, "array must have atleast %v items", limit)
+	}
+	if limit, ok := s.countLimit["maxItems"]; ok && len(instance) > limit {
+		return validationError(instancePath, "array must have atmost %v items", limit)
+	}
+
+	if s.uniqueItems {
+		for i := range instance {
+			for j := i + 1; j < len(instance); j++ {
+				if reflect.DeepEqual(instance[i], instance[j]) {
+					return validationError(instancePath, "array items at index %v and %v are not unique", i, j)
+				}
+			}
+		}
+	}
+
+	if s.items != nil {
+		for i, item := range instance {
+			if err := s.items.validate(item, fmt.Sprintf("%v/%v", instancePath, i), depth+1, budget); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Schema) validateObject(instance map[string]interface{}, instancePath string, depth int, budget *evaluationBudget) error {
+	if limit, ok := s.countLimit["minProperties"]; ok && len(instance) < limit {
+		return validationError(instancePath, "object must have atleast %v properties", limit)
+	}
+	if limit, ok := s.countLimit["maxProperties"]; ok && len(instance) > limit {
+		return validationError(instancePath, "object must have atmost %v properties", limit)
+	}
+
+	for _, requiredProperty := range s.required {
+		if _, ok := instance[requiredProperty]; !ok {
+			return validationError(instancePath, "required property %v is missing", requiredProperty)
+		}
+	}
+
+	// Properties are validated in a sorted order, so that the first invalid property is always the one reported
+	names := make([]string, 0, len(instance))
+	for name := range instance {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		propertyPath := instancePath + "/" + escapePointerToken(name)
+		if property, ok := s.properties[name]; ok {
+			if err := property.validate(instance[name], propertyPath, depth+1, budget); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if s.additionalProperties != nil {
+			if s.additionalProperties.boolean != nil && !*s.additionalProperties.boolean {
+				return validationError(instancePath, "property %v is not defined in the schema", name)
+			}
+			if err := s.additionalProperties.validate(instance[name], propertyPath, depth+1, budget); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Schema) validateSubschemas(instance interface{}, instancePath string, depth int, budget *evaluationBudget) error {
+	for _, subschema := range s.allOf {
+		if err := subschema.validate(instance, instancePath, depth+1, budget); err != nil {
+			return err
+		}
+	}
+
+	if len(s.anyOf) != 0 {
+		matched := false
+		for _, subschema := range s.anyOf {
+			if subschema.validate(instance, instancePath, depth+1, budget) == nil {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return validationError(instancePath, "value must be valid against atleast one of the anyOf schemas")
+		}
+	}
+
+	if len(s.oneOf) != 0 {
+		matched := 0
+		for _, subschema := range s.oneOf {
+			if subschema.validate(instance, instancePath, depth+1, budget) == nil {
+				matched++
+			}
+		}
+		if matched != 1 {
+			return validationError(instancePath, "value must be valid against exactly one of the oneOf schemas, matched %v", matched)
+		}
+	}
+
+	if s.not != nil && s.not.validate(instance, instancePath, depth+1, budget) == nil {
+		return validationError(instancePath, "value must not be valid against the schema of not")
+	}
+	return nil
+}
+
+// hasAnyType checks if the decoded JSON value is of any of the input JSON Schema types
+func hasAnyType(value interface{}, schemaTypes []string) bool {
+	for _, schemaType := range schemaTypes {
+		if hasType(value, schemaType) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasType(value interface{}, schemaType string) bool {
+	switch schemaType {
+	case typeString:
+		_, ok := value.(string)
+		return ok
+	case typeNumber:
+		_, ok := value.(float64)
+		return ok
+	case typeInteger:
+		number, ok := value.(float64)
+		return ok && number == math.Trunc(number)
+	case typeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case typeObject:
+		_, ok := value.(map[string]interface{})
+		return ok
+	case typeArray:
+		_, ok := value.([]interface{})
+		return ok
+	case typeNull:
+		return value == nil
+	default:
+		return false
+	}
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// isValidFormat asserts the formats date-time, date, email and uri. Other formats are not asserted.
+func isValidFormat(value string, format string) bool {
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, value)
+		return err == nil
+	case "date":
+		_, err := time.Parse(time.DateOnly, value)
+		return err == nil
+	case "email":
+		address, err := mail.ParseAddress(value)
+		return err == nil && address.Address == value
+	case "uri":
+		uri, err := url.Parse(value)
+		return err == nil && uri.IsAbs()
+	default:
+		return true
+	}
+}
+
+func validationError(instancePath string, errMsg string, errMsgArgs ...interface{}) error {
+	if instancePath == "" {
+		instancePath = "/"
+	}
+	return fmt.Errorf("%v: %v", instancePath, fmt.Sprintf(errMsg, errMsgArgs...))
+}
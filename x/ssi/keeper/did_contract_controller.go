@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// isContractSender checks if the verification method is of type CosmWasmContractMethod2024, and its contract
+// deployed on this chain is the transaction sender
+func (k Keeper) isContractSender(ctx sdk.Context, vm *types.VerificationMethod, txAuthor string) bool {
+	if vm.Type != types.CosmWasmContractMethod2024 || k.contractKeeper == nil {
+		return false
+	}
+
+	blockchainId, err := types.NewBlockchainId(vm.BlockchainAccountId)
+	if err != nil {
+		return false
+	}
+	if blockchainId.ChainId != ctx.ChainID() || blockchainId.BlockchainAddress != txAuthor {
+		return false
+	}
+
+	_, contractAddress, err := bech32.DecodeAndConvert(blockchainId.BlockchainAddress)
+	if err != nil {
+		return false
+	}
+	return k.contractKeeper.HasContractInfo(ctx, sdk.AccAddress(contractAddress))
+}
+
+// getContractAuthorizedControllers returns the controllers, having a verification method of type
+// CosmWasmContractMethod2024 whose contract is the transaction sender. Such verification methods are searched
+// in the subject DID Document and in the controller's own DID Document.
+func (k Keeper) getContractAuthorizedControllers(
+	ctx sdk.Context, controllers []string, didDocument *types.DidDocument, txAuthor string,
+) map[string]bool {
+	authorizedControllers := map[string]bool{}
+
+	for _, controller := range controllers {
+		var verificationMethods []*types.VerificationMethod
+		verificationMethods = append(verificationMethods, didDocument.VerificationMethod...)
+		if controller != didDocument.Id {
+			if controllerDidDocumentState, err := k.getDidDocumentState(&ctx, controller); err == nil {
+				verificationMethods = append(verificationMethods, controllerDidDocumentState.DidDocument.VerificationMethod...)
+			}
+		}
+
+		for _, vm := range verificationMethods {
+			if vm.Controller == controller && k.isContractSender(ctx, vm, txAuthor) {
+				authorizedControllers[controller] = true
+				break
+			}
+		}
+	}
+
+	return authorizedControllers
+}
+
+// checkNewContractVerificationMethods checks that every verification method of type CosmWasmContractMethod2024,
+// which is present in incomingVMs but not in existingVMs, belongs to the contract sending the transaction. Since
+// contracts cannot sign, being the transaction sender is their proof of possession.
+func (k Keeper) checkNewContractVerificationMethods(
+	ctx sdk.Context, existingVMs []*types.VerificationMethod, incomingVMs []*types.VerificationMethod, txAuthor string,
+) error {
+	existingVmMap := map[string]bool{}
+	for _, vm := range existingVMs {
+		existingVmMap[vm.Id] = true
+	}
+
+	for _, vm := range incomingVMs {
+		if vm.Type != types.CosmWasmContractMethod2024 || existingVmMap[vm.Id] {
+			continue
+		}
+		if !k.isContractSender(ctx, vm, txAuthor) {
+			return fmt.Errorf(
+				"verification method %s can only be added by its contract %s as the transaction sender",
+				vm.Id,
+				vm.BlockchainAccountId,
+			)
+		}
+	}
+	return nil
+}
+
+// getControllersOfVmMap returns the controllers of a controller to Extended Verification Methods map
+func getControllersOfVmMap(controllerMap map[string][]*types.ExtendedVerificationMethod) []string {
+	controllers := make([]string, 0, len(controllerMap))
+	for controller := range controllerMap {
+		controllers = append(controllers, controller)
+	}
+	return controllers
+}
+
+// chargeContractSenderFee charges the fixed fee of the SSI message to its transaction sender, if the sender is a
+// CosmWasm contract. Messages dispatched by contracts are not part of a transaction, and hence are not charged by
+// the fee deduction of ante handler.
+func (k Keeper) chargeContractSenderFee(ctx sdk.Context, msg sdk.Msg, txAuthor string) error {
+	if k.contractKeeper == nil {
+		return nil
+	}
+
+	_, senderAddress, err := bech32.DecodeAndConvert(txAuthor)
+	if err != nil || !k.contractKeeper.HasContractInfo(ctx, sdk.AccAddress(senderAddress)) {
+		return nil
+	}
+
+	fee := k.GetParams(ctx).GetFixedFee(msg)
+	if fee == nil || fee.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(senderAddress), authtypes.FeeCollectorName, sdk.NewCoins(*fee)); err != nil {
+		return errors.Wrapf(err, "contract %v cannot pay the fixed fee %v", txAuthor, fee)
+	}
+	return nil
+}
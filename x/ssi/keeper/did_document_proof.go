@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// getDidDocumentProof queries the ICS-23 Merkle proof of the latest DID Document state, at the block height of
+// query context, along with the stored value which it proves. The proof is checked against the app hash of the
+// block height, which is committed in the header of the next block.
+func (k Keeper) getDidDocumentProof(ctx sdk.Context, didId string) (*crypto.ProofOps, []byte, error) {
+	if k.storeQuerier == nil {
+		return nil, nil, fmt.Errorf("proofs are not supported by this node")
+	}
+	// Committed state differs across nodes due to pruning, and hence proofs cannot be queried during
+	// transaction execution
+	if !ctx.IsCheckTx() {
+		return nil, nil, fmt.Errorf("proofs can only be requested in queries")
+	}
+
+	res := k.storeQuerier.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", types.StoreKey),
+		Data:   types.GetDidDocumentKey(didId),
+		Height: ctx.BlockHeight(),
+		Prove:  true,
+	})
+	if !res.IsOK() {
+		return nil, nil, fmt.Errorf("unable to query proof of DID Document %s: %s", didId, res.Log)
+	}
+	if len(res.Value) == 0 || res.ProofOps == nil {
+		return nil, nil, fmt.Errorf("proof of DID Document %s not found at height %d", didId, ctx.BlockHeight())
+	}
+
+	return res.ProofOps, res.Value, nil
+}
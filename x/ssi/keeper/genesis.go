@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// GetAllDidDocumentStates returns every DID Document registered in store
+func (k Keeper) GetAllDidDocumentStates(ctx sdk.Context) []*types.DidDocumentState {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var didDocuments []*types.DidDocumentState
+	for ; iterator.Valid(); iterator.Next() {
+		var didDocument types.DidDocumentState
+		k.cdc.MustUnmarshal(iterator.Value(), &didDocument)
+		didDocuments = append(didDocuments, &didDocument)
+	}
+
+	return didDocuments
+}
+
+// GetAllDidDocumentVersions returns every version of every DID Document in store
+func (k Keeper) GetAllDidDocumentVersions(ctx sdk.Context) []*types.DidDocumentState {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidVersionKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var didDocVersions []*types.DidDocumentState
+	for ; iterator.Valid(); iterator.Next() {
+		var didDocVersion types.DidDocumentState
+		k.cdc.MustUnmarshal(iterator.Value(), &didDocVersion)
+		didDocVersions = append(didDocVersions, &didDocVersion)
+	}
+
+	return didDocVersions
+}
+
+// GetAllCredentialSchemaStates returns every Credential Schema registered in store
+func (k Keeper) GetAllCredentialSchemaStates(ctx sdk.Context) []*types.CredentialSchemaState {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SchemaKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var credentialSchemas []*types.CredentialSchemaState
+	for ; iterator.Valid(); iterator.Next() {
+		var credentialSchema types.CredentialSchemaState
+		k.cdc.MustUnmarshal(iterator.Value(), &credentialSchema)
+		credentialSchemas = append(credentialSchemas, &credentialSchema)
+	}
+
+	return credentialSchemas
+}
+
+// GetAllCredentialStatusStates returns every Credential Status registered in store
+func (k Keeper) GetAllCredentialStatusStates(ctx sdk.Context) []*types.CredentialStatusState {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var credentialStatuses []*types.CredentialStatusState
+	for ; iterator.Valid(); iterator.Next() {
+		var credentialStatus types.CredentialStatusState
+		k.cdc.MustUnmarshal(iterator.Value(), &credentialStatus)
+		credentialStatuses = append(credentialStatuses, &credentialStatus)
+	}
+
+	return credentialStatuses
+}
+
+// GetAllCredentialStatusBatchStates returns every Credential Status Batch present in store
+func (k Keeper) GetAllCredentialStatusBatchStates(ctx sdk.Context) []*types.CredentialStatusBatchState {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredBatchKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var batches []*types.CredentialStatusBatchState
+	for ; iterator.Valid(); iterator.Next() {
+		var batch types.CredentialStatusBatchState
+		k.cdc.MustUnmarshal(iterator.Value(), &batch)
+		batches = append(batches, &batch)
+	}
+
+	return batches
+}
+
+// GetAllCredentialStatusListStates returns every Credential Status List present in store
+func (k Keeper) GetAllCredentialStatusListStates(ctx sdk.Context) []*types.CredentialStatusListState {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredStatusListKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var credentialStatusLists []*types.CredentialStatusListState
+	for ; iterator.Valid(); iterator.Next() {
+		var credentialStatusList types.CredentialStatusListState
+		k.cdc.MustUnmarshal(iterator.Value(), &credentialStatusList)
+		credentialStatusLists = append(credentialStatusLists, &credentialStatusList)
+	}
+
+	return credentialStatusLists
+}
+
+// GetAllAccreditationStates returns every accreditation present in store
+func (k Keeper) GetAllAccreditationStates(ctx sdk.Context) []*types.AccreditationState {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AccreditationKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var accreditations []*types.AccreditationState
+	for ; iterator.Valid(); iterator.Next() {
+		var accreditation types.AccreditationState
+		k.cdc.MustUnmarshal(iterator.Value(), &accreditation)
+		accreditations = append(accreditations, &accreditation)
+	}
+
+	return accreditations
+}
+
+// GetAllPendingDidRecoveries returns every pending DID recovery present in store
+func (k Keeper) GetAllPendingDidRecoveries(ctx sdk.Context) []*types.PendingDidRecovery {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidRecoveryKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var recoveries []*types.PendingDidRecovery
+	for ; iterator.Valid(); iterator.Next() {
+		var recovery types.PendingDidRecovery
+		k.cdc.MustUnmarshal(iterator.Value(), &recovery)
+		recoveries = append(recoveries, &recovery)
+	}
+
+	return recoveries
+}
+
+// GetAllDidRecoveryProofs returns every proof which has initiated a DID recovery
+func (k Keeper) GetAllDidRecoveryProofs(ctx sdk.Context) []*types.DidRecoveryProofEntry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidRecoveryProofKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var entries []*types.DidRecoveryProofEntry
+	for ; iterator.Valid(); iterator.Next() {
+		key := string(iterator.Key())
+		separatorIndex := strings.LastIndex(key, "/")
+		entries = append(entries, &types.DidRecoveryProofEntry{
+			DidId:          key[:separatorIndex],
+			ProofValueHash: key[separatorIndex+1:],
+		})
+	}
+
+	return entries
+}
+
+// GetAllBlockchainAccountIds returns every blockchainAccountId entry present in store
+func (k Keeper) GetAllBlockchainAccountIds(ctx sdk.Context) []*types.BlockchainAccountIdEntry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.BlockchainAccountIdStoreKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var entries []*types.BlockchainAccountIdEntry
+	for ; iterator.Valid(); iterator.Next() {
+		entries = append(entries, &types.BlockchainAccountIdEntry{
+			BlockchainAccountId: string(iterator.Key()),
+			DidId:               string(iterator.Value()),
+		})
+	}
+
+	return entries
+}
+
+// SetDidDocumentState sets a DID Document in store without altering the DID Document count
+func (k Keeper) SetDidDocumentState(ctx sdk.Context, didDocumentState *types.DidDocumentState) {
+	k.setDidDocumentInStore(ctx, didDocumentState)
+	k.setDidControllerIndex(ctx, didDocumentState)
+}
+
+// AppendDidDocumentVersion appends a version of DID Document to its version history
+func (k Keeper) AppendDidDocumentVersion(ctx sdk.Context, didDocumentState *types.DidDocumentState) {
+	k.setDidDocumentVersionInStore(ctx, didDocumentState)
+}
+
+// SetPendingDidRecovery sets a pending DID recovery in store
+func (k Keeper) SetPendingDidRecovery(ctx sdk.Context, recovery *types.PendingDidRecovery) {
+	k.setPendingDidRecovery(ctx, recovery)
+}
+
+// SetDidRecoveryProof records a proof which has initiated a DID recovery
+func (k Keeper) SetDidRecoveryProof(ctx sdk.Context, entry *types.DidRecoveryProofEntry) {
+	k.setDidRecoveryProof(ctx, entry.DidId, entry.ProofValueHash)
+}
+
+// SetCredentialSchemaState sets a Credential Schema in store without altering the Credential Schema count
+func (k Keeper) SetCredentialSchemaState(ctx sdk.Context, credentialSchemaState *types.CredentialSchemaState) {
+	k.mustSetCredentialSchemaState(ctx, credentialSchemaState)
+}
+
+// SetCredentialStatusState sets a Credential Status in store without altering the Credential Status count
+func (k Keeper) SetCredentialStatusState(ctx sdk.Context, credentialStatusState *types.CredentialStatusState) {
+	k.setCredentialStatusState(ctx, credentialStatusState)
+}
+
+// SetCredentialStatusBatchState sets a Credential Status Batch in store
+func (k Keeper) SetCredentialStatusBatchState(ctx sdk.Context, batch *types.CredentialStatusBatchState) {
+	k.setCredentialStatusBatchState(ctx, batch)
+}
+
+// SetCredentialStatusListState sets a Credential Status List in store
+func (k Keeper) SetCredentialStatusListState(ctx sdk.Context, credentialStatusListState *types.CredentialStatusListState) {
+	k.setCredentialStatusListState(ctx, credentialStatusListState)
+}
+
+// SetAccreditationState sets an accreditation in store
+func (k Keeper) SetAccreditationState(ctx sdk.Context, accreditation *types.AccreditationState) {
+	k.setAccreditationState(ctx, accreditation)
+}
+
+// SetBlockchainAccountId sets a blockchainAccountId entry in store
+func (k Keeper) SetBlockchainAccountId(ctx sdk.Context, entry *types.BlockchainAccountIdEntry) {
+	k.setBlockchainAddressInStore(&ctx, entry.BlockchainAccountId, entry.DidId)
+}
+
+// GetDidDocumentCount returns the DID Document count
+func (k Keeper) GetDidDocumentCount(ctx sdk.Context) uint64 {
+	return k.getDidDocumentCount(ctx)
+}
+
+// SetDidDocumentCount sets the DID Document count
+func (k Keeper) SetDidDocumentCount(ctx sdk.Context, count uint64) {
+	setDidDocumentCount(k, ctx, count)
+}
+
+// GetCredentialSchemaCount returns the Credential Schema count
+func (k Keeper) GetCredentialSchemaCount(ctx sdk.Context) uint64 {
+	return k.getCredentialSchemaCount(ctx)
+}
+
+// SetCredentialSchemaCount sets the Credential Schema count
+func (k Keeper) SetCredentialSchemaCount(ctx sdk.Context, count uint64) {
+	k.setCredentialSchemaCount(ctx, count)
+}
+
+// GetCredentialStatusCount returns the Credential Status count
+func (k Keeper) GetCredentialStatusCount(ctx sdk.Context) uint64 {
+	return k.getCredentialStatusCount(ctx)
+}
+
+// SetCredentialStatusCount sets the Credential Status count
+func (k Keeper) SetCredentialStatusCount(ctx sdk.Context, count uint64) {
+	k.setCredentialStatusCount(ctx, count)
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AccreditationByID returns an accreditation registered in the trust registry
+func (k Keeper) AccreditationByID(goCtx context.Context, req *types.QueryAccreditationRequest) (*types.QueryAccreditationResponse, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accreditation, err := k.getAccreditationState(ctx, req.Id)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrAccreditationNotFound, err.Error())
+	}
+
+	return &types.QueryAccreditationResponse{Accreditation: accreditation}, nil
+}
+
+// IssuerAccreditation checks whether an issuer is accredited for a Credential Schema at a given time. The issuer is
+// accredited if any of its accreditations for the Credential Schema has a valid chain of accreditations at that time.
+func (k Keeper) IssuerAccreditation(goCtx context.Context, req *types.QueryIssuerAccreditationRequest) (*types.QueryIssuerAccreditationResponse, error) {
+	if req == nil || req.Issuer == "" || req.CredentialSchemaId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	queryTime := ctx.BlockTime()
+	if req.Time != "" {
+		var err error
+		queryTime, err = time.Parse(time.RFC3339, req.Time)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid time %s, expected RFC3339 format", req.Time)
+		}
+	}
+
+	for _, accreditationId := range k.getIssuerAccreditationIds(ctx, req.Issuer, req.CredentialSchemaId) {
+		accreditation, err := k.getAccreditationState(ctx, accreditationId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		accreditationChain, err := k.getAccreditationChain(ctx, accreditation, queryTime)
+		if err != nil {
+			continue
+		}
+		return &types.QueryIssuerAccreditationResponse{
+			Accredited:         true,
+			AccreditationChain: accreditationChain,
+		}, nil
+	}
+
+	return &types.QueryIssuerAccreditationResponse{Accredited: false}, nil
+}
@@ -29,6 +29,25 @@ func (k Keeper) CredentialStatusByID(
 	return &types.QueryCredentialStatusResponse{CredentialStatus: cred}, nil
 }
 
+// CredentialStatusBatchByID returns a Credential Status Batch Document along with its proof, which is the proof
+// of every Credential Status registered through it
+func (k Keeper) CredentialStatusBatchByID(
+	goCtx context.Context,
+	req *types.QueryCredentialStatusBatchRequest,
+) (*types.QueryCredentialStatusBatchResponse, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	batch, err := k.getCredentialStatusBatchState(ctx, req.Id)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrCredentialStatusBatchNotFound, err.Error())
+	}
+
+	return &types.QueryCredentialStatusBatchResponse{CredentialStatusBatch: batch}, nil
+}
+
 func (k Keeper) CredentialStatuses(
 	goCtx context.Context,
 	req *types.QueryCredentialStatusesRequest,
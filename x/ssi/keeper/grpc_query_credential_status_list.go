@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CredentialStatusListByID returns the Credential Status List in the form of a W3C BitstringStatusListCredential,
+// along with the registered Credential Status List Document and its proof
+func (k Keeper) CredentialStatusListByID(goCtx context.Context, req *types.QueryCredentialStatusListRequest) (*types.QueryCredentialStatusListResponse, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	credStatusList, err := k.getCredentialStatusListState(ctx, req.Id)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrCredentialStatusListNotFound, err.Error())
+	}
+
+	return &types.QueryCredentialStatusListResponse{
+		BitstringStatusListCredential: types.NewBitstringStatusListCredential(credStatusList),
+		CredentialStatusList:          credStatusList,
+	}, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -47,16 +48,94 @@ func (k Keeper) DidDocumentByID(goCtx context.Context, req *types.QueryDidDocume
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	if req.Prove && (req.VersionId != "" || req.VersionTime != "") {
+		return nil, status.Error(codes.InvalidArgument, "proof can only be requested for the latest version of Did Document")
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// Check if DID Document exists
-	didDoc, err := k.getDidDocumentState(&ctx, req.DidId)
+	didDoc, err := k.getDidDocumentStateForResolution(&ctx, req.DidId, req.VersionId, req.VersionTime)
 	if err != nil {
+		if errors.IsOf(err, types.ErrInvalidDidResolutionOptions) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, errors.Wrap(types.ErrDidDocNotFound, err.Error())
 	}
 
-	return &types.QueryDidDocumentResponse{
+	response := &types.QueryDidDocumentResponse{
 		DidDocument:         didDoc.GetDidDocument(),
 		DidDocumentMetadata: didDoc.GetDidDocumentMetadata(),
-	}, nil
+	}
+
+	if req.Prove {
+		proof, value, err := k.getDidDocumentProof(ctx, req.DidId)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		response.Proof = proof
+		response.DidDocumentStateValue = value
+		response.Height = ctx.BlockHeight()
+	}
+
+	return response, nil
+}
+
+// getDidDocumentStateForResolution gets the DID Document state, optionally selected by either
+// version id or the time at which the version was active
+func (k Keeper) getDidDocumentStateForResolution(ctx *sdk.Context, didId string, versionId string, versionTime string) (*types.DidDocumentState, error) {
+	switch {
+	case versionId != "" && versionTime != "":
+		return nil, errors.Wrap(types.ErrInvalidDidResolutionOptions, "only one of versionId and versionTime can be specified")
+	case versionId != "":
+		return k.getDidDocumentStateByVersionId(ctx, didId, versionId)
+	case versionTime != "":
+		versionTimeParsed, err := time.Parse(time.RFC3339, versionTime)
+		if err != nil {
+			return nil, errors.Wrapf(types.ErrInvalidDidResolutionOptions, "invalid versionTime %s, expected RFC3339 format", versionTime)
+		}
+		return k.getDidDocumentStateAtTime(ctx, didId, versionTimeParsed)
+	default:
+		return k.getDidDocumentState(ctx, didId)
+	}
+}
+
+func (k Keeper) DidDocumentVersions(goCtx context.Context, req *types.QueryDidDocumentVersionsRequest) (*types.QueryDidDocumentVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if DID Document exists
+	didDoc, err := k.getDidDocumentState(&ctx, req.DidId)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrDidDocNotFound, err.Error())
+	}
+
+	// Version history is absent for DID Documents which were never altered after the
+	// introduction of versioning, making their current state the only known version
+	if !k.hasDidDocumentVersions(ctx, req.DidId) {
+		return &types.QueryDidDocumentVersionsResponse{
+			DidDocumentVersions: []*types.DidDocumentState{didDoc},
+			Pagination:          &query.PageResponse{Total: 1},
+		}, nil
+	}
+
+	var didDocVersions []*types.DidDocumentState
+	pageRes, err := query.Paginate(k.didDocumentVersionStore(ctx, req.DidId), req.Pagination, func(key []byte, value []byte) error {
+		var didDocVersion types.DidDocumentState
+		if err := k.cdc.Unmarshal(value, &didDocVersion); err != nil {
+			return err
+		}
+
+		didDocVersions = append(didDocVersions, &didDocVersion)
+		return nil
+	})
+
+	// Throw an error if pagination failed
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDidDocumentVersionsResponse{DidDocumentVersions: didDocVersions, Pagination: pageRes}, nil
 }
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DereferenceDidUrl dereferences a DID URL as per W3C DID Core specification. The DID URL is resolved to
+// either the DID Document, a Verification Method or Service selected by the fragment, or the service endpoint
+// URL selected by the `service` and `relativeRef` parameters. Dereferencing errors are reported through
+// `dereferencingMetadata.error`.
+func (k Keeper) DereferenceDidUrl(goCtx context.Context, req *types.QueryDereferenceDidUrlRequest) (*types.QueryDereferenceDidUrlResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contentType := req.Accept
+	if contentType == "" {
+		contentType = types.DidLdJsonContentType
+	}
+
+	dereferencingResult := &types.QueryDereferenceDidUrlResponse{
+		Context: []string{types.DidResolutionContext},
+		DereferencingMetadata: &types.DidResolutionMetadata{
+			ContentType: contentType,
+			Retrieved:   ctx.BlockTime().Format(time.RFC3339),
+		},
+	}
+
+	didId, path, query, fragment, err := types.ParseDidUrl(req.DidUrl)
+	if err != nil {
+		dereferencingResult.DereferencingMetadata.Error = types.DidUrlDereferencingErrorInvalidDidUrl
+		return dereferencingResult, nil
+	}
+
+	// `relativeRef` is only meaningful alongside a selected service
+	serviceParam, relativeRef := query.Get(types.DidUrlParamService), query.Get(types.DidUrlParamRelativeRef)
+	if serviceParam == "" && relativeRef != "" {
+		dereferencingResult.DereferencingMetadata.Error = types.DidUrlDereferencingErrorInvalidDidUrl
+		return dereferencingResult, nil
+	}
+
+	// Check if the requested representation is supported. A service endpoint URL is always a URI list.
+	if serviceParam == "" && !utils.FindInSlice(types.SupportedDidRepresentations, contentType) {
+		dereferencingResult.DereferencingMetadata.ContentType = ""
+		dereferencingResult.DereferencingMetadata.Error = types.DidResolutionErrorRepresentationNotSupported
+		return dereferencingResult, nil
+	}
+
+	// hid method does not define any resource identified by a DID path
+	if path != "" {
+		dereferencingResult.DereferencingMetadata.Error = types.DidResolutionErrorNotFound
+		return dereferencingResult, nil
+	}
+
+	didDocumentState, err := k.getDidDocumentStateForResolution(
+		&ctx,
+		didId,
+		query.Get(types.DidUrlParamVersionId),
+		query.Get(types.DidUrlParamVersionTime),
+	)
+	if err != nil {
+		if errors.IsOf(err, types.ErrInvalidDidResolutionOptions) {
+			dereferencingResult.DereferencingMetadata.Error = types.DidUrlDereferencingErrorInvalidDidUrl
+		} else {
+			dereferencingResult.DereferencingMetadata.Error = types.DidResolutionErrorNotFound
+		}
+		return dereferencingResult, nil
+	}
+
+	dereferencingResult.ContentMetadata = didDocumentState.DidDocumentMetadata
+
+	if didDocumentState.DidDocumentMetadata.Deactivated {
+		dereferencingResult.DereferencingMetadata.Error = types.DidResolutionErrorDeactivated
+		return dereferencingResult, nil
+	}
+
+	didDocument := didDocumentState.DidDocument
+
+	switch {
+	// Service selection by `service` parameter, with an optional `relativeRef` and fragment
+	// applied on the selected service endpoint
+	case serviceParam != "":
+		service := findServiceInDidDocument(didDocument, didId+"#"+serviceParam)
+		if service == nil {
+			dereferencingResult.DereferencingMetadata.Error = types.DidResolutionErrorNotFound
+			return dereferencingResult, nil
+		}
+
+		contentUrl, err := formServiceEndpointUrl(service.ServiceEndpoint, relativeRef, fragment)
+		if err != nil {
+			dereferencingResult.DereferencingMetadata.Error = types.DidUrlDereferencingErrorInvalidDidUrl
+			return dereferencingResult, nil
+		}
+
+		dereferencingResult.DereferencingMetadata.ContentType = types.UriListContentType
+		dereferencingResult.ContentUrl = contentUrl
+
+	// Secondary resource selection by fragment. The resource is looked up in the DID Document of the DID URL
+	// itself, irrespective of the controller of the Verification Method.
+	case fragment != "":
+		resourceId := didId + "#" + fragment
+
+		if vm := findVerificationMethodInDidDocument(didDocument, resourceId); vm != nil {
+			dereferencingResult.VerificationMethod = vm
+		} else if service := findServiceInDidDocument(didDocument, resourceId); service != nil {
+			dereferencingResult.Service = service
+		} else {
+			dereferencingResult.DereferencingMetadata.Error = types.DidResolutionErrorNotFound
+			return dereferencingResult, nil
+		}
+
+	// DID URL without a fragment and service selection dereferences to the DID Document
+	default:
+		dereferencingResult.DidDocument = formDidDocumentRepresentation(didDocument, contentType)
+	}
+
+	return dereferencingResult, nil
+}
+
+// findVerificationMethodInDidDocument returns the Verification Method of DID Document with the input id
+func findVerificationMethodInDidDocument(didDocument *types.DidDocument, vmId string) *types.VerificationMethod {
+	for _, vm := range didDocument.VerificationMethod {
+		if vm.Id == vmId {
+			return vm
+		}
+	}
+	return nil
+}
+
+// findServiceInDidDocument returns the Service of DID Document with the input id
+func findServiceInDidDocument(didDocument *types.DidDocument, serviceId string) *types.Service {
+	for _, service := range didDocument.Service {
+		if service.Id == serviceId {
+			return service
+		}
+	}
+	return nil
+}
+
+// formServiceEndpointUrl resolves the relative reference against the service endpoint as per RFC3986, and
+// appends the DID URL fragment if the resulting URL does not already have one
+func formServiceEndpointUrl(serviceEndpoint string, relativeRef string, fragment string) (string, error) {
+	serviceEndpointUrl, err := url.Parse(serviceEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	if relativeRef != "" {
+		relativeRefUrl, err := url.Parse(relativeRef)
+		if err != nil {
+			return "", err
+		}
+		serviceEndpointUrl = serviceEndpointUrl.ResolveReference(relativeRefUrl)
+	}
+
+	if fragment != "" && serviceEndpointUrl.Fragment == "" {
+		serviceEndpointUrl.Fragment = fragment
+	}
+
+	return serviceEndpointUrl.String(), nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PendingDidRecovery returns the pending recovery of a DID Document
+func (k Keeper) PendingDidRecovery(goCtx context.Context, req *types.QueryPendingDidRecoveryRequest) (*types.QueryPendingDidRecoveryResponse, error) {
+	if req == nil || req.DidId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recovery, err := k.getPendingDidRecovery(ctx, req.DidId)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrDidRecoveryNotFound, err.Error())
+	}
+
+	return &types.QueryPendingDidRecoveryResponse{PendingDidRecovery: recovery}, nil
+}
+
+// PendingDidRecoveries returns the list of pending DID recoveries
+func (k Keeper) PendingDidRecoveries(goCtx context.Context, req *types.QueryPendingDidRecoveriesRequest) (*types.QueryPendingDidRecoveriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidRecoveryKey))
+
+	var recoveries []*types.PendingDidRecovery
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var recovery types.PendingDidRecovery
+		if err := k.cdc.Unmarshal(value, &recovery); err != nil {
+			return err
+		}
+
+		recoveries = append(recoveries, &recovery)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingDidRecoveriesResponse{
+		PendingDidRecoveries: recoveries,
+		Pagination:           pageRes,
+	}, nil
+}
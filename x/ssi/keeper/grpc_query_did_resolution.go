@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResolveDid resolves a DID as per W3C DID Resolution specification. Resolution errors are reported through
+// `didResolutionMetadata.error` rather than a gRPC error, as expected by DID Resolution clients.
+func (k Keeper) ResolveDid(goCtx context.Context, req *types.QueryResolveDidRequest) (*types.QueryResolveDidResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contentType := req.Accept
+	if contentType == "" {
+		contentType = types.DidLdJsonContentType
+	}
+
+	resolutionResult := &types.QueryResolveDidResponse{
+		Context: []string{types.DidResolutionContext},
+		DidResolutionMetadata: &types.DidResolutionMetadata{
+			ContentType: contentType,
+			Retrieved:   ctx.BlockTime().Format(time.RFC3339),
+		},
+	}
+
+	// Check if the requested representation is supported
+	if !utils.FindInSlice(types.SupportedDidRepresentations, contentType) {
+		resolutionResult.DidResolutionMetadata.ContentType = ""
+		resolutionResult.DidResolutionMetadata.Error = types.DidResolutionErrorRepresentationNotSupported
+		return resolutionResult, nil
+	}
+
+	// Check if the DID conforms to the hid DID method syntax
+	if err := types.ValidateDidId(req.DidId); err != nil {
+		resolutionResult.DidResolutionMetadata.Error = types.DidResolutionErrorInvalidDid
+		return resolutionResult, nil
+	}
+
+	didDocumentState, err := k.getDidDocumentStateForResolution(&ctx, req.DidId, req.VersionId, req.VersionTime)
+	if err != nil {
+		if errors.IsOf(err, types.ErrInvalidDidResolutionOptions) {
+			resolutionResult.DidResolutionMetadata.Error = types.DidResolutionErrorInvalidOptions
+		} else {
+			resolutionResult.DidResolutionMetadata.Error = types.DidResolutionErrorNotFound
+		}
+		return resolutionResult, nil
+	}
+
+	resolutionResult.DidDocumentMetadata = didDocumentState.DidDocumentMetadata
+
+	// A deactivated DID only has its document metadata returned
+	if didDocumentState.DidDocumentMetadata.Deactivated {
+		resolutionResult.DidResolutionMetadata.Error = types.DidResolutionErrorDeactivated
+		return resolutionResult, nil
+	}
+
+	resolutionResult.DidDocument = formDidDocumentRepresentation(didDocumentState.DidDocument, contentType)
+
+	return resolutionResult, nil
+}
+
+// formDidDocumentRepresentation returns the DID Document in the requested representation. The JSON-LD representation
+// must have the DID Core context as its first `@context` entry, whereas the JSON representation has no `@context`.
+func formDidDocumentRepresentation(didDocument *types.DidDocument, contentType string) *types.DidDocument {
+	didDocumentRepresentation := *didDocument
+
+	switch contentType {
+	case types.DidJsonContentType:
+		didDocumentRepresentation.Context = nil
+	case types.DidLdJsonContentType:
+		if len(didDocument.Context) == 0 || didDocument.Context[0] != types.DidCoreContext {
+			context := []string{types.DidCoreContext}
+			for _, contextUrl := range didDocument.Context {
+				if contextUrl != types.DidCoreContext {
+					context = append(context, contextUrl)
+				}
+			}
+			didDocumentRepresentation.Context = context
+		}
+	}
+
+	return &didDocumentRepresentation
+}
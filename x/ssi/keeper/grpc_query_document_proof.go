@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/verification"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// VerifyDocumentProof verifies the proof of a DID Document, Credential Schema or Credential Status against the
+// current state, without changing it. The outcome of every performed check is reported, so that the clients can
+// find out which of them failed. The proof of a Credential Status registered through a batch is verified against
+// the stored Credential Status Batch Document.
+func (k Keeper) VerifyDocumentProof(goCtx context.Context, req *types.QueryVerifyDocumentProofRequest) (*types.QueryVerifyDocumentProofResponse, error) {
+	if req == nil || req.DocumentProof == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ssiMsg, err := getDocumentForProofVerification(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	docProof := req.DocumentProof
+	res := &types.QueryVerifyDocumentProofResponse{}
+
+	if !addProofVerificationCheck(res, types.ProofCheckDocumentProof, docProof.Validate(), "") {
+		return res, nil
+	}
+
+	if !addProofVerificationCheck(res, types.ProofCheckContext, ldcontext.ValidateContextUrls(ssiMsg.GetContext()), "") {
+		return res, nil
+	}
+
+	// The proof of a Credential Status registered through a batch is created over the Credential Status Batch Document
+	if credStatus, ok := ssiMsg.(*types.CredentialStatusDocument); ok {
+		if batch := k.getCredentialStatusBatchForProofVerification(ctx, credStatus.Id, docProof); batch != nil {
+			if !addProofVerificationCheck(res, types.ProofCheckCredentialStatusBatch, checkCredentialStatusBatchMember(batch, credStatus), "resolved from state: "+batch.Id) {
+				return res, nil
+			}
+			ssiMsg = batch.CredentialStatusBatchDocument
+		}
+	}
+
+	docVm, metadata, vmSource, err := k.getVerificationMethodForProofVerification(ctx, ssiMsg, docProof.VerificationMethod)
+	if !addProofVerificationCheck(res, types.ProofCheckVerificationMethod, err, vmSource) {
+		return res, nil
+	}
+
+	if !addProofVerificationCheck(res, types.ProofCheckVerificationMethodCompromise, verification.CheckProofVerificationMethodCompromise(metadata, docProof), "") {
+		return res, nil
+	}
+
+	if !addProofVerificationCheck(res, types.ProofCheckProofType, verification.CheckProofType(docVm, docProof), "") {
+		return res, nil
+	}
+
+	signingInput, err := verification.GetDocumentSigningInput(ssiMsg, docVm, docProof)
+	if !addProofVerificationCheck(res, types.ProofCheckCanonicalization, err, "") {
+		return res, nil
+	}
+	res.SigningInput = hex.EncodeToString(signingInput)
+
+	if !addProofVerificationCheck(res, types.ProofCheckSignature, verification.VerifyDocumentProofSignature(ssiMsg, docVm, docProof), "") {
+		return res, nil
+	}
+
+	res.Verified = true
+	return res, nil
+}
+
+// getDocumentForProofVerification returns the only SSI Document present in the request
+func getDocumentForProofVerification(req *types.QueryVerifyDocumentProofRequest) (types.SsiMsg, error) {
+	var documents []types.SsiMsg
+	if req.DidDocument != nil {
+		documents = append(documents, req.DidDocument)
+	}
+	if req.CredentialSchemaDocument != nil {
+		documents = append(documents, req.CredentialSchemaDocument)
+	}
+	if req.CredentialStatusDocument != nil {
+		documents = append(documents, req.CredentialStatusDocument)
+	}
+
+	if len(documents) != 1 {
+		return nil, fmt.Errorf("exactly one of didDocument, credentialSchemaDocument and credentialStatusDocument must be provided")
+	}
+	return documents[0], nil
+}
+
+// getCredentialStatusBatchForProofVerification returns the Credential Status Batch through which the Credential Status
+// was registered, if the document proof is the proof of that batch. It returns nil otherwise.
+func (k Keeper) getCredentialStatusBatchForProofVerification(ctx sdk.Context, credId string, docProof *types.DocumentProof) *types.CredentialStatusBatchState {
+	credStatusState, err := k.getCredentialStatusFromState(&ctx, credId)
+	if err != nil || credStatusState.CredentialStatusBatchId == "" {
+		return nil
+	}
+
+	batch, err := k.getCredentialStatusBatchState(ctx, credStatusState.CredentialStatusBatchId)
+	if err != nil || !proto.Equal(batch.CredentialStatusBatchProof, docProof) {
+		return nil
+	}
+	return batch
+}
+
+// checkCredentialStatusBatchMember checks if the Credential Status, along with the context inherited from the batch,
+// is a part of the Credential Status Batch Document
+func checkCredentialStatusBatchMember(batch *types.CredentialStatusBatchState, credStatus *types.CredentialStatusDocument) error {
+	batchDocument := batch.CredentialStatusBatchDocument
+	for _, batchCredStatus := range batchDocument.CredentialStatuses {
+		if batchCredStatus.Id != credStatus.Id {
+			continue
+		}
+
+		expectedCredStatus := *batchCredStatus
+		expectedCredStatus.Context = batchDocument.Context
+		if !proto.Equal(&expectedCredStatus, credStatus) {
+			return fmt.Errorf("credential status %v differs from the one registered in credential status batch %v", credStatus.Id, batch.Id)
+		}
+		return nil
+	}
+	return fmt.Errorf("credential status %v is not a part of credential status batch %v", credStatus.Id, batch.Id)
+}
+
+// getVerificationMethodForProofVerification returns the Verification Method of document proof along with the
+// metadata of DID Document it belongs to, and its source. A DID Document may be signed by its own Verification
+// Methods which are not registered yet, and hence they are taken from the DID Document itself.
+func (k Keeper) getVerificationMethodForProofVerification(
+	ctx sdk.Context, ssiMsg types.SsiMsg, docProofVmId string,
+) (*types.VerificationMethod, *types.DidDocumentMetadata, string, error) {
+	didId, _ := types.SplitDidUrl(docProofVmId)
+
+	if didDocument, ok := ssiMsg.(*types.DidDocument); ok && didDocument.Id == didId {
+		var metadata *types.DidDocumentMetadata
+		if didDocumentState, err := k.getDidDocumentState(&ctx, didId); err == nil {
+			metadata = didDocumentState.DidDocumentMetadata
+		}
+
+		for _, vm := range didDocument.VerificationMethod {
+			if vm.Id == docProofVmId {
+				return vm, metadata, "taken from the DID Document", nil
+			}
+		}
+		return nil, nil, "", fmt.Errorf("verificationMethod %s is not present in DID document %s", docProofVmId, didId)
+	}
+
+	docVm, didDocumentState, err := k.getProofVerificationMethod(ctx, docProofVmId)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	if didDocumentState.DidDocumentMetadata.Deactivated {
+		return nil, nil, "", fmt.Errorf("DID Document %s is deactivated", didId)
+	}
+	return docVm, didDocumentState.DidDocumentMetadata, "resolved from state", nil
+}
+
+// addProofVerificationCheck adds the outcome of a check to the verification report, and returns true if it passed
+func addProofVerificationCheck(res *types.QueryVerifyDocumentProofResponse, name string, err error, passMessage string) bool {
+	check := &types.ProofVerificationCheck{
+		Name:    name,
+		Passed:  err == nil,
+		Message: passMessage,
+	}
+	if err != nil {
+		check.Message = err.Error()
+	}
+
+	res.Checks = append(res.Checks, check)
+	return check.Passed
+}
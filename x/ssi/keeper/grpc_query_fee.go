@@ -5,27 +5,34 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // QuerySSIFee fetches fees for all SSI based transactions
 func (k Keeper) QuerySSIFee(goCtx context.Context, _ *types.QuerySSIFeeRequest) (*types.QuerySSIFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	registerDidFee := k.GetFeeParams(ctx, types.ParamStoreKeyRegisterDidFee)
-	updateDidFee := k.GetFeeParams(ctx, types.ParamStoreKeyUpdateDidFee)
-	deactivateDidFee := k.GetFeeParams(ctx, types.ParamStoreKeyDeactivateDidFee)
-	registerCredentialSchemaFee := k.GetFeeParams(ctx, types.ParamStoreKeyRegisterCredentialSchemaFee)
-	updateCredentialSchemaFee := k.GetFeeParams(ctx, types.ParamStoreKeyUpdateCredentialSchemaFee)
-	registerCredentialStatusFee := k.GetFeeParams(ctx, types.ParamStoreKeyRegisterCredentialStatusFee)
-	updateCredentialStatusFee := k.GetFeeParams(ctx, types.ParamStoreKeyUpdateCredentialStatusFee)
+	params := k.GetParams(ctx)
 
 	return &types.QuerySSIFeeResponse{
-		RegisterDidFee:              &registerDidFee,
-		UpdateDidFee:                &updateDidFee,
-		DeactivateDidFee:            &deactivateDidFee,
-		RegisterCredentialSchemaFee: &registerCredentialSchemaFee,
-		UpdateCredentialSchemaFee:   &updateCredentialSchemaFee,
-		RegisterCredentialStatusFee: &registerCredentialStatusFee,
-		UpdateCredentialStatusFee:   &updateCredentialStatusFee,
+		RegisterDidFee:                       params.RegisterDidFee,
+		UpdateDidFee:                         params.UpdateDidFee,
+		DeactivateDidFee:                     params.DeactivateDidFee,
+		RegisterCredentialSchemaFee:          params.RegisterCredentialSchemaFee,
+		UpdateCredentialSchemaFee:            params.UpdateCredentialSchemaFee,
+		RegisterCredentialStatusFee:          params.RegisterCredentialStatusFee,
+		UpdateCredentialStatusFee:            params.UpdateCredentialStatusFee,
+		RegisterCredentialStatusBatchItemFee: params.RegisterCredentialStatusBatchItemFee,
 	}, nil
 }
+
+// Params returns the x/ssi module params
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DidDocumentsByController(
+	goCtx context.Context,
+	req *types.QueryDidDocumentsByControllerRequest,
+) (*types.QueryDidDocumentsByControllerResponse, error) {
+	if req == nil || req.Controller == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDidControllerIndexPrefix(req.Controller))
+
+	var didDocuments []*types.DidDocumentState
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		didDoc, err := k.getDidDocumentState(&ctx, string(key))
+		if err != nil {
+			return errors.Wrap(types.ErrDidDocNotFound, err.Error())
+		}
+
+		didDocuments = append(didDocuments, didDoc)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDidDocumentsByControllerResponse{
+		DidDocuments: didDocuments,
+		Pagination:   pageRes,
+	}, nil
+}
+
+func (k Keeper) DidDocumentByBlockchainAccountId(
+	goCtx context.Context,
+	req *types.QueryDidDocumentByBlockchainAccountIdRequest,
+) (*types.QueryDidDocumentByBlockchainAccountIdResponse, error) {
+	if req == nil || req.BlockchainAccountId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	didId := k.getBlockchainAddressFromStore(&ctx, req.BlockchainAccountId)
+	if len(didId) == 0 {
+		return nil, errors.Wrap(
+			types.ErrDidDocNotFound,
+			fmt.Sprintf("blockchainAccountId %s is not part of any DID Document", req.BlockchainAccountId),
+		)
+	}
+
+	didDoc, err := k.getDidDocumentState(&ctx, string(didId))
+	if err != nil {
+		return nil, errors.Wrap(types.ErrDidDocNotFound, err.Error())
+	}
+
+	return &types.QueryDidDocumentByBlockchainAccountIdResponse{
+		DidDocument:         didDoc.DidDocument,
+		DidDocumentMetadata: didDoc.DidDocumentMetadata,
+	}, nil
+}
+
+func (k Keeper) CredentialSchemasByAuthor(
+	goCtx context.Context,
+	req *types.QueryCredentialSchemasByAuthorRequest,
+) (*types.QueryCredentialSchemasByAuthorResponse, error) {
+	if req == nil || req.Author == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSchemaAuthorIndexPrefix(req.Author))
+	schemaStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SchemaKey))
+
+	// Index entries are keyed by the store key of Credential Schema
+	var schemas []*types.CredentialSchemaState
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, value []byte) error {
+		bz := schemaStore.Get(key)
+		if bz == nil {
+			return errors.Wrapf(types.ErrCredentialSchemaNotFound, "credential schema of index entry %x", key)
+		}
+
+		var schema types.CredentialSchemaState
+		if err := k.cdc.Unmarshal(bz, &schema); err != nil {
+			return err
+		}
+		schemas = append(schemas, &schema)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCredentialSchemasByAuthorResponse{
+		CredentialSchemas: schemas,
+		Pagination:        pageRes,
+	}, nil
+}
+
+func (k Keeper) CredentialStatusesByIssuer(
+	goCtx context.Context,
+	req *types.QueryCredentialStatusesByIssuerRequest,
+) (*types.QueryCredentialStatusesByIssuerResponse, error) {
+	if req == nil || req.Issuer == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCredIssuerIndexPrefix(req.Issuer))
+
+	var credentials []*types.CredentialStatusState
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		credential, err := k.getCredentialStatusFromState(&ctx, string(key))
+		if err != nil {
+			return errors.Wrap(types.ErrCredentialStatusNotFound, err.Error())
+		}
+		credentials = append(credentials, credential)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCredentialStatusesByIssuerResponse{
+		CredentialStatuses: credentials,
+		Pagination:         pageRes,
+	}, nil
+}
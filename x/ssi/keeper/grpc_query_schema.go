@@ -20,6 +20,32 @@ func (k Keeper) CredentialSchemaByID(
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if req.Version != "" && req.Latest {
+		return nil, status.Error(codes.InvalidArgument, "only one of version and latest can be specified")
+	}
+
+	// Specific version of schema
+	if req.Version != "" {
+		schema, err := k.getCredentialSchemaVersion(ctx, req.SchemaId+":"+req.Version)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return &types.QueryCredentialSchemaResponse{
+			CredentialSchemas: []*types.CredentialSchemaState{schema},
+		}, nil
+	}
+
+	// Latest version of schema
+	if req.Latest {
+		schema, err := k.getLatestCredentialSchema(ctx, req.SchemaId)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return &types.QueryCredentialSchemaResponse{
+			CredentialSchemas: []*types.CredentialSchemaState{schema},
+		}, nil
+	}
+
 	schema, err := k.getCredentialSchemaFromStore(ctx, req.SchemaId)
 	if err != nil {
 		return nil, status.Error(codes.NotFound, err.Error())
@@ -0,0 +1,455 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"reflect" /* #nosec G702 */
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/verification"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ValidateSSIMsg runs the validation of a x/ssi module message against the current state, without committing
+// any change. Checks which are independent of each other are all performed, so that every violation among them
+// is reported. If none of them fail, the message is processed by its RPC controller on a discarded branch of the
+// state, which performs the checks depending on the preceding ones, such as signature verification.
+func (k Keeper) ValidateSSIMsg(goCtx context.Context, req *types.QueryValidateSSIMsgRequest) (*types.QueryValidateSSIMsgResponse, error) {
+	if req == nil || req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var msg sdk.Msg
+	if err := k.cdc.UnpackAny(req.Msg, &msg); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ms := msgServer{Keeper: k}
+
+	// The remaining checks, including ValidateBasic, expect the document and its proof to be present
+	if err := missingDocumentViolation(msg); err != nil {
+		return formValidateSSIMsgResponse([]error{err}), nil
+	}
+
+	var violations []error
+
+	if err := msg.ValidateBasic(); err != nil {
+		violations = append(violations, err)
+	}
+
+	switch msg := msg.(type) {
+	case *types.MsgRegisterDID:
+		violations = append(violations, ms.collectRegisterDidViolations(ctx, msg)...)
+	case *types.MsgUpdateDID:
+		violations = append(violations, ms.collectExistingDidViolations(ctx, msg.DidDocument, msg.DidDocumentProofs, msg.VersionId)...)
+	case *types.MsgInitiateDidRecovery:
+		violations = append(violations, ms.collectExistingDidViolations(ctx, msg.DidDocument, msg.DidDocumentProofs, msg.VersionId)...)
+	case *types.MsgDeactivateDID:
+		violations = append(violations, ms.collectDeactivateDidViolations(ctx, msg)...)
+	case *types.MsgCancelDidRecovery:
+		violations = append(violations, ms.collectCancelDidRecoveryViolations(ctx, msg)...)
+	case *types.MsgMarkVerificationMethodCompromised:
+		violations = append(violations, ms.collectMarkVerificationMethodCompromisedViolations(ctx, msg)...)
+	case *types.MsgRegisterCredentialSchema:
+		violations = append(violations, ms.collectCredentialSchemaViolations(ctx, msg.CredentialSchemaDocument, msg.CredentialSchemaProof, true)...)
+	case *types.MsgUpdateCredentialSchema:
+		violations = append(violations, ms.collectCredentialSchemaViolations(ctx, msg.CredentialSchemaDocument, msg.CredentialSchemaProof, false)...)
+	case *types.MsgUpdateCredentialSchemaStatus:
+		violations = append(violations, ms.collectCredentialSchemaStatusViolations(ctx, msg)...)
+	case *types.MsgRegisterCredentialStatus:
+		violations = append(violations, ms.collectCredentialStatusViolations(ctx, msg.CredentialStatusDocument, msg.CredentialStatusProof, true)...)
+	case *types.MsgUpdateCredentialStatus:
+		violations = append(violations, ms.collectCredentialStatusViolations(ctx, msg.CredentialStatusDocument, msg.CredentialStatusProof, false)...)
+	case *types.MsgRegisterCredentialStatusBatch:
+		violations = append(violations, ms.collectCredentialStatusBatchViolations(ctx, msg)...)
+	case *types.MsgRegisterCredentialStatusList:
+		violations = append(violations, ms.collectCredentialStatusListViolations(ctx, msg.CredentialStatusListDocument, msg.CredentialStatusListProof, true)...)
+	case *types.MsgUpdateCredentialStatusList:
+		violations = append(violations, ms.collectCredentialStatusListViolations(ctx, msg.CredentialStatusListDocument, msg.CredentialStatusListProof, false)...)
+	case *types.MsgRegisterAccreditation:
+		violations = append(violations, ms.collectAccreditationViolations(ctx, msg)...)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported message type %T", msg)
+	}
+
+	if len(violations) == 0 {
+		cacheCtx, _ := ctx.CacheContext()
+		if err := ms.dryRunSSIMsg(cacheCtx, msg); err != nil {
+			violations = append(violations, err)
+		}
+	}
+
+	return formValidateSSIMsgResponse(violations), nil
+}
+
+// formValidateSSIMsgResponse forms the response of ValidateSSIMsg query with the ABCI error code of every violation
+func formValidateSSIMsgResponse(violations []error) *types.QueryValidateSSIMsgResponse {
+	res := &types.QueryValidateSSIMsgResponse{
+		Valid: len(violations) == 0,
+	}
+	for _, violation := range violations {
+		codespace, code, _ := errors.ABCIInfo(violation, false)
+		res.Violations = append(res.Violations, &types.SSIMsgViolation{
+			Codespace: codespace,
+			Code:      code,
+			Message:   violation.Error(),
+		})
+	}
+	return res
+}
+
+// dryRunSSIMsg processes the message with its RPC controller. The state changes are expected to be discarded by the caller.
+func (k msgServer) dryRunSSIMsg(ctx sdk.Context, msg sdk.Msg) error {
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	var err error
+	switch msg := msg.(type) {
+	case *types.MsgRegisterDID:
+		_, err = k.RegisterDID(goCtx, msg)
+	case *types.MsgUpdateDID:
+		_, err = k.UpdateDID(goCtx, msg)
+	case *types.MsgDeactivateDID:
+		_, err = k.DeactivateDID(goCtx, msg)
+	case *types.MsgInitiateDidRecovery:
+		_, err = k.InitiateDidRecovery(goCtx, msg)
+	case *types.MsgCancelDidRecovery:
+		_, err = k.CancelDidRecovery(goCtx, msg)
+	case *types.MsgMarkVerificationMethodCompromised:
+		_, err = k.MarkVerificationMethodCompromised(goCtx, msg)
+	case *types.MsgRegisterCredentialSchema:
+		_, err = k.RegisterCredentialSchema(goCtx, msg)
+	case *types.MsgUpdateCredentialSchema:
+		_, err = k.UpdateCredentialSchema(goCtx, msg)
+	case *types.MsgUpdateCredentialSchemaStatus:
+		_, err = k.UpdateCredentialSchemaStatus(goCtx, msg)
+	case *types.MsgRegisterCredentialStatus:
+		_, err = k.RegisterCredentialStatus(goCtx, msg)
+	case *types.MsgUpdateCredentialStatus:
+		_, err = k.UpdateCredentialStatus(goCtx, msg)
+	case *types.MsgRegisterCredentialStatusBatch:
+		_, err = k.RegisterCredentialStatusBatch(goCtx, msg)
+	case *types.MsgRegisterCredentialStatusList:
+		_, err = k.RegisterCredentialStatusList(goCtx, msg)
+	case *types.MsgUpdateCredentialStatusList:
+		_, err = k.UpdateCredentialStatusList(goCtx, msg)
+	case *types.MsgRegisterAccreditation:
+		_, err = k.RegisterAccreditation(goCtx, msg)
+	default:
+		err = fmt.Errorf("unsupported message type %T", msg)
+	}
+	return err
+}
+
+// collectDidDocumentViolations performs the checks on incoming DID Document and its proofs, which do not depend on
+// each other. Validation of DID Document itself is covered by ValidateBasic of the message.
+func (k msgServer) collectDidDocumentViolations(ctx sdk.Context, didDocument *types.DidDocument, proofs []*types.DocumentProof) []error {
+	var violations []error
+
+	if err := types.DidChainNamespaceValidation(didDocument, k.GetChainNamespace(&ctx)); err != nil {
+		violations = append(violations, errors.Wrap(types.ErrInvalidDidDoc, err.Error()))
+	}
+
+	if err := checkDidDocumentPolicyContexts(didDocument); err != nil {
+		violations = append(violations, errors.Wrap(types.ErrInvalidDidDoc, err.Error()))
+	}
+
+	return append(violations, collectProofViolations(proofs...)...)
+}
+
+// collectRegisterDidViolations performs the independent checks of DID Document registration
+func (k msgServer) collectRegisterDidViolations(ctx sdk.Context, msg *types.MsgRegisterDID) []error {
+	didDocument := msg.DidDocument
+	violations := k.collectDidDocumentViolations(ctx, didDocument, msg.DidDocumentProofs)
+
+	if err := checkMethodSpecificIdOwnership(didDocument.VerificationMethod, didDocument.Id); err != nil {
+		violations = append(violations, errors.Wrap(types.ErrInvalidDidDoc, err.Error()))
+	}
+
+	if k.hasDidDocument(ctx, didDocument.Id) {
+		violations = append(violations, errors.Wrap(types.ErrDidDocExists, didDocument.Id))
+	}
+
+	for _, vm := range didDocument.VerificationMethod {
+		if vm.BlockchainAccountId != "" {
+			if existingDidDocId := k.getBlockchainAddressFromStore(&ctx, vm.BlockchainAccountId); len(existingDidDocId) != 0 {
+				violations = append(violations, errors.Wrapf(
+					types.ErrInvalidDidDoc,
+					"blockchainAccountId %v of verification method %v is already part of DID Document %v",
+					vm.BlockchainAccountId,
+					vm.Id,
+					string(existingDidDocId),
+				))
+			}
+		}
+	}
+
+	for _, controller := range getControllersForCreateDID(didDocument) {
+		if err := k.checkControllerPresenceInState(ctx, []string{controller}, didDocument.Id); err != nil {
+			violations = append(violations, errors.Wrap(types.ErrInvalidDidDoc, err.Error()))
+		}
+	}
+
+	return violations
+}
+
+// collectExistingDidViolations performs the independent checks of messages replacing a registered DID Document
+func (k msgServer) collectExistingDidViolations(
+	ctx sdk.Context, didDocument *types.DidDocument, proofs []*types.DocumentProof, versionId string,
+) []error {
+	violations := k.collectDidDocumentViolations(ctx, didDocument, proofs)
+	violations = append(violations, k.collectRegisteredDidStateViolations(ctx, didDocument.Id, versionId)...)
+
+	if existingDidDocumentState, err := k.getDidDocumentState(&ctx, didDocument.Id); err == nil {
+		if reflect.DeepEqual(existingDidDocumentState.DidDocument, didDocument) {
+			violations = append(violations, errors.Wrap(types.ErrInvalidDidDoc, "incoming DID Document does not have any changes"))
+		}
+	}
+	return violations
+}
+
+// collectDeactivateDidViolations performs the independent checks of DID Document deactivation
+func (k msgServer) collectDeactivateDidViolations(ctx sdk.Context, msg *types.MsgDeactivateDID) []error {
+	violations := collectProofViolations(msg.DidDocumentProofs...)
+	return append(violations, k.collectRegisteredDidStateViolations(ctx, msg.DidDocumentId, msg.VersionId)...)
+}
+
+// collectRegisteredDidStateViolations checks if the DID Document is registered, active and at the expected version
+func (k msgServer) collectRegisteredDidStateViolations(ctx sdk.Context, didId string, versionId string) []error {
+	didDocumentState, err := k.getDidDocumentState(&ctx, didId)
+	if err != nil {
+		return []error{errors.Wrap(types.ErrDidDocNotFound, didId)}
+	}
+
+	violations := k.collectActiveDidViolations(ctx, didId)
+
+	if existingVersionId := didDocumentState.DidDocumentMetadata.VersionId; existingVersionId != versionId {
+		errMsg := fmt.Sprintf(
+			"Expected %s with version %s. Got version %s",
+			didId, existingVersionId, versionId)
+		violations = append(violations, errors.Wrap(types.ErrUnexpectedDidVersion, errMsg))
+	}
+
+	return violations
+}
+
+// collectActiveDidViolations checks if the DID Document is registered and active
+func (k msgServer) collectActiveDidViolations(ctx sdk.Context, didId string) []error {
+	if err := k.checkActiveDidDocument(ctx, didId); err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+// collectProofViolations validates every proof of the message
+func collectProofViolations(proofs ...*types.DocumentProof) []error {
+	var violations []error
+	for _, proof := range proofs {
+		if err := proof.Validate(); err != nil {
+			violations = append(violations, err)
+		}
+	}
+	return violations
+}
+
+// collectCancelDidRecoveryViolations performs the independent checks of DID recovery cancellation
+func (k msgServer) collectCancelDidRecoveryViolations(ctx sdk.Context, msg *types.MsgCancelDidRecovery) []error {
+	violations := collectProofViolations(msg.DidDocumentProofs...)
+	violations = append(violations, k.collectActiveDidViolations(ctx, msg.DidDocumentId)...)
+
+	if _, err := k.getPendingDidRecovery(ctx, msg.DidDocumentId); err != nil {
+		violations = append(violations, errors.Wrap(types.ErrDidRecoveryNotFound, err.Error()))
+	}
+	return violations
+}
+
+// collectMarkVerificationMethodCompromisedViolations performs the independent checks of marking a Verification Method
+// as compromised
+func (k msgServer) collectMarkVerificationMethodCompromisedViolations(ctx sdk.Context, msg *types.MsgMarkVerificationMethodCompromised) []error {
+	violations := collectProofViolations(msg.CompromiseDocumentProofs...)
+
+	if err := msg.CompromiseDocument.Validate(); err != nil {
+		violations = append(violations, errors.Wrap(types.ErrInvalidDidDoc, err.Error()))
+	}
+
+	return append(violations, k.collectRegisteredDidStateViolations(ctx, msg.CompromiseDocument.Id, msg.VersionId)...)
+}
+
+// collectCredentialSchemaViolations performs the independent checks of Credential Schema registration and update
+func (k msgServer) collectCredentialSchemaViolations(
+	ctx sdk.Context, schemaDoc *types.CredentialSchemaDocument, schemaProof *types.DocumentProof, isNewSchema bool,
+) []error {
+	violations := collectProofViolations(schemaProof)
+	violations = append(violations, k.collectActiveDidViolations(ctx, schemaDoc.Author)...)
+
+	if err := verification.IsValidID(schemaDoc.Id, k.GetChainNamespace(&ctx), "schemaDocument"); err != nil {
+		violations = append(violations, errors.Wrap(types.ErrInvalidSchemaID, err.Error()))
+	} else if isNewSchema && k.hasCredentialSchema(ctx, schemaDoc.Id) {
+		violations = append(violations, errors.Wrap(types.ErrSchemaExists, schemaDoc.Id))
+	}
+
+	if !isStringInPascalCase(schemaDoc.Name) {
+		violations = append(violations, errors.Wrapf(types.ErrInvalidCredentialSchema, "name must always be in PascalCase: %v", schemaDoc.Name))
+	}
+	return violations
+}
+
+// collectCredentialSchemaStatusViolations performs the independent checks of Credential Schema status change
+func (k msgServer) collectCredentialSchemaStatusViolations(ctx sdk.Context, msg *types.MsgUpdateCredentialSchemaStatus) []error {
+	violations := collectProofViolations(msg.CredentialSchemaStatusProof)
+
+	schemaStatusDoc := msg.CredentialSchemaStatusDocument
+	if err := schemaStatusDoc.Validate(); err != nil {
+		violations = append(violations, errors.Wrap(types.ErrInvalidCredentialSchema, err.Error()))
+	} else if _, err := k.getCredentialSchemaVersion(ctx, schemaStatusDoc.Id); err != nil {
+		violations = append(violations, errors.Wrap(types.ErrCredentialSchemaNotFound, err.Error()))
+	}
+	return violations
+}
+
+// collectCredentialStatusViolations performs the independent checks of Credential Status registration and update
+func (k msgServer) collectCredentialStatusViolations(
+	ctx sdk.Context, credStatus *types.CredentialStatusDocument, credProof *types.DocumentProof, isNewCredentialStatus bool,
+) []error {
+	violations := collectProofViolations(credProof)
+	violations = append(violations, k.collectActiveDidViolations(ctx, credStatus.Issuer)...)
+
+	if err := checkCredentialStatusContexts(credStatus, credStatus.Context, ldcontext.CredentialStatusV2Context); err != nil {
+		violations = append(violations, errors.Wrap(types.ErrInvalidCredentialStatus, err.Error()))
+	}
+
+	if isNewCredentialStatus {
+		if err := verification.IsValidID(credStatus.Id, k.GetChainNamespace(&ctx), "credDocument"); err != nil {
+			violations = append(violations, errors.Wrap(types.ErrInvalidCredentialStatusID, err.Error()))
+		} else if k.hasCredential(ctx, credStatus.Id) {
+			violations = append(violations, errors.Wrap(types.ErrCredentialStatusExists, credStatus.Id))
+		}
+	} else if _, err := k.getCredentialStatusFromState(&ctx, credStatus.Id); err != nil {
+		violations = append(violations, errors.Wrap(types.ErrCredentialStatusNotFound, err.Error()))
+	}
+	return violations
+}
+
+// collectCredentialStatusBatchViolations performs the independent checks of Credential Status Batch registration
+func (k msgServer) collectCredentialStatusBatchViolations(ctx sdk.Context, msg *types.MsgRegisterCredentialStatusBatch) []error {
+	batch := msg.CredentialStatusBatchDocument
+
+	violations := collectProofViolations(msg.CredentialStatusBatchProof)
+	violations = append(violations, k.collectActiveDidViolations(ctx, batch.Issuer)...)
+
+	for _, credStatus := range batch.CredentialStatuses {
+		if credStatus == nil {
+			continue
+		}
+		if err := checkCredentialStatusContexts(credStatus, batch.Context, ldcontext.CredentialStatusBatchV2Context); err != nil {
+			violations = append(violations, errors.Wrap(types.ErrInvalidCredentialStatusBatch, err.Error()))
+		}
+		if k.hasCredential(ctx, credStatus.Id) {
+			violations = append(violations, errors.Wrap(types.ErrCredentialStatusExists, credStatus.Id))
+		}
+	}
+	return violations
+}
+
+// collectCredentialStatusListViolations performs the independent checks of Credential Status List registration and update
+func (k msgServer) collectCredentialStatusListViolations(
+	ctx sdk.Context, credStatusList *types.CredentialStatusListDocument, credStatusListProof *types.DocumentProof, isNewCredentialStatusList bool,
+) []error {
+	violations := collectProofViolations(credStatusListProof)
+	violations = append(violations, k.collectActiveDidViolations(ctx, credStatusList.Issuer)...)
+
+	if err := credStatusList.ValidateWithGas(ctx.GasMeter()); err != nil {
+		violations = append(violations, errors.Wrap(types.ErrInvalidCredentialStatusList, err.Error()))
+	}
+
+	if isNewCredentialStatusList {
+		if err := verification.IsValidID(credStatusList.Id, k.GetChainNamespace(&ctx), "credStatusListDocument"); err != nil {
+			violations = append(violations, errors.Wrap(types.ErrInvalidCredentialStatusList, err.Error()))
+		} else if k.hasCredentialStatusList(ctx, credStatusList.Id) {
+			violations = append(violations, errors.Wrap(types.ErrCredentialStatusListExists, credStatusList.Id))
+		}
+	} else if !k.hasCredentialStatusList(ctx, credStatusList.Id) {
+		violations = append(violations, errors.Wrap(types.ErrCredentialStatusListNotFound, credStatusList.Id))
+	}
+	return violations
+}
+
+// collectAccreditationViolations performs the independent checks of Accreditation registration
+func (k msgServer) collectAccreditationViolations(ctx sdk.Context, msg *types.MsgRegisterAccreditation) []error {
+	accreditationDoc := msg.AccreditationDocument
+
+	violations := collectProofViolations(msg.AccreditationProof)
+	violations = append(violations, k.collectActiveDidViolations(ctx, accreditationDoc.Accreditor)...)
+
+	if err := accreditationDoc.Validate(); err != nil {
+		violations = append(violations, errors.Wrap(types.ErrInvalidAccreditation, err.Error()))
+	}
+	if k.hasAccreditation(ctx, accreditationDoc.Id) {
+		violations = append(violations, errors.Wrap(types.ErrAccreditationExists, accreditationDoc.Id))
+	}
+	return violations
+}
+
+// missingDocumentViolation returns the violation of a message which does not carry its document, or the proof of
+// its document. Every other check of the message depends on their presence.
+func missingDocumentViolation(msg sdk.Msg) error {
+	switch msg := msg.(type) {
+	case *types.MsgRegisterDID:
+		if msg.DidDocument == nil {
+			return errors.Wrap(types.ErrInvalidDidDoc, "DID Document must be provided")
+		}
+	case *types.MsgUpdateDID:
+		if msg.DidDocument == nil {
+			return errors.Wrap(types.ErrInvalidDidDoc, "DID Document must be provided")
+		}
+	case *types.MsgInitiateDidRecovery:
+		if msg.DidDocument == nil {
+			return errors.Wrap(types.ErrInvalidDidDoc, "DID Document must be provided")
+		}
+	case *types.MsgMarkVerificationMethodCompromised:
+		if msg.CompromiseDocument == nil {
+			return errors.Wrap(types.ErrInvalidDidDoc, "verification method compromise document must be provided")
+		}
+	case *types.MsgRegisterCredentialSchema:
+		if msg.CredentialSchemaDocument == nil || msg.CredentialSchemaProof == nil {
+			return errors.Wrap(types.ErrInvalidCredentialSchema, "credential schema document and its proof must be provided")
+		}
+	case *types.MsgUpdateCredentialSchema:
+		if msg.CredentialSchemaDocument == nil || msg.CredentialSchemaProof == nil {
+			return errors.Wrap(types.ErrInvalidCredentialSchema, "credential schema document and its proof must be provided")
+		}
+	case *types.MsgUpdateCredentialSchemaStatus:
+		if msg.CredentialSchemaStatusDocument == nil || msg.CredentialSchemaStatusProof == nil {
+			return errors.Wrap(types.ErrInvalidCredentialSchema, "credential schema status document and its proof must be provided")
+		}
+	case *types.MsgRegisterCredentialStatus:
+		if msg.CredentialStatusDocument == nil || msg.CredentialStatusProof == nil {
+			return errors.Wrap(types.ErrInvalidCredentialStatus, "credential status document and its proof must be provided")
+		}
+	case *types.MsgUpdateCredentialStatus:
+		if msg.CredentialStatusDocument == nil || msg.CredentialStatusProof == nil {
+			return errors.Wrap(types.ErrInvalidCredentialStatus, "credential status document and its proof must be provided")
+		}
+	case *types.MsgRegisterCredentialStatusBatch:
+		if msg.CredentialStatusBatchDocument == nil || msg.CredentialStatusBatchProof == nil {
+			return errors.Wrap(types.ErrInvalidCredentialStatusBatch, "credential status batch document and its proof must be provided")
+		}
+	case *types.MsgRegisterCredentialStatusList:
+		if msg.CredentialStatusListDocument == nil || msg.CredentialStatusListProof == nil {
+			return errors.Wrap(types.ErrInvalidCredentialStatusList, "credential status list document and its proof must be provided")
+		}
+	case *types.MsgUpdateCredentialStatusList:
+		if msg.CredentialStatusListDocument == nil || msg.CredentialStatusListProof == nil {
+			return errors.Wrap(types.ErrInvalidCredentialStatusList, "credential status list document and its proof must be provided")
+		}
+	case *types.MsgRegisterAccreditation:
+		if msg.AccreditationDocument == nil || msg.AccreditationProof == nil {
+			return errors.Wrap(types.ErrInvalidAccreditation, "accreditation document and its proof must be provided")
+		}
+	}
+	return nil
+}
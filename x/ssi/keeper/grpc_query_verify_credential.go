@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/vc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// VerifyCredential verifies a Verifiable Credential against the issuer DID Document, the Credential Status and
+// the Credential Schema registered in the current state. The outcome of every performed check is reported.
+func (k Keeper) VerifyCredential(goCtx context.Context, req *types.QueryVerifyCredentialRequest) (*types.QueryVerifyCredentialResponse, error) {
+	if req == nil || req.Credential == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	result := vc.VerifyCredential(credentialStateReader{k: k, ctx: ctx}, []byte(req.Credential))
+
+	return &types.QueryVerifyCredentialResponse{
+		Verified: result.Verified,
+		Checks:   result.Checks,
+	}, nil
+}
+
+// credentialStateReader provides the state of x/ssi module for Verifiable Credential verification
+type credentialStateReader struct {
+	k   Keeper
+	ctx sdk.Context
+}
+
+func (r credentialStateReader) GetDidDocumentState(didId string) (*types.DidDocumentState, error) {
+	return r.k.getDidDocumentState(&r.ctx, didId)
+}
+
+func (r credentialStateReader) GetCredentialStatusState(credentialId string) (*types.CredentialStatusState, error) {
+	return r.k.getCredentialStatusFromState(&r.ctx, credentialId)
+}
+
+func (r credentialStateReader) GetCredentialSchemaState(credentialSchemaId string) (*types.CredentialSchemaState, error) {
+	return r.k.getCredentialSchemaVersion(r.ctx, credentialSchemaId)
+}
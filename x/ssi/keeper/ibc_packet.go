@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// OnRecvSsiPacket answers the query packet of a counterparty chain, and returns the JSON encoded
+// acknowledgement carrying the result
+func (k Keeper) OnRecvSsiPacket(ctx sdk.Context, packetData types.SsiPacketData) ([]byte, error) {
+	if err := packetData.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	switch packet := packetData.Packet.(type) {
+	case *types.SsiPacketData_DidResolutionPacket:
+		return k.onRecvDidResolutionPacket(ctx, packet.DidResolutionPacket)
+	case *types.SsiPacketData_CredentialStatusPacket:
+		return k.onRecvCredentialStatusPacket(ctx, packet.CredentialStatusPacket)
+	default:
+		return nil, errors.Wrapf(types.ErrInvalidPacket, "unrecognized packet type %T", packet)
+	}
+}
+
+// onRecvDidResolutionPacket resolves the requested DID. Similar to the ResolveDid query, resolution errors
+// are reported through `didResolutionMetadata.error` of a successful acknowledgement.
+func (k Keeper) onRecvDidResolutionPacket(ctx sdk.Context, packet *types.DidResolutionPacketData) ([]byte, error) {
+	didResolution, err := k.ResolveDid(sdk.WrapSDKContext(ctx), &types.QueryResolveDidRequest{
+		DidId:       packet.DidId,
+		VersionId:   packet.VersionId,
+		VersionTime: packet.VersionTime,
+		Accept:      packet.Accept,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ModuleCdc.MarshalJSON(&types.DidResolutionPacketAck{
+		DidResolution: didResolution,
+		Height:        ctx.BlockHeight(),
+	})
+}
+
+// onRecvCredentialStatusPacket fetches the requested Credential Status
+func (k Keeper) onRecvCredentialStatusPacket(ctx sdk.Context, packet *types.CredentialStatusPacketData) ([]byte, error) {
+	credentialStatus, err := k.getCredentialStatusFromState(&ctx, packet.CredId)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrCredentialStatusNotFound, err.Error())
+	}
+
+	return types.ModuleCdc.MarshalJSON(&types.CredentialStatusPacketAck{
+		CredentialStatus: credentialStatus,
+		Height:           ctx.BlockHeight(),
+	})
+}
@@ -12,22 +12,76 @@ import (
 
 type (
 	Keeper struct {
-		cdc        codec.BinaryCodec
-		storeKey   storetypes.StoreKey
-		memKey     storetypes.StoreKey
-		paramSpace paramtypes.Subspace
+		cdc      codec.BinaryCodec
+		storeKey storetypes.StoreKey
+		memKey   storetypes.StoreKey
+
+		// legacySubspace is the x/params subspace which held the module params before
+		// they were moved to module store. It is only used for store migration.
+		legacySubspace paramtypes.Subspace
+
+		// authority is the address capable of executing MsgUpdateParams, which is
+		// typically the x/gov module account
+		authority string
+
+		// contractKeeper looks up CosmWasm contracts, which can control DID Documents. DID Documents
+		// cannot be controlled by contracts if it is not set.
+		contractKeeper types.ContractKeeper
+
+		// bankKeeper charges contracts the fixed fees of SSI messages they dispatch, since such
+		// messages are not part of a transaction and skip the fee deduction of ante handler
+		bankKeeper types.BankKeeper
+
+		// portKeeper and scopedKeeper are used by the ssi IBC application, which answers query packets
+		// of counterparty chains. The IBC application is disabled if they are not set.
+		portKeeper   types.PortKeeper
+		scopedKeeper types.ScopedKeeper
+
+		// storeQuerier queries the Merkle proofs of committed state. Proofs cannot be requested in
+		// queries if it is not set.
+		storeQuerier types.StoreQuerier
 	}
 )
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey, memKey storetypes.StoreKey, ps paramtypes.Subspace) *Keeper {
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey,
+	memKey storetypes.StoreKey,
+	legacySubspace paramtypes.Subspace,
+	authority string,
+) *Keeper {
 	return &Keeper{
-		cdc:        cdc,
-		storeKey:   storeKey,
-		memKey:     memKey,
-		paramSpace: ps,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		memKey:         memKey,
+		legacySubspace: legacySubspace,
+		authority:      authority,
 	}
 }
 
+// SetContractKeeper sets the keeper used to look up CosmWasm contracts controlling DID Documents, and
+// the bank keeper used to charge them the fixed fees of SSI messages
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper, bankKeeper types.BankKeeper) {
+	k.contractKeeper = contractKeeper
+	k.bankKeeper = bankKeeper
+}
+
+// SetIBCKeepers sets the keepers used by the ssi IBC application
+func (k *Keeper) SetIBCKeepers(portKeeper types.PortKeeper, scopedKeeper types.ScopedKeeper) {
+	k.portKeeper = portKeeper
+	k.scopedKeeper = scopedKeeper
+}
+
+// SetStoreQuerier sets the querier used to prove the x/ssi state in query responses
+func (k *Keeper) SetStoreQuerier(storeQuerier types.StoreQuerier) {
+	k.storeQuerier = storeQuerier
+}
+
+// GetAuthority returns the address of x/ssi module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
 	"github.com/hypersign-protocol/hid-node/x/ssi/types"
 	"github.com/hypersign-protocol/hid-node/x/ssi/verification"
 )
@@ -99,9 +100,7 @@ func (k msgServer) formMustControllerVmListMap(ctx sdk.Context,
 				}
 				_, presentInControllerMap := controllerMap[vmState.Controller]
 				if presentInControllerMap {
-					// Skip X25519KeyAgreementKey2020 or X25519KeyAgreementKey2020 because these
-					// are not allowed for Authentication and Assertion purposes
-					if (vmState.Type != types.X25519KeyAgreementKey2020) && (vmState.Type != types.X25519KeyAgreementKeyEIP5630) {
+					if !isNonSigningVmType(vmState.Type) {
 						vmExtended := types.CreateExtendedVerificationMethod(vmState, sign)
 						controllerMap[controller] = append(controllerMap[controller], vmExtended)
 					}
@@ -110,6 +109,10 @@ func (k msgServer) formMustControllerVmListMap(ctx sdk.Context,
 			}
 		}
 	}
+
+	if err := k.checkVmListMapCompromise(ctx, controllerMap); err != nil {
+		return nil, err
+	}
 	return controllerMap, nil
 }
 
@@ -154,9 +157,7 @@ func (k msgServer) formAnyControllerVmListMap(ctx sdk.Context,
 				}
 				_, presentInControllerMap := controllerMap[vmState.Controller]
 				if presentInControllerMap {
-					// Skip X25519KeyAgreementKey2020 or X25519KeyAgreementKey2020 because these
-					// are not allowed for Authentication and Assertion purposes
-					if (vmState.Type != types.X25519KeyAgreementKey2020) && (vmState.Type != types.X25519KeyAgreementKeyEIP5630) {
+					if !isNonSigningVmType(vmState.Type) {
 						vmExtended := types.CreateExtendedVerificationMethod(vmState, sign)
 						controllerMap[controller] = append(controllerMap[controller], vmExtended)
 					}
@@ -164,9 +165,37 @@ func (k msgServer) formAnyControllerVmListMap(ctx sdk.Context,
 			}
 		}
 	}
+
+	if err := k.checkVmListMapCompromise(ctx, controllerMap); err != nil {
+		return nil, err
+	}
 	return controllerMap, nil
 }
 
+// checkVmListMapCompromise checks that none of the Extended Verification Methods has signed after being marked as
+// compromised in the DID Document it belongs to
+func (k msgServer) checkVmListMapCompromise(ctx sdk.Context, vmListMap map[string][]*types.ExtendedVerificationMethod) error {
+	for _, vmList := range vmListMap {
+		for _, vmExtended := range vmList {
+			didId, _ := types.SplitDidUrl(vmExtended.Id)
+
+			// Verification Methods of a DID Document being registered cannot be compromised
+			if !k.hasDidDocument(ctx, didId) {
+				continue
+			}
+			didDocumentState, err := k.getDidDocumentState(&ctx, didId)
+			if err != nil {
+				return err
+			}
+
+			if err := verification.CheckTxProofVerificationMethodCompromise(didDocumentState.DidDocumentMetadata, vmExtended.Proof, ctx.BlockTime()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (k msgServer) getControllerVmFromState(ctx sdk.Context, verificationMethodId string) (*types.VerificationMethod, error) {
 	didId, _ := types.SplitDidUrl(verificationMethodId)
 
@@ -184,53 +213,88 @@ func (k msgServer) getControllerVmFromState(ctx sdk.Context, verificationMethodI
 	return nil, fmt.Errorf("verification method %v not found in controller %v", verificationMethodId, didId)
 }
 
-// VerifyDocumentProof verifies the proof of a SSI Document
-func (k msgServer) VerifyDocumentProof(ctx sdk.Context, ssiMsg types.SsiMsg, inputDocProof *types.DocumentProof) error {
+// verifyDocumentProof verifies the proof of a SSI Document
+func (k Keeper) verifyDocumentProof(ctx sdk.Context, ssiMsg types.SsiMsg, inputDocProof *types.DocumentProof) error {
+	docVm, didDocumentState, err := k.getProofVerificationMethod(ctx, inputDocProof.GetVerificationMethod())
+	if err != nil {
+		return err
+	}
+
+	// Proofs of a compromised Verification Method submitted after its compromise time are rejected
+	if err := verification.CheckTxProofVerificationMethodCompromise(didDocumentState.DidDocumentMetadata, inputDocProof, ctx.BlockTime()); err != nil {
+		return err
+	}
+
+	if err := verification.CheckProofType(docVm, inputDocProof); err != nil {
+		return err
+	}
+
+	err = verification.VerifyDocumentProofSignature(ssiMsg, docVm, inputDocProof)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getProofVerificationMethod returns the Verification Method of document proof, along with the state of
+// DID Document it belongs to
+func (k Keeper) getProofVerificationMethod(ctx sdk.Context, docProofVmId string) (*types.VerificationMethod, *types.DidDocumentState, error) {
 	// Get DID Document from State
-	docProofVmId := inputDocProof.GetVerificationMethod()
 	didId, _ := types.SplitDidUrl(docProofVmId)
 	didDocumentState, err := k.getDidDocumentState(&ctx, didId)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	didDoc := didDocumentState.DidDocument
 
 	// Search for Verification Method in DID Document
-	var docVm *types.VerificationMethod = nil
 	for _, vm := range didDoc.VerificationMethod {
 		if vm.Id == docProofVmId {
-			docVm = vm
-			break
+			return vm, didDocumentState, nil
 		}
 	}
-	if docVm == nil {
-		return fmt.Errorf("verificationMethod %s is not present in DID document %s", docProofVmId, didId)
-	}
 
-	// VerificationKeySignatureMap has X25519KeyAgreementKey2020 and X25519KeyAgreementKeyEIP5630 as supported Verification Type.
-	// However, they are not allowed to be used for Authentication or Assertion purposes. Since, their corresponding values in the map
-	// are empty string, the following check is in place.
-	if types.VerificationKeySignatureMap[docVm.Type] == "" {
-		return fmt.Errorf("proof type must be specified")
+	return nil, nil, fmt.Errorf("verificationMethod %s is not present in DID document %s", docProofVmId, didId)
+}
+
+// checkDidDocumentPolicyContexts checks if a DID Document having a controller threshold or recovery includes the
+// contexts defining them. Otherwise, these properties would be dropped during canonization and not be signed.
+func checkDidDocumentPolicyContexts(didDoc *types.DidDocument) error {
+	if didDoc.ControllerThreshold != 0 && !hasContext(didDoc.Context, ldcontext.ControllerThresholdContext) {
+		return fmt.Errorf("context %v must be present for a DID Document having controller threshold", ldcontext.ControllerThresholdContext)
+	}
+	if len(didDoc.Recovery) != 0 && !hasContext(didDoc.Context, ldcontext.DidRecoveryContext) {
+		return fmt.Errorf("context %v must be present for a DID Document having recovery", ldcontext.DidRecoveryContext)
 	}
+	return nil
+}
 
-	// Check if the Proof Type is correct
-	if types.VerificationKeySignatureMap[docVm.Type] != inputDocProof.GetType() {
+// checkCredentialStatusContexts checks if a Credential Status having an expiration date or a Credential Schema includes
+// the versioned context defining them, among the contexts it is signed with. Otherwise, these properties would be
+// dropped during canonization and not be signed.
+func checkCredentialStatusContexts(credStatus *types.CredentialStatusDocument, contexts []string, versionedContext string) error {
+	if credStatus.ExpirationDate == "" && credStatus.CredentialSchemaId == "" {
+		return nil
+	}
+	if !hasContext(contexts, versionedContext) {
 		return fmt.Errorf(
-			"expected proof type to be %v as the verificationMethod type of %v is %v, recieved %v",
-			types.VerificationKeySignatureMap[docVm.Type],
-			docVm.Id,
-			docVm.Type,
-			inputDocProof.GetType(),
+			"context %v must be present for credential status %v having expiration date or credential schema",
+			versionedContext,
+			credStatus.Id,
 		)
 	}
+	return nil
+}
 
-	err = verification.VerifyDocumentProofSignature(ssiMsg, docVm, inputDocProof)
-	if err != nil {
-		return err
+// hasContext checks if the context is present in the list of contexts of a SSI Document
+func hasContext(contexts []string, context string) bool {
+	for _, docContext := range contexts {
+		if docContext == context {
+			return true
+		}
 	}
-
-	return nil
+	return false
 }
 
 // makeSignatureMap converts []SignInfo to map
@@ -262,3 +326,12 @@ func verifyCredentialMerkleRootHash(credHash string) error {
 
 	return nil
 }
+
+// isNonSigningVmType checks if the verification method type cannot produce DID Document signatures.
+// X25519KeyAgreementKey2020 and X25519KeyAgreementKeyEIP5630 are not allowed for Authentication and
+// Assertion purposes, and CosmWasmContractMethod2024 authorises by being the transaction sender
+func isNonSigningVmType(vmType string) bool {
+	return vmType == types.X25519KeyAgreementKey2020 ||
+		vmType == types.X25519KeyAgreementKeyEIP5630 ||
+		vmType == types.CosmWasmContractMethod2024
+}
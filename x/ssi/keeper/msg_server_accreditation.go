@@ -0,0 +1,329 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/verification"
+)
+
+// RPC controller for accrediting an issuer for a Credential Schema. The accreditation must be signed either by the
+// author of Credential Schema, or by an issuer whose own accreditation allows further delegation.
+func (k msgServer) RegisterAccreditation(goCtx context.Context, msg *types.MsgRegisterAccreditation) (*types.MsgRegisterAccreditationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.chargeContractSenderFee(ctx, msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
+
+	accreditationDoc := msg.GetAccreditationDocument()
+	accreditationProof := msg.GetAccreditationProof()
+	if accreditationDoc == nil || accreditationProof == nil {
+		return nil, errors.Wrap(types.ErrInvalidAccreditation, "accreditation document and its proof must be provided")
+	}
+
+	schemaState, err := k.checkNewAccreditation(ctx, accreditationDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the DID of the accreditor exists and is not deactivated
+	if err := k.checkActiveDidDocument(ctx, accreditationDoc.Accreditor); err != nil {
+		return nil, err
+	}
+
+	// Validate Document Proof
+	if err := accreditationProof.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Accreditation must be signed by a verification method of the accreditor
+	if didId, _ := types.SplitDidUrl(accreditationProof.VerificationMethod); didId != accreditationDoc.Accreditor {
+		return nil, errors.Wrapf(
+			types.ErrInvalidProof,
+			"verification method %s does not belong to the accreditor %s",
+			accreditationProof.VerificationMethod,
+			accreditationDoc.Accreditor,
+		)
+	}
+
+	if accreditationDoc.ParentAccreditationId == "" {
+		// Only the author of Credential Schema can accredit an issuer without a parent accreditation
+		if accreditationDoc.Accreditor != schemaState.CredentialSchemaDocument.Author {
+			return nil, errors.Wrapf(
+				types.ErrInvalidAccreditation,
+				"%s is not the author of credential schema %s, and must provide its own accreditation as the parent accreditation",
+				accreditationDoc.Accreditor,
+				accreditationDoc.CredentialSchemaId,
+			)
+		}
+	} else {
+		if err := k.checkParentAccreditation(ctx, accreditationDoc); err != nil {
+			return nil, err
+		}
+	}
+
+	// Verify Signature
+	if err := k.verifyDocumentProof(ctx, accreditationDoc, accreditationProof); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidSignature, err.Error())
+	}
+
+	k.setAccreditationState(ctx, &types.AccreditationState{
+		AccreditationDocument: accreditationDoc,
+		AccreditationProof:    accreditationProof,
+	})
+
+	// Emit a successful Accreditation Registration event
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAccreditationRegistered{
+		AccreditationId:       accreditationDoc.Id,
+		Accreditor:            accreditationDoc.Accreditor,
+		AccreditedIssuer:      accreditationDoc.AccreditedIssuer,
+		CredentialSchemaId:    accreditationDoc.CredentialSchemaId,
+		ParentAccreditationId: accreditationDoc.ParentAccreditationId,
+		TxAuthor:              msg.GetTxAuthor(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterAccreditationResponse{}, nil
+}
+
+// RegisterGovernanceAccreditation accredits an issuer for a Credential Schema on behalf of the x/ssi module authority.
+// The message can only be executed by the module authority.
+func (k msgServer) RegisterGovernanceAccreditation(goCtx context.Context, msg *types.MsgRegisterGovernanceAccreditation) (*types.MsgRegisterGovernanceAccreditationResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accreditationDoc := msg.GetAccreditationDocument()
+	if accreditationDoc == nil {
+		return nil, errors.Wrap(types.ErrInvalidAccreditation, "accreditation document must be provided")
+	}
+
+	if accreditationDoc.Accreditor != msg.Authority {
+		return nil, errors.Wrapf(
+			types.ErrInvalidAccreditation,
+			"accreditor of governance accreditation must be the authority %s, got %s",
+			msg.Authority,
+			accreditationDoc.Accreditor,
+		)
+	}
+	if accreditationDoc.ParentAccreditationId != "" {
+		return nil, errors.Wrap(types.ErrInvalidAccreditation, "governance accreditation cannot have a parent accreditation")
+	}
+
+	if _, err := k.checkNewAccreditation(ctx, accreditationDoc); err != nil {
+		return nil, err
+	}
+
+	k.setAccreditationState(ctx, &types.AccreditationState{
+		AccreditationDocument: accreditationDoc,
+	})
+
+	// Emit a successful Accreditation Registration event
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAccreditationRegistered{
+		AccreditationId:    accreditationDoc.Id,
+		Accreditor:         accreditationDoc.Accreditor,
+		AccreditedIssuer:   accreditationDoc.AccreditedIssuer,
+		CredentialSchemaId: accreditationDoc.CredentialSchemaId,
+		TxAuthor:           msg.Authority,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterGovernanceAccreditationResponse{}, nil
+}
+
+// checkNewAccreditation performs the checks on an accreditation which is about to be registered, independent of
+// its accreditor. It returns the Credential Schema the issuer is accredited for.
+func (k msgServer) checkNewAccreditation(ctx sdk.Context, accreditationDoc *types.AccreditationDocument) (*types.CredentialSchemaState, error) {
+	// Check the format of Accreditation ID
+	chainNamespace := k.GetChainNamespace(&ctx)
+	if err := verification.IsValidID(accreditationDoc.Id, chainNamespace, "accreditationDocument"); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidAccreditation, err.Error())
+	}
+
+	// Check if the Accreditation already exists in the store
+	if k.hasAccreditation(ctx, accreditationDoc.Id) {
+		return nil, errors.Wrap(types.ErrAccreditationExists, accreditationDoc.Id)
+	}
+
+	if err := accreditationDoc.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidAccreditation, err.Error())
+	}
+
+	// Accreditation which has already lapsed cannot be registered
+	validUntil, _ := time.Parse(time.RFC3339, accreditationDoc.ValidUntil)
+	if !validUntil.After(ctx.BlockTime()) {
+		return nil, errors.Wrapf(types.ErrInvalidDate, "validUntil %s has already passed", accreditationDoc.ValidUntil)
+	}
+
+	// Issuers cannot be accredited for a revoked Credential Schema
+	schemaState, err := k.getCredentialSchemaVersion(ctx, accreditationDoc.CredentialSchemaId)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrCredentialSchemaNotFound, err.Error())
+	}
+	if schemaState.Status == types.CREDENTIAL_SCHEMA_STATUS_REVOKED {
+		return nil, errors.Wrapf(
+			types.ErrCredentialSchemaRevoked,
+			"issuers cannot be accredited for the revoked credential schema %s",
+			accreditationDoc.CredentialSchemaId,
+		)
+	}
+
+	// Check if the DID of the accredited issuer exists and is not deactivated
+	if err := k.checkActiveDidDocument(ctx, accreditationDoc.AccreditedIssuer); err != nil {
+		return nil, err
+	}
+
+	return schemaState, nil
+}
+
+// checkParentAccreditation checks if the parent accreditation allows the accreditor to accredit further issuers.
+// The accreditation cannot outlast its parent, nor allow more levels of delegation than it.
+func (k msgServer) checkParentAccreditation(ctx sdk.Context, accreditationDoc *types.AccreditationDocument) error {
+	parentAccreditation, err := k.getAccreditationState(ctx, accreditationDoc.ParentAccreditationId)
+	if err != nil {
+		return errors.Wrap(types.ErrAccreditationNotFound, err.Error())
+	}
+	parentAccreditationDoc := parentAccreditation.AccreditationDocument
+
+	if parentAccreditationDoc.AccreditedIssuer != accreditationDoc.Accreditor {
+		return errors.Wrapf(
+			types.ErrInvalidAccreditation,
+			"parent accreditation %s does not accredit %s",
+			parentAccreditationDoc.Id,
+			accreditationDoc.Accreditor,
+		)
+	}
+	if parentAccreditationDoc.CredentialSchemaId != accreditationDoc.CredentialSchemaId {
+		return errors.Wrapf(
+			types.ErrInvalidAccreditation,
+			"parent accreditation %s is for the credential schema %s",
+			parentAccreditationDoc.Id,
+			parentAccreditationDoc.CredentialSchemaId,
+		)
+	}
+
+	if parentAccreditationDoc.DelegationDepth == 0 {
+		return errors.Wrapf(types.ErrInvalidAccreditation, "parent accreditation %s does not allow delegation", parentAccreditationDoc.Id)
+	}
+	if accreditationDoc.DelegationDepth >= parentAccreditationDoc.DelegationDepth {
+		return errors.Wrapf(
+			types.ErrInvalidAccreditation,
+			"delegation depth %d must be less than the delegation depth %d of parent accreditation",
+			accreditationDoc.DelegationDepth,
+			parentAccreditationDoc.DelegationDepth,
+		)
+	}
+
+	validFrom, _ := time.Parse(time.RFC3339, accreditationDoc.ValidFrom)
+	validUntil, _ := time.Parse(time.RFC3339, accreditationDoc.ValidUntil)
+	parentValidFrom, _ := time.Parse(time.RFC3339, parentAccreditationDoc.ValidFrom)
+	parentValidUntil, _ := time.Parse(time.RFC3339, parentAccreditationDoc.ValidUntil)
+	if validFrom.Before(parentValidFrom) || validUntil.After(parentValidUntil) {
+		return errors.Wrapf(
+			types.ErrInvalidDate,
+			"validity of accreditation must be within the validity of parent accreditation, from %s until %s",
+			parentAccreditationDoc.ValidFrom,
+			parentAccreditationDoc.ValidUntil,
+		)
+	}
+
+	// The accreditor must currently hold a valid accreditation
+	if _, err := k.getAccreditationChain(ctx, parentAccreditation, ctx.BlockTime()); err != nil {
+		return errors.Wrap(types.ErrInvalidAccreditation, err.Error())
+	}
+
+	return nil
+}
+
+// getAccreditationChain returns the chain of accreditations from the input accreditation up to the one issued by the
+// Credential Schema author or the x/ssi module authority. Every accreditation of the chain must be valid at the
+// input time, and its accreditor must have an active DID Document.
+func (k Keeper) getAccreditationChain(ctx sdk.Context, accreditation *types.AccreditationState, t time.Time) ([]*types.AccreditationState, error) {
+	var chain []*types.AccreditationState
+
+	for {
+		accreditationDoc := accreditation.AccreditationDocument
+		chain = append(chain, accreditation)
+
+		if !accreditationDoc.IsValidAt(t) {
+			return nil, fmt.Errorf(
+				"accreditation %s is valid from %s until %s",
+				accreditationDoc.Id,
+				accreditationDoc.ValidFrom,
+				accreditationDoc.ValidUntil,
+			)
+		}
+		if err := k.checkActiveDidDocument(ctx, accreditationDoc.AccreditedIssuer); err != nil {
+			return nil, err
+		}
+
+		if accreditationDoc.ParentAccreditationId == "" {
+			return chain, k.checkRootAccreditor(ctx, accreditationDoc)
+		}
+
+		// Every delegation reduces the delegation depth, which bounds the length of chain
+		if len(chain) > types.MaxAccreditationDelegationDepth {
+			return nil, fmt.Errorf("accreditation chain of %s exceeds the maximum delegation depth", chain[0].AccreditationDocument.Id)
+		}
+
+		parentAccreditation, err := k.getAccreditationState(ctx, accreditationDoc.ParentAccreditationId)
+		if err != nil {
+			return nil, err
+		}
+		if parentAccreditation.AccreditationDocument.AccreditedIssuer != accreditationDoc.Accreditor {
+			return nil, fmt.Errorf(
+				"parent accreditation %s does not accredit %s",
+				accreditationDoc.ParentAccreditationId,
+				accreditationDoc.Accreditor,
+			)
+		}
+		accreditation = parentAccreditation
+	}
+}
+
+// checkRootAccreditor checks if the accreditation without a parent is issued by the x/ssi module authority, or by
+// the author of Credential Schema. The Credential Schema must not be revoked.
+func (k Keeper) checkRootAccreditor(ctx sdk.Context, accreditationDoc *types.AccreditationDocument) error {
+	schemaState, err := k.getCredentialSchemaVersion(ctx, accreditationDoc.CredentialSchemaId)
+	if err != nil {
+		return err
+	}
+	if schemaState.Status == types.CREDENTIAL_SCHEMA_STATUS_REVOKED {
+		return fmt.Errorf("credential schema %s is revoked", accreditationDoc.CredentialSchemaId)
+	}
+
+	if accreditationDoc.Accreditor == k.authority {
+		return nil
+	}
+	if accreditationDoc.Accreditor != schemaState.CredentialSchemaDocument.Author {
+		return fmt.Errorf(
+			"accreditor %s of accreditation %s is not the author of credential schema %s",
+			accreditationDoc.Accreditor,
+			accreditationDoc.Id,
+			accreditationDoc.CredentialSchemaId,
+		)
+	}
+	return k.checkActiveDidDocument(ctx, accreditationDoc.Accreditor)
+}
+
+// checkActiveDidDocument checks if the DID Document exists and is not deactivated
+func (k Keeper) checkActiveDidDocument(ctx sdk.Context, didId string) error {
+	didDocumentState, err := k.getDidDocumentState(&ctx, didId)
+	if err != nil {
+		return errors.Wrap(types.ErrDidDocNotFound, err.Error())
+	}
+	if didDocumentState.DidDocumentMetadata.Deactivated {
+		return errors.Wrapf(types.ErrDidDocDeactivated, "%s is deactivated", didId)
+	}
+	return nil
+}
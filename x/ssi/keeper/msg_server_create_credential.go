@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
 	"github.com/hypersign-protocol/hid-node/x/ssi/types"
 	"github.com/hypersign-protocol/hid-node/x/ssi/verification"
 )
@@ -15,81 +16,170 @@ import (
 func (k msgServer) RegisterCredentialStatus(goCtx context.Context, msg *types.MsgRegisterCredentialStatus) (*types.MsgRegisterCredentialStatusResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.chargeContractSenderFee(ctx, msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
+
 	msgCredStatus := msg.GetCredentialStatusDocument()
 	msgCredProof := msg.GetCredentialStatusProof()
 
-	credId := msgCredStatus.GetId()
+	if err := k.checkNewCredentialStatus(ctx, msgCredStatus, msgCredProof); err != nil {
+		return nil, err
+	}
 
-	chainNamespace := k.GetChainNamespace(&ctx)
+	if err := checkCredentialStatusContexts(msgCredStatus, msgCredStatus.Context, ldcontext.CredentialStatusV2Context); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidCredentialStatus, err.Error())
+	}
 
-	// Check the format of Credential Status ID
-	err := verification.IsValidID(credId, chainNamespace, "credDocument")
+	// Check if issuer's DID exists and is not deactivated
+	if err := k.checkCredentialStatusIssuer(ctx, msgCredStatus.GetIssuer()); err != nil {
+		return nil, err
+	}
+
+	// Validate Document Proof
+	if err := msgCredProof.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Verify Signature
+	err := k.verifyDocumentProof(ctx, msgCredStatus, msgCredProof)
 	if err != nil {
-		return nil, errors.Wrap(types.ErrInvalidCredentialStatusID, err.Error())
+		return nil, errors.Wrapf(types.ErrInvalidSignature, err.Error())
 	}
 
-	// Check if the credential already exist in the store
-	if k.hasCredential(ctx, credId) {
-		return nil, types.ErrCredentialStatusExists
+	cred := &types.CredentialStatusState{
+		CredentialStatusDocument: msgCredStatus,
+		CredentialStatusProof:    msgCredProof,
 	}
 
-	// Check if the DID of the issuer exists
-	issuerId := msgCredStatus.GetIssuer()
-	if !k.hasDidDocument(ctx, issuerId) {
-		return nil, errors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("Issuer`s DID %s doesnt exists", issuerId))
+	k.setCredentialStatusInState(ctx, cred)
+
+	// Emit a successful Credential Status Registration event. The untyped event is deprecated in favour of
+	// EventCredentialStatusRegistered, and is kept for the clients subscribing to it.
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("create_credential_status", sdk.NewAttribute("tx_author", msg.GetTxAuthor())),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCredentialStatusRegistered{
+		CredentialId:             msgCredStatus.Id,
+		Issuer:                   msgCredStatus.Issuer,
+		IssuanceDate:             msgCredStatus.IssuanceDate,
+		CredentialMerkleRootHash: msgCredStatus.CredentialMerkleRootHash,
+		TxAuthor:                 msg.GetTxAuthor(),
+	}); err != nil {
+		return nil, err
 	}
 
-	// Check if issuer's DID is deactivated
-	issuerDidDocument, err := k.getDidDocumentState(&ctx, issuerId)
+	return &types.MsgRegisterCredentialStatusResponse{}, nil
+}
+
+// checkNewCredentialStatus performs the checks on a Credential Status which is about to be registered
+func (k msgServer) checkNewCredentialStatus(ctx sdk.Context, msgCredStatus *types.CredentialStatusDocument, msgCredProof *types.DocumentProof) error {
+	credId := msgCredStatus.GetId()
+
+	chainNamespace := k.GetChainNamespace(&ctx)
+
+	// Check the format of Credential Status ID
+	err := verification.IsValidID(credId, chainNamespace, "credDocument")
 	if err != nil {
-		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+		return errors.Wrap(types.ErrInvalidCredentialStatusID, err.Error())
 	}
-	if issuerDidDocument.DidDocumentMetadata.Deactivated {
-		return nil, errors.Wrap(types.ErrDidDocDeactivated, fmt.Sprintf("%s is deactivated and cannot used be used to register credential status", issuerDidDocument.DidDocument.Id))
+
+	// Check if the credential already exist in the store
+	if k.hasCredential(ctx, credId) {
+		return types.ErrCredentialStatusExists
 	}
 
 	issuanceDate := msgCredStatus.GetIssuanceDate()
 	issuanceDateParsed, err := time.Parse(time.RFC3339, issuanceDate)
 	if err != nil {
-		return nil, errors.Wrapf(types.ErrInvalidDate, fmt.Sprintf("invalid issuance date format: %s", issuanceDate))
+		return errors.Wrapf(types.ErrInvalidDate, fmt.Sprintf("invalid issuance date format: %s", issuanceDate))
 	}
 
 	// Check if the created date before issuance date
 	currentDate, err := time.Parse(time.RFC3339, msgCredProof.Created)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if currentDate.Before(issuanceDateParsed) {
-		return nil, errors.Wrapf(types.ErrInvalidDate, "proof attached has a creation date before issuance date")
+		return errors.Wrapf(types.ErrInvalidDate, "proof attached has a creation date before issuance date")
+	}
+
+	// Validate the optional Expiration Date
+	if err := verifyCredentialExpirationDate(ctx, msgCredStatus, issuanceDateParsed); err != nil {
+		return err
 	}
 
 	// Validate Merkle Root Hash
 	if err := verifyCredentialMerkleRootHash(msgCredStatus.GetCredentialMerkleRootHash()); err != nil {
-		return nil, errors.Wrapf(types.ErrInvalidCredentialMerkleRootHash, err.Error())
+		return errors.Wrapf(types.ErrInvalidCredentialMerkleRootHash, err.Error())
 	}
 
-	// Validate Document Proof
-	if err := msgCredProof.Validate(); err != nil {
-		return nil, err
+	// Validate the optional Credential Schema
+	if err := k.checkCredentialStatusSchema(ctx, msgCredStatus); err != nil {
+		return err
 	}
 
-	// Verify Signature
-	err = k.VerifyDocumentProof(ctx, msgCredStatus, msgCredProof)
+	return nil
+}
+
+// checkCredentialStatusSchema checks that the optional Credential Schema of Credential Status is registered
+// and is not revoked
+func (k msgServer) checkCredentialStatusSchema(ctx sdk.Context, msgCredStatus *types.CredentialStatusDocument) error {
+	schemaId := msgCredStatus.GetCredentialSchemaId()
+	if schemaId == "" {
+		return nil
+	}
+
+	schemaState, err := k.getCredentialSchemaVersion(ctx, schemaId)
 	if err != nil {
-		return nil, errors.Wrapf(types.ErrInvalidSignature, err.Error())
+		return errors.Wrap(types.ErrCredentialSchemaNotFound, err.Error())
+	}
+	if schemaState.Status == types.CREDENTIAL_SCHEMA_STATUS_REVOKED {
+		return errors.Wrapf(
+			types.ErrCredentialSchemaRevoked,
+			"credential status %v cannot be registered against the revoked credential schema %v",
+			msgCredStatus.Id,
+			schemaId,
+		)
 	}
+	return nil
+}
 
-	cred := &types.CredentialStatusState{
-		CredentialStatusDocument: msgCredStatus,
-		CredentialStatusProof:    msgCredProof,
+// checkCredentialStatusIssuer checks if the DID of the issuer exists and is not deactivated
+func (k msgServer) checkCredentialStatusIssuer(ctx sdk.Context, issuerId string) error {
+	if !k.hasDidDocument(ctx, issuerId) {
+		return errors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("Issuer`s DID %s doesnt exists", issuerId))
 	}
 
-	k.setCredentialStatusInState(ctx, cred)
+	issuerDidDocument, err := k.getDidDocumentState(&ctx, issuerId)
+	if err != nil {
+		return errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+	}
+	if issuerDidDocument.DidDocumentMetadata.Deactivated {
+		return errors.Wrap(types.ErrDidDocDeactivated, fmt.Sprintf("%s is deactivated and cannot used be used to register credential status", issuerDidDocument.DidDocument.Id))
+	}
 
-	// Emit a successful Credential Status Registration event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent("create_credential_status", sdk.NewAttribute("tx_author", msg.GetTxAuthor())),
-	)
+	return nil
+}
 
-	return &types.MsgRegisterCredentialStatusResponse{}, nil
+// verifyCredentialExpirationDate checks that the optional expiration date of Credential Status is after
+// its issuance date and has not passed yet
+func verifyCredentialExpirationDate(ctx sdk.Context, msgCredStatus *types.CredentialStatusDocument, issuanceDate time.Time) error {
+	expirationDate := msgCredStatus.GetExpirationDate()
+	if expirationDate == "" {
+		return nil
+	}
+
+	expirationDateParsed, err := time.Parse(time.RFC3339, expirationDate)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidDate, "invalid expiration date format: %s", expirationDate)
+	}
+	if !expirationDateParsed.After(issuanceDate) {
+		return errors.Wrapf(types.ErrInvalidDate, "expiration date %s must be after the issuance date", expirationDate)
+	}
+	if !expirationDateParsed.After(ctx.BlockTime()) {
+		return errors.Wrapf(types.ErrInvalidDate, "expiration date %s has already passed", expirationDate)
+	}
+
+	return nil
 }
@@ -16,6 +16,11 @@ func (k msgServer) RegisterDID(goCtx context.Context, msg *types.MsgRegisterDID)
 	// Unwrap Go Context to Cosmos SDK Context
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Charge the fixed fee, if the message is dispatched by a CosmWasm contract
+	if err := k.chargeContractSenderFee(ctx, msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
+
 	// Get the RPC inputs
 	msgDidDocument := msg.DidDocument
 	msgDidDocumentProofs := msg.DidDocumentProofs
@@ -31,6 +36,11 @@ func (k msgServer) RegisterDID(goCtx context.Context, msg *types.MsgRegisterDID)
 		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
 	}
 
+	// Check if the controller threshold and recovery are covered by the DID Document context
+	if err := checkDidDocumentPolicyContexts(msgDidDocument); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+	}
+
 	// Validate ownership of method specific id
 	if err := checkMethodSpecificIdOwnership(msgDidDocument.VerificationMethod, msgDidDocument.Id); err != nil {
 		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
@@ -70,6 +80,11 @@ func (k msgServer) RegisterDID(goCtx context.Context, msg *types.MsgRegisterDID)
 		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
 	}
 
+	// Verification Methods of contracts can only be added by the respective contract
+	if err := k.checkNewContractVerificationMethods(ctx, nil, msgDidDocument.VerificationMethod, msg.TxAuthor); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidSignature, err.Error())
+	}
+
 	// Collect necessary Verification Methods which are needed to be valid
 	requiredVMs, err := getVerificationMethodsForCreateDID(msgDidDocument)
 	if err != nil {
@@ -84,8 +99,9 @@ func (k msgServer) RegisterDID(goCtx context.Context, msg *types.MsgRegisterDID)
 		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
 	}
 
-	// Verify Signatures
-	err = verification.VerifySignatureOfEveryController(msgDidDocument, requiredVmMap)
+	// Verify Signatures. Controllers whose contract is the transaction sender are authorised without a signature.
+	contractAuthorizedControllers := k.getContractAuthorizedControllers(ctx, controllerList, msgDidDocument, msg.TxAuthor)
+	err = verification.VerifyAuthorizationOfEveryController(msgDidDocument, requiredVmMap, contractAuthorizedControllers)
 	if err != nil {
 		return nil, errors.Wrapf(types.ErrInvalidSignature, err.Error())
 	}
@@ -101,7 +117,7 @@ func (k msgServer) RegisterDID(goCtx context.Context, msg *types.MsgRegisterDID)
 
 	// Register DID Document in Store once all validation checks are passed
 	// and increment the DID Document count
-	k.setDidDocumentInStore(ctx, &didDocumentState)
+	k.setDidDocumentWithVersionHistory(ctx, &didDocumentState)
 	k.incrementDidCount(ctx)
 
 	// After successful registration of the DID Document, every blockchainAccountIds
@@ -112,10 +128,19 @@ func (k msgServer) RegisterDID(goCtx context.Context, msg *types.MsgRegisterDID)
 		}
 	}
 
-	// Emit a successful DID Document Registration event
+	// Emit a successful DID Document Registration event. The untyped event is deprecated in favour of
+	// EventDidRegistered, and is kept for the clients subscribing to it.
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent("create_did", sdk.NewAttribute("tx_author", msg.GetTxAuthor())),
 	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDidRegistered{
+		DidId:       msgDidDocument.Id,
+		VersionId:   metadata.VersionId,
+		Controllers: types.GetDidDocumentControllers(msgDidDocument),
+		TxAuthor:    msg.GetTxAuthor(),
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgRegisterDIDResponse{}, nil
 }
@@ -181,9 +206,7 @@ func getVerificationMethodsForCreateDID(didDocument *types.DidDocument) ([]*type
 			foundAtleastOneSubjectVM = true
 		}
 
-		// Skip X25519KeyAgreementKey2020 or X25519KeyAgreementKey2020 because these
-		// are not allowed for Authentication and Assertion purposes
-		if (vm.Type == types.X25519KeyAgreementKey2020) || (vm.Type == types.X25519KeyAgreementKeyEIP5630) {
+		if isNonSigningVmType(vm.Type) {
 			continue
 		}
 
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+func (k msgServer) RegisterCredentialStatusBatch(goCtx context.Context, msg *types.MsgRegisterCredentialStatusBatch) (*types.MsgRegisterCredentialStatusBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.chargeContractSenderFee(ctx, msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
+
+	msgCredStatusBatch := msg.GetCredentialStatusBatchDocument()
+	msgCredProof := msg.GetCredentialStatusBatchProof()
+	if msgCredStatusBatch == nil || msgCredProof == nil {
+		return nil, errors.Wrap(types.ErrInvalidCredentialStatusBatch, "credential status batch document and its proof must be provided")
+	}
+
+	// Check the size of the batch
+	batchSize := len(msgCredStatusBatch.CredentialStatuses)
+	maxBatchSize := k.GetParams(ctx).MaxCredentialStatusBatchSize
+	if batchSize == 0 {
+		return nil, errors.Wrap(types.ErrInvalidCredentialStatusBatch, "batch must contain atleast one credential status")
+	}
+	if batchSize > int(maxBatchSize) {
+		return nil, errors.Wrapf(types.ErrInvalidCredentialStatusBatch, "batch has %d credential statuses, maximum allowed is %d", batchSize, maxBatchSize)
+	}
+
+	// Check if issuer's DID exists and is not deactivated
+	issuerId := msgCredStatusBatch.GetIssuer()
+	if err := k.checkCredentialStatusIssuer(ctx, issuerId); err != nil {
+		return nil, err
+	}
+
+	// Validate Document Proof
+	if err := msgCredProof.Validate(); err != nil {
+		return nil, err
+	}
+
+	// The batch must be signed by a verification method of the issuer
+	if proofDidId, _, _ := strings.Cut(msgCredProof.VerificationMethod, "#"); proofDidId != issuerId {
+		return nil, errors.Wrapf(
+			types.ErrInvalidCredentialStatusBatch,
+			"verification method %s does not belong to the issuer %s",
+			msgCredProof.VerificationMethod,
+			issuerId,
+		)
+	}
+
+	credIds := make(map[string]bool, batchSize)
+	for _, msgCredStatus := range msgCredStatusBatch.CredentialStatuses {
+		if msgCredStatus == nil {
+			return nil, errors.Wrap(types.ErrInvalidCredentialStatusBatch, "credential status cannot be empty")
+		}
+
+		// Credential Statuses inherit the context of the batch
+		if len(msgCredStatus.Context) != 0 {
+			return nil, errors.Wrapf(types.ErrInvalidCredentialStatusBatch, "credential status %s must not have a context of its own", msgCredStatus.Id)
+		}
+
+		if msgCredStatus.Issuer != issuerId {
+			return nil, errors.Wrapf(
+				types.ErrInvalidCredentialStatusBatch,
+				"issuer %s of credential status %s is not the issuer of the batch %s",
+				msgCredStatus.Issuer,
+				msgCredStatus.Id,
+				issuerId,
+			)
+		}
+
+		if credIds[msgCredStatus.Id] {
+			return nil, errors.Wrapf(types.ErrInvalidCredentialStatusBatch, "credential status %s is present more than once", msgCredStatus.Id)
+		}
+		credIds[msgCredStatus.Id] = true
+
+		if err := k.checkNewCredentialStatus(ctx, msgCredStatus, msgCredProof); err != nil {
+			return nil, errors.Wrapf(err, "credential status %s", msgCredStatus.Id)
+		}
+
+		if err := checkCredentialStatusContexts(msgCredStatus, msgCredStatusBatch.Context, ldcontext.CredentialStatusBatchV2Context); err != nil {
+			return nil, errors.Wrap(types.ErrInvalidCredentialStatusBatch, err.Error())
+		}
+	}
+
+	// Verify Signature
+	if err := k.verifyDocumentProof(ctx, msgCredStatusBatch, msgCredProof); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidSignature, err.Error())
+	}
+
+	// The batch is stored as signed, so that the proof of every Credential Status registered through it
+	// can be verified against the batch
+	batchId := types.GetCredentialStatusBatchId(msgCredStatusBatch)
+	k.setCredentialStatusBatchState(ctx, &types.CredentialStatusBatchState{
+		Id:                            batchId,
+		CredentialStatusBatchDocument: msgCredStatusBatch,
+		CredentialStatusBatchProof:    msgCredProof,
+	})
+
+	for _, batchCredStatus := range msgCredStatusBatch.CredentialStatuses {
+		msgCredStatus := *batchCredStatus
+		msgCredStatus.Context = msgCredStatusBatch.Context
+
+		k.setCredentialStatusInState(ctx, &types.CredentialStatusState{
+			CredentialStatusDocument: &msgCredStatus,
+			CredentialStatusProof:    msgCredProof,
+			CredentialStatusBatchId:  batchId,
+		})
+
+		// Emit a successful Credential Status Registration event for every Credential Status in the batch
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventCredentialStatusRegistered{
+			CredentialId:             msgCredStatus.Id,
+			Issuer:                   msgCredStatus.Issuer,
+			IssuanceDate:             msgCredStatus.IssuanceDate,
+			CredentialMerkleRootHash: msgCredStatus.CredentialMerkleRootHash,
+			TxAuthor:                 msg.GetTxAuthor(),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgRegisterCredentialStatusBatchResponse{}, nil
+}
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/verification"
+)
+
+func (k msgServer) RegisterCredentialStatusList(goCtx context.Context, msg *types.MsgRegisterCredentialStatusList) (*types.MsgRegisterCredentialStatusListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.chargeContractSenderFee(ctx, msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
+
+	msgCredStatusList := msg.GetCredentialStatusListDocument()
+	msgCredStatusListProof := msg.GetCredentialStatusListProof()
+	if msgCredStatusList == nil || msgCredStatusListProof == nil {
+		return nil, errors.Wrap(types.ErrInvalidCredentialStatusList, "credential status list document and its proof must be provided")
+	}
+
+	// Check the format of Credential Status List ID
+	chainNamespace := k.GetChainNamespace(&ctx)
+	if err := verification.IsValidID(msgCredStatusList.Id, chainNamespace, "credStatusListDocument"); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidCredentialStatusList, err.Error())
+	}
+
+	// Check if the Credential Status List already exists in the store
+	if k.hasCredentialStatusList(ctx, msgCredStatusList.Id) {
+		return nil, errors.Wrap(types.ErrCredentialStatusListExists, msgCredStatusList.Id)
+	}
+
+	if err := msgCredStatusList.ValidateWithGas(ctx.GasMeter()); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidCredentialStatusList, err.Error())
+	}
+
+	if err := k.verifyCredentialStatusListProof(ctx, msgCredStatusList, msgCredStatusListProof); err != nil {
+		return nil, err
+	}
+
+	k.setCredentialStatusListState(ctx, &types.CredentialStatusListState{
+		CredentialStatusListDocument: msgCredStatusList,
+		CredentialStatusListProof:    msgCredStatusListProof,
+	})
+
+	// Emit a successful Credential Status List Registration event
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCredentialStatusListRegistered{
+		CredentialStatusListId: msgCredStatusList.Id,
+		Issuer:                 msgCredStatusList.Issuer,
+		StatusPurpose:          msgCredStatusList.StatusPurpose,
+		TxAuthor:               msg.GetTxAuthor(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterCredentialStatusListResponse{}, nil
+}
+
+func (k msgServer) UpdateCredentialStatusList(goCtx context.Context, msg *types.MsgUpdateCredentialStatusList) (*types.MsgUpdateCredentialStatusListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.chargeContractSenderFee(ctx, msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
+
+	msgCredStatusList := msg.GetCredentialStatusListDocument()
+	msgCredStatusListProof := msg.GetCredentialStatusListProof()
+	if msgCredStatusList == nil || msgCredStatusListProof == nil {
+		return nil, errors.Wrap(types.ErrInvalidCredentialStatusList, "credential status list document and its proof must be provided")
+	}
+
+	// Get Credential Status List from store
+	existingCredStatusListState, err := k.getCredentialStatusListState(ctx, msgCredStatusList.Id)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrCredentialStatusListNotFound, err.Error())
+	}
+	existingCredStatusList := existingCredStatusListState.CredentialStatusListDocument
+
+	if err := msgCredStatusList.ValidateWithGas(ctx.GasMeter()); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidCredentialStatusList, err.Error())
+	}
+
+	// Issuer and status purpose of a Credential Status List are immutable, as Credentials
+	// issued against the list refer to them
+	if msgCredStatusList.Issuer != existingCredStatusList.Issuer {
+		return nil, errors.Wrapf(
+			types.ErrInvalidCredentialField,
+			"issuer id %s is not the issuer of credential status list %s",
+			msgCredStatusList.Issuer,
+			msgCredStatusList.Id,
+		)
+	}
+	if msgCredStatusList.StatusPurpose != existingCredStatusList.StatusPurpose {
+		return nil, errors.Wrapf(
+			types.ErrInvalidCredentialField,
+			"status purpose of credential status list %s cannot be changed from %s",
+			msgCredStatusList.Id,
+			existingCredStatusList.StatusPurpose,
+		)
+	}
+
+	// Every update must be valid from a later date than the registered one, which prevents
+	// an older signed Credential Status List from being submitted again
+	newValidFrom, err := time.Parse(time.RFC3339, msgCredStatusList.ValidFrom)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidDate, "invalid validFrom date format: %s", msgCredStatusList.ValidFrom)
+	}
+	existingValidFrom, err := time.Parse(time.RFC3339, existingCredStatusList.ValidFrom)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidDate, "invalid existing validFrom date format: %s", existingCredStatusList.ValidFrom)
+	}
+	if !newValidFrom.After(existingValidFrom) {
+		return nil, errors.Wrapf(
+			types.ErrInvalidDate,
+			"validFrom %s must be after the validFrom %s of registered credential status list",
+			msgCredStatusList.ValidFrom,
+			existingCredStatusList.ValidFrom,
+		)
+	}
+
+	if err := k.verifyCredentialStatusListProof(ctx, msgCredStatusList, msgCredStatusListProof); err != nil {
+		return nil, err
+	}
+
+	k.setCredentialStatusListState(ctx, &types.CredentialStatusListState{
+		CredentialStatusListDocument: msgCredStatusList,
+		CredentialStatusListProof:    msgCredStatusListProof,
+	})
+
+	// Emit a successful Credential Status List Update event
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCredentialStatusListUpdated{
+		CredentialStatusListId: msgCredStatusList.Id,
+		Issuer:                 msgCredStatusList.Issuer,
+		StatusPurpose:          msgCredStatusList.StatusPurpose,
+		ValidFrom:              msgCredStatusList.ValidFrom,
+		TxAuthor:               msg.GetTxAuthor(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateCredentialStatusListResponse{}, nil
+}
+
+// verifyCredentialStatusListProof checks that the Credential Status List is signed by an active issuer
+func (k msgServer) verifyCredentialStatusListProof(
+	ctx sdk.Context,
+	credStatusList *types.CredentialStatusListDocument,
+	credStatusListProof *types.DocumentProof,
+) error {
+	// Check if the DID of the issuer exists and is not deactivated
+	issuerDidDocument, err := k.getDidDocumentState(&ctx, credStatusList.Issuer)
+	if err != nil {
+		return errors.Wrap(types.ErrDidDocNotFound, err.Error())
+	}
+	if issuerDidDocument.DidDocumentMetadata.Deactivated {
+		return errors.Wrapf(
+			types.ErrDidDocDeactivated,
+			"%s is deactivated and cannot be used to register credential status list",
+			credStatusList.Issuer,
+		)
+	}
+
+	// Validate Document Proof
+	if err := credStatusListProof.Validate(); err != nil {
+		return err
+	}
+
+	// Credential Status List must be signed by a verification method of the issuer
+	proofDidId, _, found := strings.Cut(credStatusListProof.VerificationMethod, "#")
+	if !found || proofDidId != credStatusList.Issuer {
+		return errors.Wrapf(
+			types.ErrInvalidProof,
+			"verification method %s does not belong to the issuer %s",
+			credStatusListProof.VerificationMethod,
+			credStatusList.Issuer,
+		)
+	}
+
+	// Check if the proof is created before the Credential Status List is valid
+	validFrom, err := time.Parse(time.RFC3339, credStatusList.ValidFrom)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidDate, "invalid validFrom date format: %s", credStatusList.ValidFrom)
+	}
+	proofCreated, err := time.Parse(time.RFC3339, credStatusListProof.Created)
+	if err != nil {
+		return errors.Wrapf(types.ErrInvalidDate, "invalid proof created date format: %s", credStatusListProof.Created)
+	}
+	if proofCreated.Before(validFrom) {
+		return errors.Wrap(types.ErrInvalidDate, "proof attached has a creation date before validFrom date")
+	}
+
+	// Verify Signature
+	if err := k.verifyDocumentProof(ctx, credStatusList, credStatusListProof); err != nil {
+		return errors.Wrap(types.ErrInvalidSignature, fmt.Sprintf("credential status list %s: %v", credStatusList.Id, err))
+	}
+
+	return nil
+}
@@ -16,6 +16,11 @@ func (k msgServer) DeactivateDID(goCtx context.Context, msg *types.MsgDeactivate
 	// Unwrap Go Context to Cosmos SDK Context
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Charge the fixed fee, if the message is dispatched by a CosmWasm contract
+	if err := k.chargeContractSenderFee(ctx, msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
+
 	// Get the RPC inputs
 	msgDidId := msg.DidDocumentId
 	msgDidDocumentProofs := msg.DidDocumentProofs
@@ -70,8 +75,9 @@ func (k msgServer) DeactivateDID(goCtx context.Context, msg *types.MsgDeactivate
 		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
 	}
 
-	// Signature Verification
-	err = verification.VerifySignatureOfAnyController(didDocument, controllerMap)
+	// Signature Verification. Controllers whose contract is the transaction sender are authorised without a signature.
+	contractAuthorizedControllers := k.getContractAuthorizedControllers(ctx, controllers, didDocument, msg.TxAuthor)
+	err = verification.VerifyAuthorizationOfAnyController(didDocument, controllerMap, didDocument.ControllerThreshold, contractAuthorizedControllers)
 	if err != nil {
 		return nil, errors.Wrapf(types.ErrInvalidSignature, err.Error())
 	}
@@ -80,6 +86,7 @@ func (k msgServer) DeactivateDID(goCtx context.Context, msg *types.MsgDeactivate
 	updatedMetadata := types.CreateNewMetadata(ctx)
 	updatedMetadata.Created = didDocumentState.GetDidDocumentMetadata().GetCreated()
 	updatedMetadata.Deactivated = true
+	updatedMetadata.CompromisedVerificationMethods = didDocumentState.GetDidDocumentMetadata().GetCompromisedVerificationMethods()
 
 	// Form the updated DID Document
 	updatedDidDocumentState := types.DidDocumentState{
@@ -88,7 +95,7 @@ func (k msgServer) DeactivateDID(goCtx context.Context, msg *types.MsgDeactivate
 	}
 
 	// Update the DID Document in Store
-	k.setDidDocumentInStore(ctx, &updatedDidDocumentState)
+	k.setDidDocumentWithVersionHistory(ctx, &updatedDidDocumentState)
 
 	// Remove the BlockchainAccountId from BlockchainAddressStore
 	for _, vm := range didDocumentState.DidDocument.VerificationMethod {
@@ -97,6 +104,16 @@ func (k msgServer) DeactivateDID(goCtx context.Context, msg *types.MsgDeactivate
 		}
 	}
 
+	// Emit a successful DID Document Deactivation event
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDidDeactivated{
+		DidId:             didDocument.Id,
+		VersionId:         updatedMetadata.VersionId,
+		PreviousVersionId: existingDidDocVersionId,
+		TxAuthor:          msg.GetTxAuthor(),
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgDeactivateDIDResponse{}, nil
 }
 
@@ -0,0 +1,295 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"reflect" /* #nosec G702 */
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/verification"
+)
+
+// RPC controller for initiating the recovery of a DID Document, whose keys are lost. The replacement DID Document
+// takes effect after the DID recovery delay, unless the controllers of DID Document cancel it.
+func (k msgServer) InitiateDidRecovery(goCtx context.Context, msg *types.MsgInitiateDidRecovery) (*types.MsgInitiateDidRecoveryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.chargeContractSenderFee(ctx, msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
+
+	msgDidDocument := msg.DidDocument
+	msgDidDocumentProofs := msg.DidDocumentProofs
+
+	// Validate DID Document
+	if err := msgDidDocument.ValidateDidDocument(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+	}
+
+	// Validate namespace in DID Document
+	chainNamespace := k.GetChainNamespace(&ctx)
+	if err := types.DidChainNamespaceValidation(msgDidDocument, chainNamespace); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+	}
+
+	// Check if the controller threshold and recovery are covered by the DID Document context
+	if err := checkDidDocumentPolicyContexts(msgDidDocument); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+	}
+
+	// Checks if the Did Document is already registered
+	if !k.hasDidDocument(ctx, msgDidDocument.Id) {
+		return nil, errors.Wrap(types.ErrDidDocNotFound, msgDidDocument.Id)
+	}
+
+	// Validate Document Proofs
+	for _, proof := range msgDidDocumentProofs {
+		if err := proof.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	existingDidDocumentState, err := k.getDidDocumentState(&ctx, msgDidDocument.Id)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrDidDocNotFound, err.Error())
+	}
+	existingDidDocument := existingDidDocumentState.DidDocument
+
+	if existingDidDocumentState.DidDocumentMetadata.Deactivated {
+		return nil, errors.Wrapf(types.ErrDidDocDeactivated, "cannot recover didDocument %v as it is deactivated", existingDidDocument.Id)
+	}
+
+	// Check if the version id of existing Did Document matches with the current one
+	existingDidDocVersionId := existingDidDocumentState.DidDocumentMetadata.VersionId
+	if existingDidDocVersionId != msg.VersionId {
+		errMsg := fmt.Sprintf(
+			"Expected %s with version %s. Got version %s",
+			msgDidDocument.Id, existingDidDocVersionId, msg.VersionId)
+		return nil, errors.Wrap(types.ErrUnexpectedDidVersion, errMsg)
+	}
+
+	if len(existingDidDocument.Recovery) == 0 {
+		return nil, errors.Wrapf(types.ErrInvalidDidRecovery, "DID Document %v does not have any recovery", existingDidDocument.Id)
+	}
+
+	if k.hasPendingDidRecovery(ctx, existingDidDocument.Id) {
+		return nil, errors.Wrapf(types.ErrInvalidDidRecovery, "a recovery of DID Document %v is already pending", existingDidDocument.Id)
+	}
+
+	// Proofs are not bound to the version of DID Document, hence a proof which has initiated a recovery, such as a
+	// cancelled one, must not initiate it again
+	for _, proof := range msgDidDocumentProofs {
+		if k.hasDidRecoveryProof(ctx, existingDidDocument.Id, proof.ProofValue) {
+			return nil, errors.Wrapf(
+				types.ErrInvalidDidRecovery,
+				"proof of verification method %v has already initiated a recovery of DID Document %v",
+				proof.VerificationMethod,
+				existingDidDocument.Id,
+			)
+		}
+	}
+
+	if reflect.DeepEqual(existingDidDocument, msgDidDocument) {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, "incoming DID Document does not have any changes")
+	}
+
+	// Controllers of the replacement DID Document must be active
+	if err := k.checkControllerPresenceInState(ctx, types.GetDidDocumentControllers(msgDidDocument), msgDidDocument.Id); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+	}
+
+	// blockchainAccountIds of the new Verification Methods must not belong to any other DID Document
+	if _, _, err := processBlockchainAccountIdForUpdateDID(k.Keeper, ctx, existingDidDocument.VerificationMethod, msgDidDocument.VerificationMethod); err != nil {
+		return nil, err
+	}
+
+	signMap := makeSignatureMap(msgDidDocumentProofs)
+
+	// Every newly added Verification Method must sign the replacement DID Document
+	requiredVmMap := map[string][]*types.ExtendedVerificationMethod{}
+	for _, vm := range getVerificationMethodsForUpdateDID(existingDidDocument.VerificationMethod, msgDidDocument.VerificationMethod) {
+		if _, signInfoProvided := signMap[vm.Id]; !signInfoProvided {
+			return nil, errors.Wrapf(
+				types.ErrInvalidSignature,
+				"signature must be provided for verification method id %v",
+				vm.Id,
+			)
+		}
+		requiredVmMap[vm.Controller] = append(requiredVmMap[vm.Controller], types.CreateExtendedVerificationMethod(vm, signMap[vm.Id]))
+	}
+	if err := k.checkVmListMapCompromise(ctx, requiredVmMap); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+	}
+
+	// Every newly added controller must sign the replacement DID Document
+	mandatoryControllers, _ := getControllersForUpdateDID(existingDidDocument, msgDidDocument)
+	mandatoryControllerVmMap, err := k.formAnyControllerVmListMap(ctx, mandatoryControllers, msgDidDocument.VerificationMethod, signMap)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+	}
+
+	recoveryVmMap, err := k.formRecoveryVmListMap(ctx, existingDidDocument, signMap)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDidRecovery, err.Error())
+	}
+
+	// Signature Verification
+	if err := verification.VerifySignatureOfEveryController(msgDidDocument, requiredVmMap); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidSignature, err.Error())
+	}
+
+	if err := verification.VerifySignatureOfEveryController(msgDidDocument, mandatoryControllerVmMap); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidSignature, err.Error())
+	}
+
+	if err := verification.VerifySignatureOfAnyController(msgDidDocument, recoveryVmMap, 0); err != nil {
+		return nil, errors.Wrapf(
+			types.ErrInvalidSignature,
+			"need atleast one valid signature from the recovery of DID Document %v",
+			existingDidDocument.Id,
+		)
+	}
+
+	// The version id of replacement DID Document is derived from the transaction initiating the recovery
+	metadata := types.CreateNewMetadata(ctx)
+	effectiveAt := ctx.BlockTime().Add(k.GetParams(ctx).DidRecoveryDelay).Format(time.RFC3339)
+
+	k.setPendingDidRecovery(ctx, &types.PendingDidRecovery{
+		DidDocument:       msgDidDocument,
+		DidDocumentProofs: msgDidDocumentProofs,
+		PreviousVersionId: existingDidDocVersionId,
+		VersionId:         metadata.VersionId,
+		InitiatedAt:       ctx.BlockTime().Format(time.RFC3339),
+		EffectiveAt:       effectiveAt,
+	})
+	for _, proof := range msgDidDocumentProofs {
+		k.setDidRecoveryProof(ctx, existingDidDocument.Id, types.GetDidRecoveryProofValueHash(proof.ProofValue))
+	}
+
+	// Emit a successful DID Recovery Initiation event
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDidRecoveryInitiated{
+		DidId:             msgDidDocument.Id,
+		PreviousVersionId: existingDidDocVersionId,
+		EffectiveAt:       effectiveAt,
+		TxAuthor:          msg.GetTxAuthor(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInitiateDidRecoveryResponse{}, nil
+}
+
+// RPC controller for cancelling a pending recovery of a DID Document. The controllers of the registered DID Document
+// sign the replacement DID Document of the pending recovery.
+func (k msgServer) CancelDidRecovery(goCtx context.Context, msg *types.MsgCancelDidRecovery) (*types.MsgCancelDidRecoveryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.chargeContractSenderFee(ctx, msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
+
+	msgDidId := msg.DidDocumentId
+	msgDidDocumentProofs := msg.DidDocumentProofs
+
+	// Checks if the Did Document is already registered
+	if !k.hasDidDocument(ctx, msgDidId) {
+		return nil, errors.Wrap(types.ErrDidDocNotFound, msgDidId)
+	}
+
+	recovery, err := k.getPendingDidRecovery(ctx, msgDidId)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrDidRecoveryNotFound, err.Error())
+	}
+
+	// Validate Document Proofs
+	for _, proof := range msgDidDocumentProofs {
+		if err := proof.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	didDocumentState, err := k.getDidDocumentState(&ctx, msgDidId)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrDidDocNotFound, err.Error())
+	}
+	didDocument := didDocumentState.DidDocument
+
+	// Gather controllers
+	controllers := getControllersForDeactivateDID(didDocument)
+	if err := k.checkControllerPresenceInState(ctx, controllers, didDocument.Id); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+	}
+
+	signMap := makeSignatureMap(msgDidDocumentProofs)
+
+	// Get controller VM map
+	controllerMap, err := k.formAnyControllerVmListMap(ctx, controllers, didDocument.VerificationMethod, signMap)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+	}
+
+	// Signature Verification
+	if err := verification.VerifySignatureOfAnyController(recovery.DidDocument, controllerMap, didDocument.ControllerThreshold); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidSignature, err.Error())
+	}
+
+	k.removePendingDidRecovery(ctx, recovery)
+
+	// Emit a successful DID Recovery Cancellation event
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDidRecoveryCancelled{
+		DidId:    msgDidId,
+		Reason:   "cancelled by the controllers of DID Document",
+		TxAuthor: msg.GetTxAuthor(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelDidRecoveryResponse{}, nil
+}
+
+// formRecoveryVmListMap returns a map between the recovery of DID Document and a list of Extended Verification
+// Methods which signed on its behalf. A recovery DID can sign with any of its Verification Methods, while a
+// recovery Verification Method can only sign by itself.
+func (k msgServer) formRecoveryVmListMap(ctx sdk.Context,
+	didDocument *types.DidDocument, inputSignMap map[string]*types.DocumentProof,
+) (map[string][]*types.ExtendedVerificationMethod, error) {
+	var recoveryMap map[string][]*types.ExtendedVerificationMethod = map[string][]*types.ExtendedVerificationMethod{}
+	for _, recovery := range didDocument.Recovery {
+		recoveryMap[recovery] = []*types.ExtendedVerificationMethod{}
+	}
+
+	for vmId, sign := range inputSignMap {
+		didId, _ := types.SplitDidUrl(vmId)
+
+		recovery := vmId
+		if _, present := recoveryMap[recovery]; !present {
+			recovery = didId
+			if _, present := recoveryMap[recovery]; !present {
+				continue
+			}
+		}
+
+		// Recovery DID must be active
+		if err := k.checkControllerPresenceInState(ctx, []string{didId}, didDocument.Id); err != nil {
+			return nil, err
+		}
+
+		vm, err := k.getControllerVmFromState(ctx, vmId)
+		if err != nil {
+			return nil, err
+		}
+
+		if isNonSigningVmType(vm.Type) {
+			continue
+		}
+		recoveryMap[recovery] = append(recoveryMap[recovery], types.CreateExtendedVerificationMethod(vm, sign))
+	}
+
+	if err := k.checkVmListMapCompromise(ctx, recoveryMap); err != nil {
+		return nil, err
+	}
+	return recoveryMap, nil
+}
@@ -2,12 +2,13 @@ package keeper
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"regexp"
+	"time"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/jsonschema"
 	"github.com/hypersign-protocol/hid-node/x/ssi/types"
 	"github.com/hypersign-protocol/hid-node/x/ssi/verification"
 )
@@ -17,8 +18,6 @@ func storeCredentialSchema(
 	goCtx context.Context,
 	schemaDoc *types.CredentialSchemaDocument,
 	schemaProof *types.DocumentProof,
-	txAuthor string,
-	msgTypeUrl string,
 ) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -54,13 +53,13 @@ func storeCredentialSchema(
 		return errors.Wrapf(types.ErrInvalidCredentialSchema, "name must always be in PascalCase: %v", schemaDoc.Name)
 	}
 
-	// Check if `properties` field is a valid JSON document
-	if err := isValidJSONDocument(schemaDoc.Schema.Properties); err != nil {
+	// Check if `properties` field is a valid JSON Schema
+	if _, err := jsonschema.NewCredentialSchemaValidator(schemaDoc.Schema); err != nil {
 		return errors.Wrapf(types.ErrInvalidCredentialSchema, "invalid `property` provided: %v", err.Error())
 	}
 
 	// Signature check
-	if err := k.VerifyDocumentProof(ctx, schemaDoc, schemaProof); err != nil {
+	if err := k.verifyDocumentProof(ctx, schemaDoc, schemaProof); err != nil {
 		return errors.Wrap(types.ErrInvalidClientSpecType, err.Error())
 	}
 
@@ -71,16 +70,30 @@ func storeCredentialSchema(
 
 	k.setCredentialSchemaInStore(ctx, schema)
 
-	// Emit a successful Schema Registration event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(msgTypeUrl, sdk.NewAttribute("tx_author", txAuthor)),
-	)
-
 	return nil
 }
 
 func (k msgServer) RegisterCredentialSchema(goCtx context.Context, msg *types.MsgRegisterCredentialSchema) (*types.MsgRegisterCredentialSchemaResponse, error) {
-	if err := storeCredentialSchema(k, goCtx, msg.CredentialSchemaDocument, msg.CredentialSchemaProof, msg.TxAuthor, msg.Type()); err != nil {
+	if err := k.chargeContractSenderFee(sdk.UnwrapSDKContext(goCtx), msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
+
+	if err := storeCredentialSchema(k, goCtx, msg.CredentialSchemaDocument, msg.CredentialSchemaProof); err != nil {
+		return nil, err
+	}
+
+	// Emit a successful Schema Registration event. The untyped event is deprecated in favour of
+	// EventSchemaRegistered, and is kept for the clients subscribing to it.
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(msg.Type(), sdk.NewAttribute("tx_author", msg.TxAuthor)),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSchemaRegistered{
+		SchemaId: msg.CredentialSchemaDocument.Id,
+		Author:   msg.CredentialSchemaDocument.Author,
+		Version:  getCredentialSchemaVersionString(msg.CredentialSchemaDocument.Id),
+		TxAuthor: msg.TxAuthor,
+	}); err != nil {
 		return nil, err
 	}
 
@@ -88,50 +101,136 @@ func (k msgServer) RegisterCredentialSchema(goCtx context.Context, msg *types.Ms
 }
 
 func (k msgServer) UpdateCredentialSchema(goCtx context.Context, msg *types.MsgUpdateCredentialSchema) (*types.MsgUpdateCredentialSchemaResponse, error) {
-	if err := storeCredentialSchema(k, goCtx, msg.CredentialSchemaDocument, msg.CredentialSchemaProof, msg.TxAuthor, msg.Type()); err != nil {
+	if err := k.chargeContractSenderFee(sdk.UnwrapSDKContext(goCtx), msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
+
+	if err := storeCredentialSchema(k, goCtx, msg.CredentialSchemaDocument, msg.CredentialSchemaProof); err != nil {
+		return nil, err
+	}
+
+	// Emit a successful Schema Update event. The untyped event is deprecated in favour of
+	// EventSchemaUpdated, and is kept for the clients subscribing to it.
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(msg.Type(), sdk.NewAttribute("tx_author", msg.TxAuthor)),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSchemaUpdated{
+		SchemaId: msg.CredentialSchemaDocument.Id,
+		Author:   msg.CredentialSchemaDocument.Author,
+		Version:  getCredentialSchemaVersionString(msg.CredentialSchemaDocument.Id),
+		TxAuthor: msg.TxAuthor,
+	}); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateCredentialSchemaResponse{}, nil
 }
 
-// isStringInPascalCase checks if an input string is in Pascal case or not
-func isStringInPascalCase(s string) bool {
-	pascalCaseRegex := regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)
+// RPC controller for changing the status of a Credential Schema. Only the author of Credential Schema can change its
+// status, and a revoked Credential Schema cannot be brought back.
+func (k msgServer) UpdateCredentialSchemaStatus(goCtx context.Context, msg *types.MsgUpdateCredentialSchemaStatus) (*types.MsgUpdateCredentialSchemaStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	return pascalCaseRegex.MatchString(s)
-}
+	if err := k.chargeContractSenderFee(ctx, msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
 
-// isValidJSONDocument checks if the schema property attribute is a valid JSON string
-// The `type` sub-attribute is a must for every attributes in property.
-// The `format` sub-attribute is acceptable, but optional
-func isValidJSONDocument(schemaProperty string) error {
-	var schemaPropertyDocument map[string]map[string]interface{}
-	if err := json.Unmarshal([]byte(schemaProperty), &schemaPropertyDocument); err != nil {
-		return err
+	schemaStatusDoc := msg.GetCredentialSchemaStatusDocument()
+	schemaStatusProof := msg.GetCredentialSchemaStatusProof()
+
+	if schemaStatusDoc == nil {
+		return nil, errors.Wrap(types.ErrInvalidCredentialSchema, "credential schema status document must be provided")
+	}
+
+	// Validate Credential Schema Status Document
+	if err := schemaStatusDoc.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidCredentialSchema, err.Error())
 	}
+	newStatus, _ := types.ParseCredentialSchemaStatus(schemaStatusDoc.Status)
 
-	for attributeName, attributeObj := range schemaPropertyDocument {
-		isTypeSubAttributePresent := false
+	// Validate Document Proof
+	if err := schemaStatusProof.Validate(); err != nil {
+		return nil, err
+	}
 
-		for subAttributeName := range attributeObj {
-			if subAttributeName == "type" {
-				isTypeSubAttributePresent = true
-			} else if subAttributeName == "format" {
-				continue
-			} else {
-				return fmt.Errorf(
-					"invalid sub-attribute %v of attribute %v. Only `type` and `format` sub-attributes are permitted",
-					subAttributeName,
-					attributeName,
-				)
-			}
-		}
+	schemaState, err := k.getCredentialSchemaVersion(ctx, schemaStatusDoc.Id)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrCredentialSchemaNotFound, err.Error())
+	}
+	author := schemaState.CredentialSchemaDocument.Author
+
+	// The status can only be changed by a Verification Method of the schema author
+	if didId, _ := types.SplitDidUrl(schemaStatusProof.VerificationMethod); didId != author {
+		return nil, errors.Wrapf(
+			types.ErrInvalidProof,
+			"verificationMethod %v does not belong to %v, the author of credential schema %v",
+			schemaStatusProof.VerificationMethod,
+			author,
+			schemaStatusDoc.Id,
+		)
+	}
 
-		if !isTypeSubAttributePresent {
-			return fmt.Errorf("%v attribute is missing the required sub-attribute `type`", attributeName)
-		}
+	authorDidDocumentState, err := k.getDidDocumentState(&ctx, author)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("unable to get author`s DID %s from store", author))
+	}
+	if authorDidDocumentState.DidDocumentMetadata.Deactivated {
+		return nil, errors.Wrap(types.ErrDidDocDeactivated, fmt.Sprintf("%s is deactivated and cannot used be used to change schema status", author))
 	}
 
+	if err := checkCredentialSchemaStatusTransition(schemaState.Status, newStatus); err != nil {
+		return nil, err
+	}
+
+	// Signature check
+	if err := k.verifyDocumentProof(ctx, schemaStatusDoc, schemaStatusProof); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidSignature, err.Error())
+	}
+
+	schemaState.Status = newStatus
+	schemaState.StatusRemarks = schemaStatusDoc.Remarks
+	schemaState.StatusUpdated = ctx.BlockTime().Format(time.RFC3339)
+	k.mustSetCredentialSchemaState(ctx, schemaState)
+
+	// Emit a successful Schema Status Update event
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSchemaStatusUpdated{
+		SchemaId: schemaStatusDoc.Id,
+		Author:   author,
+		Status:   schemaStatusDoc.Status,
+		Remarks:  schemaStatusDoc.Remarks,
+		TxAuthor: msg.GetTxAuthor(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateCredentialSchemaStatusResponse{}, nil
+}
+
+// checkCredentialSchemaStatusTransition checks if a Credential Schema can move from its current status to the
+// new one. An active and a deprecated Credential Schema can move to any other status, while a revoked one is final.
+func checkCredentialSchemaStatusTransition(currentStatus types.CredentialSchemaStatus, newStatus types.CredentialSchemaStatus) error {
+	if currentStatus == types.CREDENTIAL_SCHEMA_STATUS_REVOKED {
+		return errors.Wrap(types.ErrCredentialSchemaRevoked, "status of a revoked credential schema cannot be changed")
+	}
+	if currentStatus == newStatus {
+		return errors.Wrapf(types.ErrInvalidCredentialSchema, "credential schema already has the status %v", newStatus)
+	}
 	return nil
 }
+
+// getCredentialSchemaVersionString returns the version number of a stored Credential Schema
+func getCredentialSchemaVersionString(schemaId string) string {
+	_, schemaVersion, err := types.SplitSchemaId(schemaId)
+	if err != nil {
+		return ""
+	}
+	return schemaVersion.String()
+}
+
+// isStringInPascalCase checks if an input string is in Pascal case or not
+func isStringInPascalCase(s string) bool {
+	pascalCaseRegex := regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`)
+
+	return pascalCaseRegex.MatchString(s)
+}
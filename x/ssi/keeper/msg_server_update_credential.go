@@ -9,12 +9,18 @@ import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
 	"github.com/hypersign-protocol/hid-node/x/ssi/types"
 )
 
 func (k msgServer) UpdateCredentialStatus(goCtx context.Context, msg *types.MsgUpdateCredentialStatus) (*types.MsgUpdateCredentialStatusResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.chargeContractSenderFee(ctx, msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
+
 	msgNewCredStatus := msg.GetCredentialStatusDocument()
 	msgNewCredProof := msg.GetCredentialStatusProof()
 
@@ -58,6 +64,11 @@ func (k msgServer) UpdateCredentialStatus(goCtx context.Context, msg *types.MsgU
 		return nil, errors.Wrapf(types.ErrInvalidCredentialStatus, "credential status %v could not be updated since it is revoked", oldCredStatus.Id)
 	}
 
+	// Check if the credential has expired
+	if oldCredStatusState.Expired {
+		return nil, errors.Wrapf(types.ErrInvalidCredentialStatus, "credential status %v could not be updated since it has expired", oldCredStatus.Id)
+	}
+
 	// Check if the new issuance date are same as old one.
 	newIssuanceDate := msgNewCredStatus.GetIssuanceDate()
 	newIssuanceDateParsed, err := time.Parse(time.RFC3339, newIssuanceDate)
@@ -74,6 +85,13 @@ func (k msgServer) UpdateCredentialStatus(goCtx context.Context, msg *types.MsgU
 		return nil, errors.Wrapf(types.ErrInvalidDate, fmt.Sprintf("issuance date should be same, new issuance date provided : %s", newIssuanceDate))
 	}
 
+	// Validate the Expiration Date, if it has changed
+	if msgNewCredStatus.GetExpirationDate() != oldCredStatus.GetExpirationDate() {
+		if err := verifyCredentialExpirationDate(ctx, msgNewCredStatus, newIssuanceDateParsed); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate Merkle Root Hash
 	if err := verifyCredentialMerkleRootHash(msgNewCredStatus.GetCredentialMerkleRootHash()); err != nil {
 		return nil, errors.Wrapf(types.ErrInvalidCredentialMerkleRootHash, err.Error())
@@ -89,6 +107,20 @@ func (k msgServer) UpdateCredentialStatus(goCtx context.Context, msg *types.MsgU
 		)
 	}
 
+	// The Credential Schema of a Credential cannot change
+	if msgNewCredStatus.CredentialSchemaId != oldCredStatus.CredentialSchemaId {
+		return nil, errors.Wrapf(
+			types.ErrInvalidCredentialField,
+			"recieved credential schema id '%v' is different from the credential schema id of registered credential status document '%v'",
+			msgNewCredStatus.CredentialSchemaId,
+			oldCredStatus.CredentialSchemaId,
+		)
+	}
+
+	if err := checkCredentialStatusContexts(msgNewCredStatus, msgNewCredStatus.Context, ldcontext.CredentialStatusV2Context); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidCredentialStatus, err.Error())
+	}
+
 	// Check if the created date before issuance date
 	currentDate, err := time.Parse(time.RFC3339, msgNewCredProof.Created)
 	if err != nil {
@@ -104,7 +136,7 @@ func (k msgServer) UpdateCredentialStatus(goCtx context.Context, msg *types.MsgU
 	}
 
 	// Verify Signature
-	err = k.VerifyDocumentProof(ctx, msgNewCredStatus, msgNewCredProof)
+	err = k.verifyDocumentProof(ctx, msgNewCredStatus, msgNewCredProof)
 	if err != nil {
 		return nil, errors.Wrap(types.ErrInvalidSignature, err.Error())
 	}
@@ -114,7 +146,51 @@ func (k msgServer) UpdateCredentialStatus(goCtx context.Context, msg *types.MsgU
 		CredentialStatusProof:    msgNewCredProof,
 	}
 
+	// Remove the previous Expiration Date from the expiry index
+	if oldExpirationTime, ok := getCredentialExpirationTime(oldCredStatus); ok {
+		k.removeCredExpiryIndex(ctx, credId, oldExpirationTime)
+	}
+
 	k.setCredentialStatusInState(ctx, &cred)
 
+	// Emit an event describing the Credential Status transition
+	if err := ctx.EventManager().EmitTypedEvent(
+		getCredentialStatusUpdateEvent(oldCredStatus, msgNewCredStatus, msg.GetTxAuthor()),
+	); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateCredentialStatusResponse{}, nil
 }
+
+// getCredentialStatusUpdateEvent returns the event for an update of Credential Status. Revocation and
+// suspension have their own events, while every other change is reported along with the changed fields.
+func getCredentialStatusUpdateEvent(
+	oldCredStatus *types.CredentialStatusDocument,
+	newCredStatus *types.CredentialStatusDocument,
+	txAuthor string,
+) proto.Message {
+	switch {
+	case newCredStatus.Revoked:
+		return &types.EventCredentialRevoked{
+			CredentialId: newCredStatus.Id,
+			Issuer:       newCredStatus.Issuer,
+			Remarks:      newCredStatus.Remarks,
+			TxAuthor:     txAuthor,
+		}
+	case newCredStatus.Suspended && !oldCredStatus.Suspended:
+		return &types.EventCredentialSuspended{
+			CredentialId: newCredStatus.Id,
+			Issuer:       newCredStatus.Issuer,
+			Remarks:      newCredStatus.Remarks,
+			TxAuthor:     txAuthor,
+		}
+	default:
+		return &types.EventCredentialStatusUpdated{
+			CredentialId:  newCredStatus.Id,
+			Issuer:        newCredStatus.Issuer,
+			ChangedFields: types.GetCredentialStatusChangedFields(oldCredStatus, newCredStatus),
+			TxAuthor:      txAuthor,
+		}
+	}
+}
@@ -16,6 +16,11 @@ func (k msgServer) UpdateDID(goCtx context.Context, msg *types.MsgUpdateDID) (*t
 	// Unwrap Go Context to Cosmos SDK Context
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Charge the fixed fee, if the message is dispatched by a CosmWasm contract
+	if err := k.chargeContractSenderFee(ctx, msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
+
 	// Get the RPC inputs
 	msgDidDocument := msg.DidDocument
 	msgDidDocumentProofs := msg.DidDocumentProofs
@@ -31,6 +36,11 @@ func (k msgServer) UpdateDID(goCtx context.Context, msg *types.MsgUpdateDID) (*t
 		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
 	}
 
+	// Check if the controller threshold and recovery are covered by the DID Document context
+	if err := checkDidDocumentPolicyContexts(msgDidDocument); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+	}
+
 	// Checks if the Did Document is already registered
 	if !k.hasDidDocument(ctx, msgDidDocument.Id) {
 		return nil, errors.Wrap(types.ErrDidDocNotFound, msgDidDocument.Id)
@@ -70,6 +80,11 @@ func (k msgServer) UpdateDID(goCtx context.Context, msg *types.MsgUpdateDID) (*t
 		return nil, errors.Wrap(types.ErrUnexpectedDidVersion, errMsg)
 	}
 
+	// Verification Methods of contracts can only be added by the respective contract
+	if err := k.checkNewContractVerificationMethods(ctx, existingDidDocument.VerificationMethod, msgDidDocument.VerificationMethod, msg.TxAuthor); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidSignature, err.Error())
+	}
+
 	signMap := makeSignatureMap(msgDidDocumentProofs)
 
 	// Check if there is any change in controllers
@@ -107,7 +122,7 @@ func (k msgServer) UpdateDID(goCtx context.Context, msg *types.MsgUpdateDID) (*t
 		} else {
 			// Get a list of Verification Methods having a populated `blockchainAccountId` field, which are being newly added
 			// and/or removed from the DID Document
-			vmsToBeAdded, vmsToBeRemoved, err = processBlockchainAccountIdForUpdateDID(k, ctx, existingDidDocument.VerificationMethod, msgDidDocument.VerificationMethod)
+			vmsToBeAdded, vmsToBeRemoved, err = processBlockchainAccountIdForUpdateDID(k.Keeper, ctx, existingDidDocument.VerificationMethod, msgDidDocument.VerificationMethod)
 			if err != nil {
 				return nil, err
 			}
@@ -147,6 +162,13 @@ func (k msgServer) UpdateDID(goCtx context.Context, msg *types.MsgUpdateDID) (*t
 			return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
 		}
 
+		// Get a list of Verification Methods having a populated `blockchainAccountId` field, which are being newly added
+		// and/or removed from the DID Document
+		vmsToBeAdded, vmsToBeRemoved, err = processBlockchainAccountIdForUpdateDID(k.Keeper, ctx, existingDidDocument.VerificationMethod, msgDidDocument.VerificationMethod)
+		if err != nil {
+			return nil, err
+		}
+
 		// Gather Verification Methods
 		updatedVms := getVerificationMethodsForUpdateDID(existingDidDocument.VerificationMethod, msgDidDocument.VerificationMethod)
 
@@ -161,19 +183,22 @@ func (k msgServer) UpdateDID(goCtx context.Context, msg *types.MsgUpdateDID) (*t
 		}
 	}
 
-	// Signature Verification
-	if err := verification.VerifySignatureOfEveryController(msgDidDocument, requiredVmMap); err != nil {
+	// Signature Verification. Controllers whose contract is the transaction sender are authorised without a signature.
+	requiredContractAuthorizedControllers := k.getContractAuthorizedControllers(ctx, getControllersOfVmMap(requiredVmMap), msgDidDocument, msg.TxAuthor)
+	if err := verification.VerifyAuthorizationOfEveryController(msgDidDocument, requiredVmMap, requiredContractAuthorizedControllers); err != nil {
 		return nil, errors.Wrapf(types.ErrInvalidSignature, err.Error())
 	}
 
-	if err := verification.VerifySignatureOfAnyController(msgDidDocument, optionalVmMap); err != nil {
+	optionalContractAuthorizedControllers := k.getContractAuthorizedControllers(ctx, getControllersOfVmMap(optionalVmMap), existingDidDocument, msg.TxAuthor)
+	if err := verification.VerifyAuthorizationOfAnyController(msgDidDocument, optionalVmMap, existingDidDocument.ControllerThreshold, optionalContractAuthorizedControllers); err != nil {
 		return nil, errors.Wrapf(types.ErrInvalidSignature, err.Error())
 	}
 
-	// Create the Metadata and assign `created` and `deactivated` to previous DIDDoc's metadata values
+	// Create the Metadata and assign `created`, `deactivated` and `compromisedVerificationMethods` to previous DIDDoc's metadata values
 	metadata := types.CreateNewMetadata(ctx)
 	metadata.Created = existingDidDocumentState.GetDidDocumentMetadata().GetCreated()
 	metadata.Deactivated = existingDidDocumentState.GetDidDocumentMetadata().GetDeactivated()
+	metadata.CompromisedVerificationMethods = existingDidDocumentState.GetDidDocumentMetadata().GetCompromisedVerificationMethods()
 
 	// Form the DID Document
 	didDocumentState := types.DidDocumentState{
@@ -182,7 +207,7 @@ func (k msgServer) UpdateDID(goCtx context.Context, msg *types.MsgUpdateDID) (*t
 	}
 
 	// Update the DID Document in store
-	k.setDidDocumentInStore(ctx, &didDocumentState)
+	k.setDidDocumentWithVersionHistory(ctx, &didDocumentState)
 
 	// Iterate through the removed Verification Methods having `blockchainAccountId` populated
 	// and remove them from store
@@ -196,6 +221,17 @@ func (k msgServer) UpdateDID(goCtx context.Context, msg *types.MsgUpdateDID) (*t
 		k.setBlockchainAddressInStore(&ctx, vm.BlockchainAccountId, vm.Controller)
 	}
 
+	// Emit a successful DID Document Update event
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDidUpdated{
+		DidId:             msgDidDocument.Id,
+		VersionId:         metadata.VersionId,
+		PreviousVersionId: existingDidDocVersionId,
+		ChangedFields:     types.GetDidDocumentChangedFields(existingDidDocument, msgDidDocument),
+		TxAuthor:          msg.GetTxAuthor(),
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateDIDResponse{}, nil
 }
 
@@ -275,9 +311,7 @@ func getVerificationMethodsForUpdateDID(existingVMs []*types.VerificationMethod,
 	// Make map of existing VMs
 	existingVmMap := map[string]*types.VerificationMethod{}
 	for _, vm := range existingVMs {
-		// Skip X25519KeyAgreementKey2020 or X25519KeyAgreementKey2020 because these
-		// are not allowed for Authentication and Assertion purposes
-		if (vm.Type == types.X25519KeyAgreementKey2020) || (vm.Type == types.X25519KeyAgreementKeyEIP5630) {
+		if isNonSigningVmType(vm.Type) {
 			continue
 		}
 		existingVmMap[vm.Id] = vm
@@ -290,7 +324,7 @@ func getVerificationMethodsForUpdateDID(existingVMs []*types.VerificationMethod,
 		// Check if VM is present in existing VM map.
 		// If it's not present, the VM is being added to existing Did Document.
 		// Add the VM to "required" group
-		if _, present := existingVmMap[vm.Id]; !present && ((vm.Type != types.X25519KeyAgreementKey2020) && (vm.Type != types.X25519KeyAgreementKeyEIP5630)) {
+		if _, present := existingVmMap[vm.Id]; !present && !isNonSigningVmType(vm.Type) {
 			updatedVms = append(
 				updatedVms,
 				vm,
@@ -301,7 +335,7 @@ func getVerificationMethodsForUpdateDID(existingVMs []*types.VerificationMethod,
 	return updatedVms
 }
 
-func processBlockchainAccountIdForUpdateDID(k msgServer, ctx sdk.Context, existingVMs []*types.VerificationMethod, incomingVMs []*types.VerificationMethod) ([]*types.VerificationMethod, []*types.VerificationMethod, error) {
+func processBlockchainAccountIdForUpdateDID(k Keeper, ctx sdk.Context, existingVMs []*types.VerificationMethod, incomingVMs []*types.VerificationMethod) ([]*types.VerificationMethod, []*types.VerificationMethod, error) {
 	newVms := []*types.VerificationMethod{}
 	deletedVms := []*types.VerificationMethod{}
 
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/verification"
+)
+
+// RPC controller for marking a Verification Method of DID Document as compromised. Transactions signed by the
+// Verification Method are rejected from then on, while its proofs created before the compromise time remain valid
+// for off-chain verification.
+func (k msgServer) MarkVerificationMethodCompromised(goCtx context.Context, msg *types.MsgMarkVerificationMethodCompromised) (*types.MsgMarkVerificationMethodCompromisedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.chargeContractSenderFee(ctx, msg, msg.TxAuthor); err != nil {
+		return nil, err
+	}
+
+	msgCompromiseDocument := msg.CompromiseDocument
+	msgCompromiseDocumentProofs := msg.CompromiseDocumentProofs
+
+	if msgCompromiseDocument == nil {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, "verification method compromise document must be provided")
+	}
+
+	// Validate Verification Method Compromise Document
+	if err := msgCompromiseDocument.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+	}
+
+	compromisedSince, _ := time.Parse(time.RFC3339, msgCompromiseDocument.CompromisedSince)
+	if compromisedSince.After(ctx.BlockTime()) {
+		return nil, errors.Wrapf(
+			types.ErrInvalidDate,
+			"compromisedSince %v cannot be a future date",
+			msgCompromiseDocument.CompromisedSince,
+		)
+	}
+
+	// Checks if the Did Document is already registered
+	didId := msgCompromiseDocument.Id
+	if !k.hasDidDocument(ctx, didId) {
+		return nil, errors.Wrap(types.ErrDidDocNotFound, didId)
+	}
+
+	// Validate Document Proofs
+	for _, proof := range msgCompromiseDocumentProofs {
+		if err := proof.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	didDocumentState, err := k.getDidDocumentState(&ctx, didId)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrDidDocNotFound, err.Error())
+	}
+	didDocument := didDocumentState.DidDocument
+	didDocumentMetadata := didDocumentState.DidDocumentMetadata
+
+	if didDocumentMetadata.Deactivated {
+		return nil, errors.Wrapf(types.ErrDidDocDeactivated, "DID Document %v is deactivated", didId)
+	}
+
+	// Check if the version id of existing Did Document matches with the current one
+	if didDocumentMetadata.VersionId != msg.VersionId {
+		errMsg := fmt.Sprintf(
+			"Expected %s with version %s. Got version %s",
+			didId, didDocumentMetadata.VersionId, msg.VersionId)
+		return nil, errors.Wrap(types.ErrUnexpectedDidVersion, errMsg)
+	}
+
+	// The Verification Method must be a part of current or any of the previous versions of DID Document,
+	// as the one removed through a DID Document update can also be compromised
+	vmId := msgCompromiseDocument.VerificationMethodId
+	if !k.hasDidDocumentEverHadVerificationMethod(ctx, didDocument, vmId) {
+		return nil, errors.Wrapf(
+			types.ErrVerificationMethodNotFound,
+			"verification method %v was never a part of DID Document %v",
+			vmId,
+			didId,
+		)
+	}
+
+	if verification.GetCompromisedVerificationMethod(didDocumentMetadata, vmId) != nil {
+		return nil, errors.Wrapf(types.ErrVerificationMethodCompromised, "%v is already marked as compromised", vmId)
+	}
+
+	// Gather controllers
+	controllers := getControllersForDeactivateDID(didDocument)
+	if err := k.checkControllerPresenceInState(ctx, controllers, didId); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+	}
+
+	// The compromised Verification Method cannot vouch for itself
+	signMap := makeSignatureMap(msgCompromiseDocumentProofs)
+	delete(signMap, vmId)
+
+	controllerMap, err := k.formAnyControllerVmListMap(ctx, controllers, didDocument.VerificationMethod, signMap)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidDidDoc, err.Error())
+	}
+
+	// Signature Verification
+	if err := verification.VerifySignatureOfAnyController(msgCompromiseDocument, controllerMap, didDocument.ControllerThreshold); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidSignature, err.Error())
+	}
+
+	// Create the Metadata and carry over the previous DID Document metadata values
+	metadata := types.CreateNewMetadata(ctx)
+	metadata.Created = didDocumentMetadata.GetCreated()
+	metadata.Deactivated = didDocumentMetadata.GetDeactivated()
+	metadata.CompromisedVerificationMethods = append(
+		didDocumentMetadata.GetCompromisedVerificationMethods(),
+		&types.CompromisedVerificationMethod{
+			VerificationMethodId: vmId,
+			CompromisedSince:     msgCompromiseDocument.CompromisedSince,
+			ReportedAt:           ctx.BlockTime().Format(time.RFC3339),
+		},
+	)
+
+	k.setDidDocumentWithVersionHistory(ctx, &types.DidDocumentState{
+		DidDocument:         didDocument,
+		DidDocumentMetadata: &metadata,
+	})
+
+	// Emit a successful Verification Method Compromise event
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventVerificationMethodCompromised{
+		DidId:                didId,
+		VerificationMethodId: vmId,
+		CompromisedSince:     msgCompromiseDocument.CompromisedSince,
+		VersionId:            metadata.VersionId,
+		TxAuthor:             msg.GetTxAuthor(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMarkVerificationMethodCompromisedResponse{}, nil
+}
+
+// hasDidDocumentEverHadVerificationMethod checks if the Verification Method is present in any version of DID Document
+func (k msgServer) hasDidDocumentEverHadVerificationMethod(ctx sdk.Context, didDocument *types.DidDocument, vmId string) bool {
+	for _, vm := range didDocument.VerificationMethod {
+		if vm.Id == vmId {
+			return true
+		}
+	}
+
+	for _, didDocumentState := range k.getDidDocumentVersionsFromStore(ctx, didDocument.Id) {
+		for _, vm := range didDocumentState.DidDocument.VerificationMethod {
+			if vm.Id == vmId {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -2,14 +2,30 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
 )
 
-func (k Keeper) SetFeeParam(ctx sdk.Context, fee sdk.Coin, ssiParamStoreKey []byte) {
-	k.paramSpace.Set(ctx, ssiParamStoreKey, fee)
+// SetParams validates and sets the x/ssi module params in the module store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.ParamsKey), k.cdc.MustMarshal(&params))
+	return nil
 }
 
-func (k Keeper) GetFeeParams(ctx sdk.Context, ssiParamStoreKey []byte) sdk.Coin {
-	var fee sdk.Coin
-	k.paramSpace.Get(ctx, ssiParamStoreKey, &fee)
-	return fee
+// GetParams returns the x/ssi module params from the module store
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.ParamsKey))
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
 }
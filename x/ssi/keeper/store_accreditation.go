@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// setAccreditationState stores accreditation in store, and indexes it against the accredited issuer
+// and the Credential Schema
+func (k Keeper) setAccreditationState(ctx sdk.Context, accreditation *types.AccreditationState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AccreditationKey))
+
+	accreditationDoc := accreditation.AccreditationDocument
+	store.Set([]byte(accreditationDoc.Id), k.cdc.MustMarshal(accreditation))
+
+	indexStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GetAccreditationIssuerIndexPrefix(accreditationDoc.AccreditedIssuer, accreditationDoc.CredentialSchemaId),
+	)
+	indexStore.Set([]byte(accreditationDoc.Id), []byte{})
+}
+
+// getAccreditationState gets accreditation from store
+func (k Keeper) getAccreditationState(ctx sdk.Context, id string) (*types.AccreditationState, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AccreditationKey))
+
+	var accreditation types.AccreditationState
+	var bytes = store.Get([]byte(id))
+	if len(bytes) == 0 {
+		return nil, fmt.Errorf("accreditation %s not found", id)
+	}
+
+	if err := k.cdc.Unmarshal(bytes, &accreditation); err != nil {
+		return nil, fmt.Errorf("internal: unable to unmarshal accreditation %s from state", id)
+	}
+
+	return &accreditation, nil
+}
+
+// hasAccreditation returns whether an accreditation is present in the store
+func (k Keeper) hasAccreditation(ctx sdk.Context, id string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AccreditationKey))
+	return store.Has([]byte(id))
+}
+
+// getIssuerAccreditationIds returns the ids of every accreditation of the issuer for a Credential Schema
+func (k Keeper) getIssuerAccreditationIds(ctx sdk.Context, issuer string, credentialSchemaId string) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetAccreditationIssuerIndexPrefix(issuer, credentialSchemaId))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var accreditationIds []string
+	for ; iterator.Valid(); iterator.Next() {
+		accreditationIds = append(accreditationIds, string(iterator.Key()))
+	}
+	return accreditationIds
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"encoding/binary"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,8 +27,12 @@ func (k Keeper) getCredentialSchemaCount(ctx sdk.Context) uint64 {
 
 // hasCredentialSchema checks whether credential schema already exists in the store
 func (k Keeper) hasCredentialSchema(ctx sdk.Context, id string) bool {
+	schemaKey, err := types.GetCredentialSchemaKey(id)
+	if err != nil {
+		return false
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SchemaKey))
-	return store.Has([]byte(id))
+	return store.Has(schemaKey)
 }
 
 // setCredentialSchemaCount sets credential schema count in store
@@ -47,31 +52,105 @@ func (k Keeper) setCredentialSchemaCount(ctx sdk.Context, count uint64) {
 func (k Keeper) setCredentialSchemaInStore(ctx sdk.Context, schema types.CredentialSchemaState) {
 	// Get the current number of Schemas in the store
 	count := k.getCredentialSchemaCount(ctx)
-	// Get the store
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SchemaKey))
-	// Marshal the Schema into bytes
-	schemaBytes := k.cdc.MustMarshal(&schema)
-	store.Set([]byte(schema.GetCredentialSchemaDocument().GetId()), schemaBytes)
+	// Store the Schema under its base id and version
+	k.mustSetCredentialSchemaState(ctx, &schema)
 	// Update the Schema count
 	k.setCredentialSchemaCount(ctx, count+1)
 }
 
-// getCredentialSchemaFromStore gets credential schema from store
+// mustSetCredentialSchemaState stores credential schema under the composite key of its base id and
+// version number, and indexes it against its author. It panics if the schema id does not carry a valid version number.
+func (k Keeper) mustSetCredentialSchemaState(ctx sdk.Context, schema *types.CredentialSchemaState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SchemaKey))
+	schemaKey, err := k.getCredentialSchemaStoreKey(store, schema.GetCredentialSchemaDocument().GetId())
+	if err != nil {
+		panic(err)
+	}
+	store.Set(schemaKey, k.cdc.MustMarshal(schema))
+	k.setSchemaAuthorIndex(ctx, schemaKey, schema.GetCredentialSchemaDocument().GetAuthor())
+}
+
+// getCredentialSchemaFromStore gets credential schemas from store. If the input is a schema id with version,
+// only that version is returned. If the input is a schema base id, every version of the schema is returned
+// in ascending order of version.
 func (k Keeper) getCredentialSchemaFromStore(ctx sdk.Context, credentialSchemaId string) ([]*types.CredentialSchemaState, error) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SchemaKey))
-	var versionNumLengthWithColon int = 4
-	var credentialSchemas []*types.CredentialSchemaState
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	if credentialSchema, err := k.getCredentialSchemaVersion(ctx, credentialSchemaId); err == nil {
+		return []*types.CredentialSchemaState{credentialSchema}, nil
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SchemaKey))
+	iterator := sdk.KVStorePrefixIterator(store, types.GetCredentialSchemaBaseKey(credentialSchemaId))
+	defer iterator.Close()
 
+	var credentialSchemas []*types.CredentialSchemaState
 	for ; iterator.Valid(); iterator.Next() {
 		var credentialSchema types.CredentialSchemaState
-		k.cdc.MustUnmarshal(iterator.Value(), &credentialSchema)
-
-		storedSchemaId := credentialSchema.CredentialSchemaDocument.Id
-		if credentialSchemaId == storedSchemaId[0:len(storedSchemaId)-versionNumLengthWithColon] || credentialSchemaId == storedSchemaId {
-			credentialSchemas = append(credentialSchemas, &credentialSchema)
+		if err := k.cdc.Unmarshal(iterator.Value(), &credentialSchema); err != nil {
+			return nil, err
 		}
+		credentialSchemas = append(credentialSchemas, &credentialSchema)
 	}
 
 	return credentialSchemas, nil
 }
+
+// getCredentialSchemaVersion gets a specific version of credential schema from store
+func (k Keeper) getCredentialSchemaVersion(ctx sdk.Context, credentialSchemaId string) (*types.CredentialSchemaState, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SchemaKey))
+	schemaKey, err := k.getCredentialSchemaStoreKey(store, credentialSchemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	bz := store.Get(schemaKey)
+	if bz == nil {
+		return nil, fmt.Errorf("credential schema %v not found", credentialSchemaId)
+	}
+
+	var credentialSchema types.CredentialSchemaState
+	if err := k.cdc.Unmarshal(bz, &credentialSchema); err != nil {
+		return nil, err
+	}
+	return &credentialSchema, nil
+}
+
+// getCredentialSchemaStoreKey returns the store key of a Credential Schema. It is the composite key of its base id
+// and version number, unless the composite key is held by another schema whose version differs only by leading zeros,
+// in which case it is the legacy key of schema.
+func (k Keeper) getCredentialSchemaStoreKey(store prefix.Store, credentialSchemaId string) ([]byte, error) {
+	schemaKey, err := types.GetCredentialSchemaKey(credentialSchemaId)
+	if err != nil {
+		return nil, err
+	}
+
+	bz := store.Get(schemaKey)
+	if bz == nil {
+		return schemaKey, nil
+	}
+
+	var credentialSchema types.CredentialSchemaState
+	if err := k.cdc.Unmarshal(bz, &credentialSchema); err != nil {
+		return nil, err
+	}
+	if credentialSchema.GetCredentialSchemaDocument().GetId() != credentialSchemaId {
+		return types.GetLegacyCredentialSchemaKey(credentialSchemaId), nil
+	}
+	return schemaKey, nil
+}
+
+// getLatestCredentialSchema gets the highest version of credential schema from store for the input base id
+func (k Keeper) getLatestCredentialSchema(ctx sdk.Context, baseId string) (*types.CredentialSchemaState, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SchemaKey))
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetCredentialSchemaBaseKey(baseId))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil, fmt.Errorf("credential schema %v not found", baseId)
+	}
+
+	var credentialSchema types.CredentialSchemaState
+	if err := k.cdc.Unmarshal(iterator.Value(), &credentialSchema); err != nil {
+		return nil, err
+	}
+	return &credentialSchema, nil
+}
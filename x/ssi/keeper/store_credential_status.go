@@ -3,22 +3,89 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/hypersign-protocol/hid-node/x/ssi/types"
 )
 
+// maxCredentialStatusExpiriesPerBlock is the maximum number of Credential Statuses marked as expired in a block
+const maxCredentialStatusExpiriesPerBlock = 1000
+
 // setCredentialStatusInState stores credential status in store
 func (k Keeper) setCredentialStatusInState(ctx sdk.Context, cred *types.CredentialStatusState) {
 	count := k.getCredentialStatusCount(ctx)
+	k.setCredentialStatusState(ctx, cred)
+	k.setCredentialStatusCount(ctx, count+1)
+}
+
+// setCredentialStatusState stores credential status in store and indexes it against its issuer
+func (k Keeper) setCredentialStatusState(ctx sdk.Context, cred *types.CredentialStatusState) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CredKey))
 
 	id := cred.CredentialStatusDocument.Id
 	credBytes := k.cdc.MustMarshal(cred)
 
 	store.Set([]byte(id), credBytes)
-	k.setCredentialStatusCount(ctx, count+1)
+	k.setCredIssuerIndex(ctx, id, cred.CredentialStatusDocument.Issuer)
+
+	if expirationTime, ok := getCredentialExpirationTime(cred.CredentialStatusDocument); ok && !cred.Expired {
+		k.setCredExpiryIndex(ctx, id, expirationTime)
+	}
+}
+
+// ExpireCredentialStatuses marks the Credential Statuses, whose expiration date is not after the block time,
+// as expired. At most maxCredentialStatusExpiriesPerBlock Credential Statuses are processed in a block, and
+// the rest are carried over to the following blocks.
+func (k Keeper) ExpireCredentialStatuses(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredExpiryIndexKey))
+
+	// Collect the index keys first, as the store must not be written while it is iterated
+	var expiryIndexKeys [][]byte
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+	for ; iterator.Valid() && len(expiryIndexKeys) < maxCredentialStatusExpiriesPerBlock; iterator.Next() {
+		expiryIndexKeys = append(expiryIndexKeys, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, expiryIndexKey := range expiryIndexKeys {
+		store.Delete(expiryIndexKey)
+
+		credId := string(expiryIndexKey[len(sdk.SortableTimeFormat):])
+		cred, err := k.getCredentialStatusFromState(&ctx, credId)
+		if err != nil || cred.Expired {
+			continue
+		}
+
+		cred.Expired = true
+		k.setCredentialStatusState(ctx, cred)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventCredentialExpired{
+			CredentialId:   cred.CredentialStatusDocument.Id,
+			Issuer:         cred.CredentialStatusDocument.Issuer,
+			ExpirationDate: cred.CredentialStatusDocument.ExpirationDate,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getCredentialExpirationTime returns the expiration time of Credential Status, if it has one
+func getCredentialExpirationTime(credStatus *types.CredentialStatusDocument) (time.Time, bool) {
+	if credStatus.GetExpirationDate() == "" {
+		return time.Time{}, false
+	}
+
+	expirationTime, err := time.Parse(time.RFC3339, credStatus.GetExpirationDate())
+	if err != nil {
+		return time.Time{}, false
+	}
+	return expirationTime, true
 }
 
 // getCredentialStatusFromState gets credential status from store
@@ -38,6 +105,29 @@ func (k Keeper) getCredentialStatusFromState(ctx *sdk.Context, id string) (*type
 	return &cred, nil
 }
 
+// setCredentialStatusBatchState stores the Credential Status Batch Document along with its proof
+func (k Keeper) setCredentialStatusBatchState(ctx sdk.Context, batch *types.CredentialStatusBatchState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredBatchKey))
+	store.Set([]byte(batch.Id), k.cdc.MustMarshal(batch))
+}
+
+// getCredentialStatusBatchState gets the Credential Status Batch from store
+func (k Keeper) getCredentialStatusBatchState(ctx sdk.Context, id string) (*types.CredentialStatusBatchState, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredBatchKey))
+
+	var batch types.CredentialStatusBatchState
+	var bytes = store.Get([]byte(id))
+	if len(bytes) == 0 {
+		return nil, fmt.Errorf("credential status batch %s not found", id)
+	}
+
+	if err := k.cdc.Unmarshal(bytes, &batch); err != nil {
+		return nil, fmt.Errorf("internal: unable to unmarshal credential status batch %s from state", id)
+	}
+
+	return &batch, nil
+}
+
 // hasCredential returns whether a credential status is present in the store
 func (k Keeper) hasCredential(ctx sdk.Context, id string) bool {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredKey))
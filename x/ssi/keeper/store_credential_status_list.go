@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// setCredentialStatusListState stores credential status list in store
+func (k Keeper) setCredentialStatusListState(ctx sdk.Context, credStatusList *types.CredentialStatusListState) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredStatusListKey))
+
+	id := credStatusList.CredentialStatusListDocument.Id
+	store.Set([]byte(id), k.cdc.MustMarshal(credStatusList))
+}
+
+// getCredentialStatusListState gets credential status list from store
+func (k Keeper) getCredentialStatusListState(ctx sdk.Context, id string) (*types.CredentialStatusListState, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredStatusListKey))
+
+	var credStatusList types.CredentialStatusListState
+	var bytes = store.Get([]byte(id))
+	if len(bytes) == 0 {
+		return nil, fmt.Errorf("credential status list %s not found", id)
+	}
+
+	if err := k.cdc.Unmarshal(bytes, &credStatusList); err != nil {
+		return nil, fmt.Errorf("internal: unable to unmarshal credential status list %s from state", id)
+	}
+
+	return &credStatusList, nil
+}
+
+// hasCredentialStatusList returns whether a credential status list is present in the store
+func (k Keeper) hasCredentialStatusList(ctx sdk.Context, id string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredStatusListKey))
+	return store.Has([]byte(id))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -104,3 +105,116 @@ func (k Keeper) removeBlockchainAddressInStore(ctx *sdk.Context, blockchainAccou
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.BlockchainAccountIdStoreKey))
 	store.Delete([]byte(blockchainAccountId))
 }
+
+// didDocumentVersionStore returns the store holding every version of a DID Document, where
+// each version is keyed by its sequence number
+func (k Keeper) didDocumentVersionStore(ctx sdk.Context, didId string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidVersionKey+didId+"/"))
+}
+
+// setDidDocumentVersionInStore appends a version of DID document to its version history
+func (k Keeper) setDidDocumentVersionInStore(ctx sdk.Context, didDoc *types.DidDocumentState) {
+	store := k.didDocumentVersionStore(ctx, didDoc.GetDidDocument().GetId())
+
+	// The sequence number of new version is one more than that of the latest version
+	var sequence uint64 = 0
+	iterator := store.ReverseIterator(nil, nil)
+	if iterator.Valid() {
+		sequence = binary.BigEndian.Uint64(iterator.Key()) + 1
+	}
+	iterator.Close()
+
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+
+	store.Set(sequenceBytes, k.cdc.MustMarshal(didDoc))
+}
+
+// setDidDocumentWithVersionHistory sets a did document in store, appends it to the version history and
+// updates its controller index. DID Documents registered before the introduction of versioning have their current state recorded as
+// the first version, before the new version is appended.
+func (k Keeper) setDidDocumentWithVersionHistory(ctx sdk.Context, didDoc *types.DidDocumentState) {
+	didId := didDoc.GetDidDocument().GetId()
+
+	if existingDidDoc, err := k.getDidDocumentState(&ctx, didId); err == nil {
+		if !k.hasDidDocumentVersions(ctx, didId) {
+			k.setDidDocumentVersionInStore(ctx, existingDidDoc)
+		}
+		k.removeDidControllerIndex(ctx, existingDidDoc)
+	}
+
+	k.setDidDocumentInStore(ctx, didDoc)
+	k.setDidDocumentVersionInStore(ctx, didDoc)
+	k.setDidControllerIndex(ctx, didDoc)
+}
+
+// hasDidDocumentVersions checks whether the version history of a did document exists in store
+func (k Keeper) hasDidDocumentVersions(ctx sdk.Context, didId string) bool {
+	iterator := k.didDocumentVersionStore(ctx, didId).Iterator(nil, nil)
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// getDidDocumentVersionsFromStore returns every version of a DID Document, from the oldest to the latest
+func (k Keeper) getDidDocumentVersionsFromStore(ctx sdk.Context, didId string) []*types.DidDocumentState {
+	store := k.didDocumentVersionStore(ctx, didId)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var didDocVersions []*types.DidDocumentState
+	for ; iterator.Valid(); iterator.Next() {
+		var didDocVersion types.DidDocumentState
+		k.cdc.MustUnmarshal(iterator.Value(), &didDocVersion)
+		didDocVersions = append(didDocVersions, &didDocVersion)
+	}
+
+	return didDocVersions
+}
+
+// getDidDocumentStateByVersionId gets the version of a DID document with the specified version id
+func (k Keeper) getDidDocumentStateByVersionId(ctx *sdk.Context, id string, versionId string) (*types.DidDocumentState, error) {
+	didDocState, err := k.getDidDocumentState(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if didDocState.DidDocumentMetadata.VersionId == versionId {
+		return didDocState, nil
+	}
+
+	// Multiple versions could share a version id if they were formed in the same transaction,
+	// in which case the latest of them is returned
+	didDocVersions := k.getDidDocumentVersionsFromStore(*ctx, id)
+	for i := len(didDocVersions) - 1; i >= 0; i-- {
+		if didDocVersions[i].DidDocumentMetadata.VersionId == versionId {
+			return didDocVersions[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("version %s of DID Document %s not found", versionId, id)
+}
+
+// getDidDocumentStateAtTime gets the version of a DID document which was active at the specified time
+func (k Keeper) getDidDocumentStateAtTime(ctx *sdk.Context, id string, versionTime time.Time) (*types.DidDocumentState, error) {
+	didDocVersions := k.getDidDocumentVersionsFromStore(*ctx, id)
+	if len(didDocVersions) == 0 {
+		// Version history is absent for DID Documents which were never altered after the
+		// introduction of versioning, making their current state the only known version
+		didDocState, err := k.getDidDocumentState(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		didDocVersions = append(didDocVersions, didDocState)
+	}
+
+	for i := len(didDocVersions) - 1; i >= 0; i-- {
+		updated, err := time.Parse(time.RFC3339, didDocVersions[i].DidDocumentMetadata.Updated)
+		if err != nil {
+			return nil, fmt.Errorf("internal: invalid updated time of DID Document %s", id)
+		}
+		if !updated.After(versionTime) {
+			return didDocVersions[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("DID Document %s did not exist at %s", id, versionTime.Format(time.RFC3339))
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// maxDidRecoveriesPerBlock is the maximum number of pending DID recoveries processed in a block
+const maxDidRecoveriesPerBlock = 1000
+
+// setPendingDidRecovery stores a pending DID recovery and queues it against the time at which it takes effect
+func (k Keeper) setPendingDidRecovery(ctx sdk.Context, recovery *types.PendingDidRecovery) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidRecoveryKey))
+	didId := recovery.DidDocument.Id
+	store.Set([]byte(didId), k.cdc.MustMarshal(recovery))
+
+	if effectiveTime, err := time.Parse(time.RFC3339, recovery.EffectiveAt); err == nil {
+		queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidRecoveryQueueKey))
+		queueStore.Set(types.GetDidRecoveryQueueKey(effectiveTime, didId), []byte{})
+	}
+}
+
+// removePendingDidRecovery removes a pending DID recovery and its entry in the recovery queue
+func (k Keeper) removePendingDidRecovery(ctx sdk.Context, recovery *types.PendingDidRecovery) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidRecoveryKey))
+	didId := recovery.DidDocument.Id
+	store.Delete([]byte(didId))
+
+	if effectiveTime, err := time.Parse(time.RFC3339, recovery.EffectiveAt); err == nil {
+		queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidRecoveryQueueKey))
+		queueStore.Delete(types.GetDidRecoveryQueueKey(effectiveTime, didId))
+	}
+}
+
+// getPendingDidRecovery gets the pending recovery of a DID Document from store
+func (k Keeper) getPendingDidRecovery(ctx sdk.Context, didId string) (*types.PendingDidRecovery, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidRecoveryKey))
+
+	var recovery types.PendingDidRecovery
+	var bytes = store.Get([]byte(didId))
+	if len(bytes) == 0 {
+		return nil, fmt.Errorf("pending recovery of DID Document %s not found", didId)
+	}
+
+	if err := k.cdc.Unmarshal(bytes, &recovery); err != nil {
+		return nil, fmt.Errorf("internal: unable to unmarshal pending recovery of DID Document %s", didId)
+	}
+
+	return &recovery, nil
+}
+
+// hasPendingDidRecovery checks whether a DID Document has a pending recovery
+func (k Keeper) hasPendingDidRecovery(ctx sdk.Context, didId string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidRecoveryKey))
+	return store.Has([]byte(didId))
+}
+
+// setDidRecoveryProof records a proof which has initiated a recovery of the DID Document
+func (k Keeper) setDidRecoveryProof(ctx sdk.Context, didId string, proofValueHash string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidRecoveryProofKey))
+	store.Set(types.GetDidRecoveryProofKey(didId, proofValueHash), []byte{})
+}
+
+// hasDidRecoveryProof checks whether the proof value has already initiated a recovery of the DID Document
+func (k Keeper) hasDidRecoveryProof(ctx sdk.Context, didId string, proofValue string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidRecoveryProofKey))
+	return store.Has(types.GetDidRecoveryProofKey(didId, types.GetDidRecoveryProofValueHash(proofValue)))
+}
+
+// ExecuteDidRecoveries replaces the DID Documents whose pending recovery has taken effect by the block time.
+// A recovery is dropped if its DID Document was updated or deactivated after the recovery was initiated. At
+// most maxDidRecoveriesPerBlock recoveries are processed in a block, and the rest are carried over to the
+// following blocks.
+func (k Keeper) ExecuteDidRecoveries(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DidRecoveryQueueKey))
+
+	// Collect the queue keys first, as the store must not be written while it is iterated
+	var queueKeys [][]byte
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+	for ; iterator.Valid() && len(queueKeys) < maxDidRecoveriesPerBlock; iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, queueKey := range queueKeys {
+		store.Delete(queueKey)
+
+		didId := string(queueKey[len(sdk.SortableTimeFormat):])
+		recovery, err := k.getPendingDidRecovery(ctx, didId)
+		if err != nil {
+			continue
+		}
+		k.removePendingDidRecovery(ctx, recovery)
+
+		if err := k.executeDidRecovery(ctx, recovery); err != nil {
+			if err := ctx.EventManager().EmitTypedEvent(&types.EventDidRecoveryCancelled{
+				DidId:  didId,
+				Reason: err.Error(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// executeDidRecovery replaces the DID Document with the replacement of its pending recovery
+func (k Keeper) executeDidRecovery(ctx sdk.Context, recovery *types.PendingDidRecovery) error {
+	didId := recovery.DidDocument.Id
+
+	existingDidDocumentState, err := k.getDidDocumentState(&ctx, didId)
+	if err != nil {
+		return err
+	}
+	if existingDidDocumentState.DidDocumentMetadata.Deactivated {
+		return fmt.Errorf("DID Document %s was deactivated", didId)
+	}
+	if existingDidDocumentState.DidDocumentMetadata.VersionId != recovery.PreviousVersionId {
+		return fmt.Errorf(
+			"DID Document %s was updated to version %s after the recovery was initiated",
+			didId,
+			existingDidDocumentState.DidDocumentMetadata.VersionId,
+		)
+	}
+
+	// blockchainAccountIds are checked again, as they may have been claimed after the recovery was initiated
+	vmsToBeAdded, vmsToBeRemoved, err := processBlockchainAccountIdForUpdateDID(
+		k, ctx, existingDidDocumentState.DidDocument.VerificationMethod, recovery.DidDocument.VerificationMethod,
+	)
+	if err != nil {
+		return err
+	}
+
+	metadata := types.CreateNewMetadata(ctx)
+	metadata.VersionId = recovery.VersionId
+	metadata.Created = existingDidDocumentState.GetDidDocumentMetadata().GetCreated()
+	metadata.CompromisedVerificationMethods = existingDidDocumentState.GetDidDocumentMetadata().GetCompromisedVerificationMethods()
+
+	k.setDidDocumentWithVersionHistory(ctx, &types.DidDocumentState{
+		DidDocument:         recovery.DidDocument,
+		DidDocumentMetadata: &metadata,
+	})
+
+	for _, vm := range vmsToBeRemoved {
+		k.removeBlockchainAddressInStore(&ctx, vm.BlockchainAccountId)
+	}
+	for _, vm := range vmsToBeAdded {
+		k.setBlockchainAddressInStore(&ctx, vm.BlockchainAccountId, vm.Controller)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventDidRecovered{
+		DidId:             didId,
+		VersionId:         metadata.VersionId,
+		PreviousVersionId: recovery.PreviousVersionId,
+	})
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// setDidControllerIndex indexes a DID Document against each of its controllers. Deactivated
+// DID Documents are not indexed, as they can no longer be controlled.
+func (k Keeper) setDidControllerIndex(ctx sdk.Context, didDoc *types.DidDocumentState) {
+	if didDoc.GetDidDocumentMetadata().GetDeactivated() {
+		return
+	}
+
+	didId := didDoc.GetDidDocument().GetId()
+	for _, controller := range types.GetDidDocumentControllers(didDoc.GetDidDocument()) {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDidControllerIndexPrefix(controller))
+		store.Set([]byte(didId), []byte{})
+	}
+}
+
+// removeDidControllerIndex removes the index entries of a DID Document against each of its controllers
+func (k Keeper) removeDidControllerIndex(ctx sdk.Context, didDoc *types.DidDocumentState) {
+	didId := didDoc.GetDidDocument().GetId()
+	for _, controller := range types.GetDidDocumentControllers(didDoc.GetDidDocument()) {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDidControllerIndexPrefix(controller))
+		store.Delete([]byte(didId))
+	}
+}
+
+// setSchemaAuthorIndex indexes a Credential Schema against its author. The index entry is
+// keyed by the store key of Credential Schema.
+func (k Keeper) setSchemaAuthorIndex(ctx sdk.Context, schemaKey []byte, author string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSchemaAuthorIndexPrefix(author))
+	store.Set(schemaKey, []byte{})
+}
+
+// setCredIssuerIndex indexes a Credential Status against its issuer
+func (k Keeper) setCredIssuerIndex(ctx sdk.Context, credId string, issuer string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCredIssuerIndexPrefix(issuer))
+	store.Set([]byte(credId), []byte{})
+}
+
+// setCredExpiryIndex indexes a Credential Status against its expiration time
+func (k Keeper) setCredExpiryIndex(ctx sdk.Context, credId string, expirationTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredExpiryIndexKey))
+	store.Set(types.GetCredExpiryIndexKey(expirationTime, credId), []byte{})
+}
+
+// removeCredExpiryIndex removes a Credential Status from the expiry index
+func (k Keeper) removeCredExpiryIndex(ctx sdk.Context, credId string, expirationTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CredExpiryIndexKey))
+	store.Delete(types.GetCredExpiryIndexKey(expirationTime, credId))
+}
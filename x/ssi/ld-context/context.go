@@ -1,5 +1,11 @@
 package ldcontext
 
+import "fmt"
+
+// HidNodeContextsUrl is the location of the contexts introduced by hid-node, which are published
+// in the contexts directory of this package
+const HidNodeContextsUrl string = "https://raw.githubusercontent.com/hypersign-protocol/hid-node/main/x/ssi/ld-context/contexts/"
+
 const DidContext string = "https://www.w3.org/ns/did/v1"
 const Ed25519Context2020 string = "https://w3id.org/security/suites/ed25519-2020/v1"
 const X25519KeyAgreement2020Context string = "https://ns.did.ai/suites/x25519-2020/v1"
@@ -7,11 +13,22 @@ const Secp256k1Recovery2020Context string = "https://ns.did.ai/suites/secp256k1-
 const BbsSignature2020Context string = "https://ns.did.ai/suites/bls12381-2020/v1"
 const Secp256k12019Context string = "https://ns.did.ai/suites/secp256k1-2019/v1"
 const X25519KeyAgreementKeyEIP5630Context string = "https://raw.githubusercontent.com/hypersign-protocol/hypersign-contexts/main/X25519KeyAgreementKeyEIP5630.jsonld"
+const CosmWasmContractMethod2024Context string = HidNodeContextsUrl + "CosmWasmContractMethod2024.jsonld"
 const CredentialStatusContext string = "https://raw.githubusercontent.com/hypersign-protocol/hypersign-contexts/main/CredentialStatus.jsonld"
+const CredentialStatusBatchContext string = HidNodeContextsUrl + "CredentialStatusBatch.jsonld"
+const CredentialStatusV2Context string = HidNodeContextsUrl + "CredentialStatus-v2.jsonld"
+const CredentialStatusBatchV2Context string = HidNodeContextsUrl + "CredentialStatusBatch-v2.jsonld"
+const CredentialStatusListContext string = HidNodeContextsUrl + "CredentialStatusList.jsonld"
 const CredentialSchemaContext string = "https://raw.githubusercontent.com/hypersign-protocol/hypersign-contexts/main/CredentialSchema.jsonld"
+const CredentialSchemaStatusContext string = HidNodeContextsUrl + "CredentialSchemaStatus.jsonld"
 const BabyJubJubKey2021Context string = "https://raw.githubusercontent.com/hypersign-protocol/hypersign-contexts/main/BabyJubJubKey2021.jsonld"
 const BJJSignature2021Context string = "https://raw.githubusercontent.com/hypersign-protocol/hypersign-contexts/main/BJJSignature2021.jsonld"
+const ControllerThresholdContext string = HidNodeContextsUrl + "ControllerThreshold.jsonld"
+const DidRecoveryContext string = HidNodeContextsUrl + "DidRecovery.jsonld"
+const VerificationMethodCompromiseContext string = HidNodeContextsUrl + "VerificationMethodCompromise.jsonld"
+const AccreditationContext string = HidNodeContextsUrl + "Accreditation.jsonld"
 const LinkedDomainsContext string = "https://raw.githubusercontent.com/hypersign-protocol/hypersign-contexts/main/LinkedDomains.jsonld"
+const CredentialsContext string = "https://www.w3.org/2018/credentials/v1"
 
 // As hid-node is not supposed to perform any GET request, the complete Context body of their
 // respective Context urls has been maintained below.
@@ -649,6 +666,27 @@ var ContextUrlMap map[string]contextObject = map[string]contextObject{
 			},
 		},
 	},
+	CosmWasmContractMethod2024Context: {
+		"id":         "@id",
+		"type":       "@type",
+		"@protected": true,
+		"CosmWasmContractMethod2024": map[string]interface{}{
+			"@id": "https://w3id.org/security#CosmWasmContractMethod2024",
+			"@context": map[string]interface{}{
+				"@protected": true,
+				"id":         "@id",
+				"type":       "@type",
+				"controller": map[string]interface{}{
+					"@id":   "https://w3id.org/security#controller",
+					"@type": "@id",
+				},
+				"blockchainAccountId": map[string]interface{}{
+					"@id":   "https://w3c.github.io/vc-data-integrity/vocab/security/vocabulary.jsonld#blockchainAccountId",
+					"@type": "https://w3id.org/security#blockchainAccountId",
+				},
+			},
+		},
+	},
 	CredentialStatusContext: {
 		"@protected":      true,
 		"@version":        1.1,
@@ -680,6 +718,146 @@ var ContextUrlMap map[string]contextObject = map[string]contextObject{
 			"@type": "xsd:string",
 		},
 	},
+	CredentialStatusV2Context: {
+		"@protected":      true,
+		"@version":        1.1,
+		"hypersign-vocab": "urn:uuid:13fe9318-bb82-4d95-8bf5-8e7fdf8b2026#",
+		"xsd":             "http://www.w3.org/2001/XMLSchema#",
+		"id":              "@id",
+		"revoked": map[string]interface{}{
+			"@id":   "hypersign-vocab:revoked",
+			"@type": "xsd:boolean",
+		},
+		"suspended": map[string]interface{}{
+			"@id":   "hypersign-vocab:suspended",
+			"@type": "xsd:boolean",
+		},
+		"remarks": map[string]interface{}{
+			"@id":   "hypersign-vocab:remarks",
+			"@type": "xsd:string",
+		},
+		"issuer": map[string]interface{}{
+			"@id":   "hypersign-vocab:issuer",
+			"@type": "xsd:string",
+		},
+		"issuanceDate": map[string]interface{}{
+			"@id":   "hypersign-vocab:issuanceDate",
+			"@type": "xsd:dateTime",
+		},
+		"credentialMerkleRootHash": map[string]interface{}{
+			"@id":   "hypersign-vocab:credentialMerkleRootHash",
+			"@type": "xsd:string",
+		},
+		"expirationDate": map[string]interface{}{
+			"@id":   "hypersign-vocab:expirationDate",
+			"@type": "xsd:dateTime",
+		},
+		"credentialSchemaId": map[string]interface{}{
+			"@id":   "hypersign-vocab:credentialSchemaId",
+			"@type": "xsd:string",
+		},
+	},
+	CredentialStatusBatchContext: {
+		"@protected":      true,
+		"@version":        1.1,
+		"hypersign-vocab": "urn:uuid:13fe9318-bb82-4d95-8bf5-8e7fdf8b2026#",
+		"xsd":             "http://www.w3.org/2001/XMLSchema#",
+		"id":              "@id",
+		"issuer": map[string]interface{}{
+			"@id":   "hypersign-vocab:issuer",
+			"@type": "xsd:string",
+		},
+		"credentialStatuses": map[string]interface{}{
+			"@id":        "hypersign-vocab:credentialStatuses",
+			"@container": "@set",
+		},
+		"revoked": map[string]interface{}{
+			"@id":   "hypersign-vocab:revoked",
+			"@type": "xsd:boolean",
+		},
+		"suspended": map[string]interface{}{
+			"@id":   "hypersign-vocab:suspended",
+			"@type": "xsd:boolean",
+		},
+		"remarks": map[string]interface{}{
+			"@id":   "hypersign-vocab:remarks",
+			"@type": "xsd:string",
+		},
+		"issuanceDate": map[string]interface{}{
+			"@id":   "hypersign-vocab:issuanceDate",
+			"@type": "xsd:dateTime",
+		},
+		"credentialMerkleRootHash": map[string]interface{}{
+			"@id":   "hypersign-vocab:credentialMerkleRootHash",
+			"@type": "xsd:string",
+		},
+	},
+	CredentialStatusBatchV2Context: {
+		"@protected":      true,
+		"@version":        1.1,
+		"hypersign-vocab": "urn:uuid:13fe9318-bb82-4d95-8bf5-8e7fdf8b2026#",
+		"xsd":             "http://www.w3.org/2001/XMLSchema#",
+		"id":              "@id",
+		"issuer": map[string]interface{}{
+			"@id":   "hypersign-vocab:issuer",
+			"@type": "xsd:string",
+		},
+		"credentialStatuses": map[string]interface{}{
+			"@id":        "hypersign-vocab:credentialStatuses",
+			"@container": "@set",
+		},
+		"revoked": map[string]interface{}{
+			"@id":   "hypersign-vocab:revoked",
+			"@type": "xsd:boolean",
+		},
+		"suspended": map[string]interface{}{
+			"@id":   "hypersign-vocab:suspended",
+			"@type": "xsd:boolean",
+		},
+		"remarks": map[string]interface{}{
+			"@id":   "hypersign-vocab:remarks",
+			"@type": "xsd:string",
+		},
+		"issuanceDate": map[string]interface{}{
+			"@id":   "hypersign-vocab:issuanceDate",
+			"@type": "xsd:dateTime",
+		},
+		"credentialMerkleRootHash": map[string]interface{}{
+			"@id":   "hypersign-vocab:credentialMerkleRootHash",
+			"@type": "xsd:string",
+		},
+		"expirationDate": map[string]interface{}{
+			"@id":   "hypersign-vocab:expirationDate",
+			"@type": "xsd:dateTime",
+		},
+		"credentialSchemaId": map[string]interface{}{
+			"@id":   "hypersign-vocab:credentialSchemaId",
+			"@type": "xsd:string",
+		},
+	},
+	CredentialStatusListContext: {
+		"@protected":      true,
+		"@version":        1.1,
+		"hypersign-vocab": "urn:uuid:13fe9318-bb82-4d95-8bf5-8e7fdf8b2026#",
+		"xsd":             "http://www.w3.org/2001/XMLSchema#",
+		"id":              "@id",
+		"issuer": map[string]interface{}{
+			"@id":   "hypersign-vocab:issuer",
+			"@type": "xsd:string",
+		},
+		"statusPurpose": map[string]interface{}{
+			"@id":   "hypersign-vocab:statusPurpose",
+			"@type": "xsd:string",
+		},
+		"encodedList": map[string]interface{}{
+			"@id":   "hypersign-vocab:encodedList",
+			"@type": "xsd:string",
+		},
+		"validFrom": map[string]interface{}{
+			"@id":   "hypersign-vocab:validFrom",
+			"@type": "xsd:dateTime",
+		},
+	},
 	CredentialSchemaContext: {
 		"@version":        1.1,
 		"hypersign-vocab": "urn:uuid:13fe9318-bb82-4d95-8bf5-8e7fdf8b2026#",
@@ -862,4 +1040,216 @@ var ContextUrlMap map[string]contextObject = map[string]contextObject{
 			},
 		},
 	},
+	ControllerThresholdContext: {
+		"@protected":      true,
+		"hypersign-vocab": "urn:uuid:13fe9318-bb82-4d95-8bf5-8e7fdf8b2026#",
+		"xsd":             "http://www.w3.org/2001/XMLSchema#",
+		"controllerThreshold": map[string]interface{}{
+			"@id":   "hypersign-vocab:controllerThreshold",
+			"@type": "xsd:integer",
+		},
+	},
+	DidRecoveryContext: {
+		"@protected":      true,
+		"hypersign-vocab": "urn:uuid:13fe9318-bb82-4d95-8bf5-8e7fdf8b2026#",
+		"recovery": map[string]interface{}{
+			"@id":        "hypersign-vocab:recovery",
+			"@type":      "@id",
+			"@container": "@set",
+		},
+	},
+	CredentialSchemaStatusContext: {
+		"@protected":      true,
+		"@version":        1.1,
+		"hypersign-vocab": "urn:uuid:13fe9318-bb82-4d95-8bf5-8e7fdf8b2026#",
+		"xsd":             "http://www.w3.org/2001/XMLSchema#",
+		"id":              "@id",
+		"status": map[string]interface{}{
+			"@id":   "hypersign-vocab:status",
+			"@type": "xsd:string",
+		},
+		"remarks": map[string]interface{}{
+			"@id":   "hypersign-vocab:remarks",
+			"@type": "xsd:string",
+		},
+	},
+	AccreditationContext: {
+		"@protected":      true,
+		"@version":        1.1,
+		"hypersign-vocab": "urn:uuid:13fe9318-bb82-4d95-8bf5-8e7fdf8b2026#",
+		"xsd":             "http://www.w3.org/2001/XMLSchema#",
+		"id":              "@id",
+		"accreditor": map[string]interface{}{
+			"@id":   "hypersign-vocab:accreditor",
+			"@type": "xsd:string",
+		},
+		"accreditedIssuer": map[string]interface{}{
+			"@id":   "hypersign-vocab:accreditedIssuer",
+			"@type": "xsd:string",
+		},
+		"credentialSchemaId": map[string]interface{}{
+			"@id":   "hypersign-vocab:credentialSchemaId",
+			"@type": "xsd:string",
+		},
+		"validFrom": map[string]interface{}{
+			"@id":   "hypersign-vocab:validFrom",
+			"@type": "xsd:dateTime",
+		},
+		"validUntil": map[string]interface{}{
+			"@id":   "hypersign-vocab:validUntil",
+			"@type": "xsd:dateTime",
+		},
+		"delegationDepth": map[string]interface{}{
+			"@id":   "hypersign-vocab:delegationDepth",
+			"@type": "xsd:integer",
+		},
+		"parentAccreditationId": map[string]interface{}{
+			"@id":   "hypersign-vocab:parentAccreditationId",
+			"@type": "xsd:string",
+		},
+	},
+	VerificationMethodCompromiseContext: {
+		"@protected":      true,
+		"@version":        1.1,
+		"hypersign-vocab": "urn:uuid:13fe9318-bb82-4d95-8bf5-8e7fdf8b2026#",
+		"xsd":             "http://www.w3.org/2001/XMLSchema#",
+		"id":              "@id",
+		"verificationMethodId": map[string]interface{}{
+			"@id":   "hypersign-vocab:verificationMethodId",
+			"@type": "@id",
+		},
+		"compromisedSince": map[string]interface{}{
+			"@id":   "hypersign-vocab:compromisedSince",
+			"@type": "xsd:dateTime",
+		},
+	},
+	CredentialsContext: {
+		"@protected": true,
+		"id":         "@id",
+		"type":       "@type",
+		"VerifiableCredential": map[string]interface{}{
+			"@id": "https://www.w3.org/2018/credentials#VerifiableCredential",
+			"@context": map[string]interface{}{
+				"@protected": true,
+				"id":         "@id",
+				"type":       "@type",
+				"cred":       "https://www.w3.org/2018/credentials#",
+				"sec":        "https://w3id.org/security#",
+				"xsd":        "http://www.w3.org/2001/XMLSchema#",
+				"credentialSchema": map[string]interface{}{
+					"@id":   "cred:credentialSchema",
+					"@type": "@id",
+					"@context": map[string]interface{}{
+						"@protected":              true,
+						"id":                      "@id",
+						"type":                    "@type",
+						"cred":                    "https://www.w3.org/2018/credentials#",
+						"JsonSchemaValidator2018": "cred:JsonSchemaValidator2018",
+					},
+				},
+				"credentialStatus": map[string]interface{}{
+					"@id":   "cred:credentialStatus",
+					"@type": "@id",
+				},
+				"credentialSubject": map[string]interface{}{
+					"@id":   "cred:credentialSubject",
+					"@type": "@id",
+				},
+				"evidence": map[string]interface{}{
+					"@id":   "cred:evidence",
+					"@type": "@id",
+				},
+				"expirationDate": map[string]interface{}{
+					"@id":   "cred:expirationDate",
+					"@type": "xsd:dateTime",
+				},
+				"holder": map[string]interface{}{
+					"@id":   "cred:holder",
+					"@type": "@id",
+				},
+				"issued": map[string]interface{}{
+					"@id":   "cred:issued",
+					"@type": "xsd:dateTime",
+				},
+				"issuer": map[string]interface{}{
+					"@id":   "cred:issuer",
+					"@type": "@id",
+				},
+				"issuanceDate": map[string]interface{}{
+					"@id":   "cred:issuanceDate",
+					"@type": "xsd:dateTime",
+				},
+				"proof": map[string]interface{}{
+					"@id":        "sec:proof",
+					"@type":      "@id",
+					"@container": "@graph",
+				},
+				"refreshService": map[string]interface{}{
+					"@id":   "cred:refreshService",
+					"@type": "@id",
+					"@context": map[string]interface{}{
+						"@protected":               true,
+						"id":                       "@id",
+						"type":                     "@type",
+						"cred":                     "https://www.w3.org/2018/credentials#",
+						"ManualRefreshService2018": "cred:ManualRefreshService2018",
+					},
+				},
+				"termsOfUse": map[string]interface{}{
+					"@id":   "cred:termsOfUse",
+					"@type": "@id",
+				},
+				"validFrom": map[string]interface{}{
+					"@id":   "cred:validFrom",
+					"@type": "xsd:dateTime",
+				},
+				"validUntil": map[string]interface{}{
+					"@id":   "cred:validUntil",
+					"@type": "xsd:dateTime",
+				},
+			},
+		},
+		"VerifiablePresentation": map[string]interface{}{
+			"@id": "https://www.w3.org/2018/credentials#VerifiablePresentation",
+			"@context": map[string]interface{}{
+				"@protected": true,
+				"id":         "@id",
+				"type":       "@type",
+				"cred":       "https://www.w3.org/2018/credentials#",
+				"sec":        "https://w3id.org/security#",
+				"holder": map[string]interface{}{
+					"@id":   "cred:holder",
+					"@type": "@id",
+				},
+				"proof": map[string]interface{}{
+					"@id":        "sec:proof",
+					"@type":      "@id",
+					"@container": "@graph",
+				},
+				"verifiableCredential": map[string]interface{}{
+					"@id":        "cred:verifiableCredential",
+					"@type":      "@id",
+					"@container": "@graph",
+				},
+			},
+		},
+		"proof": map[string]interface{}{
+			"@id":        "https://w3id.org/security#proof",
+			"@type":      "@id",
+			"@container": "@graph",
+		},
+	},
+}
+
+// ValidateContextUrls checks if every context url of a SSI Document is supported for canonization
+func ValidateContextUrls(contextUrls []string) error {
+	if len(contextUrls) == 0 {
+		return fmt.Errorf("atleast one context url must be provided")
+	}
+	for _, url := range contextUrls {
+		if _, ok := ContextUrlMap[url]; !ok {
+			return fmt.Errorf("invalid or unsupported context url: %v", url)
+		}
+	}
+	return nil
 }
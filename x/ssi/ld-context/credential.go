@@ -0,0 +1,189 @@
+package ldcontext
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/iden3/go-schema-processor/merklize"
+	"github.com/piprate/json-gold/ld"
+)
+
+// NormalizeCredentialByProofType normalizes a Verifiable Credential based on the input Proof type. Unlike
+// the SSI Documents of x/ssi module, a Verifiable Credential can have arbitrary attributes, and hence it is
+// processed as a generic JSON-LD document, whose context urls are resolved from ContextUrlMap.
+func NormalizeCredentialByProofType(credential map[string]interface{}, credentialProof *types.DocumentProof) ([]byte, error) {
+	switch credentialProof.Type {
+	case types.Ed25519Signature2020, types.EcdsaSecp256k1RecoverySignature2020,
+		types.BbsBlsSignature2020, types.EcdsaSecp256k1Signature2019:
+		return normalizeCredentialURDNA2015(credential, credentialProof)
+	case types.BJJSignature2021:
+		return normalizeCredentialBJJSignature2021(credential, credentialProof)
+	default:
+		return nil, fmt.Errorf("unsupported proof type: %v", credentialProof.Type)
+	}
+}
+
+// GetCredentialMerkleRootHash returns the hex encoded root of the JSON-LD Merkle Tree of Verifiable Credential,
+// excluding its proof. It is expected to be registered as credentialMerkleRootHash of the Credential Status.
+func GetCredentialMerkleRootHash(credential map[string]interface{}) (string, error) {
+	jsonLdCredential, err := newJsonLdCredential(credential)
+	if err != nil {
+		return "", err
+	}
+
+	mz, err := merklizeJsonLd(jsonLdCredential)
+	if err != nil {
+		return "", err
+	}
+	return mz.Root().Hex(), nil
+}
+
+// normalizeCredentialURDNA2015 hashes the URDNA2015 normalized Verifiable Credential and its proof with SHA-256,
+// and combines them in the order: DocumentProofHash + DocumentHash
+func normalizeCredentialURDNA2015(credential map[string]interface{}, credentialProof *types.DocumentProof) ([]byte, error) {
+	jsonLdCredential, err := newJsonLdCredential(credential)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizedCredentialString, err := normalizeJsonLd(jsonLdCredential, ld.AlgorithmURDNA2015)
+	if err != nil {
+		return nil, err
+	}
+
+	// Document Proof is normalized with the context of Verifiable Credential
+	jsonLdCredentialProof := newJsonLdCredentialProof(credentialProof)
+	jsonLdCredentialProof["@context"] = jsonLdCredential["@context"]
+	normalizedCredentialProofString, err := normalizeJsonLd(jsonLdCredentialProof, ld.AlgorithmURDNA2015)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizedCredentialProofHash := sha256.Sum256([]byte(normalizedCredentialProofString))
+	normalizedCredentialHash := sha256.Sum256([]byte(normalizedCredentialString))
+
+	var combinedHash []byte
+	combinedHash = append(combinedHash, normalizedCredentialProofHash[:]...)
+	combinedHash = append(combinedHash, normalizedCredentialHash[:]...)
+	return combinedHash, nil
+}
+
+// normalizeCredentialBJJSignature2021 returns the JSON-LD Merkle Tree root of Verifiable Credential, whose proof
+// is present without the proof value, based on the spec: https://iden3-communication.io/BJJSignature2021/
+func normalizeCredentialBJJSignature2021(credential map[string]interface{}, credentialProof *types.DocumentProof) ([]byte, error) {
+	jsonLdCredential, err := newJsonLdCredential(credential)
+	if err != nil {
+		return nil, err
+	}
+	jsonLdCredential["proof"] = newJsonLdCredentialProof(credentialProof)
+
+	mz, err := merklizeJsonLd(jsonLdCredential)
+	if err != nil {
+		return nil, err
+	}
+	return mz.Root().BigInt().Bytes(), nil
+}
+
+// newJsonLdCredential returns a copy of Verifiable Credential without its proof, where every context url
+// is replaced by its Context JSON body
+func newJsonLdCredential(credential map[string]interface{}) (map[string]interface{}, error) {
+	jsonLdCredential := map[string]interface{}{}
+	for attribute, value := range credential {
+		if attribute != "proof" {
+			jsonLdCredential[attribute] = value
+		}
+	}
+
+	var credentialContexts []interface{}
+	switch credentialContext := credential["@context"].(type) {
+	case []interface{}:
+		credentialContexts = credentialContext
+	case string, map[string]interface{}:
+		credentialContexts = []interface{}{credentialContext}
+	default:
+		return nil, fmt.Errorf("atleast one context must be provided in the Verifiable Credential for Canonization")
+	}
+	if len(credentialContexts) == 0 {
+		return nil, fmt.Errorf("atleast one context must be provided in the Verifiable Credential for Canonization")
+	}
+
+	var jsonLdContexts []interface{}
+	for _, credentialContext := range credentialContexts {
+		switch credentialContext := credentialContext.(type) {
+		case string:
+			contextObj, ok := ContextUrlMap[credentialContext]
+			if !ok {
+				return nil, fmt.Errorf("invalid or unsupported context url: %v", credentialContext)
+			}
+			jsonLdContexts = append(jsonLdContexts, contextObj)
+		case map[string]interface{}:
+			jsonLdContexts = append(jsonLdContexts, credentialContext)
+		default:
+			return nil, fmt.Errorf("invalid context %v", credentialContext)
+		}
+	}
+	jsonLdCredential["@context"] = jsonLdContexts
+
+	return jsonLdCredential, nil
+}
+
+// newJsonLdCredentialProof returns the Document Proof of Verifiable Credential without its proof value
+func newJsonLdCredentialProof(credentialProof *types.DocumentProof) map[string]interface{} {
+	return map[string]interface{}{
+		"type":               credentialProof.Type,
+		"created":            credentialProof.Created,
+		"verificationMethod": credentialProof.VerificationMethod,
+		"proofPurpose":       credentialProof.ProofPurpose,
+	}
+}
+
+func normalizeJsonLd(jsonLdDocument map[string]interface{}, algorithm string) (string, error) {
+	proc := ld.NewJsonLdProcessor()
+	options := ld.NewJsonLdOptions("")
+	options.Algorithm = algorithm
+	options.Format = "application/n-quads"
+	options.DocumentLoader = contextUrlMapDocumentLoader{}
+
+	normalisedJsonLd, err := proc.Normalize(jsonLdDocToInterface(jsonLdDocument), options)
+	if err != nil {
+		return "", fmt.Errorf("unable to Normalize Verifiable Credential: %v", err.Error())
+	}
+
+	canonizedDocString := normalisedJsonLd.(string)
+	if canonizedDocString == "" {
+		return "", fmt.Errorf("normalization of JSON-LD document yielded empty RDF string")
+	}
+
+	return canonizedDocString, nil
+}
+
+func merklizeJsonLd(jsonLdDocument map[string]interface{}) (*merklize.Merklizer, error) {
+	jsonLdBytes, err := json.Marshal(jsonLdDocument)
+	if err != nil {
+		return nil, err
+	}
+	return merklize.MerklizeJSONLD(
+		context.Background(),
+		strings.NewReader(string(jsonLdBytes)),
+		merklize.WithDocumentLoader(contextUrlMapDocumentLoader{}),
+	)
+}
+
+// contextUrlMapDocumentLoader resolves the context urls, which are referred inside a Context JSON body, from
+// ContextUrlMap, so that no GET request is performed during processing of a Verifiable Credential
+type contextUrlMapDocumentLoader struct{}
+
+func (contextUrlMapDocumentLoader) LoadDocument(url string) (*ld.RemoteDocument, error) {
+	contextObj, ok := ContextUrlMap[url]
+	if !ok {
+		return nil, fmt.Errorf("invalid or unsupported context url: %v", url)
+	}
+	return &ld.RemoteDocument{
+		DocumentURL: url,
+		Document:    jsonLdDocToInterface(map[string]interface{}{"@context": contextObj}),
+	}, nil
+}
@@ -152,6 +152,41 @@ func BJJSignature2021Normalize(ssiMsg types.SsiMsg, docProof *types.DocumentProo
 			return nil, err
 		}
 		jsonLDString = string(jsonLDBytes)
+	case *types.CredentialStatusBatchDocument:
+		credentialStatusBatchDocument := NewJsonLdCredentialStatusBatchBJJ(doc, docProof)
+		jsonLDBytes, err := json.Marshal(credentialStatusBatchDocument)
+		if err != nil {
+			return nil, err
+		}
+		jsonLDString = string(jsonLDBytes)
+	case *types.CredentialStatusListDocument:
+		credentialStatusListDocument := NewJsonLdCredentialStatusListBJJ(doc, docProof)
+		jsonLDBytes, err := json.Marshal(credentialStatusListDocument)
+		if err != nil {
+			return nil, err
+		}
+		jsonLDString = string(jsonLDBytes)
+	case *types.VerificationMethodCompromiseDocument:
+		verificationMethodCompromiseDocument := NewJsonLdVerificationMethodCompromiseBJJ(doc, docProof)
+		jsonLDBytes, err := json.Marshal(verificationMethodCompromiseDocument)
+		if err != nil {
+			return nil, err
+		}
+		jsonLDString = string(jsonLDBytes)
+	case *types.CredentialSchemaStatusDocument:
+		credentialSchemaStatusDocument := NewJsonLdCredentialSchemaStatusBJJ(doc, docProof)
+		jsonLDBytes, err := json.Marshal(credentialSchemaStatusDocument)
+		if err != nil {
+			return nil, err
+		}
+		jsonLDString = string(jsonLDBytes)
+	case *types.AccreditationDocument:
+		accreditationDocument := NewJsonLdAccreditationBJJ(doc, docProof)
+		jsonLDBytes, err := json.Marshal(accreditationDocument)
+		if err != nil {
+			return nil, err
+		}
+		jsonLDString = string(jsonLDBytes)
 	}
 
 	// The following canonization is done in order to check whether the canonized string
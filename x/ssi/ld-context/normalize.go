@@ -71,6 +71,41 @@ func normalizeDocument(msg types.SsiMsg, algorithm string) (string, error) {
 		if err != nil {
 			return "", err
 		}
+	case *types.CredentialStatusBatchDocument:
+		var err error
+		jsonLdCredentialStatusBatch := NewJsonLdCredentialStatusBatch(doc)
+		canonizedDocument, err = normalize(jsonLdCredentialStatusBatch, algorithm)
+		if err != nil {
+			return "", err
+		}
+	case *types.CredentialStatusListDocument:
+		var err error
+		jsonLdCredentialStatusList := NewJsonLdCredentialStatusList(doc)
+		canonizedDocument, err = normalize(jsonLdCredentialStatusList, algorithm)
+		if err != nil {
+			return "", err
+		}
+	case *types.VerificationMethodCompromiseDocument:
+		var err error
+		jsonLdVerificationMethodCompromise := NewJsonLdVerificationMethodCompromise(doc)
+		canonizedDocument, err = normalize(jsonLdVerificationMethodCompromise, algorithm)
+		if err != nil {
+			return "", err
+		}
+	case *types.CredentialSchemaStatusDocument:
+		var err error
+		jsonLdCredentialSchemaStatus := NewJsonLdCredentialSchemaStatus(doc)
+		canonizedDocument, err = normalize(jsonLdCredentialSchemaStatus, algorithm)
+		if err != nil {
+			return "", err
+		}
+	case *types.AccreditationDocument:
+		var err error
+		jsonLdAccreditation := NewJsonLdAccreditation(doc)
+		canonizedDocument, err = normalize(jsonLdAccreditation, algorithm)
+		if err != nil {
+			return "", err
+		}
 	}
 
 	return canonizedDocument, nil
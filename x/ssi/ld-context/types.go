@@ -27,6 +27,8 @@ type JsonLdDidDocument struct {
 	CapabilityInvocation []string                    `json:"capabilityInvocation,omitempty"`
 	CapabilityDelegation []string                    `json:"capabilityDelegation,omitempty"`
 	Service              []*types.Service            `json:"service,omitempty"`
+	ControllerThreshold  uint32                      `json:"controllerThreshold,omitempty"`
+	Recovery             []string                    `json:"recovery,omitempty"`
 }
 
 func (doc *JsonLdDidDocument) GetContext() []contextObject {
@@ -59,6 +61,8 @@ func NewJsonLdDidDocument(didDoc *types.DidDocument) *JsonLdDidDocument {
 	jsonLdDoc.VerificationMethod = didDoc.VerificationMethod
 	jsonLdDoc.Controller = didDoc.Controller
 	jsonLdDoc.KeyAgreement = didDoc.KeyAgreement
+	jsonLdDoc.ControllerThreshold = didDoc.ControllerThreshold
+	jsonLdDoc.Recovery = didDoc.Recovery
 
 	return jsonLdDoc
 }
@@ -75,6 +79,8 @@ type JsonLdCredentialStatus struct {
 	Issuer                   string          `json:"issuer,omitempty"`
 	IssuanceDate             string          `json:"issuanceDate,omitempty"`
 	CredentialMerkleRootHash string          `json:"credentialMerkleRootHash,omitempty"`
+	ExpirationDate           string          `json:"expirationDate,omitempty"`
+	CredentialSchemaId       string          `json:"credentialSchemaId,omitempty"`
 }
 
 func (doc *JsonLdCredentialStatus) GetContext() []contextObject {
@@ -90,6 +96,8 @@ type JsonLdCredentialStatusBJJ struct {
 	Issuer                   string              `json:"issuer,omitempty"`
 	IssuanceDate             string              `json:"issuanceDate,omitempty"`
 	CredentialMerkleRootHash string              `json:"credentialMerkleRootHash,omitempty"`
+	ExpirationDate           string              `json:"expirationDate,omitempty"`
+	CredentialSchemaId       string              `json:"credentialSchemaId,omitempty"`
 	Proof                    JsonLdDocumentProof `json:"proof,omitempty"`
 }
 
@@ -120,6 +128,8 @@ func NewJsonLdCredentialStatus(credStatusDoc *types.CredentialStatusDocument) *J
 	jsonLdCredentialStatus.Issuer = credStatusDoc.Issuer
 	jsonLdCredentialStatus.IssuanceDate = credStatusDoc.IssuanceDate
 	jsonLdCredentialStatus.CredentialMerkleRootHash = credStatusDoc.CredentialMerkleRootHash
+	jsonLdCredentialStatus.ExpirationDate = credStatusDoc.ExpirationDate
+	jsonLdCredentialStatus.CredentialSchemaId = credStatusDoc.CredentialSchemaId
 
 	return jsonLdCredentialStatus
 }
@@ -146,6 +156,8 @@ func NewJsonLdCredentialStatusBJJ(credStatusDoc *types.CredentialStatusDocument,
 	jsonLdCredentialStatus.Issuer = credStatusDoc.Issuer
 	jsonLdCredentialStatus.IssuanceDate = credStatusDoc.IssuanceDate
 	jsonLdCredentialStatus.CredentialMerkleRootHash = credStatusDoc.CredentialMerkleRootHash
+	jsonLdCredentialStatus.ExpirationDate = credStatusDoc.ExpirationDate
+	jsonLdCredentialStatus.CredentialSchemaId = credStatusDoc.CredentialSchemaId
 
 	jsonLdCredentialStatus.Proof.Type = docProof.Type
 	jsonLdCredentialStatus.Proof.Created = docProof.Created
@@ -155,6 +167,374 @@ func NewJsonLdCredentialStatusBJJ(credStatusDoc *types.CredentialStatusDocument,
 	return jsonLdCredentialStatus
 }
 
+// It is a similar to `CredentialStatusBatchDocument` struct, with the exception that the `context` attribute is of type
+// `contextObject` instead of `[]string`, which is meant for accomodating Context JSON body
+// having arbritrary attributes. It should be used for performing Canonization. The Credential
+// Statuses of the batch do not carry a context of their own, and inherit the context of the batch.
+type JsonLdCredentialStatusBatch struct {
+	Context            []contextObject          `json:"@context,omitempty"`
+	Issuer             string                   `json:"issuer,omitempty"`
+	CredentialStatuses []JsonLdCredentialStatus `json:"credentialStatuses,omitempty"`
+}
+
+func (doc *JsonLdCredentialStatusBatch) GetContext() []contextObject {
+	return doc.Context
+}
+
+type JsonLdCredentialStatusBatchBJJ struct {
+	Context            []contextObject          `json:"@context,omitempty"`
+	Issuer             string                   `json:"issuer,omitempty"`
+	CredentialStatuses []JsonLdCredentialStatus `json:"credentialStatuses,omitempty"`
+	Proof              JsonLdDocumentProof      `json:"proof,omitempty"`
+}
+
+func (doc *JsonLdCredentialStatusBatchBJJ) GetContext() []contextObject {
+	return doc.Context
+}
+
+// NewJsonLdCredentialStatusBatch returns a new JsonLdCredentialStatusBatch struct from input Credential Status Batch
+func NewJsonLdCredentialStatusBatch(credStatusBatchDoc *types.CredentialStatusBatchDocument) *JsonLdCredentialStatusBatch {
+	if len(credStatusBatchDoc.Context) == 0 {
+		panic("atleast one context url must be provided in the Credential Status Batch Document for Canonization")
+	}
+
+	var jsonLdCredentialStatusBatch *JsonLdCredentialStatusBatch = &JsonLdCredentialStatusBatch{}
+
+	for _, url := range credStatusBatchDoc.Context {
+		contextObj, ok := ContextUrlMap[url]
+		if !ok {
+			panic(fmt.Sprintf("invalid or unsupported context url: %v", url))
+		}
+		jsonLdCredentialStatusBatch.Context = append(jsonLdCredentialStatusBatch.Context, contextObj)
+	}
+
+	jsonLdCredentialStatusBatch.Issuer = credStatusBatchDoc.Issuer
+
+	for _, credStatusDoc := range credStatusBatchDoc.CredentialStatuses {
+		jsonLdCredentialStatusBatch.CredentialStatuses = append(jsonLdCredentialStatusBatch.CredentialStatuses, JsonLdCredentialStatus{
+			Id:                       credStatusDoc.Id,
+			Revoked:                  credStatusDoc.Revoked,
+			Suspended:                credStatusDoc.Suspended,
+			Remarks:                  credStatusDoc.Remarks,
+			Issuer:                   credStatusDoc.Issuer,
+			IssuanceDate:             credStatusDoc.IssuanceDate,
+			CredentialMerkleRootHash: credStatusDoc.CredentialMerkleRootHash,
+			ExpirationDate:           credStatusDoc.ExpirationDate,
+			CredentialSchemaId:       credStatusDoc.CredentialSchemaId,
+		})
+	}
+
+	return jsonLdCredentialStatusBatch
+}
+
+func NewJsonLdCredentialStatusBatchBJJ(credStatusBatchDoc *types.CredentialStatusBatchDocument, docProof *types.DocumentProof) *JsonLdCredentialStatusBatchBJJ {
+	jsonLdCredentialStatusBatch := NewJsonLdCredentialStatusBatch(credStatusBatchDoc)
+
+	return &JsonLdCredentialStatusBatchBJJ{
+		Context:            jsonLdCredentialStatusBatch.Context,
+		Issuer:             jsonLdCredentialStatusBatch.Issuer,
+		CredentialStatuses: jsonLdCredentialStatusBatch.CredentialStatuses,
+		Proof: JsonLdDocumentProof{
+			Type:               docProof.Type,
+			Created:            docProof.Created,
+			ProofPurpose:       docProof.ProofPurpose,
+			VerificationMethod: docProof.VerificationMethod,
+		},
+	}
+}
+
+// It is a similar to `CredentialStatusListDocument` struct, with the exception that the `context` attribute is of type
+// `contextObject` instead of `[]string`, which is meant for accomodating Context JSON body
+// having arbritrary attributes. It should be used for performing Canonization.
+type JsonLdCredentialStatusList struct {
+	Context       []contextObject `json:"@context,omitempty"`
+	Id            string          `json:"id,omitempty"`
+	Issuer        string          `json:"issuer,omitempty"`
+	StatusPurpose string          `json:"statusPurpose,omitempty"`
+	EncodedList   string          `json:"encodedList,omitempty"`
+	ValidFrom     string          `json:"validFrom,omitempty"`
+}
+
+func (doc *JsonLdCredentialStatusList) GetContext() []contextObject {
+	return doc.Context
+}
+
+type JsonLdCredentialStatusListBJJ struct {
+	Context       []contextObject     `json:"@context,omitempty"`
+	Id            string              `json:"id,omitempty"`
+	Issuer        string              `json:"issuer,omitempty"`
+	StatusPurpose string              `json:"statusPurpose,omitempty"`
+	EncodedList   string              `json:"encodedList,omitempty"`
+	ValidFrom     string              `json:"validFrom,omitempty"`
+	Proof         JsonLdDocumentProof `json:"proof,omitempty"`
+}
+
+func (doc *JsonLdCredentialStatusListBJJ) GetContext() []contextObject {
+	return doc.Context
+}
+
+// NewJsonLdCredentialStatusList returns a new JsonLdCredentialStatusList struct from input Credential Status List
+func NewJsonLdCredentialStatusList(credStatusListDoc *types.CredentialStatusListDocument) *JsonLdCredentialStatusList {
+	if len(credStatusListDoc.Context) == 0 {
+		panic("atleast one context url must be provided in the Credential Status List Document for Canonization")
+	}
+
+	var jsonLdCredentialStatusList *JsonLdCredentialStatusList = &JsonLdCredentialStatusList{}
+
+	for _, url := range credStatusListDoc.Context {
+		contextObj, ok := ContextUrlMap[url]
+		if !ok {
+			panic(fmt.Sprintf("invalid or unsupported context url: %v", url))
+		}
+		jsonLdCredentialStatusList.Context = append(jsonLdCredentialStatusList.Context, contextObj)
+	}
+
+	jsonLdCredentialStatusList.Id = credStatusListDoc.Id
+	jsonLdCredentialStatusList.Issuer = credStatusListDoc.Issuer
+	jsonLdCredentialStatusList.StatusPurpose = credStatusListDoc.StatusPurpose
+	jsonLdCredentialStatusList.EncodedList = credStatusListDoc.EncodedList
+	jsonLdCredentialStatusList.ValidFrom = credStatusListDoc.ValidFrom
+
+	return jsonLdCredentialStatusList
+}
+
+func NewJsonLdCredentialStatusListBJJ(credStatusListDoc *types.CredentialStatusListDocument, docProof *types.DocumentProof) *JsonLdCredentialStatusListBJJ {
+	jsonLdCredentialStatusList := NewJsonLdCredentialStatusList(credStatusListDoc)
+
+	return &JsonLdCredentialStatusListBJJ{
+		Context:       jsonLdCredentialStatusList.Context,
+		Id:            jsonLdCredentialStatusList.Id,
+		Issuer:        jsonLdCredentialStatusList.Issuer,
+		StatusPurpose: jsonLdCredentialStatusList.StatusPurpose,
+		EncodedList:   jsonLdCredentialStatusList.EncodedList,
+		ValidFrom:     jsonLdCredentialStatusList.ValidFrom,
+		Proof: JsonLdDocumentProof{
+			Type:               docProof.Type,
+			Created:            docProof.Created,
+			ProofPurpose:       docProof.ProofPurpose,
+			VerificationMethod: docProof.VerificationMethod,
+		},
+	}
+}
+
+// It is a similar to `VerificationMethodCompromiseDocument` struct, with the exception that the `context` attribute is of type
+// `contextObject` instead of `[]string`, which is meant for accomodating Context JSON body
+// having arbritrary attributes. It should be used for performing Canonization.
+type JsonLdVerificationMethodCompromise struct {
+	Context              []contextObject `json:"@context,omitempty"`
+	Id                   string          `json:"id,omitempty"`
+	VerificationMethodId string          `json:"verificationMethodId,omitempty"`
+	CompromisedSince     string          `json:"compromisedSince,omitempty"`
+}
+
+func (doc *JsonLdVerificationMethodCompromise) GetContext() []contextObject {
+	return doc.Context
+}
+
+type JsonLdVerificationMethodCompromiseBJJ struct {
+	Context              []contextObject     `json:"@context,omitempty"`
+	Id                   string              `json:"id,omitempty"`
+	VerificationMethodId string              `json:"verificationMethodId,omitempty"`
+	CompromisedSince     string              `json:"compromisedSince,omitempty"`
+	Proof                JsonLdDocumentProof `json:"proof,omitempty"`
+}
+
+func (doc *JsonLdVerificationMethodCompromiseBJJ) GetContext() []contextObject {
+	return doc.Context
+}
+
+// NewJsonLdVerificationMethodCompromise returns a new JsonLdVerificationMethodCompromise struct from input Verification Method Compromise Document
+func NewJsonLdVerificationMethodCompromise(compromiseDoc *types.VerificationMethodCompromiseDocument) *JsonLdVerificationMethodCompromise {
+	if len(compromiseDoc.Context) == 0 {
+		panic("atleast one context url must be provided in the Verification Method Compromise Document for Canonization")
+	}
+
+	var jsonLdVerificationMethodCompromise *JsonLdVerificationMethodCompromise = &JsonLdVerificationMethodCompromise{}
+
+	for _, url := range compromiseDoc.Context {
+		contextObj, ok := ContextUrlMap[url]
+		if !ok {
+			panic(fmt.Sprintf("invalid or unsupported context url: %v", url))
+		}
+		jsonLdVerificationMethodCompromise.Context = append(jsonLdVerificationMethodCompromise.Context, contextObj)
+	}
+
+	jsonLdVerificationMethodCompromise.Id = compromiseDoc.Id
+	jsonLdVerificationMethodCompromise.VerificationMethodId = compromiseDoc.VerificationMethodId
+	jsonLdVerificationMethodCompromise.CompromisedSince = compromiseDoc.CompromisedSince
+
+	return jsonLdVerificationMethodCompromise
+}
+
+func NewJsonLdVerificationMethodCompromiseBJJ(compromiseDoc *types.VerificationMethodCompromiseDocument, docProof *types.DocumentProof) *JsonLdVerificationMethodCompromiseBJJ {
+	jsonLdVerificationMethodCompromise := NewJsonLdVerificationMethodCompromise(compromiseDoc)
+
+	return &JsonLdVerificationMethodCompromiseBJJ{
+		Context:              jsonLdVerificationMethodCompromise.Context,
+		Id:                   jsonLdVerificationMethodCompromise.Id,
+		VerificationMethodId: jsonLdVerificationMethodCompromise.VerificationMethodId,
+		CompromisedSince:     jsonLdVerificationMethodCompromise.CompromisedSince,
+		Proof: JsonLdDocumentProof{
+			Type:               docProof.Type,
+			Created:            docProof.Created,
+			ProofPurpose:       docProof.ProofPurpose,
+			VerificationMethod: docProof.VerificationMethod,
+		},
+	}
+}
+
+// It is a similar to `CredentialSchemaStatusDocument` struct, with the exception that the `context` attribute is of type
+// `contextObject` instead of `[]string`, which is meant for accomodating Context JSON body
+// having arbritrary attributes. It should be used for performing Canonization.
+type JsonLdCredentialSchemaStatus struct {
+	Context []contextObject `json:"@context,omitempty"`
+	Id      string          `json:"id,omitempty"`
+	Status  string          `json:"status,omitempty"`
+	Remarks string          `json:"remarks,omitempty"`
+}
+
+func (doc *JsonLdCredentialSchemaStatus) GetContext() []contextObject {
+	return doc.Context
+}
+
+type JsonLdCredentialSchemaStatusBJJ struct {
+	Context []contextObject     `json:"@context,omitempty"`
+	Id      string              `json:"id,omitempty"`
+	Status  string              `json:"status,omitempty"`
+	Remarks string              `json:"remarks,omitempty"`
+	Proof   JsonLdDocumentProof `json:"proof,omitempty"`
+}
+
+func (doc *JsonLdCredentialSchemaStatusBJJ) GetContext() []contextObject {
+	return doc.Context
+}
+
+// NewJsonLdCredentialSchemaStatus returns a new JsonLdCredentialSchemaStatus struct from input Credential Schema Status Document
+func NewJsonLdCredentialSchemaStatus(schemaStatusDoc *types.CredentialSchemaStatusDocument) *JsonLdCredentialSchemaStatus {
+	if len(schemaStatusDoc.Context) == 0 {
+		panic("atleast one context url must be provided in the Credential Schema Status Document for Canonization")
+	}
+
+	var jsonLdCredentialSchemaStatus *JsonLdCredentialSchemaStatus = &JsonLdCredentialSchemaStatus{}
+
+	for _, url := range schemaStatusDoc.Context {
+		contextObj, ok := ContextUrlMap[url]
+		if !ok {
+			panic(fmt.Sprintf("invalid or unsupported context url: %v", url))
+		}
+		jsonLdCredentialSchemaStatus.Context = append(jsonLdCredentialSchemaStatus.Context, contextObj)
+	}
+
+	jsonLdCredentialSchemaStatus.Id = schemaStatusDoc.Id
+	jsonLdCredentialSchemaStatus.Status = schemaStatusDoc.Status
+	jsonLdCredentialSchemaStatus.Remarks = schemaStatusDoc.Remarks
+
+	return jsonLdCredentialSchemaStatus
+}
+
+func NewJsonLdCredentialSchemaStatusBJJ(schemaStatusDoc *types.CredentialSchemaStatusDocument, docProof *types.DocumentProof) *JsonLdCredentialSchemaStatusBJJ {
+	jsonLdCredentialSchemaStatus := NewJsonLdCredentialSchemaStatus(schemaStatusDoc)
+
+	return &JsonLdCredentialSchemaStatusBJJ{
+		Context: jsonLdCredentialSchemaStatus.Context,
+		Id:      jsonLdCredentialSchemaStatus.Id,
+		Status:  jsonLdCredentialSchemaStatus.Status,
+		Remarks: jsonLdCredentialSchemaStatus.Remarks,
+		Proof: JsonLdDocumentProof{
+			Type:               docProof.Type,
+			Created:            docProof.Created,
+			ProofPurpose:       docProof.ProofPurpose,
+			VerificationMethod: docProof.VerificationMethod,
+		},
+	}
+}
+
+// It is a similar to `AccreditationDocument` struct, with the exception that the `context` attribute is of type
+// `contextObject` instead of `[]string`, which is meant for accomodating Context JSON body
+// having arbritrary attributes. It should be used for performing Canonization.
+type JsonLdAccreditation struct {
+	Context               []contextObject `json:"@context,omitempty"`
+	Id                    string          `json:"id,omitempty"`
+	Accreditor            string          `json:"accreditor,omitempty"`
+	AccreditedIssuer      string          `json:"accreditedIssuer,omitempty"`
+	CredentialSchemaId    string          `json:"credentialSchemaId,omitempty"`
+	ValidFrom             string          `json:"validFrom,omitempty"`
+	ValidUntil            string          `json:"validUntil,omitempty"`
+	DelegationDepth       uint32          `json:"delegationDepth,omitempty"`
+	ParentAccreditationId string          `json:"parentAccreditationId,omitempty"`
+}
+
+func (doc *JsonLdAccreditation) GetContext() []contextObject {
+	return doc.Context
+}
+
+type JsonLdAccreditationBJJ struct {
+	Context               []contextObject     `json:"@context,omitempty"`
+	Id                    string              `json:"id,omitempty"`
+	Accreditor            string              `json:"accreditor,omitempty"`
+	AccreditedIssuer      string              `json:"accreditedIssuer,omitempty"`
+	CredentialSchemaId    string              `json:"credentialSchemaId,omitempty"`
+	ValidFrom             string              `json:"validFrom,omitempty"`
+	ValidUntil            string              `json:"validUntil,omitempty"`
+	DelegationDepth       uint32              `json:"delegationDepth,omitempty"`
+	ParentAccreditationId string              `json:"parentAccreditationId,omitempty"`
+	Proof                 JsonLdDocumentProof `json:"proof,omitempty"`
+}
+
+func (doc *JsonLdAccreditationBJJ) GetContext() []contextObject {
+	return doc.Context
+}
+
+// NewJsonLdAccreditation returns a new JsonLdAccreditation struct from input Accreditation Document
+func NewJsonLdAccreditation(accreditationDoc *types.AccreditationDocument) *JsonLdAccreditation {
+	if len(accreditationDoc.Context) == 0 {
+		panic("atleast one context url must be provided in the Accreditation Document for Canonization")
+	}
+
+	var jsonLdAccreditation *JsonLdAccreditation = &JsonLdAccreditation{}
+
+	for _, url := range accreditationDoc.Context {
+		contextObj, ok := ContextUrlMap[url]
+		if !ok {
+			panic(fmt.Sprintf("invalid or unsupported context url: %v", url))
+		}
+		jsonLdAccreditation.Context = append(jsonLdAccreditation.Context, contextObj)
+	}
+
+	jsonLdAccreditation.Id = accreditationDoc.Id
+	jsonLdAccreditation.Accreditor = accreditationDoc.Accreditor
+	jsonLdAccreditation.AccreditedIssuer = accreditationDoc.AccreditedIssuer
+	jsonLdAccreditation.CredentialSchemaId = accreditationDoc.CredentialSchemaId
+	jsonLdAccreditation.ValidFrom = accreditationDoc.ValidFrom
+	jsonLdAccreditation.ValidUntil = accreditationDoc.ValidUntil
+	jsonLdAccreditation.DelegationDepth = accreditationDoc.DelegationDepth
+	jsonLdAccreditation.ParentAccreditationId = accreditationDoc.ParentAccreditationId
+
+	return jsonLdAccreditation
+}
+
+func NewJsonLdAccreditationBJJ(accreditationDoc *types.AccreditationDocument, docProof *types.DocumentProof) *JsonLdAccreditationBJJ {
+	jsonLdAccreditation := NewJsonLdAccreditation(accreditationDoc)
+
+	return &JsonLdAccreditationBJJ{
+		Context:               jsonLdAccreditation.Context,
+		Id:                    jsonLdAccreditation.Id,
+		Accreditor:            jsonLdAccreditation.Accreditor,
+		AccreditedIssuer:      jsonLdAccreditation.AccreditedIssuer,
+		CredentialSchemaId:    jsonLdAccreditation.CredentialSchemaId,
+		ValidFrom:             jsonLdAccreditation.ValidFrom,
+		ValidUntil:            jsonLdAccreditation.ValidUntil,
+		DelegationDepth:       jsonLdAccreditation.DelegationDepth,
+		ParentAccreditationId: jsonLdAccreditation.ParentAccreditationId,
+		Proof: JsonLdDocumentProof{
+			Type:               docProof.Type,
+			Created:            docProof.Created,
+			ProofPurpose:       docProof.ProofPurpose,
+			VerificationMethod: docProof.VerificationMethod,
+		},
+	}
+}
+
 // Document Proof
 
 type JsonLdDocumentProof struct {
@@ -298,6 +678,8 @@ type JsonLdDidDocumentWithoutVM struct {
 	KeyAgreement         []verificationMethodWithoutController `json:"keyAgreement,omitempty"`
 	Proof                JsonLdDocumentProof                   `json:"proof,omitempty"`
 	Service              []*types.Service                      `protobuf:"bytes,11,rep,name=service,proto3" json:"service,omitempty"`
+	ControllerThreshold  uint32                                `json:"controllerThreshold,omitempty"`
+	Recovery             []string                              `json:"recovery,omitempty"`
 }
 
 func (doc *JsonLdDidDocumentWithoutVM) GetContext() []contextObject {
@@ -322,6 +704,8 @@ func NewJsonLdDidDocumentWithoutVM(didDoc *types.DidDocument, docProof *types.Do
 
 	jsonLdDoc.Id = didDoc.Id
 	jsonLdDoc.Controller = didDoc.Controller
+	jsonLdDoc.ControllerThreshold = didDoc.ControllerThreshold
+	jsonLdDoc.Recovery = didDoc.Recovery
 	// Replace verification method ids with their corresponding Verification Method object
 	var vmMap map[string]verificationMethodWithoutController = map[string]verificationMethodWithoutController{}
 
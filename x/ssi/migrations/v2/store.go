@@ -0,0 +1,66 @@
+package v2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// MigrateStore performs in-place store migration from ConsensusVersion 1 to 2. Credential Schemas, which
+// were stored by their schema id, are moved under the composite key of their base id and version number.
+//
+// Versions differing only by leading zeros, such as `1.0` and `01.0`, share the composite key. The schema
+// authored first, with ties broken by schema id, is moved under it, and the others are kept under their
+// legacy key.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SchemaKey))
+
+	// Collect the schemas before rewriting them, as the store must not be written while iterating
+	var oldKeys [][]byte
+	var credentialSchemas []*types.CredentialSchemaState
+
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var credentialSchema types.CredentialSchemaState
+		if err := cdc.Unmarshal(iterator.Value(), &credentialSchema); err != nil {
+			iterator.Close()
+			return err
+		}
+		oldKeys = append(oldKeys, append([]byte{}, iterator.Key()...))
+		credentialSchemas = append(credentialSchemas, &credentialSchema)
+	}
+	iterator.Close()
+
+	for _, oldKey := range oldKeys {
+		store.Delete(oldKey)
+	}
+
+	sort.SliceStable(credentialSchemas, func(i, j int) bool {
+		iDocument, jDocument := credentialSchemas[i].CredentialSchemaDocument, credentialSchemas[j].CredentialSchemaDocument
+		if iDocument.Authored != jDocument.Authored {
+			return iDocument.Authored < jDocument.Authored
+		}
+		return iDocument.Id < jDocument.Id
+	})
+
+	for _, credentialSchema := range credentialSchemas {
+		schemaId := credentialSchema.CredentialSchemaDocument.Id
+
+		schemaKey, err := types.GetCredentialSchemaKey(schemaId)
+		if err != nil {
+			return fmt.Errorf("unable to migrate credential schema %v: %v", schemaId, err)
+		}
+		if store.Has(schemaKey) {
+			schemaKey = types.GetLegacyCredentialSchemaKey(schemaId)
+		}
+
+		store.Set(schemaKey, cdc.MustMarshal(credentialSchema))
+	}
+
+	return nil
+}
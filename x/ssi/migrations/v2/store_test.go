@@ -0,0 +1,80 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	v2 "github.com/hypersign-protocol/hid-node/x/ssi/migrations/v2"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	schemaIds := []string{
+		"sch:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd:1.0",
+		"sch:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd:10.0",
+	}
+
+	// Schemas are stored by their schema id in version 1
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SchemaKey))
+	for _, schemaId := range schemaIds {
+		store.Set([]byte(schemaId), cdc.MustMarshal(&types.CredentialSchemaState{
+			CredentialSchemaDocument: &types.CredentialSchemaDocument{Id: schemaId},
+			CredentialSchemaProof:    &types.DocumentProof{},
+		}))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+
+	for _, schemaId := range schemaIds {
+		require.False(t, store.Has([]byte(schemaId)))
+
+		schemaKey, err := types.GetCredentialSchemaKey(schemaId)
+		require.NoError(t, err)
+
+		var credentialSchema types.CredentialSchemaState
+		cdc.MustUnmarshal(store.Get(schemaKey), &credentialSchema)
+		require.Equal(t, schemaId, credentialSchema.CredentialSchemaDocument.Id)
+	}
+}
+
+func TestMigrateStoreLeadingZeros(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// Versions 1.0 and 01.0 share the composite key, and 01.0 is authored first
+	schemaAuthored := map[string]string{
+		"sch:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd:1.0":  "2023-08-17T09:37:12Z",
+		"sch:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd:01.0": "2023-08-16T09:37:12Z",
+	}
+
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SchemaKey))
+	for schemaId, authored := range schemaAuthored {
+		store.Set([]byte(schemaId), cdc.MustMarshal(&types.CredentialSchemaState{
+			CredentialSchemaDocument: &types.CredentialSchemaDocument{Id: schemaId, Authored: authored},
+			CredentialSchemaProof:    &types.DocumentProof{},
+		}))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+
+	schemaKey, err := types.GetCredentialSchemaKey("sch:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd:1.0")
+	require.NoError(t, err)
+
+	var credentialSchema types.CredentialSchemaState
+	cdc.MustUnmarshal(store.Get(schemaKey), &credentialSchema)
+	require.Equal(t, "sch:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd:01.0", credentialSchema.CredentialSchemaDocument.Id)
+
+	legacySchemaKey := types.GetLegacyCredentialSchemaKey("sch:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd:1.0")
+	cdc.MustUnmarshal(store.Get(legacySchemaKey), &credentialSchema)
+	require.Equal(t, "sch:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd:1.0", credentialSchema.CredentialSchemaDocument.Id)
+}
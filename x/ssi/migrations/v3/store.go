@@ -0,0 +1,105 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// MigrateStore performs in-place store migration from ConsensusVersion 2 to 3. The reverse indexes of
+// DID Documents by controller, Credential Schemas by author and Credential Statuses by issuer are built
+// from the existing state.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	// Index keys are collected before being written, as the store must not be written while iterating
+	var indexKeys [][]byte
+
+	didIndexKeys, err := getDidControllerIndexKeys(store, cdc)
+	if err != nil {
+		return err
+	}
+	indexKeys = append(indexKeys, didIndexKeys...)
+
+	schemaIndexKeys, err := getSchemaAuthorIndexKeys(store, cdc)
+	if err != nil {
+		return err
+	}
+	indexKeys = append(indexKeys, schemaIndexKeys...)
+
+	credIndexKeys, err := getCredIssuerIndexKeys(store, cdc)
+	if err != nil {
+		return err
+	}
+	indexKeys = append(indexKeys, credIndexKeys...)
+
+	for _, indexKey := range indexKeys {
+		store.Set(indexKey, []byte{})
+	}
+
+	return nil
+}
+
+// getDidControllerIndexKeys returns the index keys of every active DID Document against its controllers
+func getDidControllerIndexKeys(store sdk.KVStore, cdc codec.BinaryCodec) ([][]byte, error) {
+	iterator := sdk.KVStorePrefixIterator(prefix.NewStore(store, types.KeyPrefix(types.DidKey)), []byte{})
+	defer iterator.Close()
+
+	var indexKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var didDocumentState types.DidDocumentState
+		if err := cdc.Unmarshal(iterator.Value(), &didDocumentState); err != nil {
+			return nil, err
+		}
+		if didDocumentState.DidDocumentMetadata.Deactivated {
+			continue
+		}
+
+		didDocument := didDocumentState.DidDocument
+		for _, controller := range types.GetDidDocumentControllers(didDocument) {
+			indexKeys = append(indexKeys, append(types.GetDidControllerIndexPrefix(controller), didDocument.Id...))
+		}
+	}
+
+	return indexKeys, nil
+}
+
+// getSchemaAuthorIndexKeys returns the index keys of every Credential Schema against its author
+func getSchemaAuthorIndexKeys(store sdk.KVStore, cdc codec.BinaryCodec) ([][]byte, error) {
+	iterator := sdk.KVStorePrefixIterator(prefix.NewStore(store, types.KeyPrefix(types.SchemaKey)), []byte{})
+	defer iterator.Close()
+
+	var indexKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var credentialSchemaState types.CredentialSchemaState
+		if err := cdc.Unmarshal(iterator.Value(), &credentialSchemaState); err != nil {
+			return nil, err
+		}
+
+		author := credentialSchemaState.CredentialSchemaDocument.Author
+		indexKeys = append(indexKeys, append(types.GetSchemaAuthorIndexPrefix(author), iterator.Key()...))
+	}
+
+	return indexKeys, nil
+}
+
+// getCredIssuerIndexKeys returns the index keys of every Credential Status against its issuer
+func getCredIssuerIndexKeys(store sdk.KVStore, cdc codec.BinaryCodec) ([][]byte, error) {
+	iterator := sdk.KVStorePrefixIterator(prefix.NewStore(store, types.KeyPrefix(types.CredKey)), []byte{})
+	defer iterator.Close()
+
+	var indexKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var credentialStatusState types.CredentialStatusState
+		if err := cdc.Unmarshal(iterator.Value(), &credentialStatusState); err != nil {
+			return nil, err
+		}
+
+		credentialStatus := credentialStatusState.CredentialStatusDocument
+		indexKeys = append(indexKeys, append(types.GetCredIssuerIndexPrefix(credentialStatus.Issuer), credentialStatus.Id...))
+	}
+
+	return indexKeys, nil
+}
@@ -0,0 +1,65 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	v3 "github.com/hypersign-protocol/hid-node/x/ssi/migrations/v3"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(storeKey)
+
+	aliceDid := "did:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd"
+	orgDid := "did:hid:devnet:z6MkqKd4EoDA2PjLz8kk8DjYSGLWSZjHSbdM4g8pC7wALqVE"
+	deactivatedDid := "did:hid:devnet:z6MkiTBz1ymuepAQ4HEHYSF1H8quG5GLVVQR3djdX3mDooWp"
+	schemaId := "sch:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd:1.0"
+	credId := "vc:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd"
+
+	didStore := prefix.NewStore(store, types.KeyPrefix(types.DidKey))
+	for _, didDocumentState := range []*types.DidDocumentState{
+		{
+			DidDocument:         &types.DidDocument{Id: aliceDid},
+			DidDocumentMetadata: &types.DidDocumentMetadata{},
+		},
+		{
+			DidDocument:         &types.DidDocument{Id: orgDid, Controller: []string{aliceDid}},
+			DidDocumentMetadata: &types.DidDocumentMetadata{},
+		},
+		{
+			DidDocument:         &types.DidDocument{Id: deactivatedDid, Controller: []string{aliceDid}},
+			DidDocumentMetadata: &types.DidDocumentMetadata{Deactivated: true},
+		},
+	} {
+		didStore.Set([]byte(didDocumentState.DidDocument.Id), cdc.MustMarshal(didDocumentState))
+	}
+
+	schemaKey, err := types.GetCredentialSchemaKey(schemaId)
+	require.NoError(t, err)
+	prefix.NewStore(store, types.KeyPrefix(types.SchemaKey)).Set(schemaKey, cdc.MustMarshal(&types.CredentialSchemaState{
+		CredentialSchemaDocument: &types.CredentialSchemaDocument{Id: schemaId, Author: aliceDid},
+	}))
+
+	prefix.NewStore(store, types.KeyPrefix(types.CredKey)).Set([]byte(credId), cdc.MustMarshal(&types.CredentialStatusState{
+		CredentialStatusDocument: &types.CredentialStatusDocument{Id: credId, Issuer: aliceDid},
+	}))
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	controllerIndex := prefix.NewStore(store, types.GetDidControllerIndexPrefix(aliceDid))
+	require.True(t, controllerIndex.Has([]byte(aliceDid)))
+	require.True(t, controllerIndex.Has([]byte(orgDid)))
+	require.False(t, controllerIndex.Has([]byte(deactivatedDid)))
+
+	require.True(t, prefix.NewStore(store, types.GetSchemaAuthorIndexPrefix(aliceDid)).Has(schemaKey))
+	require.True(t, prefix.NewStore(store, types.GetCredIssuerIndexPrefix(aliceDid)).Has([]byte(credId)))
+}
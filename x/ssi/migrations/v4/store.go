@@ -0,0 +1,46 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// LegacySubspace is the x/params subspace of x/ssi module, which held the fee params before ConsensusVersion 4
+type LegacySubspace interface {
+	GetIfExists(ctx sdk.Context, key []byte, ptr interface{})
+}
+
+// MigrateStore performs in-place store migration from ConsensusVersion 3 to 4. The fee params are moved from
+// the legacy x/params subspace to the module store. A fee param absent in the subspace takes its default value.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, legacySubspace LegacySubspace) error {
+	params := types.DefaultParams()
+
+	for _, feeParam := range []struct {
+		key []byte
+		fee **sdk.Coin
+	}{
+		{types.ParamStoreKeyRegisterDidFee, &params.RegisterDidFee},
+		{types.ParamStoreKeyUpdateDidFee, &params.UpdateDidFee},
+		{types.ParamStoreKeyDeactivateDidFee, &params.DeactivateDidFee},
+		{types.ParamStoreKeyRegisterCredentialSchemaFee, &params.RegisterCredentialSchemaFee},
+		{types.ParamStoreKeyUpdateCredentialSchemaFee, &params.UpdateCredentialSchemaFee},
+		{types.ParamStoreKeyRegisterCredentialStatusFee, &params.RegisterCredentialStatusFee},
+		{types.ParamStoreKeyUpdateCredentialStatusFee, &params.UpdateCredentialStatusFee},
+	} {
+		var fee sdk.Coin
+		legacySubspace.GetIfExists(ctx, feeParam.key, &fee)
+		if fee.Denom != "" {
+			*feeParam.fee = &fee
+		}
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set([]byte(types.ParamsKey), cdc.MustMarshal(params))
+	return nil
+}
@@ -0,0 +1,64 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v4 "github.com/hypersign-protocol/hid-node/x/ssi/migrations/v4"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSubspace struct {
+	fees map[string]sdk.Coin
+}
+
+func (ms mockSubspace) GetIfExists(_ sdk.Context, key []byte, ptr interface{}) {
+	if fee, present := ms.fees[string(key)]; present {
+		*ptr.(*sdk.Coin) = fee
+	}
+}
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	legacySubspace := mockSubspace{fees: map[string]sdk.Coin{
+		string(types.ParamStoreKeyRegisterDidFee):   sdk.NewInt64Coin("uhid", 10),
+		string(types.ParamStoreKeyUpdateDidFee):     sdk.NewInt64Coin("uhid", 20),
+		string(types.ParamStoreKeyDeactivateDidFee): sdk.NewInt64Coin("uhid", 30),
+	}}
+
+	require.NoError(t, v4.MigrateStore(ctx, storeKey, cdc, legacySubspace))
+
+	var params types.Params
+	bz := ctx.KVStore(storeKey).Get([]byte(types.ParamsKey))
+	require.NotNil(t, bz)
+	cdc.MustUnmarshal(bz, &params)
+
+	require.Equal(t, sdk.NewInt64Coin("uhid", 10), *params.RegisterDidFee)
+	require.Equal(t, sdk.NewInt64Coin("uhid", 20), *params.UpdateDidFee)
+	require.Equal(t, sdk.NewInt64Coin("uhid", 30), *params.DeactivateDidFee)
+
+	// Fee params absent in the legacy subspace take their default value
+	require.Equal(t, types.DefaultRegisterCredentialSchemaFee, *params.RegisterCredentialSchemaFee)
+	require.Equal(t, types.DefaultUpdateCredentialStatusFee, *params.UpdateCredentialStatusFee)
+}
+
+func TestMigrateStoreInvalidLegacyParams(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	legacySubspace := mockSubspace{fees: map[string]sdk.Coin{
+		string(types.ParamStoreKeyRegisterDidFee): sdk.NewInt64Coin("stake", 10),
+	}}
+
+	require.Error(t, v4.MigrateStore(ctx, storeKey, cdc, legacySubspace))
+	require.Nil(t, ctx.KVStore(storeKey).Get([]byte(types.ParamsKey)))
+}
@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestAccreditationTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	ctx = ctx.WithBlockTime(time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC))
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create DIDs of Alice, Bob, Charlie and Dave")
+	alice_kp, alice_didDoc := registerAccreditationTestDid(t, msgServer, goCtx)
+	bob_kp, bob_didDoc := registerAccreditationTestDid(t, msgServer, goCtx)
+	charlie_kp, charlie_didDoc := registerAccreditationTestDid(t, msgServer, goCtx)
+	_, dave_didDoc := registerAccreditationTestDid(t, msgServer, goCtx)
+
+	t.Log("Alice registers a Credential Schema")
+	credentialSchema := testssi.GenerateSchema(alice_kp, alice_didDoc.Id)
+	_, err := msgServer.RegisterCredentialSchema(goCtx, testssi.GenerateSchemaRPCElements(alice_kp, credentialSchema, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	t.Log("FAIL: Bob, who is not the author of Credential Schema, accredits Charlie without a parent accreditation")
+	bobRootAccreditation := testssi.GenerateAccreditation(bob_kp, bob_didDoc.Id, charlie_didDoc.Id, credentialSchema.Id)
+	_, err = msgServer.RegisterAccreditation(goCtx, testssi.GenerateAccreditationRPCElements(bob_kp, bobRootAccreditation, bob_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidAccreditation)
+	t.Log(err)
+
+	t.Log("FAIL: Bob signs an accreditation on behalf of Alice")
+	forgedAccreditation := testssi.GenerateAccreditation(bob_kp, alice_didDoc.Id, bob_didDoc.Id, credentialSchema.Id)
+	_, err = msgServer.RegisterAccreditation(goCtx, testssi.GenerateAccreditationRPCElements(bob_kp, forgedAccreditation, bob_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidProof)
+	t.Log(err)
+
+	t.Log("PASS: Alice accredits Bob for her Credential Schema, allowing one level of delegation")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	goCtx = sdk.WrapSDKContext(ctx)
+	bobAccreditation := testssi.GenerateAccreditation(alice_kp, alice_didDoc.Id, bob_didDoc.Id, credentialSchema.Id)
+	bobAccreditation.DelegationDepth = 1
+	_, err = msgServer.RegisterAccreditation(goCtx, testssi.GenerateAccreditationRPCElements(alice_kp, bobAccreditation, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	event := getTypedEvent(t, ctx, &types.EventAccreditationRegistered{}).(*types.EventAccreditationRegistered)
+	require.Equal(t, bobAccreditation.Id, event.AccreditationId)
+	require.Equal(t, alice_didDoc.Id, event.Accreditor)
+	require.Equal(t, bob_didDoc.Id, event.AccreditedIssuer)
+
+	t.Log("FAIL: Alice registers the accreditation of Bob again")
+	_, err = msgServer.RegisterAccreditation(goCtx, testssi.GenerateAccreditationRPCElements(alice_kp, bobAccreditation, alice_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrAccreditationExists)
+	t.Log(err)
+
+	t.Log("FAIL: Bob accredits Charlie beyond the validity of his own accreditation")
+	charlieAccreditation := testssi.GenerateAccreditation(bob_kp, bob_didDoc.Id, charlie_didDoc.Id, credentialSchema.Id)
+	charlieAccreditation.ParentAccreditationId = bobAccreditation.Id
+	charlieAccreditation.ValidUntil = "2025-01-01T00:00:00Z"
+	_, err = msgServer.RegisterAccreditation(goCtx, testssi.GenerateAccreditationRPCElements(bob_kp, charlieAccreditation, bob_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidDate)
+	t.Log(err)
+
+	t.Log("FAIL: Bob accredits Charlie with a delegation depth not less than his own")
+	charlieAccreditation.ValidUntil = "2023-12-01T00:00:00Z"
+	charlieAccreditation.DelegationDepth = 1
+	_, err = msgServer.RegisterAccreditation(goCtx, testssi.GenerateAccreditationRPCElements(bob_kp, charlieAccreditation, bob_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidAccreditation)
+	t.Log(err)
+
+	t.Log("PASS: Bob accredits Charlie through his own accreditation")
+	charlieAccreditation.DelegationDepth = 0
+	_, err = msgServer.RegisterAccreditation(goCtx, testssi.GenerateAccreditationRPCElements(bob_kp, charlieAccreditation, bob_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	t.Log("FAIL: Charlie, whose accreditation does not allow delegation, accredits Dave")
+	daveAccreditation := testssi.GenerateAccreditation(charlie_kp, charlie_didDoc.Id, dave_didDoc.Id, credentialSchema.Id)
+	daveAccreditation.ParentAccreditationId = charlieAccreditation.Id
+	daveAccreditation.ValidUntil = "2023-12-01T00:00:00Z"
+	_, err = msgServer.RegisterAccreditation(goCtx, testssi.GenerateAccreditationRPCElements(charlie_kp, daveAccreditation, charlie_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidAccreditation)
+	t.Log(err)
+
+	t.Log("PASS: Charlie is accredited for the Credential Schema through Alice and Bob")
+	res, err := k.IssuerAccreditation(goCtx, &types.QueryIssuerAccreditationRequest{
+		Issuer:             charlie_didDoc.Id,
+		CredentialSchemaId: credentialSchema.Id,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Accredited)
+	require.Len(t, res.AccreditationChain, 2)
+	require.Equal(t, charlieAccreditation.Id, res.AccreditationChain[0].AccreditationDocument.Id)
+	require.Equal(t, bobAccreditation.Id, res.AccreditationChain[1].AccreditationDocument.Id)
+
+	t.Log("FAIL: Charlie is not accredited after his accreditation lapses")
+	res, err = k.IssuerAccreditation(goCtx, &types.QueryIssuerAccreditationRequest{
+		Issuer:             charlie_didDoc.Id,
+		CredentialSchemaId: credentialSchema.Id,
+		Time:               "2023-12-15T00:00:00Z",
+	})
+	require.NoError(t, err)
+	require.False(t, res.Accredited)
+
+	t.Log("FAIL: Dave is not accredited for the Credential Schema")
+	res, err = k.IssuerAccreditation(goCtx, &types.QueryIssuerAccreditationRequest{
+		Issuer:             dave_didDoc.Id,
+		CredentialSchemaId: credentialSchema.Id,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Accredited)
+
+	t.Log("FAIL: Query with an invalid time")
+	_, err = k.IssuerAccreditation(goCtx, &types.QueryIssuerAccreditationRequest{
+		Issuer:             charlie_didDoc.Id,
+		CredentialSchemaId: credentialSchema.Id,
+		Time:               "2023-12-15",
+	})
+	require.Error(t, err)
+	t.Log(err)
+
+	t.Log("FAIL: Governance accreditation submitted by an account other than the module authority")
+	governanceAccreditation := testssi.GenerateAccreditation(alice_kp, k.GetAuthority(), dave_didDoc.Id, credentialSchema.Id)
+	_, err = msgServer.RegisterGovernanceAccreditation(goCtx, &types.MsgRegisterGovernanceAccreditation{
+		Authority:             alice_didDoc.Id,
+		AccreditationDocument: governanceAccreditation,
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	t.Log(err)
+
+	t.Log("PASS: Module authority accredits Dave for the Credential Schema")
+	_, err = msgServer.RegisterGovernanceAccreditation(goCtx, &types.MsgRegisterGovernanceAccreditation{
+		Authority:             k.GetAuthority(),
+		AccreditationDocument: governanceAccreditation,
+	})
+	require.NoError(t, err)
+
+	res, err = k.IssuerAccreditation(goCtx, &types.QueryIssuerAccreditationRequest{
+		Issuer:             dave_didDoc.Id,
+		CredentialSchemaId: credentialSchema.Id,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Accredited)
+	require.Len(t, res.AccreditationChain, 1)
+
+	accreditationRes, err := k.AccreditationByID(goCtx, &types.QueryAccreditationRequest{Id: governanceAccreditation.Id})
+	require.NoError(t, err)
+	require.Nil(t, accreditationRes.Accreditation.AccreditationProof)
+
+	t.Log("FAIL: Charlie is not accredited after Bob's DID is deactivated")
+	_, err = msgServer.DeactivateDID(goCtx, testssi.GetDeactivateDidDocumentRPC(k, ctx, bob_didDoc, []testcrypto.IKeyPair{bob_kp}))
+	require.NoError(t, err)
+	res, err = k.IssuerAccreditation(goCtx, &types.QueryIssuerAccreditationRequest{
+		Issuer:             charlie_didDoc.Id,
+		CredentialSchemaId: credentialSchema.Id,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Accredited)
+
+	t.Log("FAIL: Dave is not accredited after the Credential Schema is revoked")
+	schemaStatus := testssi.GenerateSchemaStatus(alice_kp, credentialSchema.Id, types.CredentialSchemaStatusRevokedValue)
+	_, err = msgServer.UpdateCredentialSchemaStatus(goCtx, testssi.GenerateSchemaStatusRPCElements(alice_kp, schemaStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+	res, err = k.IssuerAccreditation(goCtx, &types.QueryIssuerAccreditationRequest{
+		Issuer:             dave_didDoc.Id,
+		CredentialSchemaId: credentialSchema.Id,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Accredited)
+}
+
+func registerAccreditationTestDid(t *testing.T, msgServer types.MsgServer, goCtx context.Context) (testcrypto.IKeyPair, *types.DidDocument) {
+	kp := testcrypto.GenerateEd25519KeyPair()
+	didDoc := testssi.GenerateDidDoc(kp)
+	kp.VerificationMethodId = didDoc.VerificationMethod[0].Id
+	_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(didDoc, []testcrypto.IKeyPair{kp}))
+	require.NoError(t, err)
+	return kp, didDoc
+}
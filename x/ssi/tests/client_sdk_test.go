@@ -0,0 +1,221 @@
+package tests
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypersign-protocol/hid-node/app"
+	ssiclient "github.com/hypersign-protocol/hid-node/client/ssi"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+
+	testconstants "github.com/hypersign-protocol/hid-node/x/ssi/tests/constants"
+)
+
+// newSdkDidDocument builds a DID Document of a generated key pair, signed through the client SDK
+func newSdkDidDocument(t *testing.T, vmType string) (*ssiclient.KeyPair, *types.MsgRegisterDID) {
+	keyPair, err := ssiclient.GenerateKeyPair(vmType)
+	require.NoError(t, err)
+
+	didDoc, err := ssiclient.NewDidDocument(testconstants.ChainNamespace, keyPair)
+	require.NoError(t, err)
+
+	proof, err := ssiclient.CreateDocumentProof(didDoc, didDoc.VerificationMethod[0], keyPair, nil)
+	require.NoError(t, err)
+
+	return keyPair, &types.MsgRegisterDID{
+		DidDocument:       didDoc,
+		DidDocumentProofs: []*types.DocumentProof{proof},
+		TxAuthor:          testconstants.Creator,
+	}
+}
+
+func TestClientSdkTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("1. PASS: DID Documents of every signing Verification Method type are built, signed and registered through the SDK")
+	vmTypes := []string{
+		types.Ed25519VerificationKey2020,
+		types.EcdsaSecp256k1VerificationKey2019,
+		types.EcdsaSecp256k1RecoveryMethod2020,
+		types.Bls12381G2Key2020,
+		types.BabyJubJubKey2021,
+	}
+	var aliceKp *ssiclient.KeyPair
+	var aliceDidDoc *types.DidDocument
+	for _, vmType := range vmTypes {
+		keyPair, msg := newSdkDidDocument(t, vmType)
+		_, err := msgServer.RegisterDID(goCtx, msg)
+		require.NoError(t, err, vmType)
+
+		if vmType == types.Ed25519VerificationKey2020 {
+			aliceKp, aliceDidDoc = keyPair, msg.DidDocument
+		}
+	}
+
+	t.Log("2. FAIL: DID Document is signed by a key pair other than that of its Verification Method")
+	_, msg := newSdkDidDocument(t, types.Ed25519VerificationKey2020)
+	otherKp, err := ssiclient.GenerateEd25519KeyPair()
+	require.NoError(t, err)
+	msg.DidDocumentProofs[0], err = ssiclient.CreateDocumentProof(msg.DidDocument, msg.DidDocument.VerificationMethod[0], otherKp, nil)
+	require.NoError(t, err)
+	_, err = msgServer.RegisterDID(goCtx, msg)
+	require.Error(t, err)
+
+	t.Log("3. PASS: DID Document is signed with the Cosmos ADR-036 client spec")
+	bobKp, err := ssiclient.GenerateSecp256k1KeyPair()
+	require.NoError(t, err)
+	bobAddress, err := bobKp.CosmosAddress()
+	require.NoError(t, err)
+	bobDidId := ssiclient.NewDidId(testconstants.ChainNamespace, bobKp.PublicKeyMultibase)
+	bobVm := ssiclient.NewVerificationMethod(bobDidId+"#key-1", bobDidId, bobKp)
+	bobVm.BlockchainAccountId, err = ssiclient.NewCosmosBlockchainAccountId("prajna", bobAddress)
+	require.NoError(t, err)
+	bobDidDoc, err := ssiclient.NewDidDocumentBuilder(bobDidId).AddVerificationMethod(bobVm, ssiclient.Authentication).Build()
+	require.NoError(t, err)
+
+	adr036Opts := &ssiclient.ProofOptions{ClientSpecType: types.CLIENT_SPEC_TYPE_COSMOS_ADR036}
+	bobProof, err := ssiclient.CreateDocumentProof(bobDidDoc, bobVm, bobKp, adr036Opts)
+	require.NoError(t, err)
+	_, err = msgServer.RegisterDID(goCtx, types.NewMsgCreateDID(bobDidDoc, []*types.DocumentProof{bobProof}, testconstants.Creator))
+	require.NoError(t, err)
+
+	t.Log("4. PASS: DID Document is signed with the Ethereum personal_sign client spec")
+	carolKp, err := ssiclient.GenerateSecp256k1RecoveryKeyPair()
+	require.NoError(t, err)
+	carolDidDoc, err := ssiclient.NewDidDocument(testconstants.ChainNamespace, carolKp)
+	require.NoError(t, err)
+
+	personalSignOpts := &ssiclient.ProofOptions{ClientSpecType: types.CLIENT_SPEC_TYPE_ETH_PERSONAL_SIGN}
+	carolProof, err := ssiclient.CreateDocumentProof(carolDidDoc, carolDidDoc.VerificationMethod[0], carolKp, personalSignOpts)
+	require.NoError(t, err)
+	_, err = msgServer.RegisterDID(goCtx, types.NewMsgCreateDID(carolDidDoc, []*types.DocumentProof{carolProof}, testconstants.Creator))
+	require.NoError(t, err)
+
+	t.Log("5. PASS: DID Document is signed by a key of Cosmos SDK keyring, both directly and with the Cosmos ADR-036 client spec")
+	kr := keyring.NewInMemory(app.MakeEncodingConfig().Codec)
+	keyringClientSpecs := map[string]types.ClientSpecType{
+		"dave": types.CLIENT_SPEC_TYPE_NONE,
+		"eve":  types.CLIENT_SPEC_TYPE_COSMOS_ADR036,
+	}
+	for uid, clientSpecType := range keyringClientSpecs {
+		_, _, err = kr.NewMnemonic(uid, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		signer, err := ssiclient.NewKeyringSigner(kr, uid)
+		require.NoError(t, err)
+
+		publicKeyMultibase, err := signer.PublicKeyMultibase()
+		require.NoError(t, err)
+		didId := ssiclient.NewDidId(testconstants.ChainNamespace, publicKeyMultibase)
+		vm, err := signer.VerificationMethod(didId+"#key-1", didId, "prajna")
+		require.NoError(t, err)
+		didDoc, err := ssiclient.NewDidDocumentBuilder(didId).AddVerificationMethod(vm).Build()
+		require.NoError(t, err)
+
+		proof, err := ssiclient.CreateDocumentProof(didDoc, vm, signer, &ssiclient.ProofOptions{ClientSpecType: clientSpecType})
+		require.NoError(t, err)
+		_, err = msgServer.RegisterDID(goCtx, types.NewMsgCreateDID(didDoc, []*types.DocumentProof{proof}, testconstants.Creator))
+		require.NoError(t, err, clientSpecType.String())
+	}
+
+	t.Log("6. PASS: Alice registers a Credential Schema built and signed through the SDK")
+	aliceVm := aliceDidDoc.VerificationMethod[0]
+	schemaId := ssiclient.NewCredentialSchemaId(testconstants.ChainNamespace, aliceKp.MethodSpecificId(), "1.0")
+	schemaDoc, err := ssiclient.NewCredentialSchemaBuilder(schemaId, "StudentCredential", aliceDidDoc.Id, aliceKp.Type).
+		SetDescription("Student ID Credential Schema").
+		AddProperty("name", "string", true).
+		Build()
+	require.NoError(t, err)
+
+	schemaProof, err := ssiclient.CreateDocumentProof(schemaDoc, aliceVm, aliceKp, nil)
+	require.NoError(t, err)
+	_, err = msgServer.RegisterCredentialSchema(goCtx, &types.MsgRegisterCredentialSchema{
+		CredentialSchemaDocument: schemaDoc,
+		CredentialSchemaProof:    schemaProof,
+		TxAuthor:                 testconstants.Creator,
+	})
+	require.NoError(t, err)
+
+	t.Log("7. PASS: Alice registers and then suspends a Credential Status built and signed through the SDK")
+	credentialHash := sha256.Sum256([]byte("credential"))
+	issuanceDate := time.Now().Add(-time.Hour)
+	credentialStatus, err := ssiclient.NewCredentialStatusBuilder(
+		ssiclient.NewCredentialId(testconstants.ChainNamespace, otherKp.MethodSpecificId()),
+		aliceDidDoc.Id,
+		hex.EncodeToString(credentialHash[:]),
+		aliceKp.Type,
+	).SetIssuanceDate(issuanceDate).SetCredentialSchemaId(schemaId).Build()
+	require.NoError(t, err)
+
+	credentialStatusProof, err := ssiclient.CreateDocumentProof(credentialStatus, aliceVm, aliceKp, nil)
+	require.NoError(t, err)
+	_, err = msgServer.RegisterCredentialStatus(goCtx, types.NewMsgRegisterCredentialStatus(credentialStatus, credentialStatusProof, testconstants.Creator))
+	require.NoError(t, err)
+
+	suspendedCredentialStatus := ssiclient.SuspendCredentialStatus(credentialStatus, "Suspended")
+	suspensionProof, err := ssiclient.CreateDocumentProof(suspendedCredentialStatus, aliceVm, aliceKp, nil)
+	require.NoError(t, err)
+	_, err = msgServer.UpdateCredentialStatus(goCtx, &types.MsgUpdateCredentialStatus{
+		CredentialStatusDocument: suspendedCredentialStatus,
+		CredentialStatusProof:    suspensionProof,
+		TxAuthor:                 testconstants.Creator,
+	})
+	require.NoError(t, err)
+
+	t.Log("8. PASS: Alice issues a Verifiable Credential signed through the SDK, which is verified by hid-node")
+	credential := map[string]interface{}{
+		"@context": []interface{}{
+			ldcontext.CredentialsContext,
+			map[string]interface{}{
+				"hs":   "https://hypersign.id/vocab#",
+				"name": "hs:name",
+			},
+			ldcontext.Ed25519Context2020,
+		},
+		"id":           ssiclient.NewCredentialId(testconstants.ChainNamespace, aliceDidDoc.VerificationMethod[0].PublicKeyMultibase),
+		"type":         []interface{}{"VerifiableCredential"},
+		"issuer":       aliceDidDoc.Id,
+		"issuanceDate": issuanceDate.UTC().Format(time.RFC3339),
+		"credentialSubject": map[string]interface{}{
+			"id":   bobDidDoc.Id,
+			"name": "Bob",
+		},
+		"credentialSchema": map[string]interface{}{
+			"id":   schemaId,
+			"type": "JsonSchemaValidator2018",
+		},
+	}
+	credentialStatusBuilder, err := ssiclient.NewCredentialStatusBuilderForCredential(credential, aliceKp.Type)
+	require.NoError(t, err)
+	credentialStatus, err = credentialStatusBuilder.Build()
+	require.NoError(t, err)
+	credentialStatusProof, err = ssiclient.CreateDocumentProof(credentialStatus, aliceVm, aliceKp, nil)
+	require.NoError(t, err)
+	_, err = msgServer.RegisterCredentialStatus(goCtx, types.NewMsgRegisterCredentialStatus(credentialStatus, credentialStatusProof, testconstants.Creator))
+	require.NoError(t, err)
+
+	require.NoError(t, ssiclient.SignCredential(credential, aliceVm.Id, aliceKp, nil))
+	res := verifyCredential(t, k, goCtx, marshalCredential(t, credential))
+	for _, check := range res.Checks {
+		require.True(t, check.Passed, check.Name)
+	}
+	require.True(t, res.Verified)
+
+	t.Log("9. PASS: Alice deprecates the Credential Schema through a Credential Schema Status signed through the SDK")
+	schemaStatus, err := ssiclient.NewCredentialSchemaStatus(schemaId, types.CredentialSchemaStatusDeprecatedValue, "Superseded", aliceKp.Type)
+	require.NoError(t, err)
+	schemaStatusProof, err := ssiclient.CreateDocumentProof(schemaStatus, aliceVm, aliceKp, nil)
+	require.NoError(t, err)
+	_, err = msgServer.UpdateCredentialSchemaStatus(goCtx, types.NewMsgUpdateCredentialSchemaStatus(schemaStatus, schemaStatusProof, testconstants.Creator))
+	require.NoError(t, err)
+}
@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestControllerThresholdTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	var controllerKps []testcrypto.IKeyPair
+	var controllerDids []string
+	for _, name := range []string{"Alice", "Bob", "Carol"} {
+		t.Logf("Create %s's DID", name)
+		kp := testcrypto.GenerateEd25519KeyPair()
+		didDoc := testssi.GenerateDidDoc(kp)
+		kp.VerificationMethodId = didDoc.VerificationMethod[0].Id
+		_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(didDoc, []testcrypto.IKeyPair{kp}))
+		require.NoError(t, err)
+
+		controllerKps = append(controllerKps, kp)
+		controllerDids = append(controllerDids, didDoc.Id)
+	}
+	alice_kp, bob_kp, carol_kp := controllerKps[0], controllerKps[1], controllerKps[2]
+
+	org_kp := testcrypto.GenerateEd25519KeyPair()
+	org_didDoc := testssi.GenerateDidDoc(org_kp)
+	org_kp.VerificationMethodId = org_didDoc.VerificationMethod[0].Id
+	org_didDoc.Controller = controllerDids
+	org_kps := []testcrypto.IKeyPair{org_kp, alice_kp, bob_kp, carol_kp}
+
+	t.Log("FAIL: Org DID is registered with a controller threshold greater than the number of controllers")
+	org_didDoc.Context = append(org_didDoc.Context, ldcontext.ControllerThresholdContext)
+	org_didDoc.ControllerThreshold = 4
+	_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(org_didDoc, org_kps))
+	require.ErrorIs(t, err, types.ErrInvalidDidDoc)
+
+	t.Log("FAIL: Org DID is registered with a controller threshold, without the context defining it")
+	org_didDoc.Context = org_didDoc.Context[:len(org_didDoc.Context)-1]
+	org_didDoc.ControllerThreshold = 2
+	_, err = msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(org_didDoc, org_kps))
+	require.ErrorIs(t, err, types.ErrInvalidDidDoc)
+
+	t.Log("PASS: Org DID is registered with a 2-of-3 controller threshold")
+	org_didDoc.Context = append(org_didDoc.Context, ldcontext.ControllerThresholdContext)
+	_, err = msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(org_didDoc, org_kps))
+	require.NoError(t, err)
+
+	t.Log("FAIL: Alice alone attempts to update the Org DID")
+	ctx = ctx.WithTxBytes([]byte("update org did"))
+	goCtx = sdk.WrapSDKContext(ctx)
+	org_didDoc.CapabilityDelegation = []string{org_didDoc.VerificationMethod[0].Id}
+	_, err = msgServer.UpdateDID(goCtx, testssi.GetUpdateDidDocumentRPC(k, ctx, org_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	t.Log("FAIL: Alice alone attempts to lower the controller threshold of Org DID")
+	org_didDoc.ControllerThreshold = 1
+	_, err = msgServer.UpdateDID(goCtx, testssi.GetUpdateDidDocumentRPC(k, ctx, org_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	t.Log("PASS: Alice and Carol update the Org DID")
+	org_didDoc.ControllerThreshold = 2
+	_, err = msgServer.UpdateDID(goCtx, testssi.GetUpdateDidDocumentRPC(k, ctx, org_didDoc, []testcrypto.IKeyPair{alice_kp, carol_kp}))
+	require.NoError(t, err)
+
+	t.Log("FAIL: Bob alone attempts to deactivate the Org DID")
+	ctx = ctx.WithTxBytes([]byte("deactivate org did"))
+	goCtx = sdk.WrapSDKContext(ctx)
+	_, err = msgServer.DeactivateDID(goCtx, testssi.GetDeactivateDidDocumentRPC(k, ctx, org_didDoc, []testcrypto.IKeyPair{bob_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	t.Log("PASS: Bob and Carol deactivate the Org DID")
+	_, err = msgServer.DeactivateDID(goCtx, testssi.GetDeactivateDidDocumentRPC(k, ctx, org_didDoc, []testcrypto.IKeyPair{bob_kp, carol_kp}))
+	require.NoError(t, err)
+}
+
+func TestControllerThresholdDistinctControllersTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	var controllerKps []testcrypto.IKeyPair
+	var controllerDids []string
+	for _, name := range []string{"Alice", "Bob"} {
+		t.Logf("Create %s's DID", name)
+		kp := testcrypto.GenerateEd25519KeyPair()
+		didDoc := testssi.GenerateDidDoc(kp)
+		kp.VerificationMethodId = didDoc.VerificationMethod[0].Id
+		_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(didDoc, []testcrypto.IKeyPair{kp}))
+		require.NoError(t, err)
+
+		controllerKps = append(controllerKps, kp)
+		controllerDids = append(controllerDids, didDoc.Id)
+	}
+	alice_kp, bob_kp := controllerKps[0], controllerKps[1]
+
+	t.Log("PASS: Dept DID is registered with a 2-of-3 controller threshold, and a Verification Method controlled by Alice")
+	dept_kp := testcrypto.GenerateEd25519KeyPair()
+	dept_didDoc := testssi.GenerateDidDoc(dept_kp)
+	dept_kp.VerificationMethodId = dept_didDoc.VerificationMethod[0].Id
+	dept_didDoc.Controller = append([]string{dept_didDoc.Id}, controllerDids...)
+	dept_didDoc.Context = append(dept_didDoc.Context, ldcontext.ControllerThresholdContext)
+	dept_didDoc.ControllerThreshold = 2
+
+	aliceDept_kp := testcrypto.GenerateEd25519KeyPair()
+	aliceDept_kp.VerificationMethodId = dept_didDoc.Id + "#key-2"
+	dept_didDoc.VerificationMethod = append(dept_didDoc.VerificationMethod, &types.VerificationMethod{
+		Id:                 aliceDept_kp.VerificationMethodId,
+		Type:               aliceDept_kp.GetType(),
+		Controller:         controllerDids[0],
+		PublicKeyMultibase: aliceDept_kp.GetPublicKey(),
+	})
+	dept_didDoc.Authentication = append(dept_didDoc.Authentication, aliceDept_kp.VerificationMethodId)
+
+	_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(dept_didDoc, []testcrypto.IKeyPair{dept_kp, aliceDept_kp, alice_kp, bob_kp}))
+	require.NoError(t, err)
+
+	t.Log("FAIL: Alice alone updates the Dept DID by signing through two Verification Methods she controls")
+	ctx = ctx.WithTxBytes([]byte("update dept did"))
+	goCtx = sdk.WrapSDKContext(ctx)
+	dept_didDoc.CapabilityDelegation = []string{dept_didDoc.VerificationMethod[0].Id}
+	_, err = msgServer.UpdateDID(goCtx, testssi.GetUpdateDidDocumentRPC(k, ctx, dept_didDoc, []testcrypto.IKeyPair{aliceDept_kp, alice_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	t.Log("FAIL: Alice alone deactivates the Dept DID by signing through two Verification Methods she controls")
+	_, err = msgServer.DeactivateDID(goCtx, testssi.GetDeactivateDidDocumentRPC(k, ctx, dept_didDoc, []testcrypto.IKeyPair{aliceDept_kp, alice_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	t.Log("PASS: Alice and Bob update the Dept DID")
+	_, err = msgServer.UpdateDID(goCtx, testssi.GetUpdateDidDocumentRPC(k, ctx, dept_didDoc, []testcrypto.IKeyPair{aliceDept_kp, bob_kp}))
+	require.NoError(t, err)
+}
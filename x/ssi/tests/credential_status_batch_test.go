@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestCredentialStatusBatchTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Alice's and Bob's DIDs")
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+
+	bob_kp := testcrypto.GenerateEd25519KeyPair()
+	bob_didDoc := testssi.GenerateDidDoc(bob_kp)
+	bob_kp.VerificationMethodId = bob_didDoc.VerificationMethod[0].Id
+	_, err = msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(bob_didDoc, []testcrypto.IKeyPair{bob_kp}))
+	require.NoError(t, err)
+
+	t.Log("Lower the maximum batch size to 5")
+	params := *types.DefaultParams()
+	params.MaxCredentialStatusBatchSize = 5
+	require.NoError(t, k.SetParams(ctx, params))
+
+	t.Log("FAIL: Alice registers a batch larger than the maximum batch size")
+	credentialStatusBatch := testssi.GenerateCredentialStatusBatch(alice_kp, alice_didDoc.Id, 6)
+	_, err = msgServer.RegisterCredentialStatusBatch(
+		goCtx,
+		testssi.GenerateRegisterCredStatusBatchRPCElements(alice_kp, credentialStatusBatch, alice_didDoc.VerificationMethod[0]),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidCredentialStatusBatch)
+
+	t.Log("FAIL: Alice registers a batch with a duplicate credential status")
+	credentialStatusBatch = testssi.GenerateCredentialStatusBatch(alice_kp, alice_didDoc.Id, 3)
+	credentialStatusBatch.CredentialStatuses[2].Id = credentialStatusBatch.CredentialStatuses[0].Id
+	_, err = msgServer.RegisterCredentialStatusBatch(
+		goCtx,
+		testssi.GenerateRegisterCredStatusBatchRPCElements(alice_kp, credentialStatusBatch, alice_didDoc.VerificationMethod[0]),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidCredentialStatusBatch)
+
+	t.Log("FAIL: Alice registers a batch holding a credential status issued by Bob")
+	credentialStatusBatch = testssi.GenerateCredentialStatusBatch(alice_kp, alice_didDoc.Id, 3)
+	credentialStatusBatch.CredentialStatuses[1].Issuer = bob_didDoc.Id
+	_, err = msgServer.RegisterCredentialStatusBatch(
+		goCtx,
+		testssi.GenerateRegisterCredStatusBatchRPCElements(alice_kp, credentialStatusBatch, alice_didDoc.VerificationMethod[0]),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidCredentialStatusBatch)
+
+	t.Log("FAIL: Bob signs a batch issued by Alice")
+	credentialStatusBatch = testssi.GenerateCredentialStatusBatch(alice_kp, alice_didDoc.Id, 3)
+	_, err = msgServer.RegisterCredentialStatusBatch(
+		goCtx,
+		testssi.GenerateRegisterCredStatusBatchRPCElements(bob_kp, credentialStatusBatch, bob_didDoc.VerificationMethod[0]),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidCredentialStatusBatch)
+
+	t.Log("FAIL: A credential status of the batch is altered after signing")
+	credentialStatusBatchRPC := testssi.GenerateRegisterCredStatusBatchRPCElements(alice_kp, credentialStatusBatch, alice_didDoc.VerificationMethod[0])
+	credentialStatusBatchRPC.CredentialStatusBatchDocument.CredentialStatuses[1].Revoked = true
+	_, err = msgServer.RegisterCredentialStatusBatch(goCtx, credentialStatusBatchRPC)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	t.Log("PASS: Alice registers a batch of credential statuses")
+	credentialStatusBatch = testssi.GenerateCredentialStatusBatch(alice_kp, alice_didDoc.Id, 5)
+	_, err = msgServer.RegisterCredentialStatusBatch(
+		goCtx,
+		testssi.GenerateRegisterCredStatusBatchRPCElements(alice_kp, credentialStatusBatch, alice_didDoc.VerificationMethod[0]),
+	)
+	require.NoError(t, err)
+
+	batchId := types.GetCredentialStatusBatchId(credentialStatusBatch)
+	for _, credentialStatus := range credentialStatusBatch.CredentialStatuses {
+		res, err := k.CredentialStatusByID(goCtx, &types.QueryCredentialStatusRequest{CredId: credentialStatus.Id})
+		require.NoError(t, err)
+		require.Equal(t, credentialStatusBatch.Context, res.CredentialStatus.CredentialStatusDocument.Context)
+		require.Equal(t, alice_didDoc.Id, res.CredentialStatus.CredentialStatusDocument.Issuer)
+		require.Equal(t, batchId, res.CredentialStatus.CredentialStatusBatchId)
+	}
+
+	t.Log("PASS: The batch is stored along with its proof")
+	batchRes, err := k.CredentialStatusBatchByID(goCtx, &types.QueryCredentialStatusBatchRequest{Id: batchId})
+	require.NoError(t, err)
+	require.Len(t, batchRes.CredentialStatusBatch.CredentialStatusBatchDocument.CredentialStatuses, 5)
+
+	t.Log("PASS: The proof of a credential status registered in the batch is verified against the batch")
+	credStatusRes, err := k.CredentialStatusByID(goCtx, &types.QueryCredentialStatusRequest{CredId: credentialStatusBatch.CredentialStatuses[2].Id})
+	require.NoError(t, err)
+	proofRes, err := k.VerifyDocumentProof(goCtx, &types.QueryVerifyDocumentProofRequest{
+		CredentialStatusDocument: credStatusRes.CredentialStatus.CredentialStatusDocument,
+		DocumentProof:            credStatusRes.CredentialStatus.CredentialStatusProof,
+	})
+	require.NoError(t, err)
+	require.True(t, proofRes.Verified)
+	require.Equal(t, types.ProofCheckCredentialStatusBatch, proofRes.Checks[2].Name)
+
+	t.Log("FAIL: The proof of the batch is verified for an altered credential status of the batch")
+	alteredCredStatus := *credStatusRes.CredentialStatus.CredentialStatusDocument
+	alteredCredStatus.Suspended = true
+	proofRes, err = k.VerifyDocumentProof(goCtx, &types.QueryVerifyDocumentProofRequest{
+		CredentialStatusDocument: &alteredCredStatus,
+		DocumentProof:            credStatusRes.CredentialStatus.CredentialStatusProof,
+	})
+	require.NoError(t, err)
+	require.False(t, proofRes.Verified)
+	require.False(t, proofRes.Checks[len(proofRes.Checks)-1].Passed)
+	require.Equal(t, types.ProofCheckCredentialStatusBatch, proofRes.Checks[len(proofRes.Checks)-1].Name)
+
+	t.Log("FAIL: Alice registers the same batch again")
+	_, err = msgServer.RegisterCredentialStatusBatch(
+		goCtx,
+		testssi.GenerateRegisterCredStatusBatchRPCElements(alice_kp, testssi.GenerateCredentialStatusBatch(alice_kp, alice_didDoc.Id, 5), alice_didDoc.VerificationMethod[0]),
+	)
+	require.ErrorIs(t, err, types.ErrCredentialStatusExists)
+
+	t.Log("PASS: Alice revokes a credential status registered in the batch")
+	credentialStatus := testssi.GenerateCredentialStatus(alice_kp, alice_didDoc.Id)
+	credentialStatus.Id = credentialStatusBatch.CredentialStatuses[3].Id
+	credentialStatus.Revoked = true
+	_, err = msgServer.UpdateCredentialStatus(
+		goCtx,
+		testssi.GenerateUpdateCredStatusRPCElements(alice_kp, credentialStatus, alice_didDoc.VerificationMethod[0]),
+	)
+	require.NoError(t, err)
+
+	credStatusRes, err = k.CredentialStatusByID(goCtx, &types.QueryCredentialStatusRequest{CredId: credentialStatus.Id})
+	require.NoError(t, err)
+	require.Empty(t, credStatusRes.CredentialStatus.CredentialStatusBatchId)
+}
@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestCredentialStatusExpiryTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	ctx = ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Alice's DID")
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+
+	degreeCredentialStatus := testssi.GenerateCredentialStatus(alice_kp, alice_didDoc.Id)
+	membershipCredentialStatus := testssi.GenerateCredentialStatus(alice_kp, alice_didDoc.Id)
+	membershipCredentialStatus.Id = membershipCredentialStatus.Id + "2"
+
+	t.Log("FAIL: Alice registers a credential status which expires before its issuance")
+	degreeCredentialStatus.ExpirationDate = "2022-01-01T00:00:00Z"
+	_, err = msgServer.RegisterCredentialStatus(goCtx, testssi.GenerateRegisterCredStatusRPCElements(alice_kp, degreeCredentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidDate)
+
+	t.Log("FAIL: Alice registers a credential status which has already expired")
+	degreeCredentialStatus.ExpirationDate = "2022-12-01T00:00:00Z"
+	_, err = msgServer.RegisterCredentialStatus(goCtx, testssi.GenerateRegisterCredStatusRPCElements(alice_kp, degreeCredentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidDate)
+
+	t.Log("FAIL: Alice registers a credential status with an expiration date, without the context defining it")
+	degreeCredentialStatus.ExpirationDate = "2023-06-01T00:00:00Z"
+	_, err = msgServer.RegisterCredentialStatus(goCtx, testssi.GenerateRegisterCredStatusRPCElements(alice_kp, degreeCredentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidCredentialStatus)
+
+	t.Log("PASS: Alice registers two credential statuses with expiration dates")
+	degreeCredentialStatus.Context[0] = ldcontext.CredentialStatusV2Context
+	membershipCredentialStatus.Context[0] = ldcontext.CredentialStatusV2Context
+	_, err = msgServer.RegisterCredentialStatus(goCtx, testssi.GenerateRegisterCredStatusRPCElements(alice_kp, degreeCredentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	membershipCredentialStatus.ExpirationDate = "2023-03-01T00:00:00Z"
+	_, err = msgServer.RegisterCredentialStatus(goCtx, testssi.GenerateRegisterCredStatusRPCElements(alice_kp, membershipCredentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	t.Log("PASS: No credential status expires before its expiration date")
+	ctx = ctx.WithBlockTime(time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)).WithEventManager(sdk.NewEventManager())
+	ssi.EndBlocker(ctx, *k)
+	require.False(t, queryCredentialStatus(t, k, ctx, degreeCredentialStatus.Id).Expired)
+	require.False(t, queryCredentialStatus(t, k, ctx, membershipCredentialStatus.Id).Expired)
+
+	t.Log("PASS: Alice extends the expiration date of membership credential")
+	membershipCredentialStatus.ExpirationDate = "2023-09-01T00:00:00Z"
+	_, err = msgServer.UpdateCredentialStatus(sdk.WrapSDKContext(ctx), testssi.GenerateUpdateCredStatusRPCElements(alice_kp, membershipCredentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	t.Log("PASS: Degree credential expires, while the membership credential remains valid")
+	ctx = ctx.WithBlockTime(time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)).WithEventManager(sdk.NewEventManager())
+	ssi.EndBlocker(ctx, *k)
+
+	degreeCredentialStatusState := queryCredentialStatus(t, k, ctx, degreeCredentialStatus.Id)
+	require.True(t, degreeCredentialStatusState.Expired)
+	require.False(t, degreeCredentialStatusState.CredentialStatusDocument.Revoked)
+	require.False(t, degreeCredentialStatusState.CredentialStatusDocument.Suspended)
+	require.False(t, queryCredentialStatus(t, k, ctx, membershipCredentialStatus.Id).Expired)
+
+	expiredEvent := getTypedEvent(t, ctx, &types.EventCredentialExpired{}).(*types.EventCredentialExpired)
+	require.Equal(t, degreeCredentialStatus.Id, expiredEvent.CredentialId)
+	require.Equal(t, alice_didDoc.Id, expiredEvent.Issuer)
+	require.Equal(t, degreeCredentialStatus.ExpirationDate, expiredEvent.ExpirationDate)
+
+	t.Log("FAIL: Alice suspends the expired degree credential")
+	degreeCredentialStatus.Suspended = true
+	_, err = msgServer.UpdateCredentialStatus(sdk.WrapSDKContext(ctx), testssi.GenerateUpdateCredStatusRPCElements(alice_kp, degreeCredentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidCredentialStatus)
+
+	t.Log("PASS: Membership credential expires at its extended expiration date")
+	ctx = ctx.WithBlockTime(time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)).WithEventManager(sdk.NewEventManager())
+	ssi.EndBlocker(ctx, *k)
+	require.True(t, queryCredentialStatus(t, k, ctx, membershipCredentialStatus.Id).Expired)
+}
+
+func queryCredentialStatus(t *testing.T, k *keeper.Keeper, ctx sdk.Context, credId string) *types.CredentialStatusState {
+	res, err := k.CredentialStatusByID(sdk.WrapSDKContext(ctx), &types.QueryCredentialStatusRequest{CredId: credId})
+	require.NoError(t, err)
+	return res.CredentialStatus
+}
@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestCredentialStatusListTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Alice's and Bob's DIDs")
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+
+	bob_kp := testcrypto.GenerateEd25519KeyPair()
+	bob_didDoc := testssi.GenerateDidDoc(bob_kp)
+	bob_kp.VerificationMethodId = bob_didDoc.VerificationMethod[0].Id
+	_, err = msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(bob_didDoc, []testcrypto.IKeyPair{bob_kp}))
+	require.NoError(t, err)
+
+	credentialStatusList := testssi.GenerateCredentialStatusList(alice_kp, alice_didDoc.Id, types.StatusPurposeRevocation)
+
+	t.Log("FAIL: Bob registers a credential status list with Alice as the issuer")
+	_, err = msgServer.RegisterCredentialStatusList(
+		goCtx,
+		testssi.GenerateRegisterCredStatusListRPCElements(bob_kp, credentialStatusList, bob_didDoc.VerificationMethod[0]),
+	)
+	require.Error(t, err)
+
+	t.Log("FAIL: Alice registers a credential status list with a bitstring shorter than 16KB")
+	shortCredentialStatusList := testssi.GenerateCredentialStatusList(alice_kp, alice_didDoc.Id, types.StatusPurposeRevocation)
+	shortCredentialStatusList.EncodedList, err = types.EncodeStatusList(make([]byte, 1024))
+	require.NoError(t, err)
+	_, err = msgServer.RegisterCredentialStatusList(
+		goCtx,
+		testssi.GenerateRegisterCredStatusListRPCElements(alice_kp, shortCredentialStatusList, alice_didDoc.VerificationMethod[0]),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidCredentialStatusList)
+
+	t.Log("FAIL: Alice registers a credential status list with a bitstring longer than the permitted length")
+	longCredentialStatusList := testssi.GenerateCredentialStatusList(alice_kp, alice_didDoc.Id, types.StatusPurposeRevocation)
+	longCredentialStatusList.EncodedList, err = types.EncodeStatusList(make([]byte, types.MaxStatusListBitstringLength+1))
+	require.NoError(t, err)
+	_, err = msgServer.RegisterCredentialStatusList(
+		goCtx,
+		testssi.GenerateRegisterCredStatusListRPCElements(alice_kp, longCredentialStatusList, alice_didDoc.VerificationMethod[0]),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidCredentialStatusList)
+
+	t.Log("FAIL: Alice registers a credential status list with less gas than its decompression costs")
+	lowGasCtx := ctx.WithGasMeter(storetypes.NewGasMeter(types.MinStatusListBitstringLength / 2))
+	require.Panics(t, func() {
+		_, _ = msgServer.RegisterCredentialStatusList(
+			sdk.WrapSDKContext(lowGasCtx),
+			testssi.GenerateRegisterCredStatusListRPCElements(alice_kp, credentialStatusList, alice_didDoc.VerificationMethod[0]),
+		)
+	})
+
+	t.Log("PASS: Alice registers a credential status list")
+	_, err = msgServer.RegisterCredentialStatusList(
+		goCtx,
+		testssi.GenerateRegisterCredStatusListRPCElements(alice_kp, credentialStatusList, alice_didDoc.VerificationMethod[0]),
+	)
+	require.NoError(t, err)
+
+	t.Log("FAIL: Alice registers the same credential status list again")
+	_, err = msgServer.RegisterCredentialStatusList(
+		goCtx,
+		testssi.GenerateRegisterCredStatusListRPCElements(alice_kp, credentialStatusList, alice_didDoc.VerificationMethod[0]),
+	)
+	require.ErrorIs(t, err, types.ErrCredentialStatusListExists)
+
+	t.Log("Alice revokes the credential at index 7")
+	bitstring := make([]byte, types.MinStatusListBitstringLength)
+	bitstring[0] = 0x01
+	updatedEncodedList, err := types.EncodeStatusList(bitstring)
+	require.NoError(t, err)
+
+	updatedCredentialStatusList := testssi.GenerateCredentialStatusList(alice_kp, alice_didDoc.Id, types.StatusPurposeRevocation)
+	updatedCredentialStatusList.EncodedList = updatedEncodedList
+
+	t.Log("FAIL: Alice updates the credential status list without a later validFrom")
+	_, err = msgServer.UpdateCredentialStatusList(
+		goCtx,
+		testssi.GenerateUpdateCredStatusListRPCElements(alice_kp, updatedCredentialStatusList, alice_didDoc.VerificationMethod[0]),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidDate)
+
+	t.Log("FAIL: Alice changes the status purpose of credential status list")
+	updatedCredentialStatusList.ValidFrom = "2022-05-10T04:07:12Z"
+	updatedCredentialStatusList.StatusPurpose = types.StatusPurposeSuspension
+	_, err = msgServer.UpdateCredentialStatusList(
+		goCtx,
+		testssi.GenerateUpdateCredStatusListRPCElements(alice_kp, updatedCredentialStatusList, alice_didDoc.VerificationMethod[0]),
+	)
+	require.ErrorIs(t, err, types.ErrInvalidCredentialField)
+
+	t.Log("PASS: Alice updates the credential status list")
+	updatedCredentialStatusList.StatusPurpose = types.StatusPurposeRevocation
+	_, err = msgServer.UpdateCredentialStatusList(
+		goCtx,
+		testssi.GenerateUpdateCredStatusListRPCElements(alice_kp, updatedCredentialStatusList, alice_didDoc.VerificationMethod[0]),
+	)
+	require.NoError(t, err)
+
+	t.Log("Query the credential status list as a BitstringStatusListCredential")
+	res, err := k.CredentialStatusListByID(goCtx, &types.QueryCredentialStatusListRequest{Id: credentialStatusList.Id})
+	require.NoError(t, err)
+
+	credential := res.BitstringStatusListCredential
+	require.Equal(t, []string{types.VerifiableCredentialType, types.BitstringStatusListCredentialType}, credential.Type)
+	require.Equal(t, alice_didDoc.Id, credential.Issuer)
+	require.Equal(t, credentialStatusList.Id+"#list", credential.CredentialSubject.Id)
+	require.Equal(t, types.StatusPurposeRevocation, credential.CredentialSubject.StatusPurpose)
+	require.Equal(t, "2022-05-10T04:07:12Z", credential.ValidFrom)
+
+	decodedBitstring, err := types.DecodeStatusList(credential.CredentialSubject.EncodedList)
+	require.NoError(t, err)
+	require.Equal(t, bitstring, decodedBitstring)
+
+	t.Log("PASS: Registered credential status list document is returned along with its proof")
+	require.Equal(t, updatedCredentialStatusList.EncodedList, res.CredentialStatusList.CredentialStatusListDocument.EncodedList)
+	require.Equal(t, alice_didDoc.VerificationMethod[0].Id, res.CredentialStatusList.CredentialStatusListProof.VerificationMethod)
+}
@@ -1,7 +1,7 @@
 package crypto
 
 import (
-	cli "github.com/hypersign-protocol/hid-node/x/ssi/client/cli"
+	ssiclient "github.com/hypersign-protocol/hid-node/client/ssi"
 	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
 	"github.com/hypersign-protocol/hid-node/x/ssi/types"
 )
@@ -21,67 +21,25 @@ func GetPublicKeyAndOptionalID(keyPair IKeyPair) (string, string) {
 }
 
 func GetDocumentSignature(doc types.SsiMsg, docProof *types.DocumentProof, privateKey string) (string, error) {
-	var signature string
-
-	switch docProof.Type {
-	case types.Ed25519Signature2020:
-		var docBytes []byte
-		docBytes, err := ldcontext.Ed25519Signature2020Normalize(doc, docProof)
-		if err != nil {
-			return "", err
-		}
-
-		signature, err = cli.GetEd25519Signature2020(privateKey, docBytes)
-		if err != nil {
-			return "", err
-		}
-	case types.EcdsaSecp256k1Signature2019:
-		var docBytes []byte
-		docBytes, err := ldcontext.EcdsaSecp256k1Signature2019Normalize(doc, docProof)
-		if err != nil {
-			return "", err
-		}
-
-		signature, err = cli.GetEcdsaSecp256k1Signature2019(privateKey, docBytes)
-		if err != nil {
-			return "", err
-		}
-	case types.EcdsaSecp256k1RecoverySignature2020:
-		var docBytes []byte
-		docBytes, err := ldcontext.EcdsaSecp256k1RecoverySignature2020Normalize(doc, docProof)
-		if err != nil {
-			return "", err
-		}
+	docBytes, err := ldcontext.NormalizeByProofType(doc, docProof)
+	if err != nil {
+		return "", err
+	}
 
-		signature, err = cli.GetEcdsaSecp256k1RecoverySignature2020(privateKey, docBytes)
-		if err != nil {
-			return "", err
-		}
-	case types.BbsBlsSignature2020:
-		var docBytes []byte
-		docBytes, err := ldcontext.BbsBlsSignature2020Normalize(doc, docProof)
-		if err != nil {
-			return "", err
-		}
+	return ssiclient.GetSignature(docProof.Type, privateKey, docBytes)
+}
 
-		signature, err = cli.GetBbsBlsSignature2020(privateKey, docBytes)
-		if err != nil {
-			return "", err
-		}
-	case types.BJJSignature2021:
-		var docBytes []byte
-		docBytes, err := ldcontext.BJJSignature2021Normalize(doc, docProof)
-		if err != nil {
-			return "", err
-		}
+func SignCredential(keyPair IKeyPair, credential map[string]interface{}, credentialProof *types.DocumentProof) string {
+	credentialProof.Type = GetSignatureTypeFromVmType(keyPair.GetType())
 
-		signature, err = cli.GetBJJSignature2021(privateKey, docBytes)
-		if err != nil {
-			return "", err
-		}
-	default:
-		panic("recieved unsupported signing-algo. Supported algorithms are: [Ed25519Signature2020, EcdsaSecp256k1Signature2019, EcdsaSecp256k1RecoverySignature2020, BbsBlsSignature2020, BJJSignature2021]")
+	credentialBytes, err := ldcontext.NormalizeCredentialByProofType(credential, credentialProof)
+	if err != nil {
+		panic(err)
 	}
 
-	return signature, nil
+	signature, err := ssiclient.GetSignature(credentialProof.Type, keyPair.GetPrivateKey(), credentialBytes)
+	if err != nil {
+		panic(err)
+	}
+	return signature
 }
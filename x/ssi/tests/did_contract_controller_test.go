@@ -0,0 +1,209 @@
+package tests
+
+import (
+	"testing"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testconstants "github.com/hypersign-protocol/hid-node/x/ssi/tests/constants"
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+// mockContractKeeper is a set of addresses of deployed contracts
+type mockContractKeeper map[string]bool
+
+func (m mockContractKeeper) HasContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) bool {
+	return m[contractAddress.String()]
+}
+
+// mockBankKeeper is a set of account balances
+type mockBankKeeper map[string]sdk.Coins
+
+func (m mockBankKeeper) SpendableCoins(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return m[addr.String()]
+}
+
+func (m mockBankKeeper) SendCoinsFromAccountToModule(_ sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	balance, negative := m[senderAddr.String()].SafeSub(amt...)
+	if negative {
+		return errors.Wrapf(sdkerrors.ErrInsufficientFunds, "%v is smaller than %v", m[senderAddr.String()], amt)
+	}
+	m[senderAddr.String()] = balance
+	m[recipientModule] = m[recipientModule].Add(amt...)
+	return nil
+}
+
+func TestDidContractControllerTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+
+	daoContract := sdk.AccAddress([]byte("dao-multisig-contract-address-01")).String()
+	nonContract := sdk.AccAddress([]byte("externally-owned-account-address")).String()
+	k.SetContractKeeper(mockContractKeeper{daoContract: true}, mockBankKeeper{daoContract: sdk.NewCoins(sdk.NewInt64Coin("uhid", 100000))})
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Generate the DAO DID, controlled by its multisig contract")
+	daoDidId := "did:hid:" + testconstants.ChainNamespace + ":z6MkDaoMultisigContractControlledIdentity1"
+	daoDidDoc := &types.DidDocument{
+		Context: []string{
+			ldcontext.DidContext,
+			ldcontext.CosmWasmContractMethod2024Context,
+		},
+		Id:         daoDidId,
+		Controller: []string{daoDidId},
+		VerificationMethod: []*types.VerificationMethod{
+			{
+				Id:                  daoDidId + "#contract",
+				Type:                types.CosmWasmContractMethod2024,
+				Controller:          daoDidId,
+				BlockchainAccountId: "cosmos:" + ctx.ChainID() + ":" + daoContract,
+			},
+		},
+		CapabilityInvocation: []string{daoDidId + "#contract"},
+	}
+
+	t.Log("FAIL: DAO DID is registered by an account other than its contract")
+	daoRegisterMsg := testssi.GetRegisterDidDocumentRPC(daoDidDoc, []testcrypto.IKeyPair{})
+	_, err := msgServer.RegisterDID(goCtx, daoRegisterMsg)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	t.Log(err)
+
+	t.Log("FAIL: DAO DID is registered by an account which is not a contract")
+	daoDidDoc.VerificationMethod[0].BlockchainAccountId = "cosmos:" + ctx.ChainID() + ":" + nonContract
+	daoRegisterMsg = testssi.GetRegisterDidDocumentRPC(daoDidDoc, []testcrypto.IKeyPair{})
+	daoRegisterMsg.TxAuthor = nonContract
+	_, err = msgServer.RegisterDID(goCtx, daoRegisterMsg)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	t.Log(err)
+
+	t.Log("FAIL: DAO DID refers to its contract on another chain")
+	daoDidDoc.VerificationMethod[0].BlockchainAccountId = "cosmos:osmosis-1:" + daoContract
+	daoRegisterMsg = testssi.GetRegisterDidDocumentRPC(daoDidDoc, []testcrypto.IKeyPair{})
+	daoRegisterMsg.TxAuthor = daoContract
+	_, err = msgServer.RegisterDID(goCtx, daoRegisterMsg)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	t.Log(err)
+
+	t.Log("PASS: DAO DID is registered by its contract")
+	daoDidDoc.VerificationMethod[0].BlockchainAccountId = "cosmos:" + ctx.ChainID() + ":" + daoContract
+	daoRegisterMsg = testssi.GetRegisterDidDocumentRPC(daoDidDoc, []testcrypto.IKeyPair{})
+	daoRegisterMsg.TxAuthor = daoContract
+	_, err = msgServer.RegisterDID(goCtx, daoRegisterMsg)
+	require.NoError(t, err)
+
+	t.Log("FAIL: DAO DID is updated by an account other than its contract")
+	daoDidDoc.AlsoKnownAs = []string{"https://dao.example.com"}
+	daoUpdateMsg := testssi.GetUpdateDidDocumentRPC(k, ctx, daoDidDoc, []testcrypto.IKeyPair{})
+	daoUpdateMsg.TxAuthor = nonContract
+	_, err = msgServer.UpdateDID(goCtx, daoUpdateMsg)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	t.Log(err)
+
+	t.Log("PASS: DAO DID is updated by its contract")
+	daoUpdateMsg.TxAuthor = daoContract
+	_, err = msgServer.UpdateDID(goCtx, daoUpdateMsg)
+	require.NoError(t, err)
+
+	t.Log("Generate Alice's DID, controlled by the DAO DID")
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	alice_didDoc.Controller = []string{daoDidId}
+
+	t.Log("FAIL: Alice registers her DID without the authorisation of DAO")
+	_, err = msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	t.Log(err)
+
+	t.Log("PASS: DAO contract registers Alice's DID, signed by Alice")
+	aliceRegisterMsg := testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp})
+	aliceRegisterMsg.TxAuthor = daoContract
+	_, err = msgServer.RegisterDID(goCtx, aliceRegisterMsg)
+	require.NoError(t, err)
+
+	t.Log("FAIL: Alice's DID is deactivated without the authorisation of DAO")
+	_, err = msgServer.DeactivateDID(goCtx, testssi.GetDeactivateDidDocumentRPC(k, ctx, alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	t.Log(err)
+
+	t.Log("PASS: DAO contract deactivates Alice's DID")
+	aliceDeactivateMsg := testssi.GetDeactivateDidDocumentRPC(k, ctx, alice_didDoc, []testcrypto.IKeyPair{})
+	aliceDeactivateMsg.TxAuthor = daoContract
+	_, err = msgServer.DeactivateDID(goCtx, aliceDeactivateMsg)
+	require.NoError(t, err)
+
+	t.Log("FAIL: Bob adds a Verification Method of DAO contract to his DID")
+	bob_kp := testcrypto.GenerateEd25519KeyPair()
+	bob_didDoc := testssi.GenerateDidDoc(bob_kp)
+	bob_kp.VerificationMethodId = bob_didDoc.VerificationMethod[0].Id
+	_, err = msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(bob_didDoc, []testcrypto.IKeyPair{bob_kp}))
+	require.NoError(t, err)
+
+	bob_didDoc.Context = append(bob_didDoc.Context, ldcontext.CosmWasmContractMethod2024Context)
+	bob_didDoc.VerificationMethod = append(bob_didDoc.VerificationMethod, &types.VerificationMethod{
+		Id:                  bob_didDoc.Id + "#contract",
+		Type:                types.CosmWasmContractMethod2024,
+		Controller:          bob_didDoc.Id,
+		BlockchainAccountId: "cosmos:" + ctx.ChainID() + ":" + daoContract,
+	})
+	_, err = msgServer.UpdateDID(goCtx, testssi.GetUpdateDidDocumentRPC(k, ctx, bob_didDoc, []testcrypto.IKeyPair{bob_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	t.Log(err)
+
+}
+
+func TestContractSenderFeeTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+
+	daoContract := sdk.AccAddress([]byte("dao-multisig-contract-address-01")).String()
+	bankKeeper := mockBankKeeper{}
+	k.SetContractKeeper(mockContractKeeper{daoContract: true}, bankKeeper)
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+	params := *types.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+
+	t.Log("FAIL: DAO contract registers Alice's DID, without the funds to pay its fixed fee")
+	aliceRegisterMsg := testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp})
+	aliceRegisterMsg.TxAuthor = daoContract
+	_, err := msgServer.RegisterDID(goCtx, aliceRegisterMsg)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	t.Log(err)
+
+	t.Log("PASS: DAO contract registers Alice's DID, and pays its fixed fee")
+	bankKeeper[daoContract] = sdk.NewCoins(*params.RegisterDidFee).Add(*params.UpdateDidFee)
+	_, err = msgServer.RegisterDID(goCtx, aliceRegisterMsg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(*params.UpdateDidFee), bankKeeper[daoContract])
+	require.Equal(t, sdk.NewCoins(*params.RegisterDidFee), bankKeeper[authtypes.FeeCollectorName])
+
+	t.Log("PASS: Alice updates her DID, and is not charged by the msg server")
+	alice_didDoc.AlsoKnownAs = []string{"https://alice.example.com"}
+	_, err = msgServer.UpdateDID(goCtx, testssi.GetUpdateDidDocumentRPC(k, ctx, alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(*params.UpdateDidFee), bankKeeper[daoContract])
+	require.Equal(t, sdk.NewCoins(*params.RegisterDidFee), bankKeeper[authtypes.FeeCollectorName])
+
+	t.Log("PASS: DAO contract updates Alice's DID, and pays its fixed fee")
+	alice_didDoc.AlsoKnownAs = []string{"https://alice.example.org"}
+	aliceUpdateMsg := testssi.GetUpdateDidDocumentRPC(k, ctx, alice_didDoc, []testcrypto.IKeyPair{alice_kp})
+	aliceUpdateMsg.TxAuthor = daoContract
+	_, err = msgServer.UpdateDID(goCtx, aliceUpdateMsg)
+	require.NoError(t, err)
+	require.True(t, bankKeeper[daoContract].IsZero())
+	require.Equal(t, sdk.NewCoins(*params.RegisterDidFee).Add(*params.UpdateDidFee), bankKeeper[authtypes.FeeCollectorName])
+}
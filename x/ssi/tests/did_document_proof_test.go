@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypersign-protocol/hid-node/app"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/verifier"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+// queryDidDocumentByID performs the DidDocumentByID query through ABCI, the same way as RPC providers do
+func queryDidDocumentByID(t *testing.T, chain *ibctesting.TestChain, req *types.QueryDidDocumentRequest) (*types.QueryDidDocumentResponse, abci.ResponseQuery) {
+	reqBz, err := req.Marshal()
+	require.NoError(t, err)
+
+	abciRes := chain.App.Query(abci.RequestQuery{
+		Path:   "/hypersign.ssi.v1.Query/DidDocumentByID",
+		Data:   reqBz,
+		Height: chain.App.LastBlockHeight(),
+	})
+	if !abciRes.IsOK() {
+		return nil, abciRes
+	}
+
+	var res types.QueryDidDocumentResponse
+	require.NoError(t, res.Unmarshal(abciRes.Value))
+	return &res, abciRes
+}
+
+func TestDidDocumentProofTC(t *testing.T) {
+	setupIbcTestingApps(t)
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+
+	t.Log("Register Alice's and Bob's DIDs")
+	hidApp := chain.App.(*app.App)
+	var didDocs []*types.DidDocument
+	for i := 0; i < 2; i++ {
+		kp := testcrypto.GenerateEd25519KeyPair()
+		didDoc := testssi.GenerateDidDoc(kp)
+		hidApp.SsiKeeper.SetDidDocumentState(chain.GetContext(), &types.DidDocumentState{
+			DidDocument: didDoc,
+			DidDocumentMetadata: &types.DidDocumentMetadata{
+				Created:   "2024-01-01T00:00:00Z",
+				Updated:   "2024-01-01T00:00:00Z",
+				VersionId: "1",
+			},
+		})
+		didDocs = append(didDocs, didDoc)
+	}
+	alice_didDoc, bob_didDoc := didDocs[0], didDocs[1]
+	coordinator.CommitBlock(chain)
+	appHash := chain.App.LastCommitID().Hash
+
+	t.Log("PASS: Alice's DID is resolved without proof")
+	res, _ := queryDidDocumentByID(t, chain, &types.QueryDidDocumentRequest{DidId: alice_didDoc.Id})
+	require.Nil(t, res.Proof)
+	require.Error(t, verifier.VerifyDidDocumentResponse(alice_didDoc.Id, res, appHash))
+
+	t.Log("FAIL: proof is requested along with the version of Alice's DID")
+	_, abciRes := queryDidDocumentByID(t, chain, &types.QueryDidDocumentRequest{DidId: alice_didDoc.Id, VersionId: "1", Prove: true})
+	require.False(t, abciRes.IsOK())
+	t.Log(abciRes.Log)
+
+	t.Log("PASS: Alice's DID is resolved with proof, and verified against the app hash")
+	res, _ = queryDidDocumentByID(t, chain, &types.QueryDidDocumentRequest{DidId: alice_didDoc.Id, Prove: true})
+	require.NotNil(t, res.Proof)
+	require.Equal(t, chain.App.LastBlockHeight(), res.Height)
+	require.NoError(t, verifier.VerifyDidDocumentResponse(alice_didDoc.Id, res, appHash))
+
+	t.Log("FAIL: Alice's DID is verified against the app hash of another height")
+	coordinator.CommitBlock(chain)
+	require.Error(t, verifier.VerifyDidDocumentResponse(alice_didDoc.Id, res, chain.App.LastCommitID().Hash))
+
+	t.Log("FAIL: Alice's DID is verified as Bob's DID")
+	err := verifier.VerifyDidDocumentResponse(bob_didDoc.Id, res, appHash)
+	require.Error(t, err)
+	t.Log(err)
+
+	t.Log("FAIL: Alice's DID Document is altered by the RPC provider")
+	provenValue := res.DidDocumentStateValue
+	res.DidDocument.AlsoKnownAs = []string{"did:hid:devnet:z6MkimpersonatorDidDocument"}
+	err = verifier.VerifyDidDocumentResponse(alice_didDoc.Id, res, appHash)
+	require.Error(t, err)
+	t.Log(err)
+
+	t.Log("FAIL: Proven state of Alice's DID Document is altered by the RPC provider, along with the DID Document")
+	alteredDidDocumentState := &types.DidDocumentState{DidDocument: res.DidDocument, DidDocumentMetadata: res.DidDocumentMetadata}
+	res.DidDocumentStateValue, err = alteredDidDocumentState.Marshal()
+	require.NoError(t, err)
+	err = verifier.VerifyDidDocumentResponse(alice_didDoc.Id, res, appHash)
+	require.Error(t, err)
+	t.Log(err)
+	res.DidDocumentStateValue = provenValue
+
+	t.Log("FAIL: Bob's DID Document is served with the proof of Alice's DID")
+	bobRes, _ := queryDidDocumentByID(t, chain, &types.QueryDidDocumentRequest{DidId: bob_didDoc.Id, Prove: true})
+	bobRes.Proof = res.Proof
+	err = verifier.VerifyDidDocumentResponse(bob_didDoc.Id, bobRes, appHash)
+	require.Error(t, err)
+	t.Log(err)
+}
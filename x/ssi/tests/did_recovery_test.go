@@ -0,0 +1,218 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestDidRecoveryTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	require.NoError(t, k.SetParams(ctx, *types.DefaultParams()))
+	initiatedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(initiatedAt)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Bob's DID, who is the recovery of Alice's DID")
+	bob_kp := testcrypto.GenerateEd25519KeyPair()
+	bob_didDoc := testssi.GenerateDidDoc(bob_kp)
+	bob_kp.VerificationMethodId = bob_didDoc.VerificationMethod[0].Id
+	_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(bob_didDoc, []testcrypto.IKeyPair{bob_kp}))
+	require.NoError(t, err)
+
+	t.Log("Create Carol's DID")
+	carol_kp := testcrypto.GenerateEd25519KeyPair()
+	carol_didDoc := testssi.GenerateDidDoc(carol_kp)
+	carol_kp.VerificationMethodId = carol_didDoc.VerificationMethod[0].Id
+	_, err = msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(carol_didDoc, []testcrypto.IKeyPair{carol_kp}))
+	require.NoError(t, err)
+
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	alice_didDoc.Recovery = []string{bob_didDoc.Id}
+
+	t.Log("FAIL: Alice registers a DID having recovery, without the context defining it")
+	_, err = msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidDidDoc)
+
+	t.Log("PASS: Alice registers a DID with Bob as its recovery")
+	alice_didDoc.Context = append(alice_didDoc.Context, ldcontext.DidRecoveryContext)
+	_, err = msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+
+	t.Log("Alice loses her key, and generates a new one")
+	aliceNew_kp := testcrypto.GenerateEd25519KeyPair()
+	aliceNew_kp.VerificationMethodId = alice_didDoc.Id + "#key-2"
+	alice_recoveredDidDoc := &types.DidDocument{
+		Context:    alice_didDoc.Context,
+		Id:         alice_didDoc.Id,
+		Controller: alice_didDoc.Controller,
+		VerificationMethod: []*types.VerificationMethod{
+			{
+				Id:                 aliceNew_kp.VerificationMethodId,
+				Type:               aliceNew_kp.GetType(),
+				Controller:         alice_didDoc.Id,
+				PublicKeyMultibase: aliceNew_kp.GetPublicKey(),
+			},
+		},
+		Recovery: alice_didDoc.Recovery,
+	}
+
+	t.Log("FAIL: Carol, who is not the recovery of Alice's DID, initiates its recovery")
+	_, err = msgServer.InitiateDidRecovery(goCtx, testssi.GetInitiateDidRecoveryRPC(k, ctx, alice_recoveredDidDoc, []testcrypto.IKeyPair{carol_kp, aliceNew_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	t.Log("FAIL: Bob initiates the recovery of Alice's DID, without the signature of Alice's new key")
+	_, err = msgServer.InitiateDidRecovery(goCtx, testssi.GetInitiateDidRecoveryRPC(k, ctx, alice_recoveredDidDoc, []testcrypto.IKeyPair{bob_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	t.Log("FAIL: Recovery of Bob's DID is initiated, which does not have any recovery")
+	bob_recoveredDidDoc := testssi.GenerateDidDoc(bob_kp)
+	bob_recoveredDidDoc.CapabilityDelegation = []string{bob_kp.VerificationMethodId}
+	_, err = msgServer.InitiateDidRecovery(goCtx, testssi.GetInitiateDidRecoveryRPC(k, ctx, bob_recoveredDidDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidDidRecovery)
+
+	t.Log("PASS: Bob initiates the recovery of Alice's DID, along with Alice's new key")
+	ctx = ctx.WithTxBytes([]byte("initiate alice's recovery")).WithEventManager(sdk.NewEventManager())
+	goCtx = sdk.WrapSDKContext(ctx)
+	_, err = msgServer.InitiateDidRecovery(goCtx, testssi.GetInitiateDidRecoveryRPC(k, ctx, alice_recoveredDidDoc, []testcrypto.IKeyPair{bob_kp, aliceNew_kp}))
+	require.NoError(t, err)
+
+	effectiveAt := initiatedAt.Add(types.DefaultDidRecoveryDelay).Format(time.RFC3339)
+	initiatedEvent := getTypedEvent(t, ctx, &types.EventDidRecoveryInitiated{}).(*types.EventDidRecoveryInitiated)
+	require.Equal(t, alice_didDoc.Id, initiatedEvent.DidId)
+	require.Equal(t, effectiveAt, initiatedEvent.EffectiveAt)
+
+	recoveryRes, err := k.PendingDidRecovery(goCtx, &types.QueryPendingDidRecoveryRequest{DidId: alice_didDoc.Id})
+	require.NoError(t, err)
+	require.Equal(t, effectiveAt, recoveryRes.PendingDidRecovery.EffectiveAt)
+
+	t.Log("FAIL: Bob initiates another recovery of Alice's DID, while one is pending")
+	_, err = msgServer.InitiateDidRecovery(goCtx, testssi.GetInitiateDidRecoveryRPC(k, ctx, alice_recoveredDidDoc, []testcrypto.IKeyPair{bob_kp, aliceNew_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidDidRecovery)
+
+	t.Log("FAIL: Bob cancels the recovery, not being a controller of Alice's DID")
+	_, err = msgServer.CancelDidRecovery(goCtx, testssi.GetCancelDidRecoveryRPC(k, ctx, alice_didDoc.Id, []testcrypto.IKeyPair{bob_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	t.Log("PASS: Alice, having found her old key, cancels the recovery")
+	_, err = msgServer.CancelDidRecovery(goCtx, testssi.GetCancelDidRecoveryRPC(k, ctx, alice_didDoc.Id, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+	_, err = k.PendingDidRecovery(goCtx, &types.QueryPendingDidRecoveryRequest{DidId: alice_didDoc.Id})
+	require.ErrorIs(t, err, types.ErrDidRecoveryNotFound)
+
+	t.Log("FAIL: Bob replays the cancelled recovery of Alice's DID")
+	ctx = ctx.WithTxBytes([]byte("initiate alice's recovery again"))
+	goCtx = sdk.WrapSDKContext(ctx)
+	_, err = msgServer.InitiateDidRecovery(goCtx, testssi.GetInitiateDidRecoveryRPC(k, ctx, alice_recoveredDidDoc, []testcrypto.IKeyPair{bob_kp, aliceNew_kp}))
+	require.ErrorIs(t, err, types.ErrInvalidDidRecovery)
+
+	t.Log("PASS: Bob initiates the recovery of Alice's DID again, with new proofs")
+	initiateDidRecoveryRPC := testssi.GetInitiateDidRecoveryRPC(k, ctx, alice_recoveredDidDoc, []testcrypto.IKeyPair{bob_kp, aliceNew_kp})
+	for i, keyPair := range []testcrypto.IKeyPair{bob_kp, aliceNew_kp} {
+		initiateDidRecoveryRPC.DidDocumentProofs[i].Created = "2023-08-17T09:37:12Z"
+		initiateDidRecoveryRPC.DidDocumentProofs[i].ProofValue = testcrypto.SignGeneric(keyPair, alice_recoveredDidDoc, initiateDidRecoveryRPC.DidDocumentProofs[i])
+	}
+	_, err = msgServer.InitiateDidRecovery(goCtx, initiateDidRecoveryRPC)
+	require.NoError(t, err)
+
+	t.Log("PASS: Recovery does not take effect before the recovery delay")
+	ctx = ctx.WithBlockTime(initiatedAt.Add(24 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	ssi.EndBlocker(ctx, *k)
+	require.Equal(t, alice_kp.VerificationMethodId, testssi.QueryDid(k, ctx, alice_didDoc.Id).DidDocument.VerificationMethod[0].Id)
+
+	t.Log("PASS: Recovery takes effect after the recovery delay")
+	ctx = ctx.WithBlockTime(initiatedAt.Add(types.DefaultDidRecoveryDelay)).WithEventManager(sdk.NewEventManager())
+	ssi.EndBlocker(ctx, *k)
+
+	aliceDidDocState := testssi.QueryDid(k, ctx, alice_didDoc.Id)
+	require.Equal(t, aliceNew_kp.VerificationMethodId, aliceDidDocState.DidDocument.VerificationMethod[0].Id)
+
+	recoveredEvent := getTypedEvent(t, ctx, &types.EventDidRecovered{}).(*types.EventDidRecovered)
+	require.Equal(t, alice_didDoc.Id, recoveredEvent.DidId)
+	require.Equal(t, aliceDidDocState.DidDocumentMetadata.VersionId, recoveredEvent.VersionId)
+
+	_, err = k.PendingDidRecovery(sdk.WrapSDKContext(ctx), &types.QueryPendingDidRecoveryRequest{DidId: alice_didDoc.Id})
+	require.ErrorIs(t, err, types.ErrDidRecoveryNotFound)
+
+	t.Log("FAIL: Alice updates her DID with the lost key")
+	ctx = ctx.WithTxBytes([]byte("update alice's did"))
+	goCtx = sdk.WrapSDKContext(ctx)
+	alice_recoveredDidDoc.CapabilityDelegation = []string{aliceNew_kp.VerificationMethodId}
+	_, err = msgServer.UpdateDID(goCtx, testssi.GetUpdateDidDocumentRPC(k, ctx, alice_recoveredDidDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.Error(t, err)
+
+	t.Log("PASS: Alice updates her DID with the new key")
+	_, err = msgServer.UpdateDID(goCtx, testssi.GetUpdateDidDocumentRPC(k, ctx, alice_recoveredDidDoc, []testcrypto.IKeyPair{aliceNew_kp}))
+	require.NoError(t, err)
+}
+
+func TestDidRecoveryDroppedOnUpdateTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	require.NoError(t, k.SetParams(ctx, *types.DefaultParams()))
+	initiatedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(initiatedAt)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Alice's DID, having her recovery key in the DID Document of Bob")
+	bob_kp := testcrypto.GenerateEd25519KeyPair()
+	bob_didDoc := testssi.GenerateDidDoc(bob_kp)
+	bob_kp.VerificationMethodId = bob_didDoc.VerificationMethod[0].Id
+	_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(bob_didDoc, []testcrypto.IKeyPair{bob_kp}))
+	require.NoError(t, err)
+
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	alice_didDoc.Context = append(alice_didDoc.Context, ldcontext.DidRecoveryContext)
+	alice_didDoc.Recovery = []string{bob_kp.VerificationMethodId}
+	_, err = msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+
+	t.Log("PASS: Recovery of Alice's DID is initiated with the recovery key, without adding any new key")
+	alice_recoveredDidDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_recoveredDidDoc.Context = alice_didDoc.Context
+	alice_recoveredDidDoc.Recovery = alice_didDoc.Recovery
+	alice_recoveredDidDoc.CapabilityDelegation = []string{alice_kp.VerificationMethodId}
+	ctx = ctx.WithTxBytes([]byte("initiate alice's recovery"))
+	goCtx = sdk.WrapSDKContext(ctx)
+	_, err = msgServer.InitiateDidRecovery(goCtx, testssi.GetInitiateDidRecoveryRPC(k, ctx, alice_recoveredDidDoc, []testcrypto.IKeyPair{bob_kp}))
+	require.NoError(t, err)
+
+	t.Log("PASS: Alice updates her DID, while the recovery is pending")
+	ctx = ctx.WithTxBytes([]byte("update alice's did"))
+	goCtx = sdk.WrapSDKContext(ctx)
+	alice_updatedDidDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_updatedDidDoc.Context = alice_didDoc.Context
+	alice_updatedDidDoc.Recovery = alice_didDoc.Recovery
+	alice_updatedDidDoc.AssertionMethod = []string{alice_kp.VerificationMethodId}
+	_, err = msgServer.UpdateDID(goCtx, testssi.GetUpdateDidDocumentRPC(k, ctx, alice_updatedDidDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+
+	t.Log("PASS: Recovery is dropped, as Alice's DID was updated after it was initiated")
+	ctx = ctx.WithBlockTime(initiatedAt.Add(types.DefaultDidRecoveryDelay)).WithEventManager(sdk.NewEventManager())
+	ssi.EndBlocker(ctx, *k)
+
+	aliceDidDoc := testssi.QueryDid(k, ctx, alice_didDoc.Id).DidDocument
+	require.Equal(t, alice_updatedDidDoc.AssertionMethod, aliceDidDoc.AssertionMethod)
+	require.Empty(t, aliceDidDoc.CapabilityDelegation)
+
+	cancelledEvent := getTypedEvent(t, ctx, &types.EventDidRecoveryCancelled{}).(*types.EventDidRecoveryCancelled)
+	require.Equal(t, alice_didDoc.Id, cancelledEvent.DidId)
+
+	recoveriesRes, err := k.PendingDidRecoveries(sdk.WrapSDKContext(ctx), &types.QueryPendingDidRecoveriesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, recoveriesRes.PendingDidRecoveries)
+}
@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestResolveDidTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Alice's DID")
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	didDocTx := testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp})
+	_, err := msgServer.RegisterDID(goCtx, didDocTx)
+	require.NoError(t, err)
+
+	t.Log("PASS: Alice's DID is resolved in JSON-LD representation by default")
+	res, err := k.ResolveDid(goCtx, &types.QueryResolveDidRequest{DidId: alice_didDoc.Id})
+	require.NoError(t, err)
+	require.Empty(t, res.DidResolutionMetadata.Error)
+	require.Equal(t, types.DidLdJsonContentType, res.DidResolutionMetadata.ContentType)
+	require.Equal(t, []string{types.DidResolutionContext}, res.Context)
+	require.Equal(t, types.DidCoreContext, res.DidDocument.Context[0])
+	require.Equal(t, alice_didDoc.Id, res.DidDocument.Id)
+
+	t.Log("PASS: Alice's DID is resolved in JSON representation without @context")
+	res, err = k.ResolveDid(goCtx, &types.QueryResolveDidRequest{DidId: alice_didDoc.Id, Accept: types.DidJsonContentType})
+	require.NoError(t, err)
+	require.Empty(t, res.DidResolutionMetadata.Error)
+	require.Empty(t, res.DidDocument.Context)
+
+	t.Log("FAIL: Unsupported representation is requested")
+	res, err = k.ResolveDid(goCtx, &types.QueryResolveDidRequest{DidId: alice_didDoc.Id, Accept: "application/did+cbor"})
+	require.NoError(t, err)
+	require.Equal(t, types.DidResolutionErrorRepresentationNotSupported, res.DidResolutionMetadata.Error)
+	require.Nil(t, res.DidDocument)
+
+	t.Log("FAIL: Malformed DID is resolved")
+	res, err = k.ResolveDid(goCtx, &types.QueryResolveDidRequest{DidId: "did:example:123"})
+	require.NoError(t, err)
+	require.Equal(t, types.DidResolutionErrorInvalidDid, res.DidResolutionMetadata.Error)
+
+	t.Log("FAIL: Unregistered DID is resolved")
+	unregistered_didDoc := testssi.GenerateDidDoc(testcrypto.GenerateEd25519KeyPair())
+	res, err = k.ResolveDid(goCtx, &types.QueryResolveDidRequest{DidId: unregistered_didDoc.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.DidResolutionErrorNotFound, res.DidResolutionMetadata.Error)
+
+	t.Log("FAIL: Both versionId and versionTime are specified")
+	res, err = k.ResolveDid(goCtx, &types.QueryResolveDidRequest{
+		DidId:       alice_didDoc.Id,
+		VersionId:   "some-version",
+		VersionTime: "2023-01-01T00:00:00Z",
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.DidResolutionErrorInvalidOptions, res.DidResolutionMetadata.Error)
+
+	t.Log("Deactivate Alice's DID")
+	deactivateTx := testssi.GetDeactivateDidDocumentRPC(k, ctx, alice_didDoc, []testcrypto.IKeyPair{alice_kp})
+	_, err = msgServer.DeactivateDID(goCtx, deactivateTx)
+	require.NoError(t, err)
+
+	t.Log("FAIL: Deactivated DID is resolved with its metadata only")
+	res, err = k.ResolveDid(goCtx, &types.QueryResolveDidRequest{DidId: alice_didDoc.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.DidResolutionErrorDeactivated, res.DidResolutionMetadata.Error)
+	require.Nil(t, res.DidDocument)
+	require.True(t, res.DidDocumentMetadata.Deactivated)
+}
+
+func TestDereferenceDidUrlTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Alice's DID")
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	didDocTx := testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp})
+	_, err := msgServer.RegisterDID(goCtx, didDocTx)
+	require.NoError(t, err)
+
+	t.Log("Create Organization DID controlled by Alice, with a Verification Method controlled by Alice and a Service")
+	org_kp := testcrypto.GenerateEd25519KeyPair()
+	org_didDoc := testssi.GenerateDidDoc(org_kp)
+	org_didDoc.Controller = []string{alice_didDoc.Id}
+	org_didDoc.VerificationMethod[0].Controller = alice_didDoc.Id
+	org_kp.VerificationMethodId = org_didDoc.VerificationMethod[0].Id
+	org_didDoc.Service = []*types.Service{
+		{
+			Id:              org_didDoc.Id + "#linked-domain",
+			Type:            "LinkedDomains",
+			ServiceEndpoint: "https://org.example.com/api/",
+		},
+	}
+	didDocTx = testssi.GetRegisterDidDocumentRPC(org_didDoc, []testcrypto.IKeyPair{alice_kp, org_kp})
+	_, err = msgServer.RegisterDID(goCtx, didDocTx)
+	require.NoError(t, err)
+
+	t.Log("PASS: DID URL without fragment dereferences to the DID Document")
+	res, err := k.DereferenceDidUrl(goCtx, &types.QueryDereferenceDidUrlRequest{DidUrl: org_didDoc.Id})
+	require.NoError(t, err)
+	require.Empty(t, res.DereferencingMetadata.Error)
+	require.Equal(t, org_didDoc.Id, res.DidDocument.Id)
+
+	t.Log("PASS: Fragment dereferences to the Verification Method of Organization DID controlled by Alice")
+	res, err = k.DereferenceDidUrl(goCtx, &types.QueryDereferenceDidUrlRequest{DidUrl: org_didDoc.Id + "#key-1"})
+	require.NoError(t, err)
+	require.Empty(t, res.DereferencingMetadata.Error)
+	require.Equal(t, org_didDoc.VerificationMethod[0].Id, res.VerificationMethod.Id)
+	require.Equal(t, alice_didDoc.Id, res.VerificationMethod.Controller)
+
+	t.Log("PASS: Fragment dereferences to the Service")
+	res, err = k.DereferenceDidUrl(goCtx, &types.QueryDereferenceDidUrlRequest{DidUrl: org_didDoc.Id + "#linked-domain"})
+	require.NoError(t, err)
+	require.Empty(t, res.DereferencingMetadata.Error)
+	require.Equal(t, "LinkedDomains", res.Service.Type)
+
+	t.Log("PASS: Service and relativeRef parameters dereference to the service endpoint URL")
+	res, err = k.DereferenceDidUrl(goCtx, &types.QueryDereferenceDidUrlRequest{
+		DidUrl: org_didDoc.Id + "?service=linked-domain&relativeRef=" + "%2Fcredentials%2F1#status",
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.DereferencingMetadata.Error)
+	require.Equal(t, types.UriListContentType, res.DereferencingMetadata.ContentType)
+	require.Equal(t, "https://org.example.com/credentials/1#status", res.ContentUrl)
+
+	t.Log("FAIL: Fragment that is absent in the DID Document")
+	res, err = k.DereferenceDidUrl(goCtx, &types.QueryDereferenceDidUrlRequest{DidUrl: org_didDoc.Id + "#key-2"})
+	require.NoError(t, err)
+	require.Equal(t, types.DidResolutionErrorNotFound, res.DereferencingMetadata.Error)
+
+	t.Log("FAIL: Unknown service is selected")
+	res, err = k.DereferenceDidUrl(goCtx, &types.QueryDereferenceDidUrlRequest{DidUrl: org_didDoc.Id + "?service=messaging"})
+	require.NoError(t, err)
+	require.Equal(t, types.DidResolutionErrorNotFound, res.DereferencingMetadata.Error)
+
+	t.Log("FAIL: relativeRef is specified without service")
+	res, err = k.DereferenceDidUrl(goCtx, &types.QueryDereferenceDidUrlRequest{DidUrl: org_didDoc.Id + "?relativeRef=%2Fpath"})
+	require.NoError(t, err)
+	require.Equal(t, types.DidUrlDereferencingErrorInvalidDidUrl, res.DereferencingMetadata.Error)
+
+	t.Log("FAIL: Unsupported DID URL parameter is specified")
+	res, err = k.DereferenceDidUrl(goCtx, &types.QueryDereferenceDidUrlRequest{DidUrl: org_didDoc.Id + "?hl=abc"})
+	require.NoError(t, err)
+	require.Equal(t, types.DidUrlDereferencingErrorInvalidDidUrl, res.DereferencingMetadata.Error)
+}
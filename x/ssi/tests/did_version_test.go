@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestDidDocumentVersionsTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	registrationTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	updateTime := registrationTime.Add(24 * time.Hour)
+
+	t.Log("Create Alice's DID")
+	registrationCtx := ctx.WithBlockTime(registrationTime).WithTxBytes([]byte("register"))
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	didDocTx := testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp})
+	_, err := msgServer.RegisterDID(sdk.WrapSDKContext(registrationCtx), didDocTx)
+	require.NoError(t, err)
+	firstVersionId := testssi.QueryDid(k, registrationCtx, alice_didDoc.Id).DidDocumentMetadata.VersionId
+
+	t.Log("Alice rotates her key by replacing the verification method")
+	updateCtx := ctx.WithBlockTime(updateTime).WithTxBytes([]byte("update"))
+	alice_new_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_new_vm := &types.VerificationMethod{
+		Id:                 alice_didDoc.Id + "#key-2",
+		Type:               alice_didDoc.VerificationMethod[0].Type,
+		Controller:         alice_didDoc.Id,
+		PublicKeyMultibase: alice_new_kp.GetPublicKey(),
+	}
+	alice_new_kp.VerificationMethodId = alice_new_vm.Id
+	alice_updated_didDoc := *alice_didDoc
+	alice_updated_didDoc.VerificationMethod = []*types.VerificationMethod{alice_new_vm}
+	updateDidDocTx := testssi.GetUpdateDidDocumentRPC(k, updateCtx, &alice_updated_didDoc, []testcrypto.IKeyPair{alice_kp, alice_new_kp})
+	_, err = msgServer.UpdateDID(sdk.WrapSDKContext(updateCtx), updateDidDocTx)
+	require.NoError(t, err)
+
+	t.Log("PASS: Both versions of Alice's DID Document are listed")
+	versions, err := k.DidDocumentVersions(sdk.WrapSDKContext(updateCtx), &types.QueryDidDocumentVersionsRequest{DidId: alice_didDoc.Id})
+	require.NoError(t, err)
+	require.Len(t, versions.DidDocumentVersions, 2)
+
+	t.Log("PASS: The first version is resolvable by its version id and holds the rotated out key")
+	resolved, err := k.DidDocumentByID(sdk.WrapSDKContext(updateCtx), &types.QueryDidDocumentRequest{
+		DidId:     alice_didDoc.Id,
+		VersionId: firstVersionId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, alice_didDoc.VerificationMethod[0].Id, resolved.DidDocument.VerificationMethod[0].Id)
+
+	t.Log("PASS: The version active at a time between registration and update is the first version")
+	resolved, err = k.DidDocumentByID(sdk.WrapSDKContext(updateCtx), &types.QueryDidDocumentRequest{
+		DidId:       alice_didDoc.Id,
+		VersionTime: registrationTime.Add(time.Hour).Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+	require.Equal(t, firstVersionId, resolved.DidDocumentMetadata.VersionId)
+
+	t.Log("PASS: The version active after the update is the latest version")
+	resolved, err = k.DidDocumentByID(sdk.WrapSDKContext(updateCtx), &types.QueryDidDocumentRequest{
+		DidId:       alice_didDoc.Id,
+		VersionTime: updateTime.Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+	require.Equal(t, alice_new_vm.Id, resolved.DidDocument.VerificationMethod[0].Id)
+
+	t.Log("FAIL: No version of Alice's DID Document existed before its registration")
+	_, err = k.DidDocumentByID(sdk.WrapSDKContext(updateCtx), &types.QueryDidDocumentRequest{
+		DidId:       alice_didDoc.Id,
+		VersionTime: registrationTime.Add(-time.Hour).Format(time.RFC3339),
+	})
+	require.Error(t, err)
+}
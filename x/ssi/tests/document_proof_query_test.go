@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestVerifyDocumentProofQueryTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Alice's DID")
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	registerDidRPC := testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp})
+
+	t.Log("PASS: The proof of Alice's unregistered DID Document is verified with its own Verification Method")
+	res, err := k.VerifyDocumentProof(goCtx, &types.QueryVerifyDocumentProofRequest{
+		DidDocument:   alice_didDoc,
+		DocumentProof: registerDidRPC.DidDocumentProofs[0],
+	})
+	require.NoError(t, err)
+	require.True(t, res.Verified)
+
+	_, err = msgServer.RegisterDID(goCtx, registerDidRPC)
+	require.NoError(t, err)
+
+	credStatus := testssi.GenerateCredentialStatus(alice_kp, alice_didDoc.Id)
+	credStatusRPC := testssi.GenerateRegisterCredStatusRPCElements(alice_kp, credStatus, alice_didDoc.VerificationMethod[0])
+
+	t.Log("PASS: The proof of Credential Status signed by Alice is verified, without registering it")
+	res, err = k.VerifyDocumentProof(goCtx, &types.QueryVerifyDocumentProofRequest{
+		CredentialStatusDocument: credStatus,
+		DocumentProof:            credStatusRPC.CredentialStatusProof,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Verified)
+	require.NotEmpty(t, res.SigningInput)
+	for _, check := range res.Checks {
+		require.True(t, check.Passed)
+	}
+	require.Equal(t, types.ProofCheckSignature, res.Checks[len(res.Checks)-1].Name)
+
+	t.Log("FAIL: The Credential Status is tampered after being signed")
+	tamperedCredStatus := *credStatus
+	tamperedCredStatus.Remarks = "Tampered"
+	res, err = k.VerifyDocumentProof(goCtx, &types.QueryVerifyDocumentProofRequest{
+		CredentialStatusDocument: &tamperedCredStatus,
+		DocumentProof:            credStatusRPC.CredentialStatusProof,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Verified)
+	requireFailedProofCheck(t, res, types.ProofCheckSignature)
+
+	t.Log("FAIL: The Credential Status has an unsupported context")
+	unsupportedContextCredStatus := *credStatus
+	unsupportedContextCredStatus.Context = append([]string{"https://example.com/unsupported-context"}, credStatus.Context...)
+	res, err = k.VerifyDocumentProof(goCtx, &types.QueryVerifyDocumentProofRequest{
+		CredentialStatusDocument: &unsupportedContextCredStatus,
+		DocumentProof:            credStatusRPC.CredentialStatusProof,
+	})
+	require.NoError(t, err)
+	requireFailedProofCheck(t, res, types.ProofCheckContext)
+
+	t.Log("FAIL: The proof refers to a Verification Method absent in Alice's DID Document")
+	unknownVmProof := *credStatusRPC.CredentialStatusProof
+	unknownVmProof.VerificationMethod = alice_didDoc.Id + "#key-2"
+	res, err = k.VerifyDocumentProof(goCtx, &types.QueryVerifyDocumentProofRequest{
+		CredentialStatusDocument: credStatus,
+		DocumentProof:            &unknownVmProof,
+	})
+	require.NoError(t, err)
+	requireFailedProofCheck(t, res, types.ProofCheckVerificationMethod)
+
+	t.Log("FAIL: The proof type does not correspond to Alice's Verification Method")
+	wrongTypeProof := *credStatusRPC.CredentialStatusProof
+	wrongTypeProof.Type = types.EcdsaSecp256k1Signature2019
+	res, err = k.VerifyDocumentProof(goCtx, &types.QueryVerifyDocumentProofRequest{
+		CredentialStatusDocument: credStatus,
+		DocumentProof:            &wrongTypeProof,
+	})
+	require.NoError(t, err)
+	requireFailedProofCheck(t, res, types.ProofCheckProofType)
+
+	t.Log("FAIL: The proof has an invalid proof purpose")
+	invalidPurposeProof := *credStatusRPC.CredentialStatusProof
+	invalidPurposeProof.ProofPurpose = "invalidPurpose"
+	res, err = k.VerifyDocumentProof(goCtx, &types.QueryVerifyDocumentProofRequest{
+		CredentialStatusDocument: credStatus,
+		DocumentProof:            &invalidPurposeProof,
+	})
+	require.NoError(t, err)
+	requireFailedProofCheck(t, res, types.ProofCheckDocumentProof)
+
+	t.Log("FAIL: The request carries no document, or more than one")
+	_, err = k.VerifyDocumentProof(goCtx, &types.QueryVerifyDocumentProofRequest{
+		DocumentProof: credStatusRPC.CredentialStatusProof,
+	})
+	require.Error(t, err)
+	_, err = k.VerifyDocumentProof(goCtx, &types.QueryVerifyDocumentProofRequest{
+		DidDocument:              alice_didDoc,
+		CredentialStatusDocument: credStatus,
+		DocumentProof:            credStatusRPC.CredentialStatusProof,
+	})
+	require.Error(t, err)
+}
+
+// requireFailedProofCheck asserts that the verification stopped at the failure of expected check
+func requireFailedProofCheck(t *testing.T, res *types.QueryVerifyDocumentProofResponse, checkName string) {
+	require.False(t, res.Verified)
+	lastCheck := res.Checks[len(res.Checks)-1]
+	require.Equal(t, checkName, lastCheck.Name)
+	require.False(t, lastCheck.Passed)
+	require.NotEmpty(t, lastCheck.Message)
+}
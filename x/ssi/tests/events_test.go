@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testconstants "github.com/hypersign-protocol/hid-node/x/ssi/tests/constants"
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+// getTypedEvent returns the only typed event of the input event type emitted in context
+func getTypedEvent(t *testing.T, ctx sdk.Context, eventType proto.Message) proto.Message {
+	var typedEvents []proto.Message
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(eventType) {
+			continue
+		}
+		abciEvent := abci.Event(event)
+		typedEvent, err := sdk.ParseTypedEvent(abciEvent)
+		require.NoError(t, err)
+		typedEvents = append(typedEvents, typedEvent)
+	}
+	require.Len(t, typedEvents, 1)
+	return typedEvents[0]
+}
+
+// requireLegacyEvent checks that the deprecated untyped event of the input type is emitted in context,
+// carrying the transaction author
+func requireLegacyEvent(t *testing.T, ctx sdk.Context, eventType string, txAuthor string) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		require.Len(t, event.Attributes, 1)
+		require.Equal(t, "tx_author", event.Attributes[0].Key)
+		require.Equal(t, txAuthor, event.Attributes[0].Value)
+		return
+	}
+	require.Failf(t, "legacy event is not emitted", "event type %v", eventType)
+}
+
+func TestDidEvents(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	t.Log("Alice registers a DID Document")
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithTxBytes([]byte("register"))
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	_, err := msgServer.RegisterDID(sdk.WrapSDKContext(ctx), testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+
+	registeredVersionId := testssi.QueryDid(k, ctx, alice_didDoc.Id).DidDocumentMetadata.VersionId
+	registeredEvent := getTypedEvent(t, ctx, &types.EventDidRegistered{}).(*types.EventDidRegistered)
+	require.Equal(t, alice_didDoc.Id, registeredEvent.DidId)
+	require.Equal(t, registeredVersionId, registeredEvent.VersionId)
+	require.Equal(t, []string{alice_didDoc.Id}, registeredEvent.Controllers)
+	requireLegacyEvent(t, ctx, "create_did", testconstants.Creator)
+
+	t.Log("Alice adds a service to the DID Document")
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithTxBytes([]byte("update"))
+	alice_didDoc.Service = []*types.Service{
+		{
+			Id:              alice_didDoc.Id + "#linked-domain",
+			Type:            "LinkedDomains",
+			ServiceEndpoint: "https://example.com",
+		},
+	}
+	_, err = msgServer.UpdateDID(sdk.WrapSDKContext(ctx), testssi.GetUpdateDidDocumentRPC(k, ctx, alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+
+	updatedVersionId := testssi.QueryDid(k, ctx, alice_didDoc.Id).DidDocumentMetadata.VersionId
+	updatedEvent := getTypedEvent(t, ctx, &types.EventDidUpdated{}).(*types.EventDidUpdated)
+	require.Equal(t, alice_didDoc.Id, updatedEvent.DidId)
+	require.Equal(t, updatedVersionId, updatedEvent.VersionId)
+	require.Equal(t, registeredVersionId, updatedEvent.PreviousVersionId)
+	require.Equal(t, []string{"service"}, updatedEvent.ChangedFields)
+
+	t.Log("Alice deactivates the DID Document")
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithTxBytes([]byte("deactivate"))
+	_, err = msgServer.DeactivateDID(sdk.WrapSDKContext(ctx), testssi.GetDeactivateDidDocumentRPC(k, ctx, alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+
+	deactivatedEvent := getTypedEvent(t, ctx, &types.EventDidDeactivated{}).(*types.EventDidDeactivated)
+	require.Equal(t, alice_didDoc.Id, deactivatedEvent.DidId)
+	require.Equal(t, updatedVersionId, deactivatedEvent.PreviousVersionId)
+	require.Equal(t, testssi.QueryDid(k, ctx, alice_didDoc.Id).DidDocumentMetadata.VersionId, deactivatedEvent.VersionId)
+}
+
+func TestCredentialStatusEvents(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	_, err := msgServer.RegisterDID(sdk.WrapSDKContext(ctx), testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+
+	t.Log("Alice registers a credential status")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	credentialStatus := testssi.GenerateCredentialStatus(alice_kp, alice_didDoc.Id)
+	_, err = msgServer.RegisterCredentialStatus(
+		sdk.WrapSDKContext(ctx),
+		testssi.GenerateRegisterCredStatusRPCElements(alice_kp, credentialStatus, alice_didDoc.VerificationMethod[0]),
+	)
+	require.NoError(t, err)
+
+	registeredEvent := getTypedEvent(t, ctx, &types.EventCredentialStatusRegistered{}).(*types.EventCredentialStatusRegistered)
+	require.Equal(t, credentialStatus.Id, registeredEvent.CredentialId)
+	require.Equal(t, alice_didDoc.Id, registeredEvent.Issuer)
+	require.Equal(t, credentialStatus.CredentialMerkleRootHash, registeredEvent.CredentialMerkleRootHash)
+	requireLegacyEvent(t, ctx, "create_credential_status", testconstants.Creator)
+
+	t.Log("Alice suspends the credential")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	credentialStatus.Suspended = true
+	credentialStatus.Remarks = "Suspended temporarily"
+	_, err = msgServer.UpdateCredentialStatus(
+		sdk.WrapSDKContext(ctx),
+		testssi.GenerateUpdateCredStatusRPCElements(alice_kp, credentialStatus, alice_didDoc.VerificationMethod[0]),
+	)
+	require.NoError(t, err)
+
+	suspendedEvent := getTypedEvent(t, ctx, &types.EventCredentialSuspended{}).(*types.EventCredentialSuspended)
+	require.Equal(t, credentialStatus.Id, suspendedEvent.CredentialId)
+	require.Equal(t, "Suspended temporarily", suspendedEvent.Remarks)
+
+	t.Log("Alice unsuspends the credential")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	credentialStatus.Suspended = false
+	credentialStatus.Remarks = "Unsuspended"
+	_, err = msgServer.UpdateCredentialStatus(
+		sdk.WrapSDKContext(ctx),
+		testssi.GenerateUpdateCredStatusRPCElements(alice_kp, credentialStatus, alice_didDoc.VerificationMethod[0]),
+	)
+	require.NoError(t, err)
+
+	updatedEvent := getTypedEvent(t, ctx, &types.EventCredentialStatusUpdated{}).(*types.EventCredentialStatusUpdated)
+	require.Equal(t, []string{"suspended", "remarks"}, updatedEvent.ChangedFields)
+
+	t.Log("Alice revokes the credential")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	credentialStatus.Revoked = true
+	credentialStatus.Remarks = "Revoked"
+	_, err = msgServer.UpdateCredentialStatus(
+		sdk.WrapSDKContext(ctx),
+		testssi.GenerateUpdateCredStatusRPCElements(alice_kp, credentialStatus, alice_didDoc.VerificationMethod[0]),
+	)
+	require.NoError(t, err)
+
+	revokedEvent := getTypedEvent(t, ctx, &types.EventCredentialRevoked{}).(*types.EventCredentialRevoked)
+	require.Equal(t, credentialStatus.Id, revokedEvent.CredentialId)
+	require.Equal(t, alice_didDoc.Id, revokedEvent.Issuer)
+	require.Equal(t, "Revoked", revokedEvent.Remarks)
+}
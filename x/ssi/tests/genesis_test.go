@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testconstants "github.com/hypersign-protocol/hid-node/x/ssi/tests/constants"
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	genesisState := types.DefaultGenesis()
+	genesisState.ChainNamespace = testconstants.ChainNamespace
+	ssi.InitGenesis(ctx, *k, *genesisState)
+
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("PASS: Alice registers a DID Document and a Credential Schema, which must survive a genesis export and import")
+
+	t.Log("Create Alice's DID")
+	alice_kp := testcrypto.GenerateSecp256k1RecoveryKeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	didDocTx := testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp})
+	_, err := msgServer.RegisterDID(goCtx, didDocTx)
+	require.NoError(t, err)
+
+	t.Log("Alice registers a Credential Schema")
+	credentialSchema := testssi.GenerateSchema(alice_kp, alice_didDoc.Id)
+	schemaRPCElements := testssi.GenerateSchemaRPCElements(alice_kp, credentialSchema, alice_didDoc.VerificationMethod[0])
+	_, err = msgServer.RegisterCredentialSchema(goCtx, schemaRPCElements)
+	require.NoError(t, err)
+
+	t.Log("Export genesis state")
+	exportedGenesisState := ssi.ExportGenesis(ctx, *k)
+	require.NoError(t, exportedGenesisState.Validate())
+	require.Len(t, exportedGenesisState.DidDocuments, 1)
+	require.Len(t, exportedGenesisState.CredentialSchemas, 1)
+	require.Len(t, exportedGenesisState.BlockchainAccountIds, 1)
+	require.Equal(t, uint64(1), exportedGenesisState.DidDocumentCount)
+	require.Equal(t, uint64(1), exportedGenesisState.CredentialSchemaCount)
+
+	t.Log("Import the exported genesis state into a new chain")
+	newK, newCtx := TestKeeper(t)
+	ssi.InitGenesis(newCtx, *newK, *exportedGenesisState)
+	require.Equal(t, exportedGenesisState, ssi.ExportGenesis(newCtx, *newK))
+
+	t.Log("Alice's DID Document is resolvable on the new chain")
+	resolvedDidDocument := testssi.QueryDid(newK, newCtx, alice_didDoc.Id)
+	require.Equal(t, alice_didDoc.Id, resolvedDidDocument.DidDocument.Id)
+}
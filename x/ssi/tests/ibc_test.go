@@ -0,0 +1,189 @@
+package tests
+
+import (
+	"encoding/json"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypersign-protocol/hid-node/app"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+// setupIbcTestingApps sets up the ibc-go testing framework to run hid-node chains, each having
+// its own wasm directory
+func setupIbcTestingApps(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		appOptions := simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir()}
+		hidApp := app.NewHypersignApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions, nil)
+		return hidApp, app.NewDefaultGenesisState(hidApp.AppCodec())
+	}
+}
+
+// newSsiPath returns a path between the ssi ports of two chains
+func newSsiPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = types.PortID
+		endpoint.ChannelConfig.Version = types.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	return path
+}
+
+// relaySsiPacket sends the packet data from chain A, and returns the acknowledgement written by chain B
+func relaySsiPacket(t *testing.T, path *ibctesting.Path, packetData types.SsiPacketData) channeltypes.Acknowledgement {
+	timeoutHeight := clienttypes.NewHeight(1, 1000)
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, packetData.GetBytes())
+	require.NoError(t, err)
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(), sequence,
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		timeoutHeight, 0,
+	)
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+
+	ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	require.NoError(t, err)
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	return ack
+}
+
+func TestIbcSsiChannelHandshake(t *testing.T) {
+	setupIbcTestingApps(t)
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := newSsiPath(chainA, chainB)
+	coordinator.SetupConnections(path)
+
+	ssiModule, ok := chainA.App.GetIBCKeeper().Router.GetRoute(types.PortID)
+	require.True(t, ok)
+	counterparty := channeltypes.NewCounterparty(types.PortID, "")
+
+	t.Log("FAIL: ssi channel is opened with an unsupported version")
+	_, err := ssiModule.OnChanOpenInit(
+		chainA.GetContext(), channeltypes.UNORDERED, []string{path.EndpointA.ConnectionID},
+		types.PortID, "channel-0", nil, counterparty, "ssi-2",
+	)
+	require.ErrorIs(t, err, types.ErrInvalidVersion)
+	t.Log(err)
+
+	t.Log("FAIL: ssi channel is opened as an ordered channel")
+	_, err = ssiModule.OnChanOpenInit(
+		chainA.GetContext(), channeltypes.ORDERED, []string{path.EndpointA.ConnectionID},
+		types.PortID, "channel-0", nil, counterparty, types.Version,
+	)
+	require.ErrorIs(t, err, channeltypes.ErrInvalidChannelOrdering)
+	t.Log(err)
+
+	t.Log("FAIL: ssi channel is opened by a counterparty with an unsupported version")
+	_, err = ssiModule.OnChanOpenTry(
+		chainA.GetContext(), channeltypes.UNORDERED, []string{path.EndpointA.ConnectionID},
+		types.PortID, "channel-0", nil, counterparty, "ssi-2",
+	)
+	require.ErrorIs(t, err, types.ErrInvalidVersion)
+	t.Log(err)
+
+	t.Log("PASS: ssi channel is opened between two chains")
+	coordinator.CreateChannels(path)
+	require.Equal(t, types.Version, path.EndpointA.GetChannel().Version)
+	require.Equal(t, types.Version, path.EndpointB.GetChannel().Version)
+}
+
+func TestIbcSsiQueryPackets(t *testing.T) {
+	setupIbcTestingApps(t)
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := newSsiPath(chainA, chainB)
+	coordinator.Setup(path)
+
+	t.Log("Register Alice's DID and a Credential Status on chain B")
+	hidAppB := chainB.App.(*app.App)
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	hidAppB.SsiKeeper.SetDidDocumentState(chainB.GetContext(), &types.DidDocumentState{
+		DidDocument: alice_didDoc,
+		DidDocumentMetadata: &types.DidDocumentMetadata{
+			Created:   "2024-01-01T00:00:00Z",
+			Updated:   "2024-01-01T00:00:00Z",
+			VersionId: "1",
+		},
+	})
+	credentialStatus := testssi.GenerateCredentialStatus(alice_kp, alice_didDoc.Id)
+	hidAppB.SsiKeeper.SetCredentialStatusState(chainB.GetContext(), &types.CredentialStatusState{
+		CredentialStatusDocument: credentialStatus,
+	})
+	coordinator.CommitBlock(chainB)
+
+	t.Log("PASS: Alice's DID is resolved by chain A")
+	ack := relaySsiPacket(t, path, types.SsiPacketData{
+		Packet: &types.SsiPacketData_DidResolutionPacket{
+			DidResolutionPacket: &types.DidResolutionPacketData{DidId: alice_didDoc.Id},
+		},
+	})
+	require.True(t, ack.Success(), ack.GetError())
+	var didResolutionAck types.DidResolutionPacketAck
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(ack.GetResult(), &didResolutionAck))
+	require.Empty(t, didResolutionAck.DidResolution.DidResolutionMetadata.Error)
+	require.Equal(t, alice_didDoc.Id, didResolutionAck.DidResolution.DidDocument.Id)
+	require.Equal(t, "1", didResolutionAck.DidResolution.DidDocumentMetadata.VersionId)
+	require.Positive(t, didResolutionAck.Height)
+	require.Less(t, didResolutionAck.Height, chainB.CurrentHeader.Height)
+
+	t.Log("PASS: resolution of an unregistered DID is acknowledged with the notFound error")
+	ack = relaySsiPacket(t, path, types.SsiPacketData{
+		Packet: &types.SsiPacketData_DidResolutionPacket{
+			DidResolutionPacket: &types.DidResolutionPacketData{DidId: alice_didDoc.Id + "abc"},
+		},
+	})
+	require.True(t, ack.Success(), ack.GetError())
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(ack.GetResult(), &didResolutionAck))
+	require.Equal(t, types.DidResolutionErrorNotFound, didResolutionAck.DidResolution.DidResolutionMetadata.Error)
+	require.Nil(t, didResolutionAck.DidResolution.DidDocument)
+
+	t.Log("PASS: Credential Status is queried by chain A")
+	ack = relaySsiPacket(t, path, types.SsiPacketData{
+		Packet: &types.SsiPacketData_CredentialStatusPacket{
+			CredentialStatusPacket: &types.CredentialStatusPacketData{CredId: credentialStatus.Id},
+		},
+	})
+	require.True(t, ack.Success(), ack.GetError())
+	var credentialStatusAck types.CredentialStatusPacketAck
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(ack.GetResult(), &credentialStatusAck))
+	require.Equal(t, credentialStatus.Id, credentialStatusAck.CredentialStatus.CredentialStatusDocument.Id)
+	require.Positive(t, credentialStatusAck.Height)
+
+	t.Log("FAIL: status of an unregistered Credential is queried by chain A")
+	ack = relaySsiPacket(t, path, types.SsiPacketData{
+		Packet: &types.SsiPacketData_CredentialStatusPacket{
+			CredentialStatusPacket: &types.CredentialStatusPacketData{CredId: credentialStatus.Id + "abc"},
+		},
+	})
+	require.False(t, ack.Success())
+	t.Log(ack.GetError())
+
+	t.Log("FAIL: packet without a query is sent by chain A")
+	ack = relaySsiPacket(t, path, types.SsiPacketData{})
+	require.False(t, ack.Success())
+	t.Log(ack.GetError())
+}
@@ -0,0 +1,149 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestDidControllerIndexTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	t.Log("Create Alice's and Bob's DIDs")
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	didDocTx := testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp})
+	_, err := msgServer.RegisterDID(sdk.WrapSDKContext(ctx), didDocTx)
+	require.NoError(t, err)
+
+	bob_kp := testcrypto.GenerateEd25519KeyPair()
+	bob_didDoc := testssi.GenerateDidDoc(bob_kp)
+	bob_kp.VerificationMethodId = bob_didDoc.VerificationMethod[0].Id
+	didDocTx = testssi.GetRegisterDidDocumentRPC(bob_didDoc, []testcrypto.IKeyPair{bob_kp})
+	_, err = msgServer.RegisterDID(sdk.WrapSDKContext(ctx), didDocTx)
+	require.NoError(t, err)
+
+	t.Log("Create Organization DID controlled by Alice")
+	org_didDoc := testssi.GenerateDidDoc(testcrypto.GenerateEd25519KeyPair())
+	org_didDoc.Controller = []string{alice_didDoc.Id}
+	org_didDoc.VerificationMethod = []*types.VerificationMethod{}
+	didDocTx = testssi.GetRegisterDidDocumentRPC(org_didDoc, []testcrypto.IKeyPair{alice_kp})
+	_, err = msgServer.RegisterDID(sdk.WrapSDKContext(ctx), didDocTx)
+	require.NoError(t, err)
+
+	t.Log("PASS: Alice controls both her DID and Organization DID")
+	require.ElementsMatch(t, []string{alice_didDoc.Id, org_didDoc.Id}, queryDidsByController(t, k, ctx, alice_didDoc.Id))
+
+	t.Log("Alice hands over the control of Organization DID to Bob")
+	updateCtx := ctx.WithTxBytes([]byte("update"))
+	org_updated_didDoc := *org_didDoc
+	org_updated_didDoc.Controller = []string{bob_didDoc.Id}
+	updateDidDocTx := testssi.GetUpdateDidDocumentRPC(k, updateCtx, &org_updated_didDoc, []testcrypto.IKeyPair{alice_kp, bob_kp})
+	_, err = msgServer.UpdateDID(sdk.WrapSDKContext(updateCtx), updateDidDocTx)
+	require.NoError(t, err)
+
+	t.Log("PASS: Organization DID is listed under Bob and not under Alice")
+	require.ElementsMatch(t, []string{alice_didDoc.Id}, queryDidsByController(t, k, ctx, alice_didDoc.Id))
+	require.ElementsMatch(t, []string{bob_didDoc.Id, org_didDoc.Id}, queryDidsByController(t, k, ctx, bob_didDoc.Id))
+
+	t.Log("Bob deactivates Organization DID")
+	deactivateCtx := ctx.WithTxBytes([]byte("deactivate"))
+	deactivateTx := testssi.GetDeactivateDidDocumentRPC(k, deactivateCtx, &org_updated_didDoc, []testcrypto.IKeyPair{bob_kp})
+	_, err = msgServer.DeactivateDID(sdk.WrapSDKContext(deactivateCtx), deactivateTx)
+	require.NoError(t, err)
+
+	t.Log("PASS: Deactivated Organization DID is not listed under Bob")
+	require.ElementsMatch(t, []string{bob_didDoc.Id}, queryDidsByController(t, k, ctx, bob_didDoc.Id))
+}
+
+func TestIssuerIndexesTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Alice's DID with a blockchainAccountId")
+	alice_kp := testcrypto.GenerateSecp256k1RecoveryKeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	didDocTx := testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp})
+	_, err := msgServer.RegisterDID(goCtx, didDocTx)
+	require.NoError(t, err)
+
+	t.Log("PASS: Alice's DID is found by its blockchainAccountId")
+	didRes, err := k.DidDocumentByBlockchainAccountId(goCtx, &types.QueryDidDocumentByBlockchainAccountIdRequest{
+		BlockchainAccountId: alice_didDoc.VerificationMethod[0].BlockchainAccountId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, alice_didDoc.Id, didRes.DidDocument.Id)
+
+	t.Log("FAIL: Unknown blockchainAccountId is queried")
+	_, err = k.DidDocumentByBlockchainAccountId(goCtx, &types.QueryDidDocumentByBlockchainAccountIdRequest{
+		BlockchainAccountId: "eip155:1:0x0000000000000000000000000000000000000000",
+	})
+	require.ErrorIs(t, err, types.ErrDidDocNotFound)
+
+	t.Log("Alice registers a schema and a credential status")
+	credentialSchema := testssi.GenerateSchema(alice_kp, alice_didDoc.Id)
+	schemaRPCElements := testssi.GenerateSchemaRPCElements(alice_kp, credentialSchema, alice_didDoc.VerificationMethod[0])
+	_, err = msgServer.RegisterCredentialSchema(goCtx, schemaRPCElements)
+	require.NoError(t, err)
+
+	credentialStatus := testssi.GenerateCredentialStatus(alice_kp, alice_didDoc.Id)
+	credentialStatusRPCElements := testssi.GenerateRegisterCredStatusRPCElements(alice_kp, credentialStatus, alice_didDoc.VerificationMethod[0])
+	_, err = msgServer.RegisterCredentialStatus(goCtx, credentialStatusRPCElements)
+	require.NoError(t, err)
+
+	t.Log("PASS: Alice's schema is listed under her DID")
+	schemaRes, err := k.CredentialSchemasByAuthor(goCtx, &types.QueryCredentialSchemasByAuthorRequest{Author: alice_didDoc.Id})
+	require.NoError(t, err)
+	require.Len(t, schemaRes.CredentialSchemas, 1)
+	require.Equal(t, credentialSchema.Id, schemaRes.CredentialSchemas[0].CredentialSchemaDocument.Id)
+
+	t.Log("PASS: Alice's credential status is listed under her DID")
+	statusRes, err := k.CredentialStatusesByIssuer(goCtx, &types.QueryCredentialStatusesByIssuerRequest{Issuer: alice_didDoc.Id})
+	require.NoError(t, err)
+	require.Len(t, statusRes.CredentialStatuses, 1)
+	require.Equal(t, credentialStatus.Id, statusRes.CredentialStatuses[0].CredentialStatusDocument.Id)
+}
+
+func TestStaleSchemaAuthorIndexTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Index a Credential Schema against Alice's DID, without storing the schema")
+	alice_didId := "did:hid:devnet:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK"
+	schemaKey, err := types.GetCredentialSchemaKey("sch:hid:devnet:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK:1.0")
+	require.NoError(t, err)
+
+	storeKey := ctx.MultiStore().(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	}).StoreKeysByName()[types.StoreKey]
+	prefix.NewStore(ctx.KVStore(storeKey), types.GetSchemaAuthorIndexPrefix(alice_didId)).Set(schemaKey, []byte{})
+
+	t.Log("FAIL: Stale index entry is reported instead of an empty schema")
+	_, err = k.CredentialSchemasByAuthor(goCtx, &types.QueryCredentialSchemasByAuthorRequest{Author: alice_didId})
+	require.Error(t, err)
+	require.ErrorContains(t, err, types.ErrCredentialSchemaNotFound.Error())
+	t.Log(err)
+}
+
+func queryDidsByController(t *testing.T, k *keeper.Keeper, ctx sdk.Context, controller string) []string {
+	res, err := k.DidDocumentsByController(sdk.WrapSDKContext(ctx), &types.QueryDidDocumentsByControllerRequest{Controller: controller})
+	require.NoError(t, err)
+
+	var didIds []string
+	for _, didDoc := range res.DidDocuments {
+		didIds = append(didIds, didDoc.DidDocument.Id)
+	}
+	return didIds
+}
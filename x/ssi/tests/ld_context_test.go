@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/stretchr/testify/require"
+)
+
+// The contexts introduced by hid-node are resolved by verifiers from the contexts directory
+// of the ld-context package, which must match the contexts used by hid-node for normalization
+func TestPublishedHidNodeContexts(t *testing.T) {
+	publishedContextsDir := filepath.Join("..", "ld-context", "contexts")
+
+	publishedContextFiles, err := filepath.Glob(filepath.Join(publishedContextsDir, "*.jsonld"))
+	require.NoError(t, err)
+
+	hidNodeContextCount := 0
+	for contextUrl, contextBody := range ldcontext.ContextUrlMap {
+		if !strings.HasPrefix(contextUrl, ldcontext.HidNodeContextsUrl) {
+			continue
+		}
+		hidNodeContextCount++
+
+		t.Logf("PASS: Context %v is published", contextUrl)
+		publishedContextBytes, err := os.ReadFile(filepath.Join(publishedContextsDir, strings.TrimPrefix(contextUrl, ldcontext.HidNodeContextsUrl)))
+		require.NoError(t, err)
+
+		var publishedContext map[string]interface{}
+		require.NoError(t, json.Unmarshal(publishedContextBytes, &publishedContext))
+
+		contextBytes, err := json.Marshal(map[string]interface{}{"@context": contextBody})
+		require.NoError(t, err)
+		var expectedContext map[string]interface{}
+		require.NoError(t, json.Unmarshal(contextBytes, &expectedContext))
+
+		require.Equal(t, expectedContext, publishedContext)
+	}
+
+	t.Log("PASS: Every published context is used by hid-node")
+	require.Len(t, publishedContextFiles, hidNodeContextCount)
+}
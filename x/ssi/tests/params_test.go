@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateParams(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	require.NoError(t, k.SetParams(ctx, *types.DefaultParams()))
+
+	newParams := *types.DefaultParams()
+	registerDidFee := sdk.NewInt64Coin("uhid", 8000)
+	newParams.RegisterDidFee = &registerDidFee
+
+	t.Log("FAIL: Params are updated by an address other than the authority")
+	_, err := msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(
+		"hid1m3h30wlvsf8llruxtpukdvsy0km2kum8al86ug",
+		newParams,
+	))
+	require.Error(t, err)
+	require.Equal(t, types.DefaultRegisterDIDFee, *k.GetParams(ctx).RegisterDidFee)
+
+	t.Log("FAIL: Params are updated with a fee of denom other than uhid")
+	invalidParams := *types.DefaultParams()
+	invalidFee := sdk.NewInt64Coin("stake", 100)
+	invalidParams.UpdateDidFee = &invalidFee
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(k.GetAuthority(), invalidParams))
+	require.Error(t, err)
+
+	t.Log("FAIL: Params are updated with a maximum credential status batch size of zero")
+	invalidParams = *types.DefaultParams()
+	invalidParams.MaxCredentialStatusBatchSize = 0
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(k.GetAuthority(), invalidParams))
+	require.Error(t, err)
+
+	t.Log("FAIL: Params are updated with a DID recovery delay of zero")
+	invalidParams = *types.DefaultParams()
+	invalidParams.DidRecoveryDelay = 0
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(k.GetAuthority(), invalidParams))
+	require.Error(t, err)
+
+	t.Log("PASS: Params are updated by the authority")
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(k.GetAuthority(), newParams))
+	require.NoError(t, err)
+
+	paramsRes, err := k.Params(goCtx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, registerDidFee, *paramsRes.Params.RegisterDidFee)
+
+	feeRes, err := k.QuerySSIFee(goCtx, &types.QuerySSIFeeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, registerDidFee, *feeRes.RegisterDidFee)
+}
@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestSchemaStatusTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Alice's DID")
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+
+	t.Log("Create Bob's DID")
+	bob_kp := testcrypto.GenerateEd25519KeyPair()
+	bob_didDoc := testssi.GenerateDidDoc(bob_kp)
+	bob_kp.VerificationMethodId = bob_didDoc.VerificationMethod[0].Id
+	_, err = msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(bob_didDoc, []testcrypto.IKeyPair{bob_kp}))
+	require.NoError(t, err)
+
+	t.Log("Alice registers a Credential Schema")
+	credentialSchema := testssi.GenerateSchema(alice_kp, alice_didDoc.Id)
+	_, err = msgServer.RegisterCredentialSchema(goCtx, testssi.GenerateSchemaRPCElements(alice_kp, credentialSchema, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	t.Log("PASS: Registered Credential Schema is active")
+	res, err := k.CredentialSchemaByID(goCtx, &types.QueryCredentialSchemaRequest{SchemaId: credentialSchema.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.CREDENTIAL_SCHEMA_STATUS_ACTIVE, res.CredentialSchemas[0].Status)
+
+	t.Log("FAIL: Bob attempts to deprecate Alice's Credential Schema")
+	schemaStatus := testssi.GenerateSchemaStatus(bob_kp, credentialSchema.Id, types.CredentialSchemaStatusDeprecatedValue)
+	_, err = msgServer.UpdateCredentialSchemaStatus(goCtx, testssi.GenerateSchemaStatusRPCElements(bob_kp, schemaStatus, bob_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidProof)
+	t.Log(err)
+
+	t.Log("FAIL: Alice attempts to change the status of Credential Schema to an unknown status")
+	schemaStatus = testssi.GenerateSchemaStatus(alice_kp, credentialSchema.Id, "retired")
+	_, err = msgServer.UpdateCredentialSchemaStatus(goCtx, testssi.GenerateSchemaStatusRPCElements(alice_kp, schemaStatus, alice_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidCredentialSchema)
+	t.Log(err)
+
+	t.Log("FAIL: Alice attempts to change the status of an unregistered Credential Schema")
+	schemaStatus = testssi.GenerateSchemaStatus(alice_kp, credentialSchema.Id[:len(credentialSchema.Id)-3]+"2.0", types.CredentialSchemaStatusDeprecatedValue)
+	_, err = msgServer.UpdateCredentialSchemaStatus(goCtx, testssi.GenerateSchemaStatusRPCElements(alice_kp, schemaStatus, alice_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrCredentialSchemaNotFound)
+	t.Log(err)
+
+	t.Log("PASS: Alice deprecates her Credential Schema")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	goCtx = sdk.WrapSDKContext(ctx)
+	schemaStatus = testssi.GenerateSchemaStatus(alice_kp, credentialSchema.Id, types.CredentialSchemaStatusDeprecatedValue)
+	_, err = msgServer.UpdateCredentialSchemaStatus(goCtx, testssi.GenerateSchemaStatusRPCElements(alice_kp, schemaStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	event := getTypedEvent(t, ctx, &types.EventSchemaStatusUpdated{}).(*types.EventSchemaStatusUpdated)
+	require.Equal(t, credentialSchema.Id, event.SchemaId)
+	require.Equal(t, alice_didDoc.Id, event.Author)
+	require.Equal(t, types.CredentialSchemaStatusDeprecatedValue, event.Status)
+
+	res, err = k.CredentialSchemaByID(goCtx, &types.QueryCredentialSchemaRequest{SchemaId: credentialSchema.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.CREDENTIAL_SCHEMA_STATUS_DEPRECATED, res.CredentialSchemas[0].Status)
+	require.Equal(t, schemaStatus.Remarks, res.CredentialSchemas[0].StatusRemarks)
+
+	t.Log("FAIL: Alice attempts to deprecate the deprecated Credential Schema again")
+	_, err = msgServer.UpdateCredentialSchemaStatus(goCtx, testssi.GenerateSchemaStatusRPCElements(alice_kp, schemaStatus, alice_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidCredentialSchema)
+	t.Log(err)
+
+	t.Log("PASS: Credential Status is registered against the deprecated Credential Schema")
+	credentialStatus := testssi.GenerateCredentialStatus(alice_kp, alice_didDoc.Id)
+	credentialStatus.Id = credentialStatus.Id + "1"
+	credentialStatus.Context[0] = ldcontext.CredentialStatusV2Context
+	credentialStatus.CredentialSchemaId = credentialSchema.Id
+	_, err = msgServer.RegisterCredentialStatus(goCtx, testssi.GenerateRegisterCredStatusRPCElements(alice_kp, credentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	t.Log("FAIL: Credential Status is registered against an unregistered Credential Schema")
+	unknownSchemaCredentialStatus := testssi.GenerateCredentialStatus(alice_kp, alice_didDoc.Id)
+	unknownSchemaCredentialStatus.Id = unknownSchemaCredentialStatus.Id + "2"
+	unknownSchemaCredentialStatus.Context[0] = ldcontext.CredentialStatusV2Context
+	unknownSchemaCredentialStatus.CredentialSchemaId = credentialSchema.Id[:len(credentialSchema.Id)-3] + "2.0"
+	_, err = msgServer.RegisterCredentialStatus(goCtx, testssi.GenerateRegisterCredStatusRPCElements(alice_kp, unknownSchemaCredentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrCredentialSchemaNotFound)
+	t.Log(err)
+
+	t.Log("PASS: Alice revokes her Credential Schema")
+	schemaStatus = testssi.GenerateSchemaStatus(alice_kp, credentialSchema.Id, types.CredentialSchemaStatusRevokedValue)
+	_, err = msgServer.UpdateCredentialSchemaStatus(goCtx, testssi.GenerateSchemaStatusRPCElements(alice_kp, schemaStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	res, err = k.CredentialSchemaByID(goCtx, &types.QueryCredentialSchemaRequest{SchemaId: credentialSchema.Id})
+	require.NoError(t, err)
+	require.Equal(t, types.CREDENTIAL_SCHEMA_STATUS_REVOKED, res.CredentialSchemas[0].Status)
+
+	t.Log("FAIL: Alice attempts to reactivate the revoked Credential Schema")
+	schemaStatus = testssi.GenerateSchemaStatus(alice_kp, credentialSchema.Id, types.CredentialSchemaStatusActiveValue)
+	_, err = msgServer.UpdateCredentialSchemaStatus(goCtx, testssi.GenerateSchemaStatusRPCElements(alice_kp, schemaStatus, alice_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrCredentialSchemaRevoked)
+	t.Log(err)
+
+	t.Log("FAIL: Credential Status is registered against the revoked Credential Schema")
+	revokedSchemaCredentialStatus := testssi.GenerateCredentialStatus(alice_kp, alice_didDoc.Id)
+	revokedSchemaCredentialStatus.Id = revokedSchemaCredentialStatus.Id + "3"
+	revokedSchemaCredentialStatus.Context[0] = ldcontext.CredentialStatusV2Context
+	revokedSchemaCredentialStatus.CredentialSchemaId = credentialSchema.Id
+	_, err = msgServer.RegisterCredentialStatus(goCtx, testssi.GenerateRegisterCredStatusRPCElements(alice_kp, revokedSchemaCredentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrCredentialSchemaRevoked)
+	t.Log(err)
+
+	t.Log("PASS: Credential Status without a Credential Schema is registered")
+	revokedSchemaCredentialStatus.CredentialSchemaId = ""
+	_, err = msgServer.RegisterCredentialStatus(goCtx, testssi.GenerateRegisterCredStatusRPCElements(alice_kp, revokedSchemaCredentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	t.Log("FAIL: Credential Schema of registered Credential Status is changed")
+	revokedSchemaCredentialStatus.CredentialSchemaId = credentialSchema.Id
+	revokedSchemaCredentialStatus.Suspended = true
+	_, err = msgServer.UpdateCredentialStatus(goCtx, testssi.GenerateUpdateCredStatusRPCElements(alice_kp, revokedSchemaCredentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidCredentialField)
+	t.Log(err)
+}
@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestSchemaVersionsTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Bob's DID")
+	bob_kp := testcrypto.GenerateEd25519KeyPair()
+	bob_didDoc := testssi.GenerateDidDoc(bob_kp)
+	bob_kp.VerificationMethodId = bob_didDoc.VerificationMethod[0].Id
+	didDocTx := testssi.GetRegisterDidDocumentRPC(bob_didDoc, []testcrypto.IKeyPair{bob_kp})
+	_, err := msgServer.RegisterDID(goCtx, didDocTx)
+	require.NoError(t, err)
+
+	t.Log("Bob registers versions 10.0, 2.0 and 1.0 of his schema")
+	credentialSchema := testssi.GenerateSchema(bob_kp, bob_didDoc.Id)
+	baseId := credentialSchema.Id[:strings.LastIndex(credentialSchema.Id, ":")]
+	for _, version := range []string{"10.0", "2.0", "1.0"} {
+		schemaVersion := *credentialSchema
+		schemaVersion.Id = baseId + ":" + version
+		schemaRPCElements := testssi.GenerateSchemaRPCElements(bob_kp, &schemaVersion, bob_didDoc.VerificationMethod[0])
+		_, err = msgServer.RegisterCredentialSchema(goCtx, schemaRPCElements)
+		require.NoError(t, err)
+	}
+
+	t.Log("FAIL: Bob registers version 10.0 of his schema again")
+	schemaVersion := *credentialSchema
+	schemaVersion.Id = baseId + ":10.0"
+	schemaRPCElements := testssi.GenerateSchemaRPCElements(bob_kp, &schemaVersion, bob_didDoc.VerificationMethod[0])
+	_, err = msgServer.RegisterCredentialSchema(goCtx, schemaRPCElements)
+	require.ErrorIs(t, err, types.ErrSchemaExists)
+
+	for _, version := range []string{"01.0", "1.00", "010"} {
+		t.Logf("FAIL: Bob registers version %v of his schema, which has leading zeros", version)
+		schemaVersion := *credentialSchema
+		schemaVersion.Id = baseId + ":" + version
+		schemaRPCElements := testssi.GenerateSchemaRPCElements(bob_kp, &schemaVersion, bob_didDoc.VerificationMethod[0])
+		_, err = msgServer.RegisterCredentialSchema(goCtx, schemaRPCElements)
+		require.ErrorContains(t, err, "leading zeros")
+	}
+
+	t.Log("PASS: Every version is returned in ascending order for the base id")
+	res, err := k.CredentialSchemaByID(goCtx, &types.QueryCredentialSchemaRequest{SchemaId: baseId})
+	require.NoError(t, err)
+	require.Len(t, res.CredentialSchemas, 3)
+	require.Equal(t, baseId+":1.0", res.CredentialSchemas[0].CredentialSchemaDocument.Id)
+	require.Equal(t, baseId+":2.0", res.CredentialSchemas[1].CredentialSchemaDocument.Id)
+	require.Equal(t, baseId+":10.0", res.CredentialSchemas[2].CredentialSchemaDocument.Id)
+
+	t.Log("PASS: Only the queried version is returned for the schema id with version")
+	res, err = k.CredentialSchemaByID(goCtx, &types.QueryCredentialSchemaRequest{SchemaId: baseId + ":10.0"})
+	require.NoError(t, err)
+	require.Len(t, res.CredentialSchemas, 1)
+	require.Equal(t, baseId+":10.0", res.CredentialSchemas[0].CredentialSchemaDocument.Id)
+
+	t.Log("PASS: Specific version of schema is queried")
+	res, err = k.CredentialSchemaByID(goCtx, &types.QueryCredentialSchemaRequest{SchemaId: baseId, Version: "2.0"})
+	require.NoError(t, err)
+	require.Equal(t, baseId+":2.0", res.CredentialSchemas[0].CredentialSchemaDocument.Id)
+
+	t.Log("PASS: Latest version of schema is queried")
+	res, err = k.CredentialSchemaByID(goCtx, &types.QueryCredentialSchemaRequest{SchemaId: baseId, Latest: true})
+	require.NoError(t, err)
+	require.Equal(t, baseId+":10.0", res.CredentialSchemas[0].CredentialSchemaDocument.Id)
+
+	t.Log("FAIL: Unregistered version of schema is queried")
+	_, err = k.CredentialSchemaByID(goCtx, &types.QueryCredentialSchemaRequest{SchemaId: baseId, Version: "3.0"})
+	require.Error(t, err)
+}
+
+func TestSchemaBaseIdSiblingTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	bob_kp := testcrypto.GenerateEd25519KeyPair()
+	bob_didDoc := testssi.GenerateDidDoc(bob_kp)
+	bob_kp.VerificationMethodId = bob_didDoc.VerificationMethod[0].Id
+
+	credentialSchema := testssi.GenerateSchema(bob_kp, bob_didDoc.Id)
+	baseId := credentialSchema.Id[:strings.LastIndex(credentialSchema.Id, ":")]
+	siblingBaseId := baseId + ":ext"
+
+	t.Log("Store version 1.0 of a base id, and versions 1.0 and 2.0 of its sibling base id which extends it")
+	for _, schemaId := range []string{baseId + ":1.0", siblingBaseId + ":1.0", siblingBaseId + ":2.0"} {
+		schemaVersion := *credentialSchema
+		schemaVersion.Id = schemaId
+		schemaRPCElements := testssi.GenerateSchemaRPCElements(bob_kp, &schemaVersion, bob_didDoc.VerificationMethod[0])
+		k.SetCredentialSchemaState(ctx, &types.CredentialSchemaState{
+			CredentialSchemaDocument: schemaRPCElements.CredentialSchemaDocument,
+			CredentialSchemaProof:    schemaRPCElements.CredentialSchemaProof,
+		})
+	}
+
+	t.Log("PASS: Only the versions of the base id are returned for the base id")
+	res, err := k.CredentialSchemaByID(goCtx, &types.QueryCredentialSchemaRequest{SchemaId: baseId})
+	require.NoError(t, err)
+	require.Len(t, res.CredentialSchemas, 1)
+	require.Equal(t, baseId+":1.0", res.CredentialSchemas[0].CredentialSchemaDocument.Id)
+
+	t.Log("PASS: Latest version of the base id is not a version of its sibling")
+	res, err = k.CredentialSchemaByID(goCtx, &types.QueryCredentialSchemaRequest{SchemaId: baseId, Latest: true})
+	require.NoError(t, err)
+	require.Equal(t, baseId+":1.0", res.CredentialSchemas[0].CredentialSchemaDocument.Id)
+
+	t.Log("PASS: Every version of the sibling base id is returned for the sibling base id")
+	res, err = k.CredentialSchemaByID(goCtx, &types.QueryCredentialSchemaRequest{SchemaId: siblingBaseId})
+	require.NoError(t, err)
+	require.Len(t, res.CredentialSchemas, 2)
+
+	t.Log("FAIL: Versions of the schemas are queried with a truncated base id")
+	res, err = k.CredentialSchemaByID(goCtx, &types.QueryCredentialSchemaRequest{SchemaId: baseId[:strings.LastIndex(baseId, ":")]})
+	require.NoError(t, err)
+	require.Empty(t, res.CredentialSchemas)
+}
+
+func TestLegacySchemaVersionLeadingZerosTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	bob_kp := testcrypto.GenerateEd25519KeyPair()
+	bob_didDoc := testssi.GenerateDidDoc(bob_kp)
+	bob_kp.VerificationMethodId = bob_didDoc.VerificationMethod[0].Id
+
+	credentialSchema := testssi.GenerateSchema(bob_kp, bob_didDoc.Id)
+	baseId := credentialSchema.Id[:strings.LastIndex(credentialSchema.Id, ":")]
+
+	t.Log("Store versions 1.0 and 01.0 of a base id, as registered before leading zeros were rejected")
+	for _, schemaId := range []string{baseId + ":1.0", baseId + ":01.0"} {
+		schemaVersion := *credentialSchema
+		schemaVersion.Id = schemaId
+		schemaRPCElements := testssi.GenerateSchemaRPCElements(bob_kp, &schemaVersion, bob_didDoc.VerificationMethod[0])
+		k.SetCredentialSchemaState(ctx, &types.CredentialSchemaState{
+			CredentialSchemaDocument: schemaRPCElements.CredentialSchemaDocument,
+			CredentialSchemaProof:    schemaRPCElements.CredentialSchemaProof,
+		})
+	}
+
+	t.Log("PASS: Each version is returned for its own schema id")
+	for _, schemaId := range []string{baseId + ":1.0", baseId + ":01.0"} {
+		res, err := k.CredentialSchemaByID(goCtx, &types.QueryCredentialSchemaRequest{SchemaId: schemaId})
+		require.NoError(t, err)
+		require.Len(t, res.CredentialSchemas, 1)
+		require.Equal(t, schemaId, res.CredentialSchemas[0].CredentialSchemaDocument.Id)
+	}
+
+	t.Log("PASS: Both versions are exported to genesis state")
+	require.Len(t, k.GetAllCredentialSchemaStates(ctx), 2)
+}
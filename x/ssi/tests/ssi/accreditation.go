@@ -0,0 +1,46 @@
+package ssi
+
+import (
+	"strings"
+
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+
+	testconstants "github.com/hypersign-protocol/hid-node/x/ssi/tests/constants"
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+)
+
+func GenerateAccreditation(keyPair testcrypto.IKeyPair, accreditorId string, accreditedIssuerId string, schemaId string) *types.AccreditationDocument {
+	var accreditationId = "acc:" + testconstants.DidMethod + ":" + testconstants.ChainNamespace + ":" + strings.Split(accreditedIssuerId, ":")[3]
+
+	var accreditation *types.AccreditationDocument = &types.AccreditationDocument{
+		Context: []string{
+			ldcontext.AccreditationContext,
+		},
+		Id:                 accreditationId,
+		Accreditor:         accreditorId,
+		AccreditedIssuer:   accreditedIssuerId,
+		CredentialSchemaId: schemaId,
+		ValidFrom:          "2023-01-01T00:00:00Z",
+		ValidUntil:         "2024-01-01T00:00:00Z",
+	}
+	accreditation.Context = append(accreditation.Context, GetContextFromKeyPair(keyPair)...)
+
+	return accreditation
+}
+
+func GenerateAccreditationRPCElements(keyPair testcrypto.IKeyPair, accreditation *types.AccreditationDocument, verficationMethod *types.VerificationMethod) *types.MsgRegisterAccreditation {
+	var accreditationProof *types.DocumentProof = &types.DocumentProof{
+		Created:            "2023-01-01T00:00:00Z",
+		VerificationMethod: verficationMethod.Id,
+		ProofPurpose:       "assertionMethod",
+	}
+
+	accreditationProof.ProofValue = testcrypto.SignGeneric(keyPair, accreditation, accreditationProof)
+
+	return &types.MsgRegisterAccreditation{
+		AccreditationDocument: accreditation,
+		AccreditationProof:    accreditationProof,
+		TxAuthor:              testconstants.Creator,
+	}
+}
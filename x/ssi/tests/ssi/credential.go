@@ -0,0 +1,82 @@
+package ssi
+
+import (
+	"encoding/json"
+
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	testconstants "github.com/hypersign-protocol/hid-node/x/ssi/tests/constants"
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// GenerateVerifiableCredential generates an unsigned Verifiable Credential, whose claims are described by the
+// schema generated through GenerateSchema
+func GenerateVerifiableCredential(keyPair testcrypto.IKeyPair, issuerId string, schemaId string) map[string]interface{} {
+	var credentialId = "vc:" + testconstants.DidMethod + ":" + testconstants.ChainNamespace + ":" + testcrypto.GenerateEd25519KeyPair().GetPublicKey()
+
+	var credentialContext = []interface{}{
+		ldcontext.CredentialsContext,
+		map[string]interface{}{
+			"hs":      "https://hypersign.id/vocab#",
+			"jayeshL": "hs:jayeshL",
+		},
+	}
+	for _, vmContextUrl := range GetContextFromKeyPair(keyPair) {
+		credentialContext = append(credentialContext, vmContextUrl)
+	}
+
+	return map[string]interface{}{
+		"@context":     credentialContext,
+		"id":           credentialId,
+		"type":         []interface{}{"VerifiableCredential"},
+		"issuer":       issuerId,
+		"issuanceDate": "2022-04-10T04:07:12Z",
+		"credentialSubject": map[string]interface{}{
+			"id":      "did:" + testconstants.DidMethod + ":" + testconstants.ChainNamespace + ":" + testcrypto.GenerateEd25519KeyPair().GetPublicKey(),
+			"jayeshL": "Student",
+		},
+		"credentialSchema": map[string]interface{}{
+			"id":   schemaId,
+			"type": "JsonSchemaValidator2018",
+		},
+	}
+}
+
+// SignVerifiableCredential adds the proof created by the input Verification Method to the Verifiable Credential,
+// and returns its JSON encoding
+func SignVerifiableCredential(keyPair testcrypto.IKeyPair, credential map[string]interface{}, verficationMethod *types.VerificationMethod) string {
+	var credentialProof *types.DocumentProof = &types.DocumentProof{
+		Created:            "2022-04-10T04:07:12Z",
+		VerificationMethod: verficationMethod.Id,
+		ProofPurpose:       "assertionMethod",
+	}
+	credentialProof.ProofValue = testcrypto.SignCredential(keyPair, credential, credentialProof)
+
+	credential["proof"] = map[string]interface{}{
+		"type":               credentialProof.Type,
+		"created":            credentialProof.Created,
+		"verificationMethod": credentialProof.VerificationMethod,
+		"proofPurpose":       credentialProof.ProofPurpose,
+		"proofValue":         credentialProof.ProofValue,
+	}
+
+	credentialJson, err := json.Marshal(credential)
+	if err != nil {
+		panic(err)
+	}
+	return string(credentialJson)
+}
+
+// GenerateCredentialStatusForCredential generates the Credential Status of Verifiable Credential, which carries
+// its Merkle root
+func GenerateCredentialStatusForCredential(keyPair testcrypto.IKeyPair, credential map[string]interface{}) *types.CredentialStatusDocument {
+	credentialMerkleRootHash, err := ldcontext.GetCredentialMerkleRootHash(credential)
+	if err != nil {
+		panic(err)
+	}
+
+	credentialStatus := GenerateCredentialStatus(keyPair, credential["issuer"].(string))
+	credentialStatus.Id = credential["id"].(string)
+	credentialStatus.CredentialMerkleRootHash = credentialMerkleRootHash
+	return credentialStatus
+}
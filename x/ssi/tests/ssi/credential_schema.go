@@ -55,3 +55,33 @@ func GenerateSchemaRPCElements(keyPair testcrypto.IKeyPair, credentialSchema *ty
 		TxAuthor:                 testconstants.Creator,
 	}
 }
+
+func GenerateSchemaStatus(keyPair testcrypto.IKeyPair, schemaId string, status string) *types.CredentialSchemaStatusDocument {
+	var schemaStatus *types.CredentialSchemaStatusDocument = &types.CredentialSchemaStatusDocument{
+		Context: []string{
+			ldcontext.CredentialSchemaStatusContext,
+		},
+		Id:      schemaId,
+		Status:  status,
+		Remarks: "Schema is " + status,
+	}
+	schemaStatus.Context = append(schemaStatus.Context, GetContextFromKeyPair(keyPair)...)
+
+	return schemaStatus
+}
+
+func GenerateSchemaStatusRPCElements(keyPair testcrypto.IKeyPair, schemaStatus *types.CredentialSchemaStatusDocument, verficationMethod *types.VerificationMethod) *types.MsgUpdateCredentialSchemaStatus {
+	var schemaStatusProof *types.DocumentProof = &types.DocumentProof{
+		Created:            "2022-04-10T04:07:12Z",
+		VerificationMethod: verficationMethod.Id,
+		ProofPurpose:       "assertionMethod",
+	}
+
+	schemaStatusProof.ProofValue = testcrypto.SignGeneric(keyPair, schemaStatus, schemaStatusProof)
+
+	return &types.MsgUpdateCredentialSchemaStatus{
+		CredentialSchemaStatusDocument: schemaStatus,
+		CredentialSchemaStatusProof:    schemaStatusProof,
+		TxAuthor:                       testconstants.Creator,
+	}
+}
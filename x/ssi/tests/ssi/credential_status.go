@@ -3,6 +3,7 @@ package ssi
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"strconv"
 	"strings"
 
 	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
@@ -65,3 +66,40 @@ func GenerateUpdateCredStatusRPCElements(keyPair testcrypto.IKeyPair, credential
 		TxAuthor:                 testconstants.Creator,
 	}
 }
+
+// GenerateCredentialStatusBatch returns a Credential Status Batch of the issuer holding batchSize Credential Statuses
+func GenerateCredentialStatusBatch(keyPair testcrypto.IKeyPair, issuerId string, batchSize int) *types.CredentialStatusBatchDocument {
+	var credentialStatusBatch *types.CredentialStatusBatchDocument = &types.CredentialStatusBatchDocument{
+		Context: []string{
+			ldcontext.CredentialStatusBatchContext,
+		},
+		Issuer: issuerId,
+	}
+	credentialStatusBatch.Context = append(credentialStatusBatch.Context, GetContextFromKeyPair(keyPair)...)
+
+	for i := 0; i < batchSize; i++ {
+		credentialStatus := GenerateCredentialStatus(keyPair, issuerId)
+		credentialStatus.Context = nil
+		credentialStatus.Id = credentialStatus.Id + strconv.Itoa(i)
+		credentialStatusBatch.CredentialStatuses = append(credentialStatusBatch.CredentialStatuses, credentialStatus)
+	}
+
+	return credentialStatusBatch
+}
+
+func GenerateRegisterCredStatusBatchRPCElements(keyPair testcrypto.IKeyPair, credentialStatusBatch *types.CredentialStatusBatchDocument, verficationMethod *types.VerificationMethod) *types.MsgRegisterCredentialStatusBatch {
+	var credentialProof *types.DocumentProof = &types.DocumentProof{
+		Created:            "2022-04-10T04:07:12Z",
+		VerificationMethod: verficationMethod.Id,
+		ProofPurpose:       "assertionMethod",
+	}
+
+	var credentialStatusBatchSignature string = testcrypto.SignGeneric(keyPair, credentialStatusBatch, credentialProof)
+	credentialProof.ProofValue = credentialStatusBatchSignature
+
+	return &types.MsgRegisterCredentialStatusBatch{
+		CredentialStatusBatchDocument: credentialStatusBatch,
+		CredentialStatusBatchProof:    credentialProof,
+		TxAuthor:                      testconstants.Creator,
+	}
+}
@@ -0,0 +1,73 @@
+package ssi
+
+import (
+	"strings"
+
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	testconstants "github.com/hypersign-protocol/hid-node/x/ssi/tests/constants"
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// GenerateCredentialStatusList returns a Credential Status List with every bit of the bitstring unset
+func GenerateCredentialStatusList(keyPair testcrypto.IKeyPair, issuerId string, statusPurpose string) *types.CredentialStatusListDocument {
+	var credentialStatusListId = "sl:" + testconstants.DidMethod + ":" + testconstants.ChainNamespace + ":" + strings.Split(issuerId, ":")[3]
+	var vmContextUrls = GetContextFromKeyPair(keyPair)
+
+	encodedList, err := types.EncodeStatusList(make([]byte, types.MinStatusListBitstringLength))
+	if err != nil {
+		panic(err)
+	}
+
+	var credentialStatusList *types.CredentialStatusListDocument = &types.CredentialStatusListDocument{
+		Context: []string{
+			ldcontext.CredentialStatusListContext,
+		},
+		Id:            credentialStatusListId,
+		Issuer:        issuerId,
+		StatusPurpose: statusPurpose,
+		EncodedList:   encodedList,
+		ValidFrom:     "2022-04-10T04:07:12Z",
+	}
+	credentialStatusList.Context = append(credentialStatusList.Context, vmContextUrls...)
+	return credentialStatusList
+}
+
+func getCredentialStatusListProof(
+	keyPair testcrypto.IKeyPair,
+	credentialStatusList *types.CredentialStatusListDocument,
+	verficationMethod *types.VerificationMethod,
+) *types.DocumentProof {
+	var credentialStatusListProof *types.DocumentProof = &types.DocumentProof{
+		Created:            credentialStatusList.ValidFrom,
+		VerificationMethod: verficationMethod.Id,
+		ProofPurpose:       "assertionMethod",
+	}
+
+	credentialStatusListProof.ProofValue = testcrypto.SignGeneric(keyPair, credentialStatusList, credentialStatusListProof)
+	return credentialStatusListProof
+}
+
+func GenerateRegisterCredStatusListRPCElements(
+	keyPair testcrypto.IKeyPair,
+	credentialStatusList *types.CredentialStatusListDocument,
+	verficationMethod *types.VerificationMethod,
+) *types.MsgRegisterCredentialStatusList {
+	return &types.MsgRegisterCredentialStatusList{
+		CredentialStatusListDocument: credentialStatusList,
+		CredentialStatusListProof:    getCredentialStatusListProof(keyPair, credentialStatusList, verficationMethod),
+		TxAuthor:                     testconstants.Creator,
+	}
+}
+
+func GenerateUpdateCredStatusListRPCElements(
+	keyPair testcrypto.IKeyPair,
+	credentialStatusList *types.CredentialStatusListDocument,
+	verficationMethod *types.VerificationMethod,
+) *types.MsgUpdateCredentialStatusList {
+	return &types.MsgUpdateCredentialStatusList{
+		CredentialStatusListDocument: credentialStatusList,
+		CredentialStatusListProof:    getCredentialStatusListProof(keyPair, credentialStatusList, verficationMethod),
+		TxAuthor:                     testconstants.Creator,
+	}
+}
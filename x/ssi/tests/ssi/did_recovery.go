@@ -0,0 +1,59 @@
+package ssi
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+
+	testconstants "github.com/hypersign-protocol/hid-node/x/ssi/tests/constants"
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+func GetInitiateDidRecoveryRPC(
+	k *keeper.Keeper,
+	ctx sdk.Context,
+	didDocument *types.DidDocument,
+	keyPairs []testcrypto.IKeyPair,
+) *types.MsgInitiateDidRecovery {
+	// Get Version ID
+	didDocFromState := QueryDid(k, ctx, didDocument.Id)
+	versionId := didDocFromState.DidDocumentMetadata.VersionId
+
+	var proofs []*types.DocumentProof = getDocumentProof(
+		didDocument,
+		keyPairs,
+	)
+
+	return &types.MsgInitiateDidRecovery{
+		DidDocument:       didDocument,
+		DidDocumentProofs: proofs,
+		TxAuthor:          testconstants.Creator,
+		VersionId:         versionId,
+	}
+}
+
+func GetCancelDidRecoveryRPC(
+	k *keeper.Keeper,
+	ctx sdk.Context,
+	didId string,
+	keyPairs []testcrypto.IKeyPair,
+) *types.MsgCancelDidRecovery {
+	// Controllers sign the replacement DID Document of the pending recovery
+	recoveryRes, err := k.PendingDidRecovery(sdk.WrapSDKContext(ctx), &types.QueryPendingDidRecoveryRequest{
+		DidId: didId,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var proofs []*types.DocumentProof = getDocumentProof(
+		recoveryRes.PendingDidRecovery.DidDocument,
+		keyPairs,
+	)
+
+	return &types.MsgCancelDidRecovery{
+		DidDocumentId:     didId,
+		DidDocumentProofs: proofs,
+		TxAuthor:          testconstants.Creator,
+	}
+}
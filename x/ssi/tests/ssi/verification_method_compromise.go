@@ -0,0 +1,52 @@
+package ssi
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+
+	testconstants "github.com/hypersign-protocol/hid-node/x/ssi/tests/constants"
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+func GenerateVerificationMethodCompromiseDocument(
+	signerKeyPair testcrypto.IKeyPair,
+	didId string,
+	verificationMethodId string,
+	compromisedSince string,
+) *types.VerificationMethodCompromiseDocument {
+	compromiseDocument := &types.VerificationMethodCompromiseDocument{
+		Context: []string{
+			ldcontext.VerificationMethodCompromiseContext,
+		},
+		Id:                   didId,
+		VerificationMethodId: verificationMethodId,
+		CompromisedSince:     compromisedSince,
+	}
+	compromiseDocument.Context = append(compromiseDocument.Context, GetContextFromKeyPair(signerKeyPair)...)
+	return compromiseDocument
+}
+
+func GetMarkVerificationMethodCompromisedRPC(
+	k *keeper.Keeper,
+	ctx sdk.Context,
+	compromiseDocument *types.VerificationMethodCompromiseDocument,
+	keyPairs []testcrypto.IKeyPair,
+) *types.MsgMarkVerificationMethodCompromised {
+	// Get Version ID
+	didDocFromState := QueryDid(k, ctx, compromiseDocument.Id)
+	versionId := didDocFromState.DidDocumentMetadata.VersionId
+
+	var proofs []*types.DocumentProof = getDocumentProof(
+		compromiseDocument,
+		keyPairs,
+	)
+
+	return &types.MsgMarkVerificationMethodCompromised{
+		CompromiseDocument:       compromiseDocument,
+		CompromiseDocumentProofs: proofs,
+		VersionId:                versionId,
+		TxAuthor:                 testconstants.Creator,
+	}
+}
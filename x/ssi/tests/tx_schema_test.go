@@ -4,7 +4,10 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/jsonschema"
 	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
 
 	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
 	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
@@ -196,4 +199,61 @@ func TestSchemaTC6(t *testing.T) {
 		t.Log(errExpectedToFail)
 		t.FailNow()
 	}
-}
\ No newline at end of file
+}
+func TestSchemaTC7(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Bob's DID")
+	bob_kp := testcrypto.GenerateEd25519KeyPair()
+	bob_didDoc := testssi.GenerateDidDoc(bob_kp)
+	bob_didDoc.Controller = append(bob_didDoc.Controller, bob_didDoc.Id)
+	bob_kp.VerificationMethodId = bob_didDoc.VerificationMethod[0].Id
+	didDocTx := testssi.GetRegisterDidDocumentRPC(bob_didDoc, []testcrypto.IKeyPair{bob_kp})
+	_, err := msgServer.RegisterDID(goCtx, didDocTx)
+	require.NoError(t, err)
+
+	t.Log("FAIL: Bob creates a Schema whose properties are nested beyond the maximum depth")
+	credentialSchema := testssi.GenerateSchema(bob_kp, bob_didDoc.Id)
+	deeplyNestedProperty := "{\"type\":\"string\"}"
+	for i := 0; i < jsonschema.MaxSchemaDepth; i++ {
+		deeplyNestedProperty = "{\"type\":\"object\",\"properties\":{\"nested\":" + deeplyNestedProperty + "}}"
+	}
+	credentialSchema.Schema.Properties = "{\"jayeshL\":" + deeplyNestedProperty + "}"
+	_, err = msgServer.RegisterCredentialSchema(goCtx, testssi.GenerateSchemaRPCElements(bob_kp, credentialSchema, bob_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidCredentialSchema)
+
+	t.Log("FAIL: Bob creates a Schema having an unresolvable $ref")
+	credentialSchema.Schema.Properties = "{\"jayeshL\":{\"$ref\":\"#/$defs/missing\"}}"
+	_, err = msgServer.RegisterCredentialSchema(goCtx, testssi.GenerateSchemaRPCElements(bob_kp, credentialSchema, bob_didDoc.VerificationMethod[0]))
+	require.ErrorIs(t, err, types.ErrInvalidCredentialSchema)
+
+	t.Log("PASS: Bob creates a Schema with nested objects, arrays, enums, patterns and references")
+	credentialSchema.Schema.Properties = `{
+		"jayeshL": {"type": "string", "enum": ["Student", "Teacher"]},
+		"address": {
+			"type": "object",
+			"$defs": {"postalCode": {"type": "string", "pattern": "^[0-9]{6}$"}},
+			"properties": {
+				"street": {"type": "string", "minLength": 1},
+				"postalCode": {"$ref": "#/properties/address/$defs/postalCode"}
+			},
+			"required": ["street"],
+			"additionalProperties": false
+		},
+		"education": {
+			"type": "array",
+			"minItems": 1,
+			"items": {
+				"type": "object",
+				"properties": {
+					"degree": {"type": "string"},
+					"graduated": {"type": "string", "format": "date"}
+				}
+			}
+		}
+	}`
+	_, err = msgServer.RegisterCredentialSchema(goCtx, testssi.GenerateSchemaRPCElements(bob_kp, credentialSchema, bob_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+}
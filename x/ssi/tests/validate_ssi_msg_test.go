@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestValidateSSIMsgQueryTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Alice's DID")
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+
+	t.Log("PASS: Bob validates the registration of his DID, which is not committed")
+	bob_kp := testcrypto.GenerateEd25519KeyPair()
+	bob_didDoc := testssi.GenerateDidDoc(bob_kp)
+	bob_kp.VerificationMethodId = bob_didDoc.VerificationMethod[0].Id
+	res := validateSSIMsg(t, k, goCtx, testssi.GetRegisterDidDocumentRPC(bob_didDoc, []testcrypto.IKeyPair{bob_kp}))
+	require.True(t, res.Valid)
+	require.Empty(t, res.Violations)
+	_, err = k.DidDocumentByID(goCtx, &types.QueryDidDocumentRequest{DidId: bob_didDoc.Id})
+	require.Error(t, err)
+
+	t.Log("FAIL: Alice's DID is registered again with an invalid proof and an unregistered controller, and every violation is reported")
+	alice_duplicateDidDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_duplicateDidDoc.Controller = []string{alice_didDoc.Id, bob_didDoc.Id}
+	registerRPC := testssi.GetRegisterDidDocumentRPC(alice_duplicateDidDoc, []testcrypto.IKeyPair{alice_kp})
+	registerRPC.DidDocumentProofs[0].ProofPurpose = "invalidPurpose"
+	res = validateSSIMsg(t, k, goCtx, registerRPC)
+	require.False(t, res.Valid)
+	requireViolations(t, res, types.ErrInvalidProof, types.ErrDidDocExists, types.ErrInvalidDidDoc)
+
+	t.Log("FAIL: Alice's DID is updated with an unexpected version id and an invalid proof, and every violation is reported")
+	alice_updatedDidDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_updatedDidDoc.CapabilityDelegation = []string{alice_kp.VerificationMethodId}
+	updateRPC := testssi.GetUpdateDidDocumentRPC(k, ctx, alice_updatedDidDoc, []testcrypto.IKeyPair{alice_kp})
+	updateRPC.VersionId = "unexpected-version-id"
+	updateRPC.DidDocumentProofs[0].Created = "invalid-date"
+	res = validateSSIMsg(t, k, goCtx, updateRPC)
+	requireViolations(t, res, types.ErrInvalidProof, types.ErrUnexpectedDidVersion)
+
+	t.Log("FAIL: Bob, who is not a controller, signs the update of Alice's DID")
+	res = validateSSIMsg(t, k, goCtx, testssi.GetUpdateDidDocumentRPC(k, ctx, alice_updatedDidDoc, []testcrypto.IKeyPair{bob_kp}))
+	requireViolations(t, res, types.ErrInvalidSignature)
+
+	t.Log("PASS: Alice validates the update of her DID, which is not committed")
+	res = validateSSIMsg(t, k, goCtx, testssi.GetUpdateDidDocumentRPC(k, ctx, alice_updatedDidDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.True(t, res.Valid)
+	require.Empty(t, testssi.QueryDid(k, ctx, alice_didDoc.Id).DidDocument.CapabilityDelegation)
+
+	t.Log("Alice registers a credential status")
+	credentialStatus := testssi.GenerateCredentialStatus(alice_kp, alice_didDoc.Id)
+	_, err = msgServer.RegisterCredentialStatus(goCtx, testssi.GenerateRegisterCredStatusRPCElements(alice_kp, credentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	t.Log("FAIL: Alice's credential status is registered again by Bob with an invalid proof, and every violation is reported")
+	bob_credentialStatus := *credentialStatus
+	bob_credentialStatus.Issuer = bob_didDoc.Id
+	credStatusRPC := testssi.GenerateRegisterCredStatusRPCElements(bob_kp, &bob_credentialStatus, bob_didDoc.VerificationMethod[0])
+	credStatusRPC.CredentialStatusProof.ProofPurpose = "invalidPurpose"
+	credStatusRPC.TxAuthor = sdk.AccAddress(make([]byte, 20)).String()
+	res = validateSSIMsg(t, k, goCtx, credStatusRPC)
+	requireViolations(t, res, types.ErrInvalidProof, types.ErrDidDocNotFound, types.ErrCredentialStatusExists)
+
+	t.Log("FAIL: Messages without their document or its proof are reported without being processed")
+	for _, msg := range []sdk.Msg{
+		&types.MsgRegisterDID{},
+		&types.MsgMarkVerificationMethodCompromised{},
+		&types.MsgRegisterCredentialSchema{},
+		&types.MsgUpdateCredentialSchemaStatus{},
+		&types.MsgRegisterCredentialStatus{CredentialStatusDocument: credentialStatus},
+		&types.MsgUpdateCredentialStatus{},
+		&types.MsgRegisterCredentialStatusBatch{},
+		&types.MsgRegisterCredentialStatusList{},
+		&types.MsgUpdateCredentialStatusList{},
+		&types.MsgRegisterAccreditation{},
+	} {
+		res = validateSSIMsg(t, k, goCtx, msg)
+		require.False(t, res.Valid)
+		require.Len(t, res.Violations, 1)
+	}
+
+	t.Log("FAIL: A message of x/ssi module which is not supported for validation")
+	msgAny, err := codectypes.NewAnyWithValue(&types.MsgUpdateParams{})
+	require.NoError(t, err)
+	_, err = k.ValidateSSIMsg(goCtx, &types.QueryValidateSSIMsgRequest{Msg: msgAny})
+	require.Error(t, err)
+}
+
+func validateSSIMsg(t *testing.T, k *keeper.Keeper, goCtx context.Context, msg sdk.Msg) *types.QueryValidateSSIMsgResponse {
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+
+	res, err := k.ValidateSSIMsg(goCtx, &types.QueryValidateSSIMsgRequest{Msg: msgAny})
+	require.NoError(t, err)
+	return res
+}
+
+// requireViolations asserts that the reported violations correspond to the expected errors, in the same order
+func requireViolations(t *testing.T, res *types.QueryValidateSSIMsgResponse, expectedErrs ...*errorsmod.Error) {
+	require.False(t, res.Valid)
+	require.Len(t, res.Violations, len(expectedErrs))
+	for i, expectedErr := range expectedErrs {
+		require.Equal(t, expectedErr.Codespace(), res.Violations[i].Codespace)
+		require.Equal(t, expectedErr.ABCICode(), res.Violations[i].Code)
+	}
+}
@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestVerificationMethodCompromiseTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	ctx = ctx.WithBlockTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Alice's DID with two Verification Methods")
+	alice_kp1 := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp1)
+	alice_kp1.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+
+	alice_kp2 := testcrypto.GenerateEd25519KeyPair()
+	alice_kp2.VerificationMethodId = alice_didDoc.Id + "#key-2"
+	alice_vm2 := &types.VerificationMethod{
+		Id:                 alice_kp2.VerificationMethodId,
+		Type:               alice_kp2.GetType(),
+		Controller:         alice_didDoc.Id,
+		PublicKeyMultibase: alice_kp2.GetPublicKey(),
+	}
+	alice_didDoc.VerificationMethod = append(alice_didDoc.VerificationMethod, alice_vm2)
+	alice_didDoc.AssertionMethod = append(alice_didDoc.AssertionMethod, alice_vm2.Id)
+
+	_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp1, alice_kp2}))
+	require.NoError(t, err)
+
+	compromisedSince := "2022-12-01T00:00:00Z"
+
+	t.Log("FAIL: Alice's second key vouches for its own compromise")
+	compromiseDocument := testssi.GenerateVerificationMethodCompromiseDocument(alice_kp2, alice_didDoc.Id, alice_vm2.Id, compromisedSince)
+	_, err = msgServer.MarkVerificationMethodCompromised(goCtx, testssi.GetMarkVerificationMethodCompromisedRPC(k, ctx, compromiseDocument, []testcrypto.IKeyPair{alice_kp2}))
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	t.Log("FAIL: Alice marks her second key as compromised since a future date")
+	compromiseDocument = testssi.GenerateVerificationMethodCompromiseDocument(alice_kp1, alice_didDoc.Id, alice_vm2.Id, "2023-02-01T00:00:00Z")
+	_, err = msgServer.MarkVerificationMethodCompromised(goCtx, testssi.GetMarkVerificationMethodCompromisedRPC(k, ctx, compromiseDocument, []testcrypto.IKeyPair{alice_kp1}))
+	require.ErrorIs(t, err, types.ErrInvalidDate)
+
+	t.Log("FAIL: Alice marks a Verification Method which was never a part of her DID Document")
+	compromiseDocument = testssi.GenerateVerificationMethodCompromiseDocument(alice_kp1, alice_didDoc.Id, alice_didDoc.Id+"#key-3", compromisedSince)
+	_, err = msgServer.MarkVerificationMethodCompromised(goCtx, testssi.GetMarkVerificationMethodCompromisedRPC(k, ctx, compromiseDocument, []testcrypto.IKeyPair{alice_kp1}))
+	require.ErrorIs(t, err, types.ErrVerificationMethodNotFound)
+
+	t.Log("PASS: Alice marks her second key as compromised, signed with her first key")
+	ctx = ctx.WithTxBytes([]byte("mark alice's second key compromised")).WithEventManager(sdk.NewEventManager())
+	goCtx = sdk.WrapSDKContext(ctx)
+	compromiseDocument = testssi.GenerateVerificationMethodCompromiseDocument(alice_kp1, alice_didDoc.Id, alice_vm2.Id, compromisedSince)
+	_, err = msgServer.MarkVerificationMethodCompromised(goCtx, testssi.GetMarkVerificationMethodCompromisedRPC(k, ctx, compromiseDocument, []testcrypto.IKeyPair{alice_kp1}))
+	require.NoError(t, err)
+
+	compromisedEvent := getTypedEvent(t, ctx, &types.EventVerificationMethodCompromised{}).(*types.EventVerificationMethodCompromised)
+	require.Equal(t, alice_vm2.Id, compromisedEvent.VerificationMethodId)
+	require.Equal(t, compromisedSince, compromisedEvent.CompromisedSince)
+
+	aliceDidDocState := testssi.QueryDid(k, ctx, alice_didDoc.Id)
+	require.Equal(t, compromisedEvent.VersionId, aliceDidDocState.DidDocumentMetadata.VersionId)
+	require.Len(t, aliceDidDocState.DidDocumentMetadata.CompromisedVerificationMethods, 1)
+	require.Equal(t, alice_vm2.Id, aliceDidDocState.DidDocumentMetadata.CompromisedVerificationMethods[0].VerificationMethodId)
+	require.Equal(t, compromisedSince, aliceDidDocState.DidDocumentMetadata.CompromisedVerificationMethods[0].CompromisedSince)
+
+	t.Log("PASS: The compromise of Alice's second key is exposed through DID resolution")
+	resolutionRes, err := k.ResolveDid(goCtx, &types.QueryResolveDidRequest{DidId: alice_didDoc.Id})
+	require.NoError(t, err)
+	require.Len(t, resolutionRes.DidDocumentMetadata.CompromisedVerificationMethods, 1)
+
+	t.Log("FAIL: Alice marks her second key as compromised again")
+	_, err = msgServer.MarkVerificationMethodCompromised(goCtx, testssi.GetMarkVerificationMethodCompromisedRPC(k, ctx, compromiseDocument, []testcrypto.IKeyPair{alice_kp1}))
+	require.ErrorIs(t, err, types.ErrVerificationMethodCompromised)
+
+	t.Log("FAIL: Alice's compromised second key removes her first key from the DID Document")
+	attacker_didDoc := testssi.GenerateDidDoc(alice_kp1)
+	attacker_didDoc.VerificationMethod = []*types.VerificationMethod{alice_vm2}
+	attacker_didDoc.Authentication = []string{alice_vm2.Id}
+	attacker_didDoc.AssertionMethod = []string{alice_vm2.Id}
+	_, err = msgServer.UpdateDID(goCtx, testssi.GetUpdateDidDocumentRPC(k, ctx, attacker_didDoc, []testcrypto.IKeyPair{alice_kp2}))
+	require.ErrorContains(t, err, types.ErrVerificationMethodCompromised.Error())
+
+	t.Log("FAIL: Alice's compromised second key deactivates her DID Document")
+	_, err = msgServer.DeactivateDID(goCtx, testssi.GetDeactivateDidDocumentRPC(k, ctx, alice_didDoc, []testcrypto.IKeyPair{alice_kp2}))
+	require.ErrorContains(t, err, types.ErrVerificationMethodCompromised.Error())
+
+	t.Log("FAIL: Alice registers a Credential Status signed with her second key after its compromise")
+	credStatus := testssi.GenerateCredentialStatus(alice_kp2, alice_didDoc.Id)
+	credStatusRPC := testssi.GenerateRegisterCredStatusRPCElements(alice_kp2, credStatus, alice_vm2)
+	credStatusRPC.CredentialStatusProof.Created = "2022-12-15T00:00:00Z"
+	credStatusRPC.CredentialStatusProof.ProofValue = testcrypto.SignGeneric(alice_kp2, credStatus, credStatusRPC.CredentialStatusProof)
+	_, err = msgServer.RegisterCredentialStatus(goCtx, credStatusRPC)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	t.Log("FAIL: Alice's compromised second key submits a Credential Status with a proof backdated before its compromise")
+	credStatusRPC = testssi.GenerateRegisterCredStatusRPCElements(alice_kp2, credStatus, alice_vm2)
+	_, err = msgServer.RegisterCredentialStatus(goCtx, credStatusRPC)
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	require.ErrorContains(t, err, types.ErrVerificationMethodCompromised.Error())
+
+	t.Log("PASS: A proof created by Alice's second key before its compromise remains valid for off-chain verification")
+	proofRes, err := k.VerifyDocumentProof(goCtx, &types.QueryVerifyDocumentProofRequest{
+		CredentialStatusDocument: credStatus,
+		DocumentProof:            credStatusRPC.CredentialStatusProof,
+	})
+	require.NoError(t, err)
+	require.True(t, proofRes.Verified)
+
+	t.Log("PASS: Alice removes her second key, and its compromise is retained in DID Document metadata")
+	ctx = ctx.WithTxBytes([]byte("remove alice's second key"))
+	goCtx = sdk.WrapSDKContext(ctx)
+	alice_updatedDidDoc := testssi.GenerateDidDoc(alice_kp1)
+	alice_updatedDidDoc.VerificationMethod = alice_didDoc.VerificationMethod[:1]
+	_, err = msgServer.UpdateDID(goCtx, testssi.GetUpdateDidDocumentRPC(k, ctx, alice_updatedDidDoc, []testcrypto.IKeyPair{alice_kp1}))
+	require.NoError(t, err)
+
+	aliceDidDocState = testssi.QueryDid(k, ctx, alice_didDoc.Id)
+	require.Len(t, aliceDidDocState.DidDocument.VerificationMethod, 1)
+	require.Len(t, aliceDidDocState.DidDocumentMetadata.CompromisedVerificationMethods, 1)
+}
@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/vc"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestVerifyCredentialTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("Create Alice's DID, having her key as an assertion method")
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_didDoc.AssertionMethod = []string{alice_didDoc.VerificationMethod[0].Id}
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+
+	t.Log("Alice registers a Credential Schema")
+	credentialSchema := testssi.GenerateSchema(alice_kp, alice_didDoc.Id)
+	_, err = msgServer.RegisterCredentialSchema(goCtx, testssi.GenerateSchemaRPCElements(alice_kp, credentialSchema, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	t.Log("Alice issues a Verifiable Credential and registers its Credential Status")
+	credential := testssi.GenerateVerifiableCredential(alice_kp, alice_didDoc.Id, credentialSchema.Id)
+	credentialStatus := testssi.GenerateCredentialStatusForCredential(alice_kp, credential)
+	credentialJson := testssi.SignVerifiableCredential(alice_kp, credential, alice_didDoc.VerificationMethod[0])
+	_, err = msgServer.RegisterCredentialStatus(goCtx, testssi.GenerateRegisterCredStatusRPCElements(alice_kp, credentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	t.Log("PASS: Alice's Verifiable Credential is verified")
+	res := verifyCredential(t, k, goCtx, credentialJson)
+	require.True(t, res.Verified)
+	for _, check := range res.Checks {
+		require.True(t, check.Passed, check.Name)
+	}
+	require.Equal(t, vc.CheckCredentialSchema, res.Checks[len(res.Checks)-1].Name)
+
+	t.Log("FAIL: Verifiable Credential whose claim is altered after signing")
+	tamperedCredential := testssi.GenerateVerifiableCredential(alice_kp, alice_didDoc.Id, credentialSchema.Id)
+	testssi.SignVerifiableCredential(alice_kp, tamperedCredential, alice_didDoc.VerificationMethod[0])
+	tamperedCredential["credentialSubject"].(map[string]interface{})["jayeshL"] = "Teacher"
+	tamperedCredentialJson := marshalCredential(t, tamperedCredential)
+	requireFailedCredentialCheck(t, verifyCredential(t, k, goCtx, tamperedCredentialJson), types.ProofCheckSignature)
+
+	t.Log("FAIL: Verifiable Credential whose Credential Status carries a different merkle root hash")
+	unmatchedCredential := testssi.GenerateVerifiableCredential(alice_kp, alice_didDoc.Id, credentialSchema.Id)
+	unmatchedCredentialStatus := testssi.GenerateCredentialStatus(alice_kp, alice_didDoc.Id)
+	unmatchedCredentialStatus.Id = unmatchedCredential["id"].(string)
+	unmatchedCredentialJson := testssi.SignVerifiableCredential(alice_kp, unmatchedCredential, alice_didDoc.VerificationMethod[0])
+	_, err = msgServer.RegisterCredentialStatus(goCtx, testssi.GenerateRegisterCredStatusRPCElements(alice_kp, unmatchedCredentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+	requireFailedCredentialCheck(t, verifyCredential(t, k, goCtx, unmatchedCredentialJson), vc.CheckCredentialMerkleRootHash)
+
+	t.Log("FAIL: Verifiable Credential having a claim which is not defined in its Credential Schema")
+	invalidSubjectCredential := testssi.GenerateVerifiableCredential(alice_kp, alice_didDoc.Id, credentialSchema.Id)
+	invalidSubjectCredential["@context"] = append(invalidSubjectCredential["@context"].([]interface{}), map[string]interface{}{
+		"grade": "https://hypersign.id/vocab#grade",
+	})
+	invalidSubjectCredential["credentialSubject"].(map[string]interface{})["grade"] = "A"
+	invalidSubjectCredentialStatus := testssi.GenerateCredentialStatusForCredential(alice_kp, invalidSubjectCredential)
+	invalidSubjectCredentialJson := testssi.SignVerifiableCredential(alice_kp, invalidSubjectCredential, alice_didDoc.VerificationMethod[0])
+	_, err = msgServer.RegisterCredentialStatus(goCtx, testssi.GenerateRegisterCredStatusRPCElements(alice_kp, invalidSubjectCredentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+	requireFailedCredentialCheck(t, verifyCredential(t, k, goCtx, invalidSubjectCredentialJson), vc.CheckCredentialSchema)
+
+	t.Log("Create Bob's DID, without any assertion method")
+	bob_kp := testcrypto.GenerateEd25519KeyPair()
+	bob_didDoc := testssi.GenerateDidDoc(bob_kp)
+	bob_kp.VerificationMethodId = bob_didDoc.VerificationMethod[0].Id
+	_, err = msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(bob_didDoc, []testcrypto.IKeyPair{bob_kp}))
+	require.NoError(t, err)
+
+	t.Log("FAIL: Verifiable Credential signed by a Verification Method which is not an assertion method of issuer")
+	bobCredential := testssi.GenerateVerifiableCredential(bob_kp, bob_didDoc.Id, credentialSchema.Id)
+	bobCredentialJson := testssi.SignVerifiableCredential(bob_kp, bobCredential, bob_didDoc.VerificationMethod[0])
+	requireFailedCredentialCheck(t, verifyCredential(t, k, goCtx, bobCredentialJson), types.ProofCheckVerificationMethod)
+
+	t.Log("FAIL: Verifiable Credential whose issuer is not the signer of its proof")
+	forgedCredential := testssi.GenerateVerifiableCredential(bob_kp, alice_didDoc.Id, credentialSchema.Id)
+	forgedCredentialJson := testssi.SignVerifiableCredential(bob_kp, forgedCredential, bob_didDoc.VerificationMethod[0])
+	requireFailedCredentialCheck(t, verifyCredential(t, k, goCtx, forgedCredentialJson), types.ProofCheckVerificationMethod)
+
+	t.Log("FAIL: Verifiable Credential whose Credential Schema is revoked")
+	schemaStatus := testssi.GenerateSchemaStatus(alice_kp, credentialSchema.Id, types.CredentialSchemaStatusRevokedValue)
+	_, err = msgServer.UpdateCredentialSchemaStatus(goCtx, testssi.GenerateSchemaStatusRPCElements(alice_kp, schemaStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+	requireFailedCredentialCheck(t, verifyCredential(t, k, goCtx, credentialJson), vc.CheckCredentialSchema)
+
+	t.Log("FAIL: Verifiable Credential whose Credential Status is revoked")
+	credentialStatus.Revoked = true
+	credentialStatus.Remarks = "Revoked"
+	_, err = msgServer.UpdateCredentialStatus(goCtx, testssi.GenerateUpdateCredStatusRPCElements(alice_kp, credentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+	requireFailedCredentialCheck(t, verifyCredential(t, k, goCtx, credentialJson), vc.CheckCredentialStatus)
+
+	t.Log("FAIL: Verifiable Credential whose issuer DID is deactivated")
+	_, err = msgServer.DeactivateDID(goCtx, testssi.GetDeactivateDidDocumentRPC(k, ctx, alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+	requireFailedCredentialCheck(t, verifyCredential(t, k, goCtx, credentialJson), vc.CheckIssuer)
+
+	t.Log("FAIL: Invalid Verifiable Credential JSON")
+	requireFailedCredentialCheck(t, verifyCredential(t, k, goCtx, "{\"id\": 1}"), vc.CheckCredential)
+}
+
+func verifyCredential(t *testing.T, k *keeper.Keeper, goCtx context.Context, credentialJson string) *types.QueryVerifyCredentialResponse {
+	res, err := k.VerifyCredential(goCtx, &types.QueryVerifyCredentialRequest{Credential: credentialJson})
+	require.NoError(t, err)
+	return res
+}
+
+// requireFailedCredentialCheck asserts that the verification stopped at the input check
+func requireFailedCredentialCheck(t *testing.T, res *types.QueryVerifyCredentialResponse, checkName string) {
+	require.False(t, res.Verified)
+	lastCheck := res.Checks[len(res.Checks)-1]
+	require.Equal(t, checkName, lastCheck.Name, lastCheck.Message)
+	require.False(t, lastCheck.Passed)
+	t.Log(lastCheck.Message)
+}
+
+func marshalCredential(t *testing.T, credential map[string]interface{}) string {
+	credentialJson, err := json.Marshal(credential)
+	require.NoError(t, err)
+	return string(credentialJson)
+}
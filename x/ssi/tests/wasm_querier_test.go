@@ -0,0 +1,110 @@
+package tests
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/wasmbinding"
+	"github.com/stretchr/testify/require"
+
+	testcrypto "github.com/hypersign-protocol/hid-node/x/ssi/tests/crypto"
+	testssi "github.com/hypersign-protocol/hid-node/x/ssi/tests/ssi"
+)
+
+func TestWasmCustomQuerierTC(t *testing.T) {
+	k, ctx := TestKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	querier := wasmbinding.CustomQuerier(k, cdc)
+
+	t.Log("Create Alice's DID, having her key as an assertion method")
+	alice_kp := testcrypto.GenerateEd25519KeyPair()
+	alice_didDoc := testssi.GenerateDidDoc(alice_kp)
+	alice_didDoc.AssertionMethod = []string{alice_didDoc.VerificationMethod[0].Id}
+	alice_kp.VerificationMethodId = alice_didDoc.VerificationMethod[0].Id
+	_, err := msgServer.RegisterDID(goCtx, testssi.GetRegisterDidDocumentRPC(alice_didDoc, []testcrypto.IKeyPair{alice_kp}))
+	require.NoError(t, err)
+
+	t.Log("Alice registers a Credential Schema, and issues a Verifiable Credential")
+	credentialSchema := testssi.GenerateSchema(alice_kp, alice_didDoc.Id)
+	_, err = msgServer.RegisterCredentialSchema(goCtx, testssi.GenerateSchemaRPCElements(alice_kp, credentialSchema, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+	credential := testssi.GenerateVerifiableCredential(alice_kp, alice_didDoc.Id, credentialSchema.Id)
+	credentialStatus := testssi.GenerateCredentialStatusForCredential(alice_kp, credential)
+	credentialJson := testssi.SignVerifiableCredential(alice_kp, credential, alice_didDoc.VerificationMethod[0])
+	_, err = msgServer.RegisterCredentialStatus(goCtx, testssi.GenerateRegisterCredStatusRPCElements(alice_kp, credentialStatus, alice_didDoc.VerificationMethod[0]))
+	require.NoError(t, err)
+
+	t.Log("PASS: Contract resolves Alice's DID, and is charged the gas of query")
+	gasBefore := ctx.GasMeter().GasConsumed()
+	resolveDidRequest := []byte(`{"didId": "` + alice_didDoc.Id + `"}`)
+	bz, err := querier(ctx, []byte(`{"resolve_did": `+string(resolveDidRequest)+`}`))
+	require.NoError(t, err)
+	require.GreaterOrEqual(
+		t,
+		ctx.GasMeter().GasConsumed()-gasBefore,
+		wasmbinding.ResolveDidGas+uint64(len(resolveDidRequest))*wasmbinding.QueryRequestGasPerByte,
+	)
+
+	var didDocumentRes types.QueryDidDocumentResponse
+	require.NoError(t, cdc.UnmarshalJSON(bz, &didDocumentRes))
+	require.Equal(t, alice_didDoc.Id, didDocumentRes.DidDocument.Id)
+
+	t.Log("PASS: Contract looks up the Credential Status")
+	bz, err = querier(ctx, []byte(`{"credential_status": {"credId": "`+credentialStatus.Id+`"}}`))
+	require.NoError(t, err)
+
+	var credentialStatusRes types.QueryCredentialStatusResponse
+	require.NoError(t, cdc.UnmarshalJSON(bz, &credentialStatusRes))
+	require.Equal(t, alice_didDoc.Id, credentialStatusRes.CredentialStatus.CredentialStatusDocument.Issuer)
+	require.False(t, credentialStatusRes.CredentialStatus.CredentialStatusDocument.Revoked)
+
+	t.Log("PASS: Contract looks up the Credential Schema")
+	bz, err = querier(ctx, []byte(`{"credential_schema": {"schemaId": "`+credentialSchema.Id+`"}}`))
+	require.NoError(t, err)
+
+	var credentialSchemaRes types.QueryCredentialSchemaResponse
+	require.NoError(t, cdc.UnmarshalJSON(bz, &credentialSchemaRes))
+	require.Equal(t, alice_didDoc.Id, credentialSchemaRes.CredentialSchemas[0].CredentialSchemaDocument.Author)
+
+	t.Log("FAIL: Contract verifies Alice's Verifiable Credential, which is not available to contracts")
+	verifyCredentialReq, err := json.Marshal(map[string]interface{}{
+		"verify_credential": map[string]string{"credential": credentialJson},
+	})
+	require.NoError(t, err)
+	_, err = querier(ctx, verifyCredentialReq)
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+	t.Log(err)
+
+	t.Log("FAIL: Contract sends a query exceeding the maximum request size")
+	_, err = querier(ctx, []byte(`{"resolve_did": {"didId": "`+strings.Repeat("a", wasmbinding.MaxQueryRequestSize)+`"}}`))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	t.Log(err)
+
+	t.Log("FAIL: Contract resolves an unregistered DID")
+	_, err = querier(ctx, []byte(`{"resolve_did": {"didId": "did:hid:devnet:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK"}}`))
+	require.Error(t, err)
+	t.Log(err)
+
+	t.Log("FAIL: Contract sends a query with an invalid request")
+	_, err = querier(ctx, []byte(`{"credential_status": {"credId": 1}}`))
+	require.Error(t, err)
+	t.Log(err)
+
+	t.Log("FAIL: Contract sends an unknown query")
+	_, err = querier(ctx, []byte(`{"resolve_schema": {}}`))
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+	t.Log(err)
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxAccreditationDelegationDepth is the maximum number of levels an accredited issuer can delegate its accreditation to
+const MaxAccreditationDelegationDepth = 8
+
+// Validate checks the fields of Accreditation Document. The accreditor is validated against the
+// accreditation path it is registered through.
+func (doc *AccreditationDocument) Validate() error {
+	if doc.Accreditor == "" {
+		return fmt.Errorf("accreditor cannot be empty")
+	}
+
+	if err := isValidDidDocId(doc.AccreditedIssuer); err != nil {
+		return fmt.Errorf("invalid accredited issuer %v: %v", doc.AccreditedIssuer, err)
+	}
+	if doc.AccreditedIssuer == doc.Accreditor {
+		return fmt.Errorf("%v cannot accredit itself", doc.Accreditor)
+	}
+
+	if _, _, err := SplitSchemaId(doc.CredentialSchemaId); err != nil {
+		return fmt.Errorf("invalid credential schema id %v: %v", doc.CredentialSchemaId, err)
+	}
+
+	validFrom, err := time.Parse(time.RFC3339, doc.ValidFrom)
+	if err != nil {
+		return fmt.Errorf("invalid validFrom %v, expected RFC3339 format", doc.ValidFrom)
+	}
+	validUntil, err := time.Parse(time.RFC3339, doc.ValidUntil)
+	if err != nil {
+		return fmt.Errorf("invalid validUntil %v, expected RFC3339 format", doc.ValidUntil)
+	}
+	if !validUntil.After(validFrom) {
+		return fmt.Errorf("validUntil %v must be after validFrom %v", doc.ValidUntil, doc.ValidFrom)
+	}
+
+	if doc.DelegationDepth > MaxAccreditationDelegationDepth {
+		return fmt.Errorf("delegation depth %v exceeds the maximum of %v", doc.DelegationDepth, MaxAccreditationDelegationDepth)
+	}
+	return nil
+}
+
+// IsValidAt checks if the accreditation is valid at the input time. An accreditation is valid from its
+// `validFrom` date, until its `validUntil` date.
+func (doc *AccreditationDocument) IsValidAt(t time.Time) bool {
+	validFrom, err := time.Parse(time.RFC3339, doc.ValidFrom)
+	if err != nil {
+		return false
+	}
+	validUntil, err := time.Parse(time.RFC3339, doc.ValidUntil)
+	if err != nil {
+		return false
+	}
+	return !t.Before(validFrom) && t.Before(validUntil)
+}
@@ -0,0 +1,973 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hypersign/ssi/v1/accreditation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AccreditationDocument accredits the DID `accreditedIssuer` to issue Credentials of the Credential Schema
+// `credentialSchemaId` from `validFrom` until `validUntil`. The `accreditor` is either the author of Credential Schema,
+// the x/ssi module authority, or an issuer accredited by `parentAccreditationId` to accredit further issuers.
+// `delegationDepth` is the number of further levels of accreditation the accredited issuer can issue.
+type AccreditationDocument struct {
+	Context               []string `protobuf:"bytes,1,rep,name=context,json=@context,proto3" json:"@context"`
+	Id                    string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Accreditor            string   `protobuf:"bytes,3,opt,name=accreditor,proto3" json:"accreditor,omitempty"`
+	AccreditedIssuer      string   `protobuf:"bytes,4,opt,name=accreditedIssuer,proto3" json:"accreditedIssuer,omitempty"`
+	CredentialSchemaId    string   `protobuf:"bytes,5,opt,name=credentialSchemaId,proto3" json:"credentialSchemaId,omitempty"`
+	ValidFrom             string   `protobuf:"bytes,6,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
+	ValidUntil            string   `protobuf:"bytes,7,opt,name=validUntil,proto3" json:"validUntil,omitempty"`
+	DelegationDepth       uint32   `protobuf:"varint,8,opt,name=delegationDepth,proto3" json:"delegationDepth,omitempty"`
+	ParentAccreditationId string   `protobuf:"bytes,9,opt,name=parentAccreditationId,proto3" json:"parentAccreditationId,omitempty"`
+}
+
+func (m *AccreditationDocument) Reset()         { *m = AccreditationDocument{} }
+func (m *AccreditationDocument) String() string { return proto.CompactTextString(m) }
+func (*AccreditationDocument) ProtoMessage()    {}
+func (*AccreditationDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe56aa1d34cd9beb, []int{0}
+}
+func (m *AccreditationDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccreditationDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccreditationDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccreditationDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccreditationDocument.Merge(m, src)
+}
+func (m *AccreditationDocument) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccreditationDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccreditationDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccreditationDocument proto.InternalMessageInfo
+
+func (m *AccreditationDocument) GetContext() []string {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+func (m *AccreditationDocument) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AccreditationDocument) GetAccreditor() string {
+	if m != nil {
+		return m.Accreditor
+	}
+	return ""
+}
+
+func (m *AccreditationDocument) GetAccreditedIssuer() string {
+	if m != nil {
+		return m.AccreditedIssuer
+	}
+	return ""
+}
+
+func (m *AccreditationDocument) GetCredentialSchemaId() string {
+	if m != nil {
+		return m.CredentialSchemaId
+	}
+	return ""
+}
+
+func (m *AccreditationDocument) GetValidFrom() string {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return ""
+}
+
+func (m *AccreditationDocument) GetValidUntil() string {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return ""
+}
+
+func (m *AccreditationDocument) GetDelegationDepth() uint32 {
+	if m != nil {
+		return m.DelegationDepth
+	}
+	return 0
+}
+
+func (m *AccreditationDocument) GetParentAccreditationId() string {
+	if m != nil {
+		return m.ParentAccreditationId
+	}
+	return ""
+}
+
+type AccreditationState struct {
+	AccreditationDocument *AccreditationDocument `protobuf:"bytes,1,opt,name=accreditationDocument,proto3" json:"accreditationDocument,omitempty"`
+	// Absent for an accreditation issued by the x/ssi module authority through governance
+	AccreditationProof *DocumentProof `protobuf:"bytes,2,opt,name=accreditationProof,proto3" json:"accreditationProof,omitempty"`
+}
+
+func (m *AccreditationState) Reset()         { *m = AccreditationState{} }
+func (m *AccreditationState) String() string { return proto.CompactTextString(m) }
+func (*AccreditationState) ProtoMessage()    {}
+func (*AccreditationState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe56aa1d34cd9beb, []int{1}
+}
+func (m *AccreditationState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccreditationState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccreditationState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccreditationState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccreditationState.Merge(m, src)
+}
+func (m *AccreditationState) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccreditationState) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccreditationState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccreditationState proto.InternalMessageInfo
+
+func (m *AccreditationState) GetAccreditationDocument() *AccreditationDocument {
+	if m != nil {
+		return m.AccreditationDocument
+	}
+	return nil
+}
+
+func (m *AccreditationState) GetAccreditationProof() *DocumentProof {
+	if m != nil {
+		return m.AccreditationProof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AccreditationDocument)(nil), "hypersign.ssi.v1.AccreditationDocument")
+	proto.RegisterType((*AccreditationState)(nil), "hypersign.ssi.v1.AccreditationState")
+}
+
+func init() {
+	proto.RegisterFile("hypersign/ssi/v1/accreditation.proto", fileDescriptor_fe56aa1d34cd9beb)
+}
+
+var fileDescriptor_fe56aa1d34cd9beb = []byte{
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x8a, 0xdb, 0x30,
+	0x14, 0x85, 0x23, 0x4f, 0x3b, 0x33, 0xd6, 0xf4, 0x67, 0x10, 0x0d, 0x88, 0x61, 0xf0, 0x98, 0x50,
+	0x88, 0x29, 0xc4, 0x26, 0x69, 0x1f, 0xa0, 0x0d, 0xa5, 0x10, 0x28, 0xb4, 0x38, 0x74, 0x53, 0xe8,
+	0x42, 0xb1, 0x54, 0x5b, 0x60, 0x4b, 0x46, 0x52, 0x42, 0xf2, 0x16, 0x7d, 0xa8, 0x2e, 0xba, 0xcc,
+	0xb2, 0xab, 0x52, 0x92, 0x5d, 0x9e, 0xa2, 0x58, 0x6e, 0xfe, 0xbd, 0xbb, 0xfa, 0xee, 0xb9, 0x87,
+	0x8b, 0xce, 0x85, 0x2f, 0xb3, 0x45, 0xc9, 0x94, 0xe6, 0xa9, 0x88, 0xb4, 0xe6, 0xd1, 0xac, 0x1f,
+	0x91, 0x24, 0x51, 0x8c, 0x72, 0x43, 0x0c, 0x97, 0x22, 0x2c, 0x95, 0x34, 0x12, 0xdd, 0xee, 0x54,
+	0xa1, 0xd6, 0x3c, 0x9c, 0xf5, 0xef, 0xee, 0xcf, 0xe6, 0x4a, 0x25, 0xe5, 0xf7, 0x5a, 0x7f, 0xf7,
+	0x22, 0x95, 0xa9, 0xb4, 0x65, 0x54, 0x55, 0x35, 0xed, 0x6c, 0x1c, 0xd8, 0x7e, 0x77, 0xe8, 0xfe,
+	0x5e, 0x26, 0xd3, 0x82, 0x09, 0x83, 0xba, 0xf0, 0x2a, 0x91, 0xc2, 0xb0, 0xb9, 0xc1, 0xc0, 0xbf,
+	0x08, 0xdc, 0xe1, 0x93, 0xcd, 0x9f, 0x87, 0xeb, 0xb7, 0xff, 0x59, 0xbc, 0xab, 0xd0, 0x33, 0xe8,
+	0x70, 0x8a, 0x1d, 0x1f, 0x04, 0x6e, 0xec, 0x70, 0x8a, 0x3c, 0x08, 0xb7, 0xfb, 0x4a, 0x85, 0x2f,
+	0x2c, 0x3f, 0x20, 0xe8, 0x15, 0xbc, 0xdd, 0xbe, 0x18, 0x1d, 0x69, 0x3d, 0x65, 0x0a, 0x3f, 0xb2,
+	0xaa, 0x33, 0x8e, 0x42, 0x88, 0x2a, 0xc2, 0x84, 0xe1, 0x24, 0x1f, 0x27, 0x19, 0x2b, 0xc8, 0x88,
+	0xe2, 0xc7, 0x56, 0xdd, 0xd0, 0x41, 0xf7, 0xd0, 0x9d, 0x91, 0x9c, 0xd3, 0x0f, 0x4a, 0x16, 0xf8,
+	0xd2, 0xca, 0xf6, 0xa0, 0xda, 0xcc, 0x3e, 0xbe, 0x08, 0xc3, 0x73, 0x7c, 0x55, 0x6f, 0xb6, 0x27,
+	0x28, 0x80, 0xcf, 0x29, 0xcb, 0x59, 0x5a, 0x7f, 0x04, 0x2b, 0x4d, 0x86, 0xaf, 0x7d, 0x10, 0x3c,
+	0x8d, 0x4f, 0x31, 0x7a, 0x03, 0xdb, 0x25, 0x51, 0x4c, 0x98, 0xa3, 0xbf, 0x1b, 0x51, 0xec, 0x5a,
+	0xd3, 0xe6, 0x66, 0xe7, 0x27, 0x80, 0xe8, 0x88, 0x8d, 0x0d, 0x31, 0x0c, 0x7d, 0x83, 0x6d, 0xd2,
+	0x14, 0x01, 0x06, 0x3e, 0x08, 0x6e, 0x06, 0xdd, 0xf0, 0x34, 0xe9, 0xb0, 0x31, 0xb1, 0xb8, 0xd9,
+	0x05, 0x7d, 0x82, 0xe8, 0xa8, 0xf1, 0xb9, 0x3a, 0x0a, 0x9b, 0xd7, 0xcd, 0xe0, 0xe1, 0xdc, 0x7b,
+	0x3b, 0x67, 0x65, 0x71, 0xc3, 0xe8, 0xf0, 0xe3, 0xaf, 0x95, 0x07, 0x96, 0x2b, 0x0f, 0xfc, 0x5d,
+	0x79, 0xe0, 0xc7, 0xda, 0x6b, 0x2d, 0xd7, 0x5e, 0xeb, 0xf7, 0xda, 0x6b, 0x7d, 0x1d, 0xa4, 0xdc,
+	0x64, 0xd3, 0x49, 0x98, 0xc8, 0x22, 0xda, 0x19, 0xf7, 0xec, 0xa5, 0x25, 0x32, 0x8f, 0x32, 0x4e,
+	0x7b, 0x42, 0x52, 0x16, 0xcd, 0xed, 0x81, 0x9a, 0x45, 0xc9, 0xf4, 0xe4, 0xd2, 0xb6, 0x5f, 0xff,
+	0x1b, 0x00, 0x39, 0xa4, 0x31, 0x54, 0xf6, 0x02, 0x00, 0x00,
+}
+
+func (m *AccreditationDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccreditationDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccreditationDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ParentAccreditationId) > 0 {
+		i -= len(m.ParentAccreditationId)
+		copy(dAtA[i:], m.ParentAccreditationId)
+		i = encodeVarintAccreditation(dAtA, i, uint64(len(m.ParentAccreditationId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DelegationDepth != 0 {
+		i = encodeVarintAccreditation(dAtA, i, uint64(m.DelegationDepth))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ValidUntil) > 0 {
+		i -= len(m.ValidUntil)
+		copy(dAtA[i:], m.ValidUntil)
+		i = encodeVarintAccreditation(dAtA, i, uint64(len(m.ValidUntil)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ValidFrom) > 0 {
+		i -= len(m.ValidFrom)
+		copy(dAtA[i:], m.ValidFrom)
+		i = encodeVarintAccreditation(dAtA, i, uint64(len(m.ValidFrom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CredentialSchemaId) > 0 {
+		i -= len(m.CredentialSchemaId)
+		copy(dAtA[i:], m.CredentialSchemaId)
+		i = encodeVarintAccreditation(dAtA, i, uint64(len(m.CredentialSchemaId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AccreditedIssuer) > 0 {
+		i -= len(m.AccreditedIssuer)
+		copy(dAtA[i:], m.AccreditedIssuer)
+		i = encodeVarintAccreditation(dAtA, i, uint64(len(m.AccreditedIssuer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Accreditor) > 0 {
+		i -= len(m.Accreditor)
+		copy(dAtA[i:], m.Accreditor)
+		i = encodeVarintAccreditation(dAtA, i, uint64(len(m.Accreditor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAccreditation(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Context) > 0 {
+		for iNdEx := len(m.Context) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Context[iNdEx])
+			copy(dAtA[i:], m.Context[iNdEx])
+			i = encodeVarintAccreditation(dAtA, i, uint64(len(m.Context[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccreditationState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccreditationState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccreditationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccreditationProof != nil {
+		{
+			size, err := m.AccreditationProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccreditation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AccreditationDocument != nil {
+		{
+			size, err := m.AccreditationDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccreditation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccreditation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccreditation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccreditationDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Context) > 0 {
+		for _, s := range m.Context {
+			l = len(s)
+			n += 1 + l + sovAccreditation(uint64(l))
+		}
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	l = len(m.Accreditor)
+	if l > 0 {
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	l = len(m.AccreditedIssuer)
+	if l > 0 {
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	l = len(m.CredentialSchemaId)
+	if l > 0 {
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	l = len(m.ValidFrom)
+	if l > 0 {
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	l = len(m.ValidUntil)
+	if l > 0 {
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	if m.DelegationDepth != 0 {
+		n += 1 + sovAccreditation(uint64(m.DelegationDepth))
+	}
+	l = len(m.ParentAccreditationId)
+	if l > 0 {
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	return n
+}
+
+func (m *AccreditationState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccreditationDocument != nil {
+		l = m.AccreditationDocument.Size()
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	if m.AccreditationProof != nil {
+		l = m.AccreditationProof.Size()
+		n += 1 + l + sovAccreditation(uint64(l))
+	}
+	return n
+}
+
+func sovAccreditation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccreditation(x uint64) (n int) {
+	return sovAccreditation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccreditationDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccreditation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccreditationDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccreditationDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = append(m.Context, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accreditor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accreditor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditedIssuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccreditedIssuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialSchemaId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidUntil = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationDepth", wireType)
+			}
+			m.DelegationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentAccreditationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentAccreditationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccreditation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccreditationState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccreditation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccreditationState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccreditationState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditationDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccreditationDocument == nil {
+				m.AccreditationDocument = &AccreditationDocument{}
+			}
+			if err := m.AccreditationDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditationProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccreditationProof == nil {
+				m.AccreditationProof = &DocumentProof{}
+			}
+			if err := m.AccreditationProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccreditation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccreditation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccreditation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccreditation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccreditation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccreditation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccreditation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccreditation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccreditation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccreditation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccreditation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -12,8 +12,18 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterDID{}, "ssi/RegisterDID", nil)
 	cdc.RegisterConcrete(&MsgUpdateDID{}, "ssi/UpdateDID", nil)
 	cdc.RegisterConcrete(&MsgRegisterCredentialSchema{}, "ssi/RegisterCredentialSchema", nil)
+	cdc.RegisterConcrete(&MsgUpdateCredentialSchemaStatus{}, "ssi/UpdateCredentialSchemaStatus", nil)
 	cdc.RegisterConcrete(&MsgDeactivateDID{}, "ssi/DeactivateDID", nil)
+	cdc.RegisterConcrete(&MsgInitiateDidRecovery{}, "ssi/InitiateDidRecovery", nil)
+	cdc.RegisterConcrete(&MsgCancelDidRecovery{}, "ssi/CancelDidRecovery", nil)
+	cdc.RegisterConcrete(&MsgMarkVerificationMethodCompromised{}, "ssi/MarkVerificationMethodCompromised", nil)
 	cdc.RegisterConcrete(&MsgRegisterCredentialStatus{}, "ssi/RegisterCredentialStatus", nil)
+	cdc.RegisterConcrete(&MsgRegisterCredentialStatusBatch{}, "ssi/RegisterCredentialStatusBatch", nil)
+	cdc.RegisterConcrete(&MsgRegisterCredentialStatusList{}, "ssi/RegisterCredentialStatusList", nil)
+	cdc.RegisterConcrete(&MsgUpdateCredentialStatusList{}, "ssi/UpdateCredentialStatusList", nil)
+	cdc.RegisterConcrete(&MsgRegisterAccreditation{}, "ssi/RegisterAccreditation", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "ssi/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgRegisterGovernanceAccreditation{}, "ssi/RegisterGovernanceAccreditation", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -23,9 +33,19 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateDID{},
 		&MsgRegisterCredentialSchema{},
 		&MsgUpdateCredentialSchema{},
+		&MsgUpdateCredentialSchemaStatus{},
 		&MsgDeactivateDID{},
+		&MsgInitiateDidRecovery{},
+		&MsgCancelDidRecovery{},
+		&MsgMarkVerificationMethodCompromised{},
 		&MsgRegisterCredentialStatus{},
 		&MsgUpdateCredentialStatus{},
+		&MsgRegisterCredentialStatusBatch{},
+		&MsgRegisterCredentialStatusList{},
+		&MsgUpdateCredentialStatusList{},
+		&MsgRegisterAccreditation{},
+		&MsgUpdateParams{},
+		&MsgRegisterGovernanceAccreditation{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
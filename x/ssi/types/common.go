@@ -12,6 +12,7 @@ const X25519KeyAgreementKey2020 = "X25519KeyAgreementKey2020"
 const X25519KeyAgreementKeyEIP5630 = "X25519KeyAgreementKeyEIP5630" // TODO: Temporary spec name for KeyAgreement type from Metamask
 const Bls12381G2Key2020 = "Bls12381G2Key2020"
 const BabyJubJubKey2021 = "BabyJubJubKey2021"
+const CosmWasmContractMethod2024 = "CosmWasmContractMethod2024"
 
 // Supported Proof Types
 const Ed25519Signature2020 = "Ed25519Signature2020"
@@ -29,6 +30,7 @@ var VerificationKeySignatureMap = map[string]string{
 	X25519KeyAgreementKeyEIP5630:      "", // Authentication and Assertion are not allowed
 	BabyJubJubKey2021:                 BJJSignature2021,
 	Bls12381G2Key2020:                 BbsBlsSignature2020,
+	CosmWasmContractMethod2024:        "", // Authorises by being the transaction sender, hence cannot sign
 }
 
 var supportedVerificationMethodTypes []string = func() []string {
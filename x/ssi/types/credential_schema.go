@@ -0,0 +1,176 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SchemaVersion is the version number of a Credential Schema, which is of the form `<major>.<minor>` or `<major>`
+type SchemaVersion struct {
+	Major    uint64
+	Minor    uint64
+	HasMinor bool
+}
+
+// ParseSchemaVersion parses the version number of a Credential Schema. Numbers with leading zeros are
+// rejected, such that every version has a single textual form.
+func ParseSchemaVersion(version string) (SchemaVersion, error) {
+	return parseSchemaVersion(version, false)
+}
+
+// parseSchemaVersion parses the version number of a Credential Schema. Leading zeros are permitted only
+// for deriving the store keys of schemas registered before they were rejected.
+func parseSchemaVersion(version string, allowLeadingZeros bool) (SchemaVersion, error) {
+	var schemaVersion SchemaVersion
+
+	versionElements := strings.Split(version, ".")
+	if len(versionElements) > 2 {
+		return SchemaVersion{}, fmt.Errorf("invalid schema version %v: expected <major>.<minor> or <major>", version)
+	}
+
+	major, err := parseSchemaVersionNumber(versionElements[0], allowLeadingZeros)
+	if err != nil {
+		return SchemaVersion{}, fmt.Errorf("invalid major number of schema version %v: %v", version, err)
+	}
+	schemaVersion.Major = major
+
+	if len(versionElements) == 2 {
+		minor, err := parseSchemaVersionNumber(versionElements[1], allowLeadingZeros)
+		if err != nil {
+			return SchemaVersion{}, fmt.Errorf("invalid minor number of schema version %v: %v", version, err)
+		}
+		schemaVersion.Minor = minor
+		schemaVersion.HasMinor = true
+	}
+
+	return schemaVersion, nil
+}
+
+// parseSchemaVersionNumber parses a single numeric element of schema version. Only digits are permitted.
+func parseSchemaVersionNumber(number string, allowLeadingZeros bool) (uint64, error) {
+	if number == "" {
+		return 0, fmt.Errorf("version number cannot be empty")
+	}
+	for _, c := range number {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("version number %v must only contain digits", number)
+		}
+	}
+	if !allowLeadingZeros && len(number) > 1 && number[0] == '0' {
+		return 0, fmt.Errorf("version number %v cannot have leading zeros", number)
+	}
+	return strconv.ParseUint(number, 10, 64)
+}
+
+// String returns the version number in the form it is parsed from, without leading zeros
+func (v SchemaVersion) String() string {
+	if v.HasMinor {
+		return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+	}
+	return fmt.Sprintf("%d", v.Major)
+}
+
+// Bytes returns a fixed length encoding of version whose byte-wise order matches the version order. Version
+// without minor number sorts before every version with the same major number, such that `1` < `1.0` < `1.1` < `2`.
+func (v SchemaVersion) Bytes() []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], v.Major)
+	if v.HasMinor {
+		binary.BigEndian.PutUint64(bz[8:], v.Minor+1)
+	}
+	return bz
+}
+
+// SplitSchemaId returns the base id and the version number of a Credential Schema Id. Schema Id is of the form
+// `<base-id>:<version>`, where the base id is `sch:hid[:<namespace>]:<method-specific-id>`
+func SplitSchemaId(schemaId string) (string, SchemaVersion, error) {
+	return splitSchemaId(schemaId, false)
+}
+
+func splitSchemaId(schemaId string, allowLeadingZeros bool) (string, SchemaVersion, error) {
+	versionIndex := strings.LastIndex(schemaId, ":")
+	if versionIndex == -1 {
+		return "", SchemaVersion{}, fmt.Errorf("schema version number is not present in schema id %v", schemaId)
+	}
+
+	baseId, version := schemaId[:versionIndex], schemaId[versionIndex+1:]
+	if len(strings.Split(baseId, ":")) < 3 {
+		return "", SchemaVersion{}, fmt.Errorf("schema version number is not present in schema id %v", schemaId)
+	}
+
+	schemaVersion, err := parseSchemaVersion(version, allowLeadingZeros)
+	if err != nil {
+		return "", SchemaVersion{}, err
+	}
+
+	return baseId, schemaVersion, nil
+}
+
+// GetCredentialSchemaBaseKey returns the store key prefix shared by every version of a Credential Schema. The
+// base id is prefixed with its length, such that the key prefix of a base id does not match the keys of another
+// base id which extends it, such as `<base-id>:<suffix>`.
+func GetCredentialSchemaBaseKey(baseId string) []byte {
+	bz := make([]byte, 4, 4+len(baseId))
+	binary.BigEndian.PutUint32(bz, uint32(len(baseId)))
+	return append(bz, baseId...)
+}
+
+// GetCredentialSchemaKey returns the store key of a Credential Schema, which is composed of its base id and
+// its version number. Versions with leading zeros, which could be registered before they were rejected, share
+// the key of their version without leading zeros.
+func GetCredentialSchemaKey(schemaId string) ([]byte, error) {
+	baseId, schemaVersion, err := splitSchemaId(schemaId, true)
+	if err != nil {
+		return nil, err
+	}
+	return append(GetCredentialSchemaBaseKey(baseId), schemaVersion.Bytes()...), nil
+}
+
+// GetLegacyCredentialSchemaKey returns the store key of a Credential Schema, whose version differs from another
+// schema's only by leading zeros and which could not be moved under their shared composite key during the store
+// migration. Such schemas are kept under their schema id, which was their store key before the migration.
+func GetLegacyCredentialSchemaKey(schemaId string) []byte {
+	return []byte(schemaId)
+}
+
+// Status values of Credential Schema Status Document
+const (
+	CredentialSchemaStatusActiveValue     = "active"
+	CredentialSchemaStatusDeprecatedValue = "deprecated"
+	CredentialSchemaStatusRevokedValue    = "revoked"
+)
+
+var credentialSchemaStatusValues = map[string]CredentialSchemaStatus{
+	CredentialSchemaStatusActiveValue:     CREDENTIAL_SCHEMA_STATUS_ACTIVE,
+	CredentialSchemaStatusDeprecatedValue: CREDENTIAL_SCHEMA_STATUS_DEPRECATED,
+	CredentialSchemaStatusRevokedValue:    CREDENTIAL_SCHEMA_STATUS_REVOKED,
+}
+
+// ParseCredentialSchemaStatus parses the status value of Credential Schema Status Document
+func ParseCredentialSchemaStatus(status string) (CredentialSchemaStatus, error) {
+	schemaStatus, ok := credentialSchemaStatusValues[status]
+	if !ok {
+		return CREDENTIAL_SCHEMA_STATUS_ACTIVE, fmt.Errorf(
+			"invalid credential schema status %v, expected one of %v, %v and %v",
+			status,
+			CredentialSchemaStatusActiveValue,
+			CredentialSchemaStatusDeprecatedValue,
+			CredentialSchemaStatusRevokedValue,
+		)
+	}
+	return schemaStatus, nil
+}
+
+// Validate checks the fields of Credential Schema Status Document
+func (doc *CredentialSchemaStatusDocument) Validate() error {
+	if _, _, err := SplitSchemaId(doc.Id); err != nil {
+		return fmt.Errorf("invalid schema id %v: %v", doc.Id, err)
+	}
+
+	if _, err := ParseCredentialSchemaStatus(doc.Status); err != nil {
+		return err
+	}
+	return nil
+}
@@ -23,6 +23,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CredentialSchemaStatus is the lifecycle status of a Credential Schema. A deprecated Credential Schema
+// can still be used, while a revoked one is retired permanently.
+type CredentialSchemaStatus int32
+
+const (
+	CREDENTIAL_SCHEMA_STATUS_ACTIVE     CredentialSchemaStatus = 0
+	CREDENTIAL_SCHEMA_STATUS_DEPRECATED CredentialSchemaStatus = 1
+	CREDENTIAL_SCHEMA_STATUS_REVOKED    CredentialSchemaStatus = 2
+)
+
+var CredentialSchemaStatus_name = map[int32]string{
+	0: "CREDENTIAL_SCHEMA_STATUS_ACTIVE",
+	1: "CREDENTIAL_SCHEMA_STATUS_DEPRECATED",
+	2: "CREDENTIAL_SCHEMA_STATUS_REVOKED",
+}
+
+var CredentialSchemaStatus_value = map[string]int32{
+	"CREDENTIAL_SCHEMA_STATUS_ACTIVE":     0,
+	"CREDENTIAL_SCHEMA_STATUS_DEPRECATED": 1,
+	"CREDENTIAL_SCHEMA_STATUS_REVOKED":    2,
+}
+
+func (x CredentialSchemaStatus) String() string {
+	return proto.EnumName(CredentialSchemaStatus_name, int32(x))
+}
+
+func (CredentialSchemaStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5c0546f877a0a994, []int{0}
+}
+
 type CredentialSchemaDocument struct {
 	Context      []string                  `protobuf:"bytes,1,rep,name=context,json=@context,proto3" json:"@context"`
 	Type         string                    `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
@@ -210,6 +240,11 @@ func (m *CredentialSchemaProperty) GetAdditionalProperties() bool {
 type CredentialSchemaState struct {
 	CredentialSchemaDocument *CredentialSchemaDocument `protobuf:"bytes,1,opt,name=credentialSchemaDocument,proto3" json:"credentialSchemaDocument,omitempty"`
 	CredentialSchemaProof    *DocumentProof            `protobuf:"bytes,2,opt,name=credentialSchemaProof,proto3" json:"credentialSchemaProof,omitempty"`
+	Status                   CredentialSchemaStatus    `protobuf:"varint,3,opt,name=status,proto3,enum=hypersign.ssi.v1.CredentialSchemaStatus" json:"status,omitempty"`
+	// Remarks of the author on the latest status change
+	StatusRemarks string `protobuf:"bytes,4,opt,name=statusRemarks,proto3" json:"statusRemarks,omitempty"`
+	// Time of the latest status change
+	StatusUpdated string `protobuf:"bytes,5,opt,name=statusUpdated,proto3" json:"statusUpdated,omitempty"`
 }
 
 func (m *CredentialSchemaState) Reset()         { *m = CredentialSchemaState{} }
@@ -259,10 +294,103 @@ func (m *CredentialSchemaState) GetCredentialSchemaProof() *DocumentProof {
 	return nil
 }
 
+func (m *CredentialSchemaState) GetStatus() CredentialSchemaStatus {
+	if m != nil {
+		return m.Status
+	}
+	return CREDENTIAL_SCHEMA_STATUS_ACTIVE
+}
+
+func (m *CredentialSchemaState) GetStatusRemarks() string {
+	if m != nil {
+		return m.StatusRemarks
+	}
+	return ""
+}
+
+func (m *CredentialSchemaState) GetStatusUpdated() string {
+	if m != nil {
+		return m.StatusUpdated
+	}
+	return ""
+}
+
+// CredentialSchemaStatusDocument is signed by the author of Credential Schema `id` to change its status
+// to one of `active`, `deprecated` and `revoked`
+type CredentialSchemaStatusDocument struct {
+	Context []string `protobuf:"bytes,1,rep,name=context,json=@context,proto3" json:"@context"`
+	Id      string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Remarks string   `protobuf:"bytes,4,opt,name=remarks,proto3" json:"remarks,omitempty"`
+}
+
+func (m *CredentialSchemaStatusDocument) Reset()         { *m = CredentialSchemaStatusDocument{} }
+func (m *CredentialSchemaStatusDocument) String() string { return proto.CompactTextString(m) }
+func (*CredentialSchemaStatusDocument) ProtoMessage()    {}
+func (*CredentialSchemaStatusDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c0546f877a0a994, []int{3}
+}
+func (m *CredentialSchemaStatusDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CredentialSchemaStatusDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CredentialSchemaStatusDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CredentialSchemaStatusDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialSchemaStatusDocument.Merge(m, src)
+}
+func (m *CredentialSchemaStatusDocument) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredentialSchemaStatusDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialSchemaStatusDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialSchemaStatusDocument proto.InternalMessageInfo
+
+func (m *CredentialSchemaStatusDocument) GetContext() []string {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+func (m *CredentialSchemaStatusDocument) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CredentialSchemaStatusDocument) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *CredentialSchemaStatusDocument) GetRemarks() string {
+	if m != nil {
+		return m.Remarks
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("hypersign.ssi.v1.CredentialSchemaStatus", CredentialSchemaStatus_name, CredentialSchemaStatus_value)
 	proto.RegisterType((*CredentialSchemaDocument)(nil), "hypersign.ssi.v1.CredentialSchemaDocument")
 	proto.RegisterType((*CredentialSchemaProperty)(nil), "hypersign.ssi.v1.CredentialSchemaProperty")
 	proto.RegisterType((*CredentialSchemaState)(nil), "hypersign.ssi.v1.CredentialSchemaState")
+	proto.RegisterType((*CredentialSchemaStatusDocument)(nil), "hypersign.ssi.v1.CredentialSchemaStatusDocument")
 }
 
 func init() {
@@ -270,37 +398,46 @@ func init() {
 }
 
 var fileDescriptor_5c0546f877a0a994 = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xcd, 0x26, 0x6d, 0x9a, 0x6e, 0x2a, 0x84, 0x56, 0x2d, 0x5a, 0x45, 0xc8, 0x8d, 0x72, 0x21,
-	0x42, 0xaa, 0xad, 0x86, 0x1f, 0x40, 0x81, 0x23, 0x87, 0xca, 0x15, 0x1c, 0xb8, 0x20, 0x77, 0x77,
-	0x13, 0xaf, 0x14, 0x7b, 0xcc, 0xee, 0xba, 0x6a, 0xfe, 0x82, 0x1b, 0xbf, 0xc4, 0xb1, 0x27, 0xd4,
-	0x13, 0x42, 0xc9, 0x8d, 0xaf, 0x40, 0xbb, 0xeb, 0x98, 0x10, 0x37, 0x52, 0x6f, 0x33, 0x6f, 0x66,
-	0xde, 0xcc, 0x7b, 0xf6, 0xe2, 0x71, 0xba, 0x2c, 0x84, 0xd2, 0x72, 0x9e, 0x47, 0x5a, 0xcb, 0xe8,
-	0xf6, 0x32, 0x62, 0x4a, 0x70, 0x91, 0x1b, 0x99, 0x2c, 0xbe, 0x68, 0x96, 0x8a, 0x2c, 0x09, 0x0b,
-	0x05, 0x06, 0xc8, 0xf3, 0xba, 0x33, 0xd4, 0x5a, 0x86, 0xb7, 0x97, 0x83, 0x97, 0x8d, 0xd9, 0x42,
-	0x01, 0xcc, 0x7c, 0xff, 0xe0, 0x74, 0x0e, 0x73, 0x70, 0x61, 0x64, 0x23, 0x8f, 0x8e, 0xbe, 0xb7,
-	0x31, 0x7d, 0x57, 0x6f, 0xb8, 0x76, 0x0b, 0xde, 0x03, 0x2b, 0x33, 0x91, 0x1b, 0xf2, 0x0a, 0x1f,
-	0x31, 0xc8, 0x8d, 0xb8, 0x33, 0x14, 0x0d, 0x3b, 0xe3, 0xe3, 0xe9, 0xc9, 0x9f, 0x5f, 0xe7, 0xbd,
-	0xb7, 0x15, 0x16, 0xd7, 0x11, 0x21, 0xf8, 0xc0, 0x2c, 0x0b, 0x41, 0xdb, 0x43, 0x34, 0x3e, 0x8e,
-	0x5d, 0x4c, 0x46, 0xf8, 0x24, 0x03, 0x2e, 0x16, 0x9f, 0xec, 0x49, 0x90, 0xd3, 0x8e, 0xab, 0xfd,
-	0x87, 0x91, 0x67, 0xb8, 0x2d, 0x39, 0x3d, 0x70, 0x95, 0xb6, 0xe4, 0x96, 0x27, 0x4f, 0x32, 0x41,
-	0x0f, 0x3d, 0x8f, 0x8d, 0xc9, 0x0b, 0xdc, 0x4d, 0x4a, 0x93, 0x82, 0xa2, 0x5d, 0x87, 0x56, 0x19,
-	0x19, 0xe0, 0x9e, 0x8f, 0x04, 0xa7, 0x47, 0xae, 0x52, 0xe7, 0x64, 0x8a, 0xbb, 0xde, 0x2b, 0xda,
-	0x1b, 0xa2, 0x71, 0x7f, 0xf2, 0x3a, 0xdc, 0x35, 0x2b, 0xdc, 0x15, 0x7d, 0xa5, 0xa0, 0x10, 0xca,
-	0x2c, 0xe3, 0x6a, 0x72, 0xf4, 0x80, 0x9a, 0xce, 0x6c, 0x9a, 0xec, 0x51, 0xd5, 0x02, 0xe4, 0x8f,
-	0xf2, 0x19, 0x19, 0xe2, 0x3e, 0x17, 0x9a, 0x29, 0x59, 0x18, 0xab, 0xd9, 0xfb, 0xb1, 0x0d, 0xd5,
-	0x56, 0x75, 0xb6, 0xac, 0x0a, 0x30, 0x2e, 0x3c, 0xb3, 0x14, 0xba, 0xb2, 0x63, 0x0b, 0xb1, 0x52,
-	0x95, 0xf8, 0x5a, 0x4a, 0x2b, 0xf5, 0xd0, 0x7e, 0x88, 0xb8, 0xce, 0xc9, 0x04, 0x9f, 0x26, 0x9c,
-	0x4b, 0xcb, 0x9d, 0x2c, 0xae, 0xfe, 0xb1, 0x58, 0xb3, 0x7a, 0xf1, 0xa3, 0xb5, 0xd1, 0x4f, 0x84,
-	0xcf, 0x76, 0xa5, 0x5d, 0x9b, 0xc4, 0x08, 0x32, 0xc3, 0x94, 0xed, 0xf9, 0x1b, 0x9c, 0xd2, 0x27,
-	0x59, 0xb9, 0x99, 0x88, 0xf7, 0x72, 0x91, 0x8f, 0xf8, 0x8c, 0x35, 0xbd, 0x85, 0x99, 0x73, 0xac,
-	0x3f, 0x39, 0x6f, 0x2e, 0xd9, 0x8c, 0xba, 0xb6, 0xf8, 0xf1, 0xe9, 0xe9, 0x87, 0x1f, 0xab, 0x00,
-	0xdd, 0xaf, 0x02, 0xf4, 0x7b, 0x15, 0xa0, 0x6f, 0xeb, 0xa0, 0x75, 0xbf, 0x0e, 0x5a, 0x0f, 0xeb,
-	0xa0, 0xf5, 0x79, 0x32, 0x97, 0x26, 0x2d, 0x6f, 0x42, 0x06, 0x59, 0x54, 0x73, 0x5f, 0xb8, 0x37,
-	0xc0, 0x60, 0x11, 0xa5, 0x92, 0x5f, 0xe4, 0xc0, 0x45, 0x74, 0xe7, 0x9e, 0x8e, 0xfd, 0x2a, 0xfa,
-	0xa6, 0xeb, 0xca, 0x6f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x5d, 0xc3, 0xde, 0xb1, 0x94, 0x03,
-	0x00, 0x00,
+	// 620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdb, 0x40,
+	0x10, 0xce, 0x1a, 0x08, 0x61, 0xa0, 0x28, 0x5a, 0x01, 0x5a, 0x45, 0x95, 0x89, 0x02, 0x12, 0x11,
+	0x12, 0xb1, 0x48, 0x5f, 0x80, 0x90, 0x58, 0x2a, 0x2a, 0x6d, 0xd1, 0x26, 0x70, 0xe8, 0x25, 0x32,
+	0xf6, 0x92, 0xac, 0x1a, 0x7b, 0x5d, 0x7b, 0x83, 0xe0, 0x0d, 0x2a, 0xf5, 0x52, 0xf5, 0xd2, 0x07,
+	0xe8, 0xcb, 0xf4, 0xc8, 0x91, 0x53, 0x55, 0x41, 0x4f, 0x7d, 0x8a, 0x6a, 0xd7, 0x8e, 0x49, 0x20,
+	0x51, 0xdb, 0xdb, 0xcc, 0x37, 0xdf, 0xfc, 0x7d, 0xeb, 0x31, 0x54, 0xfb, 0xd7, 0x21, 0x8b, 0x62,
+	0xde, 0x0b, 0xac, 0x38, 0xe6, 0xd6, 0xe5, 0xbe, 0xe5, 0x46, 0xcc, 0x63, 0x81, 0xe4, 0xce, 0xa0,
+	0x1b, 0xbb, 0x7d, 0xe6, 0x3b, 0xb5, 0x30, 0x12, 0x52, 0xe0, 0x62, 0xc6, 0xac, 0xc5, 0x31, 0xaf,
+	0x5d, 0xee, 0x97, 0x9e, 0x3f, 0xc9, 0x0d, 0x23, 0x21, 0x2e, 0x12, 0x7e, 0x69, 0xad, 0x27, 0x7a,
+	0x42, 0x9b, 0x96, 0xb2, 0x12, 0xb4, 0xf2, 0xd5, 0x00, 0xd2, 0xcc, 0x3a, 0xb4, 0x75, 0x83, 0x96,
+	0x70, 0x87, 0x3e, 0x0b, 0x24, 0xde, 0x81, 0x45, 0x57, 0x04, 0x92, 0x5d, 0x49, 0x82, 0xca, 0x73,
+	0xd5, 0xa5, 0xc3, 0x95, 0xdf, 0x3f, 0x36, 0x0b, 0x07, 0x29, 0x46, 0x33, 0x0b, 0x63, 0x98, 0x97,
+	0xd7, 0x21, 0x23, 0x46, 0x19, 0x55, 0x97, 0xa8, 0xb6, 0x71, 0x05, 0x56, 0x7c, 0xe1, 0xb1, 0xc1,
+	0x99, 0x1a, 0x49, 0x04, 0x64, 0x4e, 0xc7, 0x26, 0x30, 0xbc, 0x0a, 0x06, 0xf7, 0xc8, 0xbc, 0x8e,
+	0x18, 0xdc, 0x53, 0x75, 0x02, 0xc7, 0x67, 0x64, 0x21, 0xa9, 0xa3, 0x6c, 0xbc, 0x01, 0x79, 0x67,
+	0x28, 0xfb, 0x22, 0x22, 0x79, 0x8d, 0xa6, 0x1e, 0x2e, 0x41, 0x21, 0xb1, 0x98, 0x47, 0x16, 0x75,
+	0x24, 0xf3, 0xf1, 0x21, 0xe4, 0x13, 0xad, 0x48, 0xa1, 0x8c, 0xaa, 0xcb, 0xf5, 0xdd, 0xda, 0x63,
+	0xb1, 0x6a, 0x8f, 0x97, 0x3e, 0x89, 0x44, 0xc8, 0x22, 0x79, 0x4d, 0xd3, 0xcc, 0xca, 0x2d, 0x02,
+	0x32, 0x8b, 0xa4, 0x86, 0x4a, 0x1b, 0xa0, 0x64, 0xa8, 0xc4, 0xc3, 0x65, 0x58, 0xf6, 0x58, 0xec,
+	0x46, 0x3c, 0x94, 0x6a, 0xe7, 0x44, 0x8f, 0x71, 0x28, 0x93, 0x6a, 0x6e, 0x4c, 0x2a, 0x13, 0x20,
+	0x4c, 0x2a, 0x73, 0x16, 0xa7, 0x72, 0x8c, 0x21, 0x6a, 0xd5, 0x88, 0x7d, 0x18, 0x72, 0xb5, 0xea,
+	0x82, 0x7a, 0x08, 0x9a, 0xf9, 0xb8, 0x0e, 0x6b, 0x8e, 0xe7, 0x71, 0x55, 0xdb, 0x19, 0x9c, 0x3c,
+	0x54, 0x51, 0x62, 0x15, 0xe8, 0xd4, 0x58, 0xe5, 0x97, 0x01, 0xeb, 0x8f, 0x57, 0x6b, 0x4b, 0x47,
+	0x32, 0x7c, 0x01, 0xc4, 0x9d, 0xf1, 0x35, 0x10, 0xf4, 0xaf, 0x52, 0x8e, 0x32, 0xe8, 0xcc, 0x5a,
+	0xf8, 0x14, 0xd6, 0xdd, 0xa7, 0xda, 0x8a, 0x0b, 0xad, 0xd8, 0x72, 0x7d, 0xf3, 0x69, 0x93, 0x51,
+	0xaa, 0xa6, 0xd1, 0xe9, 0xd9, 0xf8, 0x00, 0xf2, 0xb1, 0x74, 0xe4, 0x30, 0xd6, 0xf2, 0xae, 0xd6,
+	0xab, 0x7f, 0x1f, 0xb6, 0xad, 0xf9, 0x34, 0xcd, 0xc3, 0xdb, 0xf0, 0x2c, 0xb1, 0x28, 0xf3, 0x9d,
+	0xe8, 0xfd, 0xe8, 0x35, 0x26, 0xc1, 0x07, 0xd6, 0x69, 0xe8, 0x39, 0x52, 0xbf, 0xca, 0x18, 0x2b,
+	0x05, 0x2b, 0x9f, 0x10, 0x98, 0xd3, 0xdb, 0xfd, 0xff, 0x85, 0x25, 0x97, 0x62, 0x64, 0x97, 0xb2,
+	0x31, 0xb1, 0xe9, 0x52, 0x36, 0x3f, 0x81, 0xc5, 0x68, 0x62, 0xf2, 0x91, 0xbb, 0xfb, 0x05, 0xc1,
+	0xc6, 0xf4, 0x69, 0xf0, 0x16, 0x6c, 0x36, 0xa9, 0xdd, 0xb2, 0xdf, 0x74, 0x8e, 0x1a, 0xc7, 0xdd,
+	0x76, 0xf3, 0xa5, 0xfd, 0xba, 0xd1, 0x6d, 0x77, 0x1a, 0x9d, 0xd3, 0x76, 0xb7, 0xd1, 0xec, 0x1c,
+	0x9d, 0xd9, 0xc5, 0x1c, 0xde, 0x81, 0xad, 0x99, 0xa4, 0x96, 0x7d, 0x42, 0xed, 0x66, 0xa3, 0x63,
+	0xb7, 0x8a, 0x08, 0x6f, 0x43, 0x79, 0x26, 0x91, 0xda, 0x67, 0x6f, 0x5f, 0xd9, 0xad, 0xa2, 0x51,
+	0x9a, 0xff, 0xf8, 0xcd, 0xcc, 0x1d, 0x1e, 0x7f, 0xbf, 0x33, 0xd1, 0xcd, 0x9d, 0x89, 0x7e, 0xde,
+	0x99, 0xe8, 0xf3, 0xbd, 0x99, 0xbb, 0xb9, 0x37, 0x73, 0xb7, 0xf7, 0x66, 0xee, 0x5d, 0xbd, 0xc7,
+	0x65, 0x7f, 0x78, 0x5e, 0x73, 0x85, 0x6f, 0x65, 0x8f, 0xb8, 0xa7, 0x7f, 0x5a, 0xae, 0x18, 0x58,
+	0x7d, 0xee, 0xed, 0x05, 0xc2, 0x63, 0xd6, 0x95, 0xfe, 0xd7, 0xa9, 0x33, 0x8a, 0xcf, 0xf3, 0x3a,
+	0xfc, 0xe2, 0xcf, 0x00, 0x2b, 0x11, 0xb5, 0x0a, 0x45, 0x05, 0x00, 0x00,
 }
 
 func (m *CredentialSchemaDocument) Marshal() (dAtA []byte, err error) {
@@ -479,6 +616,25 @@ func (m *CredentialSchemaState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StatusUpdated) > 0 {
+		i -= len(m.StatusUpdated)
+		copy(dAtA[i:], m.StatusUpdated)
+		i = encodeVarintCredentialSchema(dAtA, i, uint64(len(m.StatusUpdated)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StatusRemarks) > 0 {
+		i -= len(m.StatusRemarks)
+		copy(dAtA[i:], m.StatusRemarks)
+		i = encodeVarintCredentialSchema(dAtA, i, uint64(len(m.StatusRemarks)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintCredentialSchema(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.CredentialSchemaProof != nil {
 		{
 			size, err := m.CredentialSchemaProof.MarshalToSizedBuffer(dAtA[:i])
@@ -506,6 +662,59 @@ func (m *CredentialSchemaState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CredentialSchemaStatusDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialSchemaStatusDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialSchemaStatusDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remarks) > 0 {
+		i -= len(m.Remarks)
+		copy(dAtA[i:], m.Remarks)
+		i = encodeVarintCredentialSchema(dAtA, i, uint64(len(m.Remarks)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintCredentialSchema(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintCredentialSchema(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Context) > 0 {
+		for iNdEx := len(m.Context) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Context[iNdEx])
+			copy(dAtA[i:], m.Context[iNdEx])
+			i = encodeVarintCredentialSchema(dAtA, i, uint64(len(m.Context[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCredentialSchema(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCredentialSchema(v)
 	base := offset
@@ -608,6 +817,44 @@ func (m *CredentialSchemaState) Size() (n int) {
 		l = m.CredentialSchemaProof.Size()
 		n += 1 + l + sovCredentialSchema(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovCredentialSchema(uint64(m.Status))
+	}
+	l = len(m.StatusRemarks)
+	if l > 0 {
+		n += 1 + l + sovCredentialSchema(uint64(l))
+	}
+	l = len(m.StatusUpdated)
+	if l > 0 {
+		n += 1 + l + sovCredentialSchema(uint64(l))
+	}
+	return n
+}
+
+func (m *CredentialSchemaStatusDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Context) > 0 {
+		for _, s := range m.Context {
+			l = len(s)
+			n += 1 + l + sovCredentialSchema(uint64(l))
+		}
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCredentialSchema(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovCredentialSchema(uint64(l))
+	}
+	l = len(m.Remarks)
+	if l > 0 {
+		n += 1 + l + sovCredentialSchema(uint64(l))
+	}
 	return n
 }
 
@@ -1258,6 +1505,267 @@ func (m *CredentialSchemaState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CredentialSchemaStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusRemarks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusRemarks = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusUpdated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusUpdated = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCredentialSchema(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCredentialSchema
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CredentialSchemaStatusDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCredentialSchema
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredentialSchemaStatusDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredentialSchemaStatusDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = append(m.Context, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remarks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialSchema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialSchema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialSchema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remarks = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCredentialSchema(dAtA[iNdEx:])
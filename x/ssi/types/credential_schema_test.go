@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitSchemaId(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		schemaId string
+		baseId   string
+		version  string
+		valid    bool
+	}{
+		{
+			desc:     "schema id with namespace",
+			schemaId: "sch:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd:1.0",
+			baseId:   "sch:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd",
+			version:  "1.0",
+			valid:    true,
+		},
+		{
+			desc:     "mainnet schema id with two digit major version",
+			schemaId: "sch:hid:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd:10.0",
+			baseId:   "sch:hid:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd",
+			version:  "10.0",
+			valid:    true,
+		},
+		{
+			desc:     "schema id with major version only",
+			schemaId: "sch:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd:3",
+			baseId:   "sch:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd",
+			version:  "3",
+			valid:    true,
+		},
+		{
+			desc:     "base id of mainnet schema",
+			schemaId: "sch:hid:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd",
+			valid:    false,
+		},
+		{
+			desc:     "schema id with malformed version",
+			schemaId: "sch:hid:devnet:z6Mkk8qQLgQpEQhnR1ZwBdnKPMUvQ8TtTfnSQ1Vb9wQpKxVd:1.0.0",
+			valid:    false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			baseId, version, err := types.SplitSchemaId(tc.schemaId)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.baseId, baseId)
+			require.Equal(t, tc.version, version.String())
+		})
+	}
+}
+
+func TestSchemaVersionOrder(t *testing.T) {
+	orderedVersions := []string{"1", "1.0", "1.1", "1.10", "2", "2.0", "10.0"}
+
+	for i := 1; i < len(orderedVersions); i++ {
+		lower, err := types.ParseSchemaVersion(orderedVersions[i-1])
+		require.NoError(t, err)
+		higher, err := types.ParseSchemaVersion(orderedVersions[i])
+		require.NoError(t, err)
+
+		require.Equal(t, -1, bytes.Compare(lower.Bytes(), higher.Bytes()), "%v must sort before %v", lower, higher)
+	}
+}
@@ -32,6 +32,11 @@ type CredentialStatusDocument struct {
 	Issuer                   string   `protobuf:"bytes,6,opt,name=issuer,proto3" json:"issuer,omitempty"`
 	IssuanceDate             string   `protobuf:"bytes,7,opt,name=issuanceDate,proto3" json:"issuanceDate,omitempty"`
 	CredentialMerkleRootHash string   `protobuf:"bytes,8,opt,name=credentialMerkleRootHash,proto3" json:"credentialMerkleRootHash,omitempty"`
+	// Optional RFC3339 date after which the Credential is no longer valid
+	ExpirationDate string `protobuf:"bytes,9,opt,name=expirationDate,proto3" json:"expirationDate,omitempty"`
+	// Optional id of the Credential Schema of the Credential. Credential Status cannot be registered
+	// against a revoked Credential Schema.
+	CredentialSchemaId string `protobuf:"bytes,10,opt,name=credentialSchemaId,proto3" json:"credentialSchemaId,omitempty"`
 }
 
 func (m *CredentialStatusDocument) Reset()         { *m = CredentialStatusDocument{} }
@@ -123,9 +128,28 @@ func (m *CredentialStatusDocument) GetCredentialMerkleRootHash() string {
 	return ""
 }
 
+func (m *CredentialStatusDocument) GetExpirationDate() string {
+	if m != nil {
+		return m.ExpirationDate
+	}
+	return ""
+}
+
+func (m *CredentialStatusDocument) GetCredentialSchemaId() string {
+	if m != nil {
+		return m.CredentialSchemaId
+	}
+	return ""
+}
+
 type CredentialStatusState struct {
 	CredentialStatusDocument *CredentialStatusDocument `protobuf:"bytes,1,opt,name=credentialStatusDocument,proto3" json:"credentialStatusDocument,omitempty"`
 	CredentialStatusProof    *DocumentProof            `protobuf:"bytes,2,opt,name=credentialStatusProof,proto3" json:"credentialStatusProof,omitempty"`
+	// Set by the chain once the expirationDate of Credential Status has passed
+	Expired bool `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	// Id of the Credential Status Batch through which the Credential Status was registered. The
+	// credentialStatusProof is then the proof of the Credential Status Batch Document stored against it.
+	CredentialStatusBatchId string `protobuf:"bytes,4,opt,name=credentialStatusBatchId,proto3" json:"credentialStatusBatchId,omitempty"`
 }
 
 func (m *CredentialStatusState) Reset()         { *m = CredentialStatusState{} }
@@ -175,9 +199,150 @@ func (m *CredentialStatusState) GetCredentialStatusProof() *DocumentProof {
 	return nil
 }
 
+func (m *CredentialStatusState) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+func (m *CredentialStatusState) GetCredentialStatusBatchId() string {
+	if m != nil {
+		return m.CredentialStatusBatchId
+	}
+	return ""
+}
+
+// CredentialStatusBatchDocument holds the Credential Status Documents of a single issuer which are
+// registered together. The Credential Status Documents inherit the context of the batch.
+type CredentialStatusBatchDocument struct {
+	Context            []string                    `protobuf:"bytes,1,rep,name=context,json=@context,proto3" json:"@context"`
+	Issuer             string                      `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	CredentialStatuses []*CredentialStatusDocument `protobuf:"bytes,3,rep,name=credentialStatuses,proto3" json:"credentialStatuses,omitempty"`
+}
+
+func (m *CredentialStatusBatchDocument) Reset()         { *m = CredentialStatusBatchDocument{} }
+func (m *CredentialStatusBatchDocument) String() string { return proto.CompactTextString(m) }
+func (*CredentialStatusBatchDocument) ProtoMessage()    {}
+func (*CredentialStatusBatchDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8253d9579d71e297, []int{2}
+}
+func (m *CredentialStatusBatchDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CredentialStatusBatchDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CredentialStatusBatchDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CredentialStatusBatchDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialStatusBatchDocument.Merge(m, src)
+}
+func (m *CredentialStatusBatchDocument) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredentialStatusBatchDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialStatusBatchDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialStatusBatchDocument proto.InternalMessageInfo
+
+func (m *CredentialStatusBatchDocument) GetContext() []string {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+func (m *CredentialStatusBatchDocument) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *CredentialStatusBatchDocument) GetCredentialStatuses() []*CredentialStatusDocument {
+	if m != nil {
+		return m.CredentialStatuses
+	}
+	return nil
+}
+
+// CredentialStatusBatchState stores a registered Credential Status Batch Document along with its proof, so that
+// the proof of the Credential Statuses registered through it can be verified
+type CredentialStatusBatchState struct {
+	// Hex encoded SHA-256 hash of the Credential Status Batch Document
+	Id                            string                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CredentialStatusBatchDocument *CredentialStatusBatchDocument `protobuf:"bytes,2,opt,name=credentialStatusBatchDocument,proto3" json:"credentialStatusBatchDocument,omitempty"`
+	CredentialStatusBatchProof    *DocumentProof                 `protobuf:"bytes,3,opt,name=credentialStatusBatchProof,proto3" json:"credentialStatusBatchProof,omitempty"`
+}
+
+func (m *CredentialStatusBatchState) Reset()         { *m = CredentialStatusBatchState{} }
+func (m *CredentialStatusBatchState) String() string { return proto.CompactTextString(m) }
+func (*CredentialStatusBatchState) ProtoMessage()    {}
+func (*CredentialStatusBatchState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8253d9579d71e297, []int{3}
+}
+func (m *CredentialStatusBatchState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CredentialStatusBatchState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CredentialStatusBatchState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CredentialStatusBatchState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialStatusBatchState.Merge(m, src)
+}
+func (m *CredentialStatusBatchState) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredentialStatusBatchState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialStatusBatchState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialStatusBatchState proto.InternalMessageInfo
+
+func (m *CredentialStatusBatchState) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CredentialStatusBatchState) GetCredentialStatusBatchDocument() *CredentialStatusBatchDocument {
+	if m != nil {
+		return m.CredentialStatusBatchDocument
+	}
+	return nil
+}
+
+func (m *CredentialStatusBatchState) GetCredentialStatusBatchProof() *DocumentProof {
+	if m != nil {
+		return m.CredentialStatusBatchProof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CredentialStatusDocument)(nil), "hypersign.ssi.v1.CredentialStatusDocument")
 	proto.RegisterType((*CredentialStatusState)(nil), "hypersign.ssi.v1.CredentialStatusState")
+	proto.RegisterType((*CredentialStatusBatchDocument)(nil), "hypersign.ssi.v1.CredentialStatusBatchDocument")
+	proto.RegisterType((*CredentialStatusBatchState)(nil), "hypersign.ssi.v1.CredentialStatusBatchState")
 }
 
 func init() {
@@ -185,32 +350,41 @@ func init() {
 }
 
 var fileDescriptor_8253d9579d71e297 = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcd, 0xae, 0xd2, 0x40,
-	0x14, 0x66, 0x7a, 0x95, 0x9f, 0xb9, 0xc4, 0x98, 0x89, 0x98, 0x09, 0x21, 0xa5, 0x61, 0x63, 0x63,
-	0x42, 0x1b, 0x70, 0xe7, 0xca, 0x20, 0x0b, 0x17, 0x9a, 0x98, 0x1a, 0x37, 0x6e, 0x4c, 0xe9, 0x1c,
-	0xda, 0x09, 0xd0, 0x69, 0x66, 0xa6, 0x04, 0xde, 0xc2, 0x17, 0xf0, 0x7d, 0x5c, 0xb2, 0x32, 0xae,
-	0x8c, 0x81, 0x9d, 0x4f, 0x61, 0x3a, 0x40, 0x11, 0xb8, 0x6c, 0x26, 0xe7, 0x9c, 0xef, 0xe7, 0x24,
-	0xdf, 0x1c, 0xec, 0x26, 0xeb, 0x0c, 0xa4, 0xe2, 0x71, 0xea, 0x2b, 0xc5, 0xfd, 0xe5, 0xc0, 0x8f,
-	0x24, 0x30, 0x48, 0x35, 0x0f, 0xe7, 0x5f, 0x95, 0x0e, 0x75, 0xae, 0xbc, 0x4c, 0x0a, 0x2d, 0xc8,
-	0xd3, 0x92, 0xe9, 0x29, 0xc5, 0xbd, 0xe5, 0xa0, 0xdd, 0xb9, 0xd2, 0x66, 0x52, 0x88, 0xe9, 0x9e,
-	0xdf, 0x7e, 0x16, 0x8b, 0x58, 0x98, 0xd2, 0x2f, 0xaa, 0xfd, 0xb4, 0xf7, 0xdd, 0xc2, 0xf4, 0x6d,
-	0xb9, 0xe1, 0x93, 0x59, 0x30, 0x16, 0x51, 0xbe, 0x80, 0x54, 0x93, 0x17, 0xb8, 0x16, 0x89, 0x54,
-	0xc3, 0x4a, 0x53, 0xe4, 0xdc, 0xb9, 0x8d, 0x51, 0xf3, 0xef, 0xef, 0x6e, 0xfd, 0xcd, 0x61, 0x16,
-	0x94, 0x15, 0x79, 0x82, 0x2d, 0xce, 0xa8, 0xe5, 0x20, 0xb7, 0x11, 0x58, 0x9c, 0x11, 0x8a, 0x6b,
-	0x12, 0x96, 0x62, 0x06, 0x8c, 0xde, 0x39, 0xc8, 0xad, 0x07, 0xc7, 0x96, 0x74, 0x70, 0x43, 0xe5,
-	0x2a, 0x83, 0x94, 0x01, 0xa3, 0x8f, 0x0c, 0x76, 0x1a, 0xec, 0x75, 0x8b, 0x50, 0xce, 0x14, 0x7d,
-	0x6c, 0xcc, 0x8e, 0x2d, 0x79, 0x8e, 0xab, 0x5c, 0xa9, 0x1c, 0x24, 0xad, 0x1a, 0xe0, 0xd0, 0x91,
-	0x1e, 0x6e, 0x16, 0x55, 0x98, 0x46, 0x30, 0x0e, 0x35, 0xd0, 0x9a, 0x41, 0xcf, 0x66, 0xe4, 0x35,
-	0xa6, 0xa7, 0x10, 0x3f, 0x80, 0x9c, 0xcd, 0x21, 0x10, 0x42, 0xbf, 0x0b, 0x55, 0x42, 0xeb, 0x86,
-	0x7f, 0x13, 0xef, 0xfd, 0x44, 0xb8, 0x75, 0x99, 0x4f, 0xf1, 0x02, 0x99, 0xfe, 0xef, 0x7a, 0x1e,
-	0x1c, 0x45, 0x0e, 0x72, 0xef, 0x87, 0x2f, 0xbd, 0xcb, 0x2f, 0xf2, 0x6e, 0x45, 0x1d, 0xdc, 0xf4,
-	0x22, 0x9f, 0x71, 0xeb, 0x12, 0xfb, 0x58, 0x7c, 0xab, 0x89, 0xfb, 0x7e, 0xd8, 0xbd, 0x5e, 0x72,
-	0x94, 0x1a, 0x5a, 0xf0, 0xb0, 0x7a, 0xf4, 0xfe, 0xc7, 0xd6, 0x46, 0x9b, 0xad, 0x8d, 0xfe, 0x6c,
-	0x6d, 0xf4, 0x6d, 0x67, 0x57, 0x36, 0x3b, 0xbb, 0xf2, 0x6b, 0x67, 0x57, 0xbe, 0x0c, 0x63, 0xae,
-	0x93, 0x7c, 0xe2, 0x45, 0x62, 0xe1, 0x97, 0xde, 0x7d, 0x73, 0x2e, 0x91, 0x98, 0xfb, 0x09, 0x67,
-	0xfd, 0x54, 0x30, 0xf0, 0x57, 0xe6, 0xca, 0xf4, 0x3a, 0x03, 0x35, 0xa9, 0x1a, 0xf8, 0xd5, 0xbf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x38, 0xb6, 0x1c, 0xf4, 0xbf, 0x02, 0x00, 0x00,
+	// 536 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xee, 0x3a, 0x90, 0x9f, 0x6d, 0x55, 0xa1, 0x15, 0x85, 0x55, 0xd4, 0xba, 0x91, 0x0f, 0x60,
+	0x21, 0xd5, 0x56, 0xc3, 0x05, 0x71, 0x42, 0x69, 0x0f, 0x54, 0x02, 0x09, 0xb9, 0xe2, 0xd2, 0x4b,
+	0xe5, 0xda, 0xd3, 0x78, 0x95, 0xc4, 0x6b, 0x79, 0xd7, 0x51, 0x7a, 0xe7, 0x01, 0x78, 0x0e, 0x9e,
+	0x81, 0x07, 0xe0, 0xd8, 0x23, 0x27, 0x84, 0x92, 0x1b, 0x77, 0xee, 0xc8, 0xeb, 0xc4, 0xc6, 0xae,
+	0x13, 0xe8, 0xc5, 0x9a, 0x99, 0x6f, 0x7e, 0xd6, 0xf3, 0x7d, 0xbb, 0xd8, 0x0c, 0x6e, 0x22, 0x88,
+	0x05, 0x1b, 0x86, 0xb6, 0x10, 0xcc, 0x9e, 0x1e, 0xdb, 0x5e, 0x0c, 0x3e, 0x84, 0x92, 0xb9, 0xe3,
+	0x4b, 0x21, 0x5d, 0x99, 0x08, 0x2b, 0x8a, 0xb9, 0xe4, 0xe4, 0x51, 0x9e, 0x69, 0x09, 0xc1, 0xac,
+	0xe9, 0x71, 0x77, 0xff, 0x4e, 0x6d, 0x14, 0x73, 0x7e, 0x9d, 0xe5, 0x77, 0x1f, 0x0f, 0xf9, 0x90,
+	0x2b, 0xd3, 0x4e, 0xad, 0x2c, 0x6a, 0xfc, 0xd6, 0x30, 0x3d, 0xc9, 0x27, 0x9c, 0xab, 0x01, 0xa7,
+	0xdc, 0x4b, 0x26, 0x10, 0x4a, 0xf2, 0x1c, 0xb7, 0x3c, 0x1e, 0x4a, 0x98, 0x49, 0x8a, 0x7a, 0x0d,
+	0xb3, 0x33, 0xd8, 0xf9, 0xf5, 0xe3, 0xb0, 0xfd, 0x66, 0x19, 0x73, 0x72, 0x8b, 0xec, 0x62, 0x8d,
+	0xf9, 0x54, 0xeb, 0x21, 0xb3, 0xe3, 0x68, 0xcc, 0x27, 0x14, 0xb7, 0x62, 0x98, 0xf2, 0x11, 0xf8,
+	0xb4, 0xd1, 0x43, 0x66, 0xdb, 0x59, 0xb9, 0x64, 0x1f, 0x77, 0x44, 0x22, 0x22, 0x08, 0x7d, 0xf0,
+	0xe9, 0x03, 0x85, 0x15, 0x81, 0xac, 0x6e, 0xe2, 0xc6, 0x23, 0x41, 0x1f, 0xaa, 0x66, 0x2b, 0x97,
+	0x3c, 0xc1, 0x4d, 0x26, 0x44, 0x02, 0x31, 0x6d, 0x2a, 0x60, 0xe9, 0x11, 0x03, 0xef, 0xa4, 0x96,
+	0x1b, 0x7a, 0x70, 0xea, 0x4a, 0xa0, 0x2d, 0x85, 0x96, 0x62, 0xe4, 0x35, 0xa6, 0xc5, 0x12, 0xdf,
+	0x43, 0x3c, 0x1a, 0x83, 0xc3, 0xb9, 0x7c, 0xeb, 0x8a, 0x80, 0xb6, 0x55, 0xfe, 0x5a, 0x9c, 0x3c,
+	0xc3, 0xbb, 0x30, 0x8b, 0x58, 0xec, 0x4a, 0xc6, 0x43, 0x35, 0xa1, 0xa3, 0x2a, 0x2a, 0x51, 0x62,
+	0x61, 0x52, 0xf4, 0x38, 0xf7, 0x02, 0x98, 0xb8, 0x67, 0x3e, 0xc5, 0x2a, 0xb7, 0x06, 0x31, 0xbe,
+	0x68, 0x78, 0xaf, 0xba, 0xf7, 0xf4, 0x0b, 0xe4, 0xfa, 0xef, 0xd3, 0x96, 0x09, 0xa1, 0xa8, 0x87,
+	0xcc, 0xed, 0xfe, 0x0b, 0xab, 0x4a, 0xbd, 0xb5, 0x8e, 0x42, 0x67, 0x6d, 0x2f, 0xf2, 0x11, 0xef,
+	0x55, 0xb1, 0x0f, 0xa9, 0x5c, 0x14, 0x8d, 0xdb, 0xfd, 0xc3, 0xbb, 0x43, 0x56, 0xa5, 0x2a, 0xcd,
+	0xa9, 0xaf, 0x4e, 0x29, 0x54, 0xab, 0x29, 0xa8, 0x5f, 0xba, 0xe4, 0x15, 0x7e, 0x5a, 0x2d, 0x19,
+	0xb8, 0xd2, 0x0b, 0xce, 0x32, 0x21, 0x74, 0x9c, 0x75, 0xb0, 0xf1, 0x15, 0xe1, 0x83, 0x93, 0x3a,
+	0xec, 0xfe, 0x4a, 0x2d, 0x74, 0xa4, 0x95, 0x74, 0x74, 0x51, 0xe2, 0x4f, 0x4d, 0x00, 0x41, 0x1b,
+	0xbd, 0xc6, 0x3d, 0xf7, 0x5d, 0xd3, 0xc5, 0xf8, 0xa4, 0xe1, 0x6e, 0xed, 0xf1, 0x33, 0xc2, 0xb3,
+	0xcb, 0x83, 0xf2, 0xcb, 0x93, 0xe0, 0x03, 0x6f, 0xd3, 0xcf, 0x2e, 0x09, 0xb2, 0xff, 0x7d, 0xaa,
+	0x52, 0x99, 0xb3, 0xb9, 0x2b, 0xb9, 0xc4, 0xdd, 0xda, 0x84, 0x4c, 0x14, 0x8d, 0xff, 0x13, 0xc5,
+	0x86, 0x16, 0x83, 0x77, 0xdf, 0xe6, 0x3a, 0xba, 0x9d, 0xeb, 0xe8, 0xe7, 0x5c, 0x47, 0x9f, 0x17,
+	0xfa, 0xd6, 0xed, 0x42, 0xdf, 0xfa, 0xbe, 0xd0, 0xb7, 0x2e, 0xfa, 0x43, 0x26, 0x83, 0xe4, 0xca,
+	0xf2, 0xf8, 0xc4, 0xce, 0x07, 0x1c, 0xa9, 0x07, 0xca, 0xe3, 0x63, 0x3b, 0x60, 0xfe, 0x51, 0xc8,
+	0x7d, 0xb0, 0x67, 0xea, 0x5d, 0x93, 0x37, 0x11, 0x88, 0xab, 0xa6, 0x82, 0x5f, 0xfe, 0x19, 0x00,
+	0xfd, 0x13, 0xf4, 0xa1, 0x31, 0x05, 0x00, 0x00,
 }
 
 func (m *CredentialStatusDocument) Marshal() (dAtA []byte, err error) {
@@ -233,6 +407,20 @@ func (m *CredentialStatusDocument) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.CredentialSchemaId) > 0 {
+		i -= len(m.CredentialSchemaId)
+		copy(dAtA[i:], m.CredentialSchemaId)
+		i = encodeVarintCredentialStatus(dAtA, i, uint64(len(m.CredentialSchemaId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ExpirationDate) > 0 {
+		i -= len(m.ExpirationDate)
+		copy(dAtA[i:], m.ExpirationDate)
+		i = encodeVarintCredentialStatus(dAtA, i, uint64(len(m.ExpirationDate)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.CredentialMerkleRootHash) > 0 {
 		i -= len(m.CredentialMerkleRootHash)
 		copy(dAtA[i:], m.CredentialMerkleRootHash)
@@ -320,6 +508,23 @@ func (m *CredentialStatusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CredentialStatusBatchId) > 0 {
+		i -= len(m.CredentialStatusBatchId)
+		copy(dAtA[i:], m.CredentialStatusBatchId)
+		i = encodeVarintCredentialStatus(dAtA, i, uint64(len(m.CredentialStatusBatchId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.CredentialStatusProof != nil {
 		{
 			size, err := m.CredentialStatusProof.MarshalToSizedBuffer(dAtA[:i])
@@ -347,6 +552,113 @@ func (m *CredentialStatusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CredentialStatusBatchDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialStatusBatchDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialStatusBatchDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredentialStatuses) > 0 {
+		for iNdEx := len(m.CredentialStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredentialStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCredentialStatus(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintCredentialStatus(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Context) > 0 {
+		for iNdEx := len(m.Context) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Context[iNdEx])
+			copy(dAtA[i:], m.Context[iNdEx])
+			i = encodeVarintCredentialStatus(dAtA, i, uint64(len(m.Context[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CredentialStatusBatchState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialStatusBatchState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialStatusBatchState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CredentialStatusBatchProof != nil {
+		{
+			size, err := m.CredentialStatusBatchProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCredentialStatus(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CredentialStatusBatchDocument != nil {
+		{
+			size, err := m.CredentialStatusBatchDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCredentialStatus(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintCredentialStatus(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCredentialStatus(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCredentialStatus(v)
 	base := offset
@@ -396,6 +708,14 @@ func (m *CredentialStatusDocument) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCredentialStatus(uint64(l))
 	}
+	l = len(m.ExpirationDate)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatus(uint64(l))
+	}
+	l = len(m.CredentialSchemaId)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatus(uint64(l))
+	}
 	return n
 }
 
@@ -413,6 +733,59 @@ func (m *CredentialStatusState) Size() (n int) {
 		l = m.CredentialStatusProof.Size()
 		n += 1 + l + sovCredentialStatus(uint64(l))
 	}
+	if m.Expired {
+		n += 2
+	}
+	l = len(m.CredentialStatusBatchId)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatus(uint64(l))
+	}
+	return n
+}
+
+func (m *CredentialStatusBatchDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Context) > 0 {
+		for _, s := range m.Context {
+			l = len(s)
+			n += 1 + l + sovCredentialStatus(uint64(l))
+		}
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatus(uint64(l))
+	}
+	if len(m.CredentialStatuses) > 0 {
+		for _, e := range m.CredentialStatuses {
+			l = e.Size()
+			n += 1 + l + sovCredentialStatus(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CredentialStatusBatchState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatus(uint64(l))
+	}
+	if m.CredentialStatusBatchDocument != nil {
+		l = m.CredentialStatusBatchDocument.Size()
+		n += 1 + l + sovCredentialStatus(uint64(l))
+	}
+	if m.CredentialStatusBatchProof != nil {
+		l = m.CredentialStatusBatchProof.Size()
+		n += 1 + l + sovCredentialStatus(uint64(l))
+	}
 	return n
 }
 
@@ -683,17 +1056,81 @@ func (m *CredentialStatusDocument) Unmarshal(dAtA []byte) error {
 			}
 			m.CredentialMerkleRootHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCredentialStatus(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCredentialStatus
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpirationDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialSchemaId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCredentialStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -805,6 +1242,360 @@ func (m *CredentialStatusState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusBatchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialStatusBatchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCredentialStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CredentialStatusBatchDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCredentialStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredentialStatusBatchDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredentialStatusBatchDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = append(m.Context, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialStatuses = append(m.CredentialStatuses, &CredentialStatusDocument{})
+			if err := m.CredentialStatuses[len(m.CredentialStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCredentialStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CredentialStatusBatchState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCredentialStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredentialStatusBatchState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredentialStatusBatchState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusBatchDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialStatusBatchDocument == nil {
+				m.CredentialStatusBatchDocument = &CredentialStatusBatchDocument{}
+			}
+			if err := m.CredentialStatusBatchDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusBatchProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialStatusBatchProof == nil {
+				m.CredentialStatusBatchProof = &DocumentProof{}
+			}
+			if err := m.CredentialStatusBatchProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCredentialStatus(dAtA[iNdEx:])
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/utils"
+	"github.com/multiformats/go-multibase"
+)
+
+// Status purposes of a Credential Status List
+const (
+	StatusPurposeRevocation = "revocation"
+	StatusPurposeSuspension = "suspension"
+)
+
+var SupportedStatusPurposes = []string{
+	StatusPurposeRevocation,
+	StatusPurposeSuspension,
+}
+
+// W3C Bitstring Status List specification requires the uncompressed bitstring to be at least 16KB,
+// which provides group privacy to the holders of Credentials issued against a list
+const MinStatusListBitstringLength = 16 * 1024
+
+// MaxStatusListBitstringLength bounds the uncompressed size of bitstring, as the encoded list
+// is decompressed during validation. It accommodates the statuses of over 16 million Credentials.
+const MaxStatusListBitstringLength = 2 * 1024 * 1024
+
+// StatusListDecompressionGasPerByte is the gas charged for every byte of bitstring decompressed
+// from the encoded list of a Credential Status List transaction
+const StatusListDecompressionGasPerByte uint64 = 1
+
+// W3C Verifiable Credential representation of Credential Status List
+const (
+	CredentialsV2Context               = "https://www.w3.org/ns/credentials/v2"
+	VerifiableCredentialType           = "VerifiableCredential"
+	BitstringStatusListCredentialType  = "BitstringStatusListCredential"
+	BitstringStatusListType            = "BitstringStatusList"
+	bitstringStatusListSubjectFragment = "#list"
+)
+
+// DecodeStatusList decodes the encoded list of Credential Status List into its uncompressed bitstring.
+// The encoded list is expected to be the Multibase base64url encoding of GZIP-compressed bitstring.
+func DecodeStatusList(encodedList string) ([]byte, error) {
+	return DecodeStatusListWithGas(encodedList, nil)
+}
+
+// DecodeStatusListWithGas decodes the encoded list like DecodeStatusList, and charges gasMeter for every
+// decompressed byte as it is read, such that decompression stops once the transaction runs out of gas.
+// Gas is not charged if gasMeter is nil.
+func DecodeStatusListWithGas(encodedList string, gasMeter storetypes.GasMeter) ([]byte, error) {
+	encoding, compressedList, err := multibase.Decode(encodedList)
+	if err != nil {
+		return nil, fmt.Errorf("encoded list is not a valid multibase string: %v", err)
+	}
+	if encoding != multibase.Base64url {
+		return nil, fmt.Errorf("encoded list must be multibase base64url encoded")
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(compressedList))
+	if err != nil {
+		return nil, fmt.Errorf("encoded list is not GZIP compressed: %v", err)
+	}
+	defer reader.Close()
+
+	var decompressedReader io.Reader = reader
+	if gasMeter != nil {
+		decompressedReader = &gasMeteredReader{reader: reader, gasMeter: gasMeter}
+	}
+
+	// Read one byte beyond the permitted length to detect oversized bitstrings
+	bitstring, err := io.ReadAll(io.LimitReader(decompressedReader, MaxStatusListBitstringLength+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress encoded list: %v", err)
+	}
+	if len(bitstring) > MaxStatusListBitstringLength {
+		return nil, fmt.Errorf("bitstring must not be longer than %v bytes", MaxStatusListBitstringLength)
+	}
+
+	return bitstring, nil
+}
+
+// gasMeteredReader charges gas for every byte read from the underlying reader
+type gasMeteredReader struct {
+	reader   io.Reader
+	gasMeter storetypes.GasMeter
+}
+
+func (r *gasMeteredReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.gasMeter.ConsumeGas(uint64(n)*StatusListDecompressionGasPerByte, "decompress credential status list")
+	return n, err
+}
+
+// EncodeStatusList encodes a bitstring into the encoded list of Credential Status List
+func EncodeStatusList(bitstring []byte) (string, error) {
+	var compressedList bytes.Buffer
+
+	writer := gzip.NewWriter(&compressedList)
+	if _, err := writer.Write(bitstring); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	return multibase.Encode(multibase.Base64url, compressedList.Bytes())
+}
+
+// Validate performs stateless validation of Credential Status List Document
+func (doc *CredentialStatusListDocument) Validate() error {
+	return doc.ValidateWithGas(nil)
+}
+
+// ValidateWithGas performs stateless validation of Credential Status List Document, and charges gasMeter
+// for decompressing its encoded list
+func (doc *CredentialStatusListDocument) ValidateWithGas(gasMeter storetypes.GasMeter) error {
+	if doc.Id == "" {
+		return fmt.Errorf("credential status list id cannot be empty")
+	}
+	if len(doc.Context) == 0 {
+		return fmt.Errorf("atleast one context url must be provided for credential status list %v", doc.Id)
+	}
+	if doc.Issuer == "" {
+		return fmt.Errorf("issuer of credential status list %v cannot be empty", doc.Id)
+	}
+
+	if !utils.FindInSlice(SupportedStatusPurposes, doc.StatusPurpose) {
+		return fmt.Errorf(
+			"unsupported status purpose %v, supported status purposes are %v",
+			doc.StatusPurpose,
+			SupportedStatusPurposes,
+		)
+	}
+
+	if _, err := time.Parse(time.RFC3339, doc.ValidFrom); err != nil {
+		return fmt.Errorf("invalid validFrom date format: %v", doc.ValidFrom)
+	}
+
+	bitstring, err := DecodeStatusListWithGas(doc.EncodedList, gasMeter)
+	if err != nil {
+		return err
+	}
+	if len(bitstring) < MinStatusListBitstringLength {
+		return fmt.Errorf("bitstring must be atleast %v bytes long, got %v", MinStatusListBitstringLength, len(bitstring))
+	}
+
+	return nil
+}
+
+// NewBitstringStatusListCredential returns the W3C Verifiable Credential representation of Credential Status List.
+// It carries no proof, as the proof of Credential Status List is created over its document.
+func NewBitstringStatusListCredential(credentialStatusList *CredentialStatusListState) *BitstringStatusListCredential {
+	doc := credentialStatusList.CredentialStatusListDocument
+
+	return &BitstringStatusListCredential{
+		Context:   []string{CredentialsV2Context},
+		Id:        doc.Id,
+		Type:      []string{VerifiableCredentialType, BitstringStatusListCredentialType},
+		Issuer:    doc.Issuer,
+		ValidFrom: doc.ValidFrom,
+		CredentialSubject: &BitstringStatusList{
+			Id:            doc.Id + bitstringStatusListSubjectFragment,
+			Type:          BitstringStatusListType,
+			StatusPurpose: doc.StatusPurpose,
+			EncodedList:   doc.EncodedList,
+		},
+	}
+}
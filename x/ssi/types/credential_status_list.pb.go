@@ -0,0 +1,1605 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hypersign/ssi/v1/credential_status_list.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CredentialStatusListDocument is a compressed bitstring of statuses of every Credential issued against it,
+// as per W3C Bitstring Status List specification. `encodedList` is the Multibase (base64url, no padding)
+// encoding of the GZIP-compressed bitstring.
+type CredentialStatusListDocument struct {
+	Context       []string `protobuf:"bytes,1,rep,name=context,json=@context,proto3" json:"@context"`
+	Id            string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Issuer        string   `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	StatusPurpose string   `protobuf:"bytes,4,opt,name=statusPurpose,proto3" json:"statusPurpose,omitempty"`
+	EncodedList   string   `protobuf:"bytes,5,opt,name=encodedList,proto3" json:"encodedList,omitempty"`
+	ValidFrom     string   `protobuf:"bytes,6,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
+}
+
+func (m *CredentialStatusListDocument) Reset()         { *m = CredentialStatusListDocument{} }
+func (m *CredentialStatusListDocument) String() string { return proto.CompactTextString(m) }
+func (*CredentialStatusListDocument) ProtoMessage()    {}
+func (*CredentialStatusListDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91cbf2a853e542ca, []int{0}
+}
+func (m *CredentialStatusListDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CredentialStatusListDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CredentialStatusListDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CredentialStatusListDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialStatusListDocument.Merge(m, src)
+}
+func (m *CredentialStatusListDocument) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredentialStatusListDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialStatusListDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialStatusListDocument proto.InternalMessageInfo
+
+func (m *CredentialStatusListDocument) GetContext() []string {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+func (m *CredentialStatusListDocument) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CredentialStatusListDocument) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *CredentialStatusListDocument) GetStatusPurpose() string {
+	if m != nil {
+		return m.StatusPurpose
+	}
+	return ""
+}
+
+func (m *CredentialStatusListDocument) GetEncodedList() string {
+	if m != nil {
+		return m.EncodedList
+	}
+	return ""
+}
+
+func (m *CredentialStatusListDocument) GetValidFrom() string {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return ""
+}
+
+type CredentialStatusListState struct {
+	CredentialStatusListDocument *CredentialStatusListDocument `protobuf:"bytes,1,opt,name=credentialStatusListDocument,proto3" json:"credentialStatusListDocument,omitempty"`
+	CredentialStatusListProof    *DocumentProof                `protobuf:"bytes,2,opt,name=credentialStatusListProof,proto3" json:"credentialStatusListProof,omitempty"`
+}
+
+func (m *CredentialStatusListState) Reset()         { *m = CredentialStatusListState{} }
+func (m *CredentialStatusListState) String() string { return proto.CompactTextString(m) }
+func (*CredentialStatusListState) ProtoMessage()    {}
+func (*CredentialStatusListState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91cbf2a853e542ca, []int{1}
+}
+func (m *CredentialStatusListState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CredentialStatusListState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CredentialStatusListState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CredentialStatusListState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialStatusListState.Merge(m, src)
+}
+func (m *CredentialStatusListState) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredentialStatusListState) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialStatusListState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialStatusListState proto.InternalMessageInfo
+
+func (m *CredentialStatusListState) GetCredentialStatusListDocument() *CredentialStatusListDocument {
+	if m != nil {
+		return m.CredentialStatusListDocument
+	}
+	return nil
+}
+
+func (m *CredentialStatusListState) GetCredentialStatusListProof() *DocumentProof {
+	if m != nil {
+		return m.CredentialStatusListProof
+	}
+	return nil
+}
+
+// BitstringStatusListCredential is the W3C Verifiable Credential representation of a Credential Status List. It
+// carries no proof, as the proof of the issuer is created over the Credential Status List Document.
+type BitstringStatusListCredential struct {
+	Context           []string             `protobuf:"bytes,1,rep,name=context,json=@context,proto3" json:"@context"`
+	Id                string               `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Type              []string             `protobuf:"bytes,3,rep,name=type,proto3" json:"type,omitempty"`
+	Issuer            string               `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	ValidFrom         string               `protobuf:"bytes,5,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
+	CredentialSubject *BitstringStatusList `protobuf:"bytes,6,opt,name=credentialSubject,proto3" json:"credentialSubject,omitempty"`
+}
+
+func (m *BitstringStatusListCredential) Reset()         { *m = BitstringStatusListCredential{} }
+func (m *BitstringStatusListCredential) String() string { return proto.CompactTextString(m) }
+func (*BitstringStatusListCredential) ProtoMessage()    {}
+func (*BitstringStatusListCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91cbf2a853e542ca, []int{2}
+}
+func (m *BitstringStatusListCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BitstringStatusListCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BitstringStatusListCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BitstringStatusListCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BitstringStatusListCredential.Merge(m, src)
+}
+func (m *BitstringStatusListCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *BitstringStatusListCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_BitstringStatusListCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BitstringStatusListCredential proto.InternalMessageInfo
+
+func (m *BitstringStatusListCredential) GetContext() []string {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+func (m *BitstringStatusListCredential) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BitstringStatusListCredential) GetType() []string {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *BitstringStatusListCredential) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *BitstringStatusListCredential) GetValidFrom() string {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return ""
+}
+
+func (m *BitstringStatusListCredential) GetCredentialSubject() *BitstringStatusList {
+	if m != nil {
+		return m.CredentialSubject
+	}
+	return nil
+}
+
+type BitstringStatusList struct {
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	StatusPurpose string `protobuf:"bytes,3,opt,name=statusPurpose,proto3" json:"statusPurpose,omitempty"`
+	EncodedList   string `protobuf:"bytes,4,opt,name=encodedList,proto3" json:"encodedList,omitempty"`
+}
+
+func (m *BitstringStatusList) Reset()         { *m = BitstringStatusList{} }
+func (m *BitstringStatusList) String() string { return proto.CompactTextString(m) }
+func (*BitstringStatusList) ProtoMessage()    {}
+func (*BitstringStatusList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91cbf2a853e542ca, []int{3}
+}
+func (m *BitstringStatusList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BitstringStatusList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BitstringStatusList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BitstringStatusList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BitstringStatusList.Merge(m, src)
+}
+func (m *BitstringStatusList) XXX_Size() int {
+	return m.Size()
+}
+func (m *BitstringStatusList) XXX_DiscardUnknown() {
+	xxx_messageInfo_BitstringStatusList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BitstringStatusList proto.InternalMessageInfo
+
+func (m *BitstringStatusList) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BitstringStatusList) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *BitstringStatusList) GetStatusPurpose() string {
+	if m != nil {
+		return m.StatusPurpose
+	}
+	return ""
+}
+
+func (m *BitstringStatusList) GetEncodedList() string {
+	if m != nil {
+		return m.EncodedList
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CredentialStatusListDocument)(nil), "hypersign.ssi.v1.CredentialStatusListDocument")
+	proto.RegisterType((*CredentialStatusListState)(nil), "hypersign.ssi.v1.CredentialStatusListState")
+	proto.RegisterType((*BitstringStatusListCredential)(nil), "hypersign.ssi.v1.BitstringStatusListCredential")
+	proto.RegisterType((*BitstringStatusList)(nil), "hypersign.ssi.v1.BitstringStatusList")
+}
+
+func init() {
+	proto.RegisterFile("hypersign/ssi/v1/credential_status_list.proto", fileDescriptor_91cbf2a853e542ca)
+}
+
+var fileDescriptor_91cbf2a853e542ca = []byte{
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xae, 0xdb, 0xac, 0x6b, 0x5d, 0x40, 0xc3, 0x20, 0xe4, 0x4d, 0x25, 0x8b, 0x22, 0x10, 0xbd,
+	0x34, 0xd1, 0xc2, 0x1f, 0x40, 0x05, 0x71, 0x40, 0x3b, 0x4c, 0xd9, 0x0d, 0x09, 0x4d, 0xad, 0x6d,
+	0x52, 0xa3, 0x34, 0x8e, 0x6c, 0xa7, 0xda, 0x8e, 0xfc, 0x03, 0x7e, 0x16, 0xc7, 0x5d, 0x90, 0x38,
+	0x21, 0xd4, 0xde, 0x38, 0xf0, 0x0f, 0x90, 0x50, 0x9c, 0x92, 0x7e, 0x24, 0x54, 0x88, 0xdb, 0xeb,
+	0xe7, 0xfd, 0x7c, 0xde, 0xe7, 0x35, 0x1c, 0x4e, 0x6f, 0x52, 0x26, 0x15, 0x8f, 0x12, 0x5f, 0x29,
+	0xee, 0xcf, 0xcf, 0x7c, 0x22, 0x19, 0x65, 0x89, 0xe6, 0xe3, 0xf8, 0x4a, 0xe9, 0xb1, 0xce, 0xd4,
+	0x55, 0xcc, 0x95, 0xf6, 0x52, 0x29, 0xb4, 0x40, 0x47, 0x65, 0xb8, 0xa7, 0x14, 0xf7, 0xe6, 0x67,
+	0x27, 0xfd, 0x4a, 0x81, 0x54, 0x0a, 0xf1, 0xbe, 0x88, 0x3f, 0x79, 0x18, 0x89, 0x48, 0x18, 0xd3,
+	0xcf, 0xad, 0x02, 0x75, 0xbf, 0x00, 0xd8, 0x7f, 0x59, 0xb6, 0xb9, 0x34, 0x5d, 0xce, 0xb9, 0xd2,
+	0xaf, 0x04, 0xc9, 0x66, 0x2c, 0xd1, 0xe8, 0x19, 0x3c, 0x24, 0x22, 0xd1, 0xec, 0x5a, 0x63, 0xe0,
+	0xb4, 0x06, 0xdd, 0xd1, 0x9d, 0x1f, 0xdf, 0x4e, 0x3b, 0x2f, 0x56, 0x58, 0x58, 0x5a, 0xe8, 0x1e,
+	0x6c, 0x72, 0x8a, 0x9b, 0x0e, 0x18, 0x74, 0xc3, 0x26, 0xa7, 0xe8, 0x11, 0x6c, 0x73, 0xa5, 0x32,
+	0x26, 0x71, 0xcb, 0x60, 0xab, 0x17, 0x7a, 0x02, 0xef, 0x16, 0x64, 0x2e, 0x32, 0x99, 0x0a, 0xc5,
+	0xb0, 0x65, 0xdc, 0xdb, 0x20, 0x72, 0x60, 0x8f, 0x25, 0x44, 0x50, 0x46, 0xf3, 0x69, 0xf0, 0x81,
+	0x89, 0xd9, 0x84, 0x50, 0x1f, 0x76, 0xe7, 0xe3, 0x98, 0xd3, 0xd7, 0x52, 0xcc, 0x70, 0xdb, 0xf8,
+	0xd7, 0x80, 0xfb, 0x13, 0xc0, 0xe3, 0x3a, 0x5e, 0xb9, 0xc5, 0x90, 0x84, 0x7d, 0xb2, 0x87, 0x34,
+	0x06, 0x0e, 0x18, 0xf4, 0x02, 0xcf, 0xdb, 0x5d, 0xb1, 0xb7, 0x6f, 0x55, 0xe1, 0xde, 0x9a, 0xe8,
+	0x1d, 0x3c, 0xae, 0xf3, 0x5f, 0xe4, 0x12, 0x99, 0xb5, 0xf5, 0x82, 0xd3, 0x6a, 0xc3, 0x3f, 0xe9,
+	0x26, 0x2c, 0xfc, 0x7b, 0x05, 0xf7, 0x17, 0x80, 0x8f, 0x47, 0x5c, 0x2b, 0x2d, 0x79, 0x12, 0xad,
+	0x9d, 0xeb, 0x81, 0xff, 0x5f, 0x49, 0x04, 0x2d, 0x7d, 0x93, 0x32, 0xdc, 0xca, 0xb3, 0x42, 0x63,
+	0x6f, 0xa8, 0x6b, 0x6d, 0xa9, 0xbb, 0xa5, 0xca, 0xc1, 0x8e, 0x2a, 0xe8, 0x12, 0xde, 0xdf, 0x60,
+	0x90, 0x4d, 0x3e, 0x30, 0xa2, 0x8d, 0x76, 0xbd, 0xe0, 0x69, 0x95, 0x7b, 0x0d, 0x9d, 0xb0, 0x9a,
+	0xff, 0xc6, 0xea, 0x1c, 0x1e, 0x75, 0xdc, 0x8f, 0x00, 0x3e, 0xa8, 0x49, 0x58, 0x91, 0x01, 0x15,
+	0x32, 0x05, 0xbd, 0x82, 0x4c, 0xe5, 0x24, 0x5b, 0xff, 0x70, 0x92, 0x56, 0xe5, 0x24, 0x47, 0xe7,
+	0x9f, 0x17, 0x36, 0xb8, 0x5d, 0xd8, 0xe0, 0xfb, 0xc2, 0x06, 0x9f, 0x96, 0x76, 0xe3, 0x76, 0x69,
+	0x37, 0xbe, 0x2e, 0xed, 0xc6, 0xdb, 0x20, 0xe2, 0x7a, 0x9a, 0x4d, 0x3c, 0x22, 0x66, 0x7e, 0xc9,
+	0x73, 0x68, 0xbe, 0x20, 0x11, 0xb1, 0x3f, 0xe5, 0x74, 0x98, 0x08, 0xca, 0xfc, 0x6b, 0xf3, 0x73,
+	0xf3, 0xa1, 0xd4, 0xa4, 0x6d, 0xdc, 0xcf, 0x7f, 0x0f, 0x00, 0x04, 0xf2, 0x1f, 0x53, 0x18, 0x04,
+	0x00, 0x00,
+}
+
+func (m *CredentialStatusListDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialStatusListDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialStatusListDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidFrom) > 0 {
+		i -= len(m.ValidFrom)
+		copy(dAtA[i:], m.ValidFrom)
+		i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.ValidFrom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EncodedList) > 0 {
+		i -= len(m.EncodedList)
+		copy(dAtA[i:], m.EncodedList)
+		i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.EncodedList)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StatusPurpose) > 0 {
+		i -= len(m.StatusPurpose)
+		copy(dAtA[i:], m.StatusPurpose)
+		i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.StatusPurpose)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Context) > 0 {
+		for iNdEx := len(m.Context) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Context[iNdEx])
+			copy(dAtA[i:], m.Context[iNdEx])
+			i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.Context[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CredentialStatusListState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialStatusListState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialStatusListState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CredentialStatusListProof != nil {
+		{
+			size, err := m.CredentialStatusListProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCredentialStatusList(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CredentialStatusListDocument != nil {
+		{
+			size, err := m.CredentialStatusListDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCredentialStatusList(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BitstringStatusListCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BitstringStatusListCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BitstringStatusListCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CredentialSubject != nil {
+		{
+			size, err := m.CredentialSubject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCredentialStatusList(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ValidFrom) > 0 {
+		i -= len(m.ValidFrom)
+		copy(dAtA[i:], m.ValidFrom)
+		i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.ValidFrom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		for iNdEx := len(m.Type) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Type[iNdEx])
+			copy(dAtA[i:], m.Type[iNdEx])
+			i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.Type[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Context) > 0 {
+		for iNdEx := len(m.Context) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Context[iNdEx])
+			copy(dAtA[i:], m.Context[iNdEx])
+			i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.Context[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BitstringStatusList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BitstringStatusList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BitstringStatusList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncodedList) > 0 {
+		i -= len(m.EncodedList)
+		copy(dAtA[i:], m.EncodedList)
+		i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.EncodedList)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StatusPurpose) > 0 {
+		i -= len(m.StatusPurpose)
+		copy(dAtA[i:], m.StatusPurpose)
+		i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.StatusPurpose)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintCredentialStatusList(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCredentialStatusList(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCredentialStatusList(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CredentialStatusListDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Context) > 0 {
+		for _, s := range m.Context {
+			l = len(s)
+			n += 1 + l + sovCredentialStatusList(uint64(l))
+		}
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	l = len(m.StatusPurpose)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	l = len(m.EncodedList)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	l = len(m.ValidFrom)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	return n
+}
+
+func (m *CredentialStatusListState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredentialStatusListDocument != nil {
+		l = m.CredentialStatusListDocument.Size()
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	if m.CredentialStatusListProof != nil {
+		l = m.CredentialStatusListProof.Size()
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	return n
+}
+
+func (m *BitstringStatusListCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Context) > 0 {
+		for _, s := range m.Context {
+			l = len(s)
+			n += 1 + l + sovCredentialStatusList(uint64(l))
+		}
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	if len(m.Type) > 0 {
+		for _, s := range m.Type {
+			l = len(s)
+			n += 1 + l + sovCredentialStatusList(uint64(l))
+		}
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	l = len(m.ValidFrom)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	if m.CredentialSubject != nil {
+		l = m.CredentialSubject.Size()
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	return n
+}
+
+func (m *BitstringStatusList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	l = len(m.StatusPurpose)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	l = len(m.EncodedList)
+	if l > 0 {
+		n += 1 + l + sovCredentialStatusList(uint64(l))
+	}
+	return n
+}
+
+func sovCredentialStatusList(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCredentialStatusList(x uint64) (n int) {
+	return sovCredentialStatusList(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CredentialStatusListDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCredentialStatusList
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredentialStatusListDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredentialStatusListDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = append(m.Context, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusPurpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusPurpose = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedList = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCredentialStatusList(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CredentialStatusListState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCredentialStatusList
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredentialStatusListState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredentialStatusListState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusListDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialStatusListDocument == nil {
+				m.CredentialStatusListDocument = &CredentialStatusListDocument{}
+			}
+			if err := m.CredentialStatusListDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusListProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialStatusListProof == nil {
+				m.CredentialStatusListProof = &DocumentProof{}
+			}
+			if err := m.CredentialStatusListProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCredentialStatusList(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BitstringStatusListCredential) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCredentialStatusList
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BitstringStatusListCredential: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BitstringStatusListCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = append(m.Context, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = append(m.Type, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSubject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialSubject == nil {
+				m.CredentialSubject = &BitstringStatusList{}
+			}
+			if err := m.CredentialSubject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCredentialStatusList(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BitstringStatusList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCredentialStatusList
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BitstringStatusList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BitstringStatusList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusPurpose", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusPurpose = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedList = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCredentialStatusList(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCredentialStatusList
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCredentialStatusList(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCredentialStatusList
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCredentialStatusList
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCredentialStatusList
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCredentialStatusList
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCredentialStatusList
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCredentialStatusList        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCredentialStatusList          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCredentialStatusList = fmt.Errorf("proto: unexpected end of group")
+)
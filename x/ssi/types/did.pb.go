@@ -35,6 +35,12 @@ type DidDocument struct {
 	CapabilityInvocation []string              `protobuf:"bytes,9,rep,name=capabilityInvocation,proto3" json:"capabilityInvocation,omitempty"`
 	CapabilityDelegation []string              `protobuf:"bytes,10,rep,name=capabilityDelegation,proto3" json:"capabilityDelegation,omitempty"`
 	Service              []*Service            `protobuf:"bytes,11,rep,name=service,proto3" json:"service,omitempty"`
+	// Minimum number of controllers required to update or deactivate the DID Document. If it is
+	// not set, a signature from any one of the controllers is sufficient.
+	ControllerThreshold uint32 `protobuf:"varint,12,opt,name=controllerThreshold,proto3" json:"controllerThreshold,omitempty"`
+	// DIDs and verification method ids which can initiate a time-locked replacement of the DID Document,
+	// in case its keys are lost
+	Recovery []string `protobuf:"bytes,13,rep,name=recovery,proto3" json:"recovery,omitempty"`
 }
 
 func (m *DidDocument) Reset()         { *m = DidDocument{} }
@@ -147,11 +153,27 @@ func (m *DidDocument) GetService() []*Service {
 	return nil
 }
 
+func (m *DidDocument) GetControllerThreshold() uint32 {
+	if m != nil {
+		return m.ControllerThreshold
+	}
+	return 0
+}
+
+func (m *DidDocument) GetRecovery() []string {
+	if m != nil {
+		return m.Recovery
+	}
+	return nil
+}
+
 type DidDocumentMetadata struct {
 	Created     string `protobuf:"bytes,1,opt,name=created,proto3" json:"created,omitempty"`
 	Updated     string `protobuf:"bytes,2,opt,name=updated,proto3" json:"updated,omitempty"`
 	Deactivated bool   `protobuf:"varint,3,opt,name=deactivated,proto3" json:"deactivated,omitempty"`
 	VersionId   string `protobuf:"bytes,4,opt,name=versionId,proto3" json:"versionId,omitempty"`
+	// Verification Methods of DID Document which are reported as compromised
+	CompromisedVerificationMethods []*CompromisedVerificationMethod `protobuf:"bytes,5,rep,name=compromisedVerificationMethods,proto3" json:"compromisedVerificationMethods,omitempty"`
 }
 
 func (m *DidDocumentMetadata) Reset()         { *m = DidDocumentMetadata{} }
@@ -215,6 +237,146 @@ func (m *DidDocumentMetadata) GetVersionId() string {
 	return ""
 }
 
+func (m *DidDocumentMetadata) GetCompromisedVerificationMethods() []*CompromisedVerificationMethod {
+	if m != nil {
+		return m.CompromisedVerificationMethods
+	}
+	return nil
+}
+
+// CompromisedVerificationMethod records a Verification Method which is compromised since `compromisedSince`.
+// Proofs created by the Verification Method after that time are rejected, and so are the transactions signed by it
+// once it is recorded.
+type CompromisedVerificationMethod struct {
+	VerificationMethodId string `protobuf:"bytes,1,opt,name=verificationMethodId,proto3" json:"verificationMethodId,omitempty"`
+	CompromisedSince     string `protobuf:"bytes,2,opt,name=compromisedSince,proto3" json:"compromisedSince,omitempty"`
+	ReportedAt           string `protobuf:"bytes,3,opt,name=reportedAt,proto3" json:"reportedAt,omitempty"`
+}
+
+func (m *CompromisedVerificationMethod) Reset()         { *m = CompromisedVerificationMethod{} }
+func (m *CompromisedVerificationMethod) String() string { return proto.CompactTextString(m) }
+func (*CompromisedVerificationMethod) ProtoMessage()    {}
+func (*CompromisedVerificationMethod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28faf1be229531f8, []int{2}
+}
+func (m *CompromisedVerificationMethod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompromisedVerificationMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompromisedVerificationMethod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompromisedVerificationMethod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompromisedVerificationMethod.Merge(m, src)
+}
+func (m *CompromisedVerificationMethod) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompromisedVerificationMethod) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompromisedVerificationMethod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompromisedVerificationMethod proto.InternalMessageInfo
+
+func (m *CompromisedVerificationMethod) GetVerificationMethodId() string {
+	if m != nil {
+		return m.VerificationMethodId
+	}
+	return ""
+}
+
+func (m *CompromisedVerificationMethod) GetCompromisedSince() string {
+	if m != nil {
+		return m.CompromisedSince
+	}
+	return ""
+}
+
+func (m *CompromisedVerificationMethod) GetReportedAt() string {
+	if m != nil {
+		return m.ReportedAt
+	}
+	return ""
+}
+
+// VerificationMethodCompromiseDocument is signed by the controllers of DID Document `id` to report one of its
+// Verification Methods as compromised since `compromisedSince`
+type VerificationMethodCompromiseDocument struct {
+	Context              []string `protobuf:"bytes,1,rep,name=context,json=@context,proto3" json:"@context"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	VerificationMethodId string   `protobuf:"bytes,3,opt,name=verificationMethodId,proto3" json:"verificationMethodId,omitempty"`
+	CompromisedSince     string   `protobuf:"bytes,4,opt,name=compromisedSince,proto3" json:"compromisedSince,omitempty"`
+}
+
+func (m *VerificationMethodCompromiseDocument) Reset()         { *m = VerificationMethodCompromiseDocument{} }
+func (m *VerificationMethodCompromiseDocument) String() string { return proto.CompactTextString(m) }
+func (*VerificationMethodCompromiseDocument) ProtoMessage()    {}
+func (*VerificationMethodCompromiseDocument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28faf1be229531f8, []int{3}
+}
+func (m *VerificationMethodCompromiseDocument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationMethodCompromiseDocument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerificationMethodCompromiseDocument.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerificationMethodCompromiseDocument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationMethodCompromiseDocument.Merge(m, src)
+}
+func (m *VerificationMethodCompromiseDocument) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationMethodCompromiseDocument) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationMethodCompromiseDocument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationMethodCompromiseDocument proto.InternalMessageInfo
+
+func (m *VerificationMethodCompromiseDocument) GetContext() []string {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+func (m *VerificationMethodCompromiseDocument) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *VerificationMethodCompromiseDocument) GetVerificationMethodId() string {
+	if m != nil {
+		return m.VerificationMethodId
+	}
+	return ""
+}
+
+func (m *VerificationMethodCompromiseDocument) GetCompromisedSince() string {
+	if m != nil {
+		return m.CompromisedSince
+	}
+	return ""
+}
+
 type VerificationMethod struct {
 	Id                  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Type                string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
@@ -227,7 +389,7 @@ func (m *VerificationMethod) Reset()         { *m = VerificationMethod{} }
 func (m *VerificationMethod) String() string { return proto.CompactTextString(m) }
 func (*VerificationMethod) ProtoMessage()    {}
 func (*VerificationMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_28faf1be229531f8, []int{2}
+	return fileDescriptor_28faf1be229531f8, []int{4}
 }
 func (m *VerificationMethod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,7 +463,7 @@ func (m *Service) Reset()         { *m = Service{} }
 func (m *Service) String() string { return proto.CompactTextString(m) }
 func (*Service) ProtoMessage()    {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_28faf1be229531f8, []int{3}
+	return fileDescriptor_28faf1be229531f8, []int{5}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +522,7 @@ func (m *DidDocumentState) Reset()         { *m = DidDocumentState{} }
 func (m *DidDocumentState) String() string { return proto.CompactTextString(m) }
 func (*DidDocumentState) ProtoMessage()    {}
 func (*DidDocumentState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_28faf1be229531f8, []int{4}
+	return fileDescriptor_28faf1be229531f8, []int{6}
 }
 func (m *DidDocumentState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,56 +565,131 @@ func (m *DidDocumentState) GetDidDocumentMetadata() *DidDocumentMetadata {
 	return nil
 }
 
+// DidResolutionMetadata holds the metadata of a DID Resolution process, as per W3C DID Resolution
+type DidResolutionMetadata struct {
+	ContentType string `protobuf:"bytes,1,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Error       string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Retrieved   string `protobuf:"bytes,3,opt,name=retrieved,proto3" json:"retrieved,omitempty"`
+}
+
+func (m *DidResolutionMetadata) Reset()         { *m = DidResolutionMetadata{} }
+func (m *DidResolutionMetadata) String() string { return proto.CompactTextString(m) }
+func (*DidResolutionMetadata) ProtoMessage()    {}
+func (*DidResolutionMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28faf1be229531f8, []int{7}
+}
+func (m *DidResolutionMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidResolutionMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidResolutionMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DidResolutionMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidResolutionMetadata.Merge(m, src)
+}
+func (m *DidResolutionMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidResolutionMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidResolutionMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidResolutionMetadata proto.InternalMessageInfo
+
+func (m *DidResolutionMetadata) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *DidResolutionMetadata) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DidResolutionMetadata) GetRetrieved() string {
+	if m != nil {
+		return m.Retrieved
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DidDocument)(nil), "hypersign.ssi.v1.DidDocument")
 	proto.RegisterType((*DidDocumentMetadata)(nil), "hypersign.ssi.v1.DidDocumentMetadata")
+	proto.RegisterType((*CompromisedVerificationMethod)(nil), "hypersign.ssi.v1.CompromisedVerificationMethod")
+	proto.RegisterType((*VerificationMethodCompromiseDocument)(nil), "hypersign.ssi.v1.VerificationMethodCompromiseDocument")
 	proto.RegisterType((*VerificationMethod)(nil), "hypersign.ssi.v1.VerificationMethod")
 	proto.RegisterType((*Service)(nil), "hypersign.ssi.v1.Service")
 	proto.RegisterType((*DidDocumentState)(nil), "hypersign.ssi.v1.DidDocumentState")
+	proto.RegisterType((*DidResolutionMetadata)(nil), "hypersign.ssi.v1.DidResolutionMetadata")
 }
 
 func init() { proto.RegisterFile("hypersign/ssi/v1/did.proto", fileDescriptor_28faf1be229531f8) }
 
 var fileDescriptor_28faf1be229531f8 = []byte{
-	// 597 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x4e, 0xdb, 0x40,
-	0x14, 0xc6, 0x84, 0x12, 0xf2, 0x8c, 0x00, 0x0d, 0x2c, 0x5c, 0xd4, 0xba, 0x51, 0xd4, 0x9f, 0x6c,
-	0xb0, 0x4b, 0x38, 0x40, 0x0b, 0xa2, 0x0b, 0x44, 0xd9, 0x98, 0xaa, 0x48, 0xdd, 0x4d, 0x66, 0x5e,
-	0x93, 0x11, 0x66, 0xc6, 0xf2, 0x8c, 0x5d, 0x72, 0x84, 0xee, 0x7a, 0x90, 0x9e, 0xa0, 0x27, 0xe8,
-	0x92, 0x65, 0x57, 0x55, 0x05, 0xbb, 0xee, 0x7a, 0x83, 0xca, 0x13, 0x27, 0x31, 0x71, 0x54, 0x75,
-	0x37, 0xfe, 0x7e, 0xe6, 0x3d, 0xbf, 0xef, 0x69, 0x60, 0x77, 0x38, 0x4a, 0x30, 0xd5, 0x62, 0x20,
-	0x43, 0xad, 0x45, 0x98, 0xef, 0x87, 0x5c, 0xf0, 0x20, 0x49, 0x95, 0x51, 0x64, 0x6b, 0xca, 0x05,
-	0x5a, 0x8b, 0x20, 0xdf, 0xdf, 0xdd, 0x19, 0xa8, 0x81, 0xb2, 0x64, 0x58, 0x9c, 0xc6, 0xba, 0xce,
-	0x9f, 0x06, 0xb8, 0xc7, 0x82, 0x1f, 0x2b, 0x96, 0x5d, 0xa1, 0x34, 0xe4, 0x05, 0x34, 0x99, 0x92,
-	0x06, 0xaf, 0x8d, 0xe7, 0xb4, 0x1b, 0xdd, 0xd6, 0xd1, 0xfa, 0xef, 0x9f, 0x4f, 0xd6, 0x5e, 0x97,
-	0x58, 0x34, 0x3d, 0x91, 0x0d, 0x58, 0x16, 0xdc, 0x5b, 0x6e, 0x3b, 0xdd, 0x56, 0xb4, 0x2c, 0x38,
-	0xf1, 0x01, 0x0a, 0x2a, 0x55, 0x71, 0x8c, 0xa9, 0xd7, 0x28, 0xbc, 0x51, 0x05, 0x21, 0x6d, 0x70,
-	0x69, 0xac, 0xd5, 0xa9, 0x54, 0x9f, 0xe4, 0xa1, 0xf6, 0x56, 0xac, 0xa0, 0x0a, 0x91, 0x77, 0x40,
-	0x72, 0x4c, 0xc5, 0x47, 0xc1, 0xa8, 0x11, 0x4a, 0x9e, 0xa1, 0x19, 0x2a, 0xee, 0x3d, 0x68, 0x37,
-	0xba, 0x6e, 0xef, 0x69, 0x30, 0xff, 0x3f, 0xc1, 0xfb, 0x9a, 0x36, 0x5a, 0xe0, 0x27, 0xcf, 0x61,
-	0x83, 0x66, 0x66, 0x88, 0xd2, 0x94, 0xb8, 0xb7, 0x6a, 0x4b, 0xcf, 0xa1, 0xa4, 0x0b, 0x9b, 0x54,
-	0x6b, 0x4c, 0x2b, 0xa5, 0x9b, 0x56, 0x38, 0x0f, 0x93, 0x0e, 0xac, 0x5f, 0xe2, 0xe8, 0x70, 0x90,
-	0x22, 0x16, 0x23, 0xf3, 0xd6, 0xac, 0xec, 0x1e, 0x46, 0x7a, 0xb0, 0xc3, 0x68, 0x42, 0xfb, 0x22,
-	0x16, 0x66, 0x74, 0x22, 0x73, 0x55, 0xd6, 0x6e, 0x59, 0xed, 0x42, 0xee, 0xbe, 0xe7, 0x18, 0x63,
-	0x1c, 0x8c, 0x3d, 0x30, 0xef, 0x99, 0x71, 0xe4, 0x00, 0x9a, 0x1a, 0xd3, 0x5c, 0x30, 0xf4, 0x5c,
-	0x3b, 0xa8, 0x87, 0xf5, 0x41, 0x9d, 0x8f, 0x05, 0xd1, 0x44, 0xd9, 0xf9, 0xec, 0xc0, 0x76, 0x25,
-	0xf3, 0x33, 0x34, 0x94, 0x53, 0x43, 0x89, 0x07, 0x4d, 0x96, 0x22, 0x35, 0xc8, 0x3d, 0xc7, 0xe6,
-	0x3a, 0xf9, 0x2c, 0x98, 0x2c, 0xe1, 0x96, 0x19, 0x27, 0x3e, 0xf9, 0x2c, 0x62, 0xe5, 0x48, 0x99,
-	0x11, 0xb9, 0x65, 0x1b, 0x6d, 0xa7, 0xbb, 0x16, 0x55, 0x21, 0xf2, 0x08, 0x5a, 0x79, 0xd1, 0x90,
-	0x92, 0x27, 0xdc, 0x5b, 0xb1, 0xee, 0x19, 0xd0, 0xf9, 0xe6, 0x00, 0xa9, 0x27, 0x59, 0x6e, 0x97,
-	0x33, 0xdd, 0x2e, 0x02, 0x2b, 0x66, 0x94, 0x60, 0x59, 0xdd, 0x9e, 0x6b, 0x1b, 0xe7, 0xcc, 0x6d,
-	0x5c, 0x00, 0x24, 0xc9, 0xfa, 0xb1, 0x60, 0xa7, 0x38, 0x3a, 0xcb, 0x62, 0x23, 0xfa, 0x54, 0x63,
-	0xd9, 0xc1, 0x02, 0x86, 0xbc, 0x84, 0xed, 0x7e, 0xac, 0xd8, 0x25, 0x1b, 0x52, 0x21, 0x0f, 0x19,
-	0x53, 0x99, 0x34, 0x27, 0xc5, 0x02, 0x16, 0x86, 0x45, 0x54, 0xe7, 0x02, 0x9a, 0xe5, 0x70, 0xff,
-	0xab, 0xe1, 0x2e, 0x6c, 0x96, 0x11, 0xbc, 0x91, 0x3c, 0x51, 0x42, 0x9a, 0xb2, 0xeb, 0x79, 0xb8,
-	0xf3, 0xd5, 0x81, 0xad, 0x4a, 0x42, 0xe7, 0x86, 0x1a, 0x24, 0xaf, 0xc0, 0xe5, 0x33, 0xcc, 0xd6,
-	0x72, 0x7b, 0x8f, 0xeb, 0x79, 0x57, 0x8c, 0x51, 0xd5, 0x41, 0x2e, 0x60, 0x9b, 0xd7, 0x63, 0xb7,
-	0x2d, 0xba, 0xbd, 0x67, 0xff, 0xbc, 0x68, 0x22, 0x8e, 0x16, 0xdd, 0x70, 0xf4, 0xf6, 0xfb, 0xad,
-	0xef, 0xdc, 0xdc, 0xfa, 0xce, 0xaf, 0x5b, 0xdf, 0xf9, 0x72, 0xe7, 0x2f, 0xdd, 0xdc, 0xf9, 0x4b,
-	0x3f, 0xee, 0xfc, 0xa5, 0x0f, 0xbd, 0x81, 0x30, 0xc3, 0xac, 0x1f, 0x30, 0x75, 0x15, 0x4e, 0xef,
-	0xdf, 0xb3, 0x4f, 0x0f, 0x53, 0x71, 0x38, 0x14, 0x7c, 0x4f, 0x2a, 0x8e, 0xe1, 0xb5, 0x7d, 0xc1,
-	0x8a, 0x29, 0xe9, 0xfe, 0xaa, 0xa5, 0x0f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x42, 0xb0, 0x1c,
-	0xf1, 0xdf, 0x04, 0x00, 0x00,
+	// 778 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x35, 0x2d, 0x25, 0xb2, 0x46, 0x4e, 0x62, 0xac, 0x5d, 0x80, 0x35, 0x1a, 0x55, 0x20, 0xd2,
+	0x56, 0x28, 0x10, 0xb1, 0x51, 0x3e, 0xa0, 0x75, 0xea, 0x1e, 0x84, 0xd4, 0x17, 0xda, 0x68, 0x80,
+	0xde, 0xa8, 0xdd, 0xa9, 0xb8, 0x08, 0xb5, 0x4b, 0xec, 0x2e, 0x99, 0xe8, 0xda, 0x2f, 0xe8, 0x1f,
+	0xf4, 0x07, 0xfa, 0x05, 0xbd, 0xe4, 0xda, 0x63, 0x8e, 0x3d, 0x15, 0x85, 0x7d, 0xeb, 0x57, 0x14,
+	0xbb, 0x22, 0x25, 0x46, 0x64, 0xdd, 0x16, 0xb9, 0x71, 0xdf, 0x9b, 0xd9, 0x9d, 0x79, 0xfb, 0x66,
+	0x09, 0xa7, 0xc9, 0x2a, 0x43, 0xa5, 0xf9, 0x42, 0x84, 0x5a, 0xf3, 0xb0, 0x78, 0x12, 0x32, 0xce,
+	0x26, 0x99, 0x92, 0x46, 0x92, 0xa3, 0x0d, 0x37, 0xd1, 0x9a, 0x4f, 0x8a, 0x27, 0xa7, 0x27, 0x0b,
+	0xb9, 0x90, 0x8e, 0x0c, 0xed, 0xd7, 0x3a, 0x2e, 0x78, 0xd3, 0x85, 0xc1, 0x39, 0x67, 0xe7, 0x92,
+	0xe6, 0x4b, 0x14, 0x86, 0x7c, 0x06, 0x3d, 0x2a, 0x85, 0xc1, 0xd7, 0xc6, 0xf7, 0x46, 0x9d, 0x71,
+	0xff, 0xd9, 0xe1, 0x5f, 0x7f, 0x7c, 0x7c, 0xf0, 0x55, 0x89, 0x45, 0x9b, 0x2f, 0x72, 0x1f, 0xf6,
+	0x39, 0xf3, 0xf7, 0x47, 0xde, 0xb8, 0x1f, 0xed, 0x73, 0x46, 0x86, 0x00, 0x96, 0x52, 0x32, 0x4d,
+	0x51, 0xf9, 0x1d, 0x9b, 0x1b, 0xd5, 0x10, 0x32, 0x82, 0x41, 0x9c, 0x6a, 0xf9, 0x5c, 0xc8, 0x57,
+	0xe2, 0x4c, 0xfb, 0x5d, 0x17, 0x50, 0x87, 0xc8, 0x15, 0x90, 0x02, 0x15, 0xff, 0x81, 0xd3, 0xd8,
+	0x70, 0x29, 0x2e, 0xd0, 0x24, 0x92, 0xf9, 0x77, 0x46, 0x9d, 0xf1, 0x60, 0xfa, 0x68, 0xb2, 0xdb,
+	0xcf, 0xe4, 0xbb, 0x46, 0x6c, 0xd4, 0x92, 0x4f, 0x3e, 0x85, 0xfb, 0x71, 0x6e, 0x12, 0x14, 0xa6,
+	0xc4, 0xfd, 0xbb, 0xee, 0xe8, 0x1d, 0x94, 0x8c, 0xe1, 0x41, 0xac, 0x35, 0xaa, 0xda, 0xd1, 0x3d,
+	0x17, 0xb8, 0x0b, 0x93, 0x00, 0x0e, 0x5f, 0xe2, 0xea, 0x6c, 0xa1, 0x10, 0xad, 0x64, 0xfe, 0x81,
+	0x0b, 0x7b, 0x07, 0x23, 0x53, 0x38, 0xa1, 0x71, 0x16, 0xcf, 0x79, 0xca, 0xcd, 0x6a, 0x26, 0x0a,
+	0x59, 0x9e, 0xdd, 0x77, 0xb1, 0xad, 0xdc, 0xbb, 0x39, 0xe7, 0x98, 0xe2, 0x62, 0x9d, 0x03, 0xbb,
+	0x39, 0x5b, 0x8e, 0x3c, 0x85, 0x9e, 0x46, 0x55, 0x70, 0x8a, 0xfe, 0xc0, 0x09, 0xf5, 0x61, 0x53,
+	0xa8, 0xcb, 0x75, 0x40, 0x54, 0x45, 0x92, 0x2f, 0xe0, 0x78, 0x7b, 0x31, 0x57, 0x89, 0x42, 0x9d,
+	0xc8, 0x94, 0xf9, 0x87, 0x23, 0x6f, 0x7c, 0x2f, 0x6a, 0xa3, 0xc8, 0x29, 0x1c, 0x28, 0xa4, 0xb2,
+	0x40, 0xb5, 0xf2, 0xef, 0xb9, 0x72, 0x36, 0xeb, 0xe0, 0xc7, 0x7d, 0x38, 0xae, 0x39, 0xe8, 0x02,
+	0x4d, 0xcc, 0x62, 0x13, 0x13, 0x1f, 0x7a, 0x54, 0x61, 0x6c, 0x90, 0xf9, 0x9e, 0x73, 0x49, 0xb5,
+	0xb4, 0x4c, 0x9e, 0x31, 0xc7, 0xac, 0xfd, 0x53, 0x2d, 0xad, 0x49, 0x18, 0xc6, 0xd4, 0xf0, 0xc2,
+	0xb1, 0x9d, 0x91, 0x37, 0x3e, 0x88, 0xea, 0x10, 0xf9, 0x08, 0xfa, 0x85, 0x6d, 0x4f, 0x8a, 0x19,
+	0xf3, 0xbb, 0x2e, 0x7b, 0x0b, 0x90, 0x57, 0x30, 0xa4, 0x72, 0x99, 0x29, 0xb9, 0xe4, 0x1a, 0x59,
+	0xd3, 0x21, 0xba, 0xb4, 0x53, 0xd8, 0x54, 0xe9, 0xeb, 0xdb, 0xf2, 0xa2, 0x7f, 0xd9, 0x36, 0xf8,
+	0xd9, 0x83, 0x87, 0xb7, 0xee, 0x60, 0x6f, 0xb7, 0xe9, 0xce, 0x59, 0xa5, 0x4d, 0x2b, 0x47, 0x3e,
+	0x87, 0xa3, 0xda, 0xb9, 0x97, 0x5c, 0x50, 0x2c, 0x15, 0x6b, 0xe0, 0x76, 0xfe, 0x14, 0x66, 0x52,
+	0x19, 0x64, 0x67, 0xc6, 0x29, 0xd7, 0x8f, 0x6a, 0x48, 0xf0, 0xc6, 0x83, 0x47, 0xcd, 0xb2, 0xb6,
+	0x35, 0xbf, 0xff, 0x0b, 0xf0, 0x4f, 0x1d, 0x76, 0xfe, 0x67, 0x87, 0xdd, 0xf6, 0x0e, 0x83, 0x5f,
+	0x3d, 0x20, 0x2d, 0xc2, 0xae, 0xcb, 0xf0, 0x36, 0x65, 0x10, 0xe8, 0x9a, 0x55, 0x56, 0x09, 0xe5,
+	0xbe, 0x1b, 0x8f, 0x93, 0xb7, 0xf3, 0x38, 0x4d, 0x80, 0x64, 0xf9, 0x3c, 0xe5, 0xf4, 0x39, 0xae,
+	0x2e, 0xf2, 0xd4, 0xf0, 0x79, 0xac, 0xab, 0x42, 0x5a, 0x18, 0x3b, 0x41, 0xf3, 0x54, 0xd2, 0x97,
+	0x34, 0x89, 0xb9, 0x38, 0xa3, 0x54, 0xe6, 0xc2, 0xcc, 0xec, 0x5b, 0x65, 0x13, 0xda, 0xa8, 0xe0,
+	0x05, 0xf4, 0xca, 0x39, 0xfc, 0x4f, 0x05, 0x8f, 0xe1, 0x41, 0x39, 0xad, 0xdf, 0x08, 0x96, 0x49,
+	0x2e, 0xaa, 0x2b, 0xdd, 0x85, 0x83, 0x5f, 0x3c, 0x38, 0xaa, 0x8d, 0xdf, 0xa5, 0x89, 0x0d, 0x92,
+	0x2f, 0x61, 0xc0, 0xb6, 0x98, 0x3b, 0x6b, 0x30, 0x7d, 0xd8, 0x34, 0x7d, 0x2d, 0x31, 0xaa, 0x67,
+	0x90, 0x17, 0x70, 0xcc, 0x9a, 0x33, 0xed, 0x4a, 0x1c, 0x4c, 0x3f, 0xb9, 0x75, 0xa3, 0x2a, 0x38,
+	0x6a, 0xdb, 0x21, 0x58, 0xc2, 0x07, 0xe7, 0x9c, 0x45, 0xa8, 0x65, 0x9a, 0x97, 0x97, 0xe8, 0x08,
+	0x3b, 0xfa, 0xce, 0x58, 0xc2, 0x5c, 0x59, 0x31, 0xd6, 0xf2, 0xd4, 0x21, 0x72, 0x02, 0x77, 0x50,
+	0x29, 0xa9, 0x4a, 0xa1, 0xd6, 0x0b, 0xfb, 0x20, 0x28, 0x34, 0x8a, 0x63, 0x81, 0x95, 0xd5, 0xb6,
+	0xc0, 0xb3, 0x6f, 0x7f, 0xbb, 0x1e, 0x7a, 0x6f, 0xaf, 0x87, 0xde, 0x9f, 0xd7, 0x43, 0xef, 0xa7,
+	0x9b, 0xe1, 0xde, 0xdb, 0x9b, 0xe1, 0xde, 0xef, 0x37, 0xc3, 0xbd, 0xef, 0xa7, 0x0b, 0x6e, 0x92,
+	0x7c, 0x3e, 0xa1, 0x72, 0x19, 0x6e, 0xda, 0x79, 0xec, 0x7e, 0x8a, 0x54, 0xa6, 0x61, 0xc2, 0xd9,
+	0x63, 0x21, 0x19, 0x86, 0xaf, 0xdd, 0xbf, 0xd5, 0x5e, 0x8a, 0x9e, 0xdf, 0x75, 0xf4, 0xd3, 0xbf,
+	0x07, 0x00, 0x2d, 0x07, 0xb5, 0x97, 0x79, 0x07, 0x00, 0x00,
 }
 
 func (m *DidDocument) Marshal() (dAtA []byte, err error) {
@@ -475,6 +712,20 @@ func (m *DidDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recovery) > 0 {
+		for iNdEx := len(m.Recovery) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Recovery[iNdEx])
+			copy(dAtA[i:], m.Recovery[iNdEx])
+			i = encodeVarintDid(dAtA, i, uint64(len(m.Recovery[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.ControllerThreshold != 0 {
+		i = encodeVarintDid(dAtA, i, uint64(m.ControllerThreshold))
+		i--
+		dAtA[i] = 0x60
+	}
 	if len(m.Service) > 0 {
 		for iNdEx := len(m.Service) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -605,6 +856,20 @@ func (m *DidDocumentMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CompromisedVerificationMethods) > 0 {
+		for iNdEx := len(m.CompromisedVerificationMethods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompromisedVerificationMethods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.VersionId) > 0 {
 		i -= len(m.VersionId)
 		copy(dAtA[i:], m.VersionId)
@@ -639,6 +904,103 @@ func (m *DidDocumentMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CompromisedVerificationMethod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompromisedVerificationMethod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompromisedVerificationMethod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReportedAt) > 0 {
+		i -= len(m.ReportedAt)
+		copy(dAtA[i:], m.ReportedAt)
+		i = encodeVarintDid(dAtA, i, uint64(len(m.ReportedAt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CompromisedSince) > 0 {
+		i -= len(m.CompromisedSince)
+		copy(dAtA[i:], m.CompromisedSince)
+		i = encodeVarintDid(dAtA, i, uint64(len(m.CompromisedSince)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VerificationMethodId) > 0 {
+		i -= len(m.VerificationMethodId)
+		copy(dAtA[i:], m.VerificationMethodId)
+		i = encodeVarintDid(dAtA, i, uint64(len(m.VerificationMethodId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerificationMethodCompromiseDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerificationMethodCompromiseDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationMethodCompromiseDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CompromisedSince) > 0 {
+		i -= len(m.CompromisedSince)
+		copy(dAtA[i:], m.CompromisedSince)
+		i = encodeVarintDid(dAtA, i, uint64(len(m.CompromisedSince)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VerificationMethodId) > 0 {
+		i -= len(m.VerificationMethodId)
+		copy(dAtA[i:], m.VerificationMethodId)
+		i = encodeVarintDid(dAtA, i, uint64(len(m.VerificationMethodId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDid(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Context) > 0 {
+		for iNdEx := len(m.Context) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Context[iNdEx])
+			copy(dAtA[i:], m.Context[iNdEx])
+			i = encodeVarintDid(dAtA, i, uint64(len(m.Context[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *VerificationMethod) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -788,6 +1150,50 @@ func (m *DidDocumentState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DidResolutionMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidResolutionMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidResolutionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Retrieved) > 0 {
+		i -= len(m.Retrieved)
+		copy(dAtA[i:], m.Retrieved)
+		i = encodeVarintDid(dAtA, i, uint64(len(m.Retrieved)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintDid(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintDid(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDid(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDid(v)
 	base := offset
@@ -869,6 +1275,15 @@ func (m *DidDocument) Size() (n int) {
 			n += 1 + l + sovDid(uint64(l))
 		}
 	}
+	if m.ControllerThreshold != 0 {
+		n += 1 + sovDid(uint64(m.ControllerThreshold))
+	}
+	if len(m.Recovery) > 0 {
+		for _, s := range m.Recovery {
+			l = len(s)
+			n += 1 + l + sovDid(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -893,6 +1308,60 @@ func (m *DidDocumentMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDid(uint64(l))
 	}
+	if len(m.CompromisedVerificationMethods) > 0 {
+		for _, e := range m.CompromisedVerificationMethods {
+			l = e.Size()
+			n += 1 + l + sovDid(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CompromisedVerificationMethod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VerificationMethodId)
+	if l > 0 {
+		n += 1 + l + sovDid(uint64(l))
+	}
+	l = len(m.CompromisedSince)
+	if l > 0 {
+		n += 1 + l + sovDid(uint64(l))
+	}
+	l = len(m.ReportedAt)
+	if l > 0 {
+		n += 1 + l + sovDid(uint64(l))
+	}
+	return n
+}
+
+func (m *VerificationMethodCompromiseDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Context) > 0 {
+		for _, s := range m.Context {
+			l = len(s)
+			n += 1 + l + sovDid(uint64(l))
+		}
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDid(uint64(l))
+	}
+	l = len(m.VerificationMethodId)
+	if l > 0 {
+		n += 1 + l + sovDid(uint64(l))
+	}
+	l = len(m.CompromisedSince)
+	if l > 0 {
+		n += 1 + l + sovDid(uint64(l))
+	}
 	return n
 }
 
@@ -963,6 +1432,27 @@ func (m *DidDocumentState) Size() (n int) {
 	return n
 }
 
+func (m *DidResolutionMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovDid(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovDid(uint64(l))
+	}
+	l = len(m.Retrieved)
+	if l > 0 {
+		n += 1 + l + sovDid(uint64(l))
+	}
+	return n
+}
+
 func sovDid(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1224,11 +1714,358 @@ func (m *DidDocument) Unmarshal(dAtA []byte) error {
 			}
 			m.AssertionMethod = append(m.AssertionMethod, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyAgreement = append(m.KeyAgreement, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityInvocation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CapabilityInvocation = append(m.CapabilityInvocation, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CapabilityDelegation = append(m.CapabilityDelegation, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = append(m.Service, &Service{})
+			if err := m.Service[len(m.Service)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerThreshold", wireType)
+			}
+			m.ControllerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ControllerThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovery", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recovery = append(m.Recovery, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DidDocumentMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidDocumentMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidDocumentMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Created = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updated = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deactivated = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyAgreement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompromisedVerificationMethods", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDid
@@ -1238,27 +2075,79 @@ func (m *DidDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDid
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDid
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyAgreement = append(m.KeyAgreement, string(dAtA[iNdEx:postIndex]))
+			m.CompromisedVerificationMethods = append(m.CompromisedVerificationMethods, &CompromisedVerificationMethod{})
+			if err := m.CompromisedVerificationMethods[len(m.CompromisedVerificationMethods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompromisedVerificationMethod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompromisedVerificationMethod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompromisedVerificationMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityInvocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1286,11 +2175,11 @@ func (m *DidDocument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CapabilityInvocation = append(m.CapabilityInvocation, string(dAtA[iNdEx:postIndex]))
+			m.VerificationMethodId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CapabilityDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompromisedSince", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1318,13 +2207,13 @@ func (m *DidDocument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CapabilityDelegation = append(m.CapabilityDelegation, string(dAtA[iNdEx:postIndex]))
+			m.CompromisedSince = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedAt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDid
@@ -1334,25 +2223,23 @@ func (m *DidDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDid
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDid
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Service = append(m.Service, &Service{})
-			if err := m.Service[len(m.Service)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReportedAt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1375,7 +2262,7 @@ func (m *DidDocument) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DidDocumentMetadata) Unmarshal(dAtA []byte) error {
+func (m *VerificationMethodCompromiseDocument) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1398,15 +2285,15 @@ func (m *DidDocumentMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DidDocumentMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerificationMethodCompromiseDocument: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DidDocumentMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerificationMethodCompromiseDocument: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1434,11 +2321,11 @@ func (m *DidDocumentMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Created = string(dAtA[iNdEx:postIndex])
+			m.Context = append(m.Context, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1466,13 +2353,13 @@ func (m *DidDocumentMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updated = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deactivated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethodId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDid
@@ -1482,15 +2369,27 @@ func (m *DidDocumentMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Deactivated = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationMethodId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompromisedSince", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1518,7 +2417,7 @@ func (m *DidDocumentMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionId = string(dAtA[iNdEx:postIndex])
+			m.CompromisedSince = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2019,6 +2918,152 @@ func (m *DidDocumentState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DidResolutionMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidResolutionMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidResolutionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retrieved", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Retrieved = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDid(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,607 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hypersign/ssi/v1/did_recovery.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingDidRecovery is a replacement of DID Document, initiated by one of its recovery keys. The replacement
+// takes effect at `effectiveAt`, unless it is cancelled by the controllers of DID Document before that.
+type PendingDidRecovery struct {
+	// Replacement DID Document
+	DidDocument       *DidDocument     `protobuf:"bytes,1,opt,name=didDocument,proto3" json:"didDocument,omitempty"`
+	DidDocumentProofs []*DocumentProof `protobuf:"bytes,2,rep,name=didDocumentProofs,proto3" json:"didDocumentProofs,omitempty"`
+	// Version of DID Document being replaced. The recovery is dropped if DID Document is updated or deactivated before
+	// the recovery takes effect.
+	PreviousVersionId string `protobuf:"bytes,3,opt,name=previousVersionId,proto3" json:"previousVersionId,omitempty"`
+	// Version id assigned to the replacement DID Document
+	VersionId   string `protobuf:"bytes,4,opt,name=versionId,proto3" json:"versionId,omitempty"`
+	InitiatedAt string `protobuf:"bytes,5,opt,name=initiatedAt,proto3" json:"initiatedAt,omitempty"`
+	EffectiveAt string `protobuf:"bytes,6,opt,name=effectiveAt,proto3" json:"effectiveAt,omitempty"`
+}
+
+func (m *PendingDidRecovery) Reset()         { *m = PendingDidRecovery{} }
+func (m *PendingDidRecovery) String() string { return proto.CompactTextString(m) }
+func (*PendingDidRecovery) ProtoMessage()    {}
+func (*PendingDidRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ce46684fe39934a, []int{0}
+}
+func (m *PendingDidRecovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingDidRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingDidRecovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingDidRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingDidRecovery.Merge(m, src)
+}
+func (m *PendingDidRecovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingDidRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingDidRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingDidRecovery proto.InternalMessageInfo
+
+func (m *PendingDidRecovery) GetDidDocument() *DidDocument {
+	if m != nil {
+		return m.DidDocument
+	}
+	return nil
+}
+
+func (m *PendingDidRecovery) GetDidDocumentProofs() []*DocumentProof {
+	if m != nil {
+		return m.DidDocumentProofs
+	}
+	return nil
+}
+
+func (m *PendingDidRecovery) GetPreviousVersionId() string {
+	if m != nil {
+		return m.PreviousVersionId
+	}
+	return ""
+}
+
+func (m *PendingDidRecovery) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *PendingDidRecovery) GetInitiatedAt() string {
+	if m != nil {
+		return m.InitiatedAt
+	}
+	return ""
+}
+
+func (m *PendingDidRecovery) GetEffectiveAt() string {
+	if m != nil {
+		return m.EffectiveAt
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*PendingDidRecovery)(nil), "hypersign.ssi.v1.PendingDidRecovery")
+}
+
+func init() {
+	proto.RegisterFile("hypersign/ssi/v1/did_recovery.proto", fileDescriptor_7ce46684fe39934a)
+}
+
+var fileDescriptor_7ce46684fe39934a = []byte{
+	// 308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0x02, 0x31,
+	0x1c, 0xc6, 0x39, 0x50, 0x12, 0x7a, 0x8b, 0x74, 0xba, 0x10, 0xac, 0x17, 0x5d, 0x18, 0xa4, 0x0d,
+	0xf8, 0x00, 0x06, 0xc3, 0x62, 0xa2, 0x09, 0xb9, 0xc1, 0xc1, 0xc5, 0xc8, 0xf5, 0x0f, 0xfc, 0x13,
+	0x69, 0x2f, 0x6d, 0x69, 0xe4, 0x2d, 0x7c, 0x18, 0x1f, 0xc2, 0x91, 0xd1, 0xd1, 0xc0, 0x8b, 0x18,
+	0x0e, 0x03, 0x17, 0x8f, 0xf1, 0xbe, 0xdf, 0xef, 0xbb, 0x2f, 0x6d, 0xc9, 0xd5, 0x6c, 0x99, 0x81,
+	0xb1, 0x38, 0x55, 0xc2, 0x5a, 0x14, 0xbe, 0x27, 0x24, 0xca, 0x17, 0x03, 0xa9, 0xf6, 0x60, 0x96,
+	0x3c, 0x33, 0xda, 0x69, 0x7a, 0xb6, 0x97, 0xb8, 0xb5, 0xc8, 0x7d, 0xaf, 0xd5, 0x3a, 0x56, 0xdb,
+	0xd9, 0xad, 0x76, 0x89, 0x65, 0x46, 0xeb, 0xc9, 0x8e, 0x5e, 0x7e, 0x56, 0x09, 0x1d, 0x81, 0x92,
+	0xa8, 0xa6, 0x43, 0x94, 0xc9, 0xdf, 0x10, 0xbd, 0x25, 0xa1, 0x44, 0x39, 0xd4, 0xe9, 0x62, 0x0e,
+	0xca, 0x45, 0x41, 0x1c, 0x74, 0xc2, 0xfe, 0x39, 0xff, 0x3f, 0xcc, 0x87, 0x07, 0x29, 0x29, 0x36,
+	0xe8, 0x23, 0x69, 0x16, 0x3e, 0x47, 0xdb, 0x45, 0x1b, 0x55, 0xe3, 0x5a, 0x27, 0xec, 0x5f, 0x1c,
+	0xf9, 0x4d, 0xd1, 0x4b, 0xca, 0x4d, 0x7a, 0x4d, 0x9a, 0x99, 0x01, 0x8f, 0x7a, 0x61, 0x9f, 0xb6,
+	0x55, 0xad, 0xee, 0x65, 0x54, 0x8b, 0x83, 0x4e, 0x23, 0x29, 0x03, 0xda, 0x26, 0x0d, 0xbf, 0xb7,
+	0x4e, 0x72, 0xeb, 0x10, 0xd0, 0x98, 0x84, 0xa8, 0xd0, 0xe1, 0xab, 0x03, 0x39, 0x70, 0xd1, 0x69,
+	0xce, 0x8b, 0xd1, 0xd6, 0x80, 0xc9, 0x04, 0x52, 0x87, 0x1e, 0x06, 0x2e, 0xaa, 0xef, 0x8c, 0x42,
+	0x74, 0xf7, 0xf0, 0xb5, 0x66, 0xc1, 0x6a, 0xcd, 0x82, 0x9f, 0x35, 0x0b, 0x3e, 0x36, 0xac, 0xb2,
+	0xda, 0xb0, 0xca, 0xf7, 0x86, 0x55, 0x9e, 0xfb, 0x53, 0x74, 0xb3, 0xc5, 0x98, 0xa7, 0x7a, 0x2e,
+	0xf6, 0xe7, 0xec, 0xe6, 0x97, 0x9d, 0xea, 0x37, 0x31, 0x43, 0xd9, 0x55, 0x5a, 0x82, 0x78, 0xcf,
+	0x5f, 0xc3, 0x2d, 0x33, 0xb0, 0xe3, 0x7a, 0x8e, 0x6f, 0x7e, 0x07, 0x00, 0xb2, 0xe1, 0xc4, 0x47,
+	0xfe, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingDidRecovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingDidRecovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingDidRecovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EffectiveAt) > 0 {
+		i -= len(m.EffectiveAt)
+		copy(dAtA[i:], m.EffectiveAt)
+		i = encodeVarintDidRecovery(dAtA, i, uint64(len(m.EffectiveAt)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.InitiatedAt) > 0 {
+		i -= len(m.InitiatedAt)
+		copy(dAtA[i:], m.InitiatedAt)
+		i = encodeVarintDidRecovery(dAtA, i, uint64(len(m.InitiatedAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintDidRecovery(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousVersionId) > 0 {
+		i -= len(m.PreviousVersionId)
+		copy(dAtA[i:], m.PreviousVersionId)
+		i = encodeVarintDidRecovery(dAtA, i, uint64(len(m.PreviousVersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DidDocumentProofs) > 0 {
+		for iNdEx := len(m.DidDocumentProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocumentProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDidRecovery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DidDocument != nil {
+		{
+			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDidRecovery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDidRecovery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDidRecovery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingDidRecovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidDocument != nil {
+		l = m.DidDocument.Size()
+		n += 1 + l + sovDidRecovery(uint64(l))
+	}
+	if len(m.DidDocumentProofs) > 0 {
+		for _, e := range m.DidDocumentProofs {
+			l = e.Size()
+			n += 1 + l + sovDidRecovery(uint64(l))
+		}
+	}
+	l = len(m.PreviousVersionId)
+	if l > 0 {
+		n += 1 + l + sovDidRecovery(uint64(l))
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovDidRecovery(uint64(l))
+	}
+	l = len(m.InitiatedAt)
+	if l > 0 {
+		n += 1 + l + sovDidRecovery(uint64(l))
+	}
+	l = len(m.EffectiveAt)
+	if l > 0 {
+		n += 1 + l + sovDidRecovery(uint64(l))
+	}
+	return n
+}
+
+func sovDidRecovery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDidRecovery(x uint64) (n int) {
+	return sovDidRecovery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingDidRecovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDidRecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingDidRecovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingDidRecovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDidRecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocument == nil {
+				m.DidDocument = &DidDocument{}
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDidRecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocumentProofs = append(m.DidDocumentProofs, &DocumentProof{})
+			if err := m.DidDocumentProofs[len(m.DidDocumentProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousVersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousVersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitiatedAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDidRecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDidRecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDidRecovery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDidRecovery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDidRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDidRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDidRecovery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDidRecovery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDidRecovery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDidRecovery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDidRecovery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDidRecovery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hypersign-protocol/hid-node/x/ssi/utils"
+)
+
+// DID Resolution Context
+const DidResolutionContext = "https://w3id.org/did-resolution/v1"
+
+// DID Core Context
+const DidCoreContext = "https://www.w3.org/ns/did/v1"
+
+// Supported DID Document representations
+const DidLdJsonContentType = "application/did+ld+json"
+const DidJsonContentType = "application/did+json"
+
+var SupportedDidRepresentations = []string{
+	DidLdJsonContentType,
+	DidJsonContentType,
+}
+
+// DID Resolution errors, as per W3C DID Resolution specification
+const DidResolutionErrorInvalidDid = "invalidDid"
+const DidResolutionErrorNotFound = "notFound"
+const DidResolutionErrorDeactivated = "deactivated"
+const DidResolutionErrorRepresentationNotSupported = "representationNotSupported"
+const DidResolutionErrorInvalidOptions = "invalidOptions"
+
+// ValidateDidId checks if the input is a valid DID Id of hid method
+func ValidateDidId(id string) error {
+	return isValidDidDocId(id)
+}
+
+// DID URL Dereferencing error, as per W3C DID Resolution specification
+const DidUrlDereferencingErrorInvalidDidUrl = "invalidDidUrl"
+
+// Media type of the service endpoint URL selected while dereferencing a DID URL
+const UriListContentType = "text/uri-list"
+
+// DID URL query parameters, as per W3C DID Core specification
+const DidUrlParamService = "service"
+const DidUrlParamRelativeRef = "relativeRef"
+const DidUrlParamVersionId = "versionId"
+const DidUrlParamVersionTime = "versionTime"
+
+var SupportedDidUrlParams = []string{
+	DidUrlParamService,
+	DidUrlParamRelativeRef,
+	DidUrlParamVersionId,
+	DidUrlParamVersionTime,
+}
+
+// ParseDidUrl returns the elements of a DID URL. It returns the element in order:
+// didId, path, query, fragment
+func ParseDidUrl(didUrl string) (string, string, url.Values, string, error) {
+	var fragment string
+	if fragmentIndex := strings.Index(didUrl, "#"); fragmentIndex != -1 {
+		didUrl, fragment = didUrl[:fragmentIndex], didUrl[fragmentIndex+1:]
+	}
+
+	var rawQuery string
+	if queryIndex := strings.Index(didUrl, "?"); queryIndex != -1 {
+		didUrl, rawQuery = didUrl[:queryIndex], didUrl[queryIndex+1:]
+	}
+
+	var path string
+	if pathIndex := strings.Index(didUrl, "/"); pathIndex != -1 {
+		didUrl, path = didUrl[:pathIndex], didUrl[pathIndex:]
+	}
+
+	if err := ValidateDidId(didUrl); err != nil {
+		return "", "", nil, "", err
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", "", nil, "", fmt.Errorf("invalid query in DID URL: %v", err)
+	}
+	for param, values := range query {
+		if !utils.FindInSlice(SupportedDidUrlParams, param) {
+			return "", "", nil, "", fmt.Errorf("unsupported DID URL parameter %s", param)
+		}
+		if len(values) > 1 {
+			return "", "", nil, "", fmt.Errorf("DID URL parameter %s is specified more than once", param)
+		}
+	}
+
+	return didUrl, path, query, fragment, nil
+}
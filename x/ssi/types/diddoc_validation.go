@@ -3,6 +3,10 @@ package types
 import (
 	"fmt"
 	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 )
 
 // isValidDidDocId checks if the DID Id is valid
@@ -202,6 +206,19 @@ func verificationKeyCheck(vm *VerificationMethod) error {
 				vm.Type,
 			)
 		}
+	case CosmWasmContractMethod2024:
+		if vm.GetPublicKeyMultibase() != "" {
+			return fmt.Errorf(
+				"publicKeyMultibase should not be provided for verification method %s as it is of type %s",
+				vm.Id,
+				vm.Type,
+			)
+		}
+		// The contract is deployed on this chain, hence its chain-id is not among the supported CAIP-10 chain-ids
+		if err := validateContractBlockchainAccountId(vm.GetBlockchainAccountId()); err != nil {
+			return fmt.Errorf("invalid blockchainAccountId %v of verification method %s: %v", vm.BlockchainAccountId, vm.Id, err)
+		}
+		return nil
 	default:
 		return fmt.Errorf("unsupported verification method type: %v. Supported verification method types are: %v", vm.Type, supportedVerificationMethodTypes)
 	}
@@ -252,6 +269,52 @@ func validateServices(services []*Service) error {
 }
 
 // validateVerificationMethods validates all the verification methods present in DID Document
+// validateControllerThreshold checks that the controller threshold does not exceed the number of distinct
+// controllers. DID Subject is assumed to be the sole controller, if the controller list is empty.
+func validateControllerThreshold(didDoc *DidDocument) error {
+	controllers := map[string]bool{didDoc.Id: true}
+	if len(didDoc.Controller) != 0 {
+		controllers = map[string]bool{}
+		for _, controller := range didDoc.Controller {
+			controllers[controller] = true
+		}
+	}
+
+	if int(didDoc.ControllerThreshold) > len(controllers) {
+		return fmt.Errorf(
+			"controller threshold %v cannot be greater than the number of controllers %v",
+			didDoc.ControllerThreshold,
+			len(controllers),
+		)
+	}
+	return nil
+}
+
+// validateRecovery checks that every recovery entry is either a DID or a verification method id, other
+// than the DID Subject itself
+func validateRecovery(didDoc *DidDocument) error {
+	for _, recovery := range didDoc.Recovery {
+		var err error
+		if strings.Contains(recovery, "#") {
+			err = isDidUrl(recovery)
+		} else {
+			err = isValidDidDocId(recovery)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid recovery %v: %v", recovery, err)
+		}
+
+		if recovery == didDoc.Id {
+			return fmt.Errorf("DID Subject %v cannot be its own recovery", didDoc.Id)
+		}
+	}
+
+	if duplicate := checkDuplicateItems(didDoc.Recovery); duplicate != "" {
+		return fmt.Errorf("duplicate recovery %v found", duplicate)
+	}
+	return nil
+}
+
 func validateVerificationMethods(vms []*VerificationMethod) error {
 	for _, vm := range vms {
 		var err error
@@ -384,6 +447,26 @@ func validateVmRelationships(didDoc *DidDocument) error {
 	return nil
 }
 
+// validateContractBlockchainAccountId validates the blockchainAccountId of CosmWasmContractMethod2024, which
+// must be a CAIP-10 account of the form cosmos:<chain-id>:<contract-address>
+func validateContractBlockchainAccountId(blockchainAccountId string) error {
+	blockchainId, err := NewBlockchainId(blockchainAccountId)
+	if err != nil {
+		return err
+	}
+
+	if blockchainId.CAIP10Prefix != CosmosCAIP10Prefix {
+		return fmt.Errorf("expected CAIP-10 prefix to be %v, got %v", CosmosCAIP10Prefix, blockchainId.CAIP10Prefix)
+	}
+	if blockchainId.ChainId == "" {
+		return fmt.Errorf("chain-id cannot be empty")
+	}
+	if _, _, err := bech32.DecodeAndConvert(blockchainId.BlockchainAddress); err != nil {
+		return fmt.Errorf("invalid contract address %v: %v", blockchainId.BlockchainAddress, err)
+	}
+	return nil
+}
+
 func validateBlockchainAccountId(blockchainAccountId string) error {
 	blockchainId, err := NewBlockchainId(blockchainAccountId)
 	if err != nil {
@@ -452,6 +535,18 @@ func (didDoc *DidDocument) ValidateDidDocument() error {
 		}
 	}
 
+	// Controller Threshold check
+	err = validateControllerThreshold(didDoc)
+	if err != nil {
+		return err
+	}
+
+	// Recovery check
+	err = validateRecovery(didDoc)
+	if err != nil {
+		return err
+	}
+
 	// VerificationMethod check
 	err = validateVerificationMethods(didDoc.VerificationMethod)
 	if err != nil {
@@ -472,3 +567,26 @@ func (didDoc *DidDocument) ValidateDidDocument() error {
 
 	return nil
 }
+
+// Validate checks the fields of Verification Method Compromise Document
+func (doc *VerificationMethodCompromiseDocument) Validate() error {
+	if err := isValidDidDocId(doc.Id); err != nil {
+		return fmt.Errorf("invalid DID Id %v: %v", doc.Id, err)
+	}
+
+	if err := isDidUrl(doc.VerificationMethodId); err != nil {
+		return err
+	}
+	if didId, _ := SplitDidUrl(doc.VerificationMethodId); didId != doc.Id {
+		return fmt.Errorf(
+			"verification method %v does not belong to DID Document %v",
+			doc.VerificationMethodId,
+			doc.Id,
+		)
+	}
+
+	if _, err := time.Parse(time.RFC3339, doc.CompromisedSince); err != nil {
+		return fmt.Errorf("invalid compromisedSince %v, expected RFC3339 format", doc.CompromisedSince)
+	}
+	return nil
+}
@@ -27,4 +27,20 @@ var (
 	ErrInvalidCredentialStatusID       = errors.Register(ModuleName, 117, "invalid credential status Id")
 	ErrInvalidProof                    = errors.Register(ModuleName, 118, "invalid document proof")
 	ErrInvalidCredentialSchema         = errors.Register(ModuleName, 119, "invalid credential schema")
+	ErrInvalidDidResolutionOptions     = errors.Register(ModuleName, 120, "invalid DID resolution options")
+	ErrInvalidCredentialStatusList     = errors.Register(ModuleName, 121, "invalid credential status list")
+	ErrCredentialStatusListExists      = errors.Register(ModuleName, 122, "credential status list already exists")
+	ErrCredentialStatusListNotFound    = errors.Register(ModuleName, 123, "credential status list not found")
+	ErrInvalidCredentialStatusBatch    = errors.Register(ModuleName, 124, "invalid credential status batch")
+	ErrInvalidDidRecovery              = errors.Register(ModuleName, 125, "invalid DID recovery")
+	ErrDidRecoveryNotFound             = errors.Register(ModuleName, 126, "pending DID recovery not found")
+	ErrVerificationMethodCompromised   = errors.Register(ModuleName, 127, "verification method is compromised")
+	ErrCredentialSchemaNotFound        = errors.Register(ModuleName, 128, "credential schema not found")
+	ErrCredentialSchemaRevoked         = errors.Register(ModuleName, 129, "credential schema is revoked")
+	ErrInvalidAccreditation            = errors.Register(ModuleName, 130, "invalid accreditation")
+	ErrAccreditationExists             = errors.Register(ModuleName, 131, "accreditation already exists")
+	ErrAccreditationNotFound           = errors.Register(ModuleName, 132, "accreditation not found")
+	ErrInvalidPacket                   = errors.Register(ModuleName, 133, "invalid ssi packet")
+	ErrInvalidVersion                  = errors.Register(ModuleName, 134, "invalid ssi IBC application version")
+	ErrCredentialStatusBatchNotFound   = errors.Register(ModuleName, 135, "credential status batch not found")
 )
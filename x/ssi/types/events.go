@@ -0,0 +1,40 @@
+package types
+
+import (
+	"reflect" /* #nosec G702 */
+	"strings"
+)
+
+// GetDidDocumentChangedFields returns the names of top-level DID Document properties which differ
+// between the two versions of a DID Document
+func GetDidDocumentChangedFields(prev *DidDocument, next *DidDocument) []string {
+	return getChangedFields(prev, next)
+}
+
+// GetCredentialStatusChangedFields returns the names of Credential Status Document properties which
+// differ between the two versions of a Credential Status Document
+func GetCredentialStatusChangedFields(prev *CredentialStatusDocument, next *CredentialStatusDocument) []string {
+	return getChangedFields(prev, next)
+}
+
+// getChangedFields compares every field of two structs of the same type and returns the JSON names
+// of fields having different values
+func getChangedFields(prev interface{}, next interface{}) []string {
+	prevValue, nextValue := reflect.ValueOf(prev).Elem(), reflect.ValueOf(next).Elem()
+
+	var changedFields []string
+	for i := 0; i < prevValue.NumField(); i++ {
+		prevField, nextField := prevValue.Field(i), nextValue.Field(i)
+		if reflect.DeepEqual(prevField.Interface(), nextField.Interface()) {
+			continue
+		}
+		// Nil and empty lists are not distinguished, as both are encoded identically
+		if prevField.Kind() == reflect.Slice && prevField.Len() == 0 && nextField.Len() == 0 {
+			continue
+		}
+		fieldName := strings.Split(prevValue.Type().Field(i).Tag.Get("json"), ",")[0]
+		changedFields = append(changedFields, fieldName)
+	}
+
+	return changedFields
+}
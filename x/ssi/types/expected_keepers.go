@@ -1,8 +1,10 @@
 package types
 
 import (
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -11,8 +13,34 @@ type AccountKeeper interface {
 	// Methods imported from account should be defined here
 }
 
-// BankKeeper defines the expected interface needed to retrieve account balances.
+// BankKeeper defines the expected interface needed to retrieve account balances, and to charge
+// CosmWasm contracts the fixed fees of SSI messages they dispatch
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
+
+// ContractKeeper defines the expected interface of x/wasm keeper, needed to authorise the DID Documents
+// controlled by CosmWasm contracts
+type ContractKeeper interface {
+	HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+}
+
+// PortKeeper defines the expected IBC port keeper, needed to bind the ssi port
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
+
+// ScopedKeeper defines the expected capability keeper scoped to x/ssi module, needed to own the
+// capabilities of ssi port and channels
+type ScopedKeeper interface {
+	GetCapability(ctx sdk.Context, name string) (*capabilitytypes.Capability, bool)
+	AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool
+	ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error
+}
+
+// StoreQuerier defines the expected interface of BaseApp, needed to query the Merkle proofs of x/ssi state
+type StoreQuerier interface {
+	Query(req abci.RequestQuery) abci.ResponseQuery
+}
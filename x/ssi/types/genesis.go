@@ -1,8 +1,11 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	fmt "fmt"
 	"regexp"
+	"time"
 )
 
 // DefaultGenesis returns the default ssi genesis state
@@ -32,5 +35,343 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("chain namespace should be in alphanumeric format, namespace recieved %s", namespace)
 	}
 
+	// Default params are used if params are absent in genesis state
+	if gs.Params != nil {
+		if err := gs.Params.Validate(); err != nil {
+			return err
+		}
+	}
+
+	didDocumentIdMap, err := gs.validateDidDocuments()
+	if err != nil {
+		return err
+	}
+
+	if err := gs.validatePendingDidRecoveries(didDocumentIdMap); err != nil {
+		return err
+	}
+
+	if err := gs.validateDidRecoveryProofs(didDocumentIdMap); err != nil {
+		return err
+	}
+
+	if err := gs.validateCredentialSchemas(didDocumentIdMap); err != nil {
+		return err
+	}
+
+	credentialStatusBatchIdMap, err := gs.validateCredentialStatusBatches()
+	if err != nil {
+		return err
+	}
+
+	if err := gs.validateCredentialStatuses(didDocumentIdMap, credentialStatusBatchIdMap); err != nil {
+		return err
+	}
+
+	if err := gs.validateCredentialStatusLists(didDocumentIdMap); err != nil {
+		return err
+	}
+
+	if err := gs.validateAccreditations(didDocumentIdMap); err != nil {
+		return err
+	}
+
+	for _, entry := range gs.BlockchainAccountIds {
+		if entry == nil {
+			return fmt.Errorf("blockchainAccountId entry cannot be empty")
+		}
+		if err := validateBlockchainAccountId(entry.BlockchainAccountId); err != nil {
+			return err
+		}
+		if _, present := didDocumentIdMap[entry.DidId]; !present {
+			return fmt.Errorf(
+				"DID Document %v of blockchainAccountId %v is not present in genesis state",
+				entry.DidId,
+				entry.BlockchainAccountId,
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateDidDocuments validates every DID Document in genesis state and returns a map of DID Document Ids
+func (gs GenesisState) validateDidDocuments() (map[string]bool, error) {
+	didDocumentIdMap := map[string]bool{}
+
+	for _, didDocumentState := range gs.DidDocuments {
+		if didDocumentState == nil || didDocumentState.DidDocument == nil || didDocumentState.DidDocumentMetadata == nil {
+			return nil, fmt.Errorf("DID Document state must contain both DID Document and its metadata")
+		}
+
+		didDocument := didDocumentState.DidDocument
+		if _, present := didDocumentIdMap[didDocument.Id]; present {
+			return nil, fmt.Errorf("duplicate DID Document %v found in genesis state", didDocument.Id)
+		}
+		didDocumentIdMap[didDocument.Id] = true
+
+		if err := didDocument.ValidateDidDocument(); err != nil {
+			return nil, fmt.Errorf("invalid DID Document %v: %v", didDocument.Id, err)
+		}
+		if err := DidChainNamespaceValidation(didDocument, gs.ChainNamespace); err != nil {
+			return nil, err
+		}
+		if didDocumentState.DidDocumentMetadata.VersionId == "" {
+			return nil, fmt.Errorf("versionId of DID Document %v cannot be empty", didDocument.Id)
+		}
+	}
+
+	for _, didDocumentVersion := range gs.DidDocumentVersions {
+		if didDocumentVersion == nil || didDocumentVersion.DidDocument == nil || didDocumentVersion.DidDocumentMetadata == nil {
+			return nil, fmt.Errorf("DID Document version must contain both DID Document and its metadata")
+		}
+		if _, present := didDocumentIdMap[didDocumentVersion.DidDocument.Id]; !present {
+			return nil, fmt.Errorf("DID Document %v of a version is not present in genesis state", didDocumentVersion.DidDocument.Id)
+		}
+	}
+
+	if gs.DidDocumentCount != 0 && gs.DidDocumentCount < uint64(len(gs.DidDocuments)) {
+		return nil, fmt.Errorf(
+			"DID Document count %v is less than the number of DID Documents %v",
+			gs.DidDocumentCount,
+			len(gs.DidDocuments),
+		)
+	}
+
+	return didDocumentIdMap, nil
+}
+
+// validatePendingDidRecoveries validates every pending DID recovery in genesis state
+func (gs GenesisState) validatePendingDidRecoveries(didDocumentIdMap map[string]bool) error {
+	recoveryDidIdMap := map[string]bool{}
+
+	for _, recovery := range gs.PendingDidRecoveries {
+		if recovery == nil || recovery.DidDocument == nil {
+			return fmt.Errorf("pending DID recovery must contain the replacement DID Document")
+		}
+
+		didId := recovery.DidDocument.Id
+		if _, present := recoveryDidIdMap[didId]; present {
+			return fmt.Errorf("duplicate pending recovery of DID Document %v found in genesis state", didId)
+		}
+		recoveryDidIdMap[didId] = true
+
+		if _, present := didDocumentIdMap[didId]; !present {
+			return fmt.Errorf("DID Document %v of a pending recovery is not present in genesis state", didId)
+		}
+		if err := recovery.DidDocument.ValidateDidDocument(); err != nil {
+			return fmt.Errorf("invalid replacement DID Document %v: %v", didId, err)
+		}
+		if recovery.VersionId == "" || recovery.PreviousVersionId == "" {
+			return fmt.Errorf("versionId and previousVersionId of pending recovery of DID Document %v cannot be empty", didId)
+		}
+		if _, err := time.Parse(time.RFC3339, recovery.EffectiveAt); err != nil {
+			return fmt.Errorf("invalid effectiveAt %v of pending recovery of DID Document %v", recovery.EffectiveAt, didId)
+		}
+	}
+
+	return nil
+}
+
+// validateDidRecoveryProofs validates every proof which has initiated a DID recovery in genesis state
+func (gs GenesisState) validateDidRecoveryProofs(didDocumentIdMap map[string]bool) error {
+	for _, entry := range gs.DidRecoveryProofs {
+		if entry == nil {
+			return fmt.Errorf("DID recovery proof entry cannot be empty")
+		}
+		if _, present := didDocumentIdMap[entry.DidId]; !present {
+			return fmt.Errorf("DID Document %v of a DID recovery proof is not present in genesis state", entry.DidId)
+		}
+		if proofValueHash, err := hex.DecodeString(entry.ProofValueHash); err != nil || len(proofValueHash) != sha256.Size {
+			return fmt.Errorf("invalid proof value hash %v of DID recovery proof of DID Document %v", entry.ProofValueHash, entry.DidId)
+		}
+	}
+
+	return nil
+}
+
+// validateCredentialSchemas validates every Credential Schema in genesis state
+func (gs GenesisState) validateCredentialSchemas(didDocumentIdMap map[string]bool) error {
+	credentialSchemaIdMap := map[string]bool{}
+
+	for _, credentialSchemaState := range gs.CredentialSchemas {
+		if credentialSchemaState == nil || credentialSchemaState.CredentialSchemaDocument == nil || credentialSchemaState.CredentialSchemaProof == nil {
+			return fmt.Errorf("credential schema state must contain both credential schema document and its proof")
+		}
+
+		credentialSchema := credentialSchemaState.CredentialSchemaDocument
+		if credentialSchema.Id == "" {
+			return fmt.Errorf("credential schema id cannot be empty")
+		}
+
+		// Versions differing only by leading zeros, which were registered before they were rejected, are kept
+		// apart in store, hence duplicates are checked on the schema id
+		if _, err := GetCredentialSchemaKey(credentialSchema.Id); err != nil {
+			return fmt.Errorf("invalid credential schema id %v: %v", credentialSchema.Id, err)
+		}
+		if _, present := credentialSchemaIdMap[credentialSchema.Id]; present {
+			return fmt.Errorf("duplicate credential schema %v found in genesis state", credentialSchema.Id)
+		}
+		credentialSchemaIdMap[credentialSchema.Id] = true
+
+		if _, present := didDocumentIdMap[credentialSchema.Author]; !present {
+			return fmt.Errorf(
+				"author %v of credential schema %v is not present in genesis state",
+				credentialSchema.Author,
+				credentialSchema.Id,
+			)
+		}
+	}
+
+	if gs.CredentialSchemaCount != 0 && gs.CredentialSchemaCount < uint64(len(gs.CredentialSchemas)) {
+		return fmt.Errorf(
+			"credential schema count %v is less than the number of credential schemas %v",
+			gs.CredentialSchemaCount,
+			len(gs.CredentialSchemas),
+		)
+	}
+
+	return nil
+}
+
+// validateCredentialStatusBatches validates every Credential Status Batch in genesis state, and returns a map of
+// their ids
+func (gs GenesisState) validateCredentialStatusBatches() (map[string]bool, error) {
+	credentialStatusBatchIdMap := map[string]bool{}
+
+	for _, batchState := range gs.CredentialStatusBatches {
+		if batchState == nil || batchState.CredentialStatusBatchDocument == nil || batchState.CredentialStatusBatchProof == nil {
+			return nil, fmt.Errorf("credential status batch state must contain both credential status batch document and its proof")
+		}
+		if batchId := GetCredentialStatusBatchId(batchState.CredentialStatusBatchDocument); batchState.Id != batchId {
+			return nil, fmt.Errorf("expected id of credential status batch to be %v, recieved %v", batchId, batchState.Id)
+		}
+		if _, present := credentialStatusBatchIdMap[batchState.Id]; present {
+			return nil, fmt.Errorf("duplicate credential status batch %v found in genesis state", batchState.Id)
+		}
+		credentialStatusBatchIdMap[batchState.Id] = true
+	}
+
+	return credentialStatusBatchIdMap, nil
+}
+
+// validateCredentialStatuses validates every Credential Status in genesis state
+func (gs GenesisState) validateCredentialStatuses(didDocumentIdMap map[string]bool, credentialStatusBatchIdMap map[string]bool) error {
+	credentialStatusIdMap := map[string]bool{}
+
+	for _, credentialStatusState := range gs.CredentialStatuses {
+		if credentialStatusState == nil || credentialStatusState.CredentialStatusDocument == nil || credentialStatusState.CredentialStatusProof == nil {
+			return fmt.Errorf("credential status state must contain both credential status document and its proof")
+		}
+
+		credentialStatus := credentialStatusState.CredentialStatusDocument
+		if credentialStatus.Id == "" {
+			return fmt.Errorf("credential status id cannot be empty")
+		}
+		if _, present := credentialStatusIdMap[credentialStatus.Id]; present {
+			return fmt.Errorf("duplicate credential status %v found in genesis state", credentialStatus.Id)
+		}
+		credentialStatusIdMap[credentialStatus.Id] = true
+
+		if err := chainNamespaceValidation(credentialStatus.Id, gs.ChainNamespace); err != nil {
+			return err
+		}
+		if _, present := didDocumentIdMap[credentialStatus.Issuer]; !present {
+			return fmt.Errorf(
+				"issuer %v of credential status %v is not present in genesis state",
+				credentialStatus.Issuer,
+				credentialStatus.Id,
+			)
+		}
+		if credentialStatus.ExpirationDate != "" {
+			if _, err := time.Parse(time.RFC3339, credentialStatus.ExpirationDate); err != nil {
+				return fmt.Errorf("invalid expiration date %v of credential status %v", credentialStatus.ExpirationDate, credentialStatus.Id)
+			}
+		} else if credentialStatusState.Expired {
+			return fmt.Errorf("credential status %v without an expiration date cannot be expired", credentialStatus.Id)
+		}
+		if batchId := credentialStatusState.CredentialStatusBatchId; batchId != "" && !credentialStatusBatchIdMap[batchId] {
+			return fmt.Errorf(
+				"credential status batch %v of credential status %v is not present in genesis state",
+				batchId,
+				credentialStatus.Id,
+			)
+		}
+	}
+
+	if gs.CredentialStatusCount != 0 && gs.CredentialStatusCount < uint64(len(gs.CredentialStatuses)) {
+		return fmt.Errorf(
+			"credential status count %v is less than the number of credential statuses %v",
+			gs.CredentialStatusCount,
+			len(gs.CredentialStatuses),
+		)
+	}
+
+	return nil
+}
+
+// validateCredentialStatusLists validates every Credential Status List in genesis state
+func (gs GenesisState) validateCredentialStatusLists(didDocumentIdMap map[string]bool) error {
+	credentialStatusListIdMap := map[string]bool{}
+
+	for _, credentialStatusListState := range gs.CredentialStatusLists {
+		if credentialStatusListState == nil || credentialStatusListState.CredentialStatusListDocument == nil || credentialStatusListState.CredentialStatusListProof == nil {
+			return fmt.Errorf("credential status list state must contain both credential status list document and its proof")
+		}
+
+		credentialStatusList := credentialStatusListState.CredentialStatusListDocument
+		if err := credentialStatusList.Validate(); err != nil {
+			return fmt.Errorf("invalid credential status list %v: %v", credentialStatusList.Id, err)
+		}
+		if _, present := credentialStatusListIdMap[credentialStatusList.Id]; present {
+			return fmt.Errorf("duplicate credential status list %v found in genesis state", credentialStatusList.Id)
+		}
+		credentialStatusListIdMap[credentialStatusList.Id] = true
+
+		if err := chainNamespaceValidation(credentialStatusList.Id, gs.ChainNamespace); err != nil {
+			return err
+		}
+		if _, present := didDocumentIdMap[credentialStatusList.Issuer]; !present {
+			return fmt.Errorf(
+				"issuer %v of credential status list %v is not present in genesis state",
+				credentialStatusList.Issuer,
+				credentialStatusList.Id,
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateAccreditations validates every accreditation in genesis state
+func (gs GenesisState) validateAccreditations(didDocumentIdMap map[string]bool) error {
+	accreditationIdMap := map[string]bool{}
+
+	for _, accreditationState := range gs.Accreditations {
+		if accreditationState == nil || accreditationState.AccreditationDocument == nil {
+			return fmt.Errorf("accreditation state must contain the accreditation document")
+		}
+
+		accreditation := accreditationState.AccreditationDocument
+		if err := accreditation.Validate(); err != nil {
+			return fmt.Errorf("invalid accreditation %v: %v", accreditation.Id, err)
+		}
+		if _, present := accreditationIdMap[accreditation.Id]; present {
+			return fmt.Errorf("duplicate accreditation %v found in genesis state", accreditation.Id)
+		}
+		accreditationIdMap[accreditation.Id] = true
+
+		if err := chainNamespaceValidation(accreditation.Id, gs.ChainNamespace); err != nil {
+			return err
+		}
+		if _, present := didDocumentIdMap[accreditation.AccreditedIssuer]; !present {
+			return fmt.Errorf(
+				"accredited issuer %v of accreditation %v is not present in genesis state",
+				accreditation.AccreditedIssuer,
+				accreditation.Id,
+			)
+		}
+	}
+
 	return nil
 }
@@ -8,15 +8,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,8 +30,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the ssi module's genesis state.
 type GenesisState struct {
-	ChainNamespace string  `protobuf:"bytes,1,opt,name=chainNamespace,proto3" json:"chainNamespace,omitempty"`
-	Params         *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	ChainNamespace          string                        `protobuf:"bytes,1,opt,name=chainNamespace,proto3" json:"chainNamespace,omitempty"`
+	Params                  *Params                       `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	DidDocuments            []*DidDocumentState           `protobuf:"bytes,3,rep,name=didDocuments,proto3" json:"didDocuments,omitempty"`
+	CredentialSchemas       []*CredentialSchemaState      `protobuf:"bytes,4,rep,name=credentialSchemas,proto3" json:"credentialSchemas,omitempty"`
+	CredentialStatuses      []*CredentialStatusState      `protobuf:"bytes,5,rep,name=credentialStatuses,proto3" json:"credentialStatuses,omitempty"`
+	BlockchainAccountIds    []*BlockchainAccountIdEntry   `protobuf:"bytes,6,rep,name=blockchainAccountIds,proto3" json:"blockchainAccountIds,omitempty"`
+	DidDocumentCount        uint64                        `protobuf:"varint,7,opt,name=didDocumentCount,proto3" json:"didDocumentCount,omitempty"`
+	CredentialSchemaCount   uint64                        `protobuf:"varint,8,opt,name=credentialSchemaCount,proto3" json:"credentialSchemaCount,omitempty"`
+	CredentialStatusCount   uint64                        `protobuf:"varint,9,opt,name=credentialStatusCount,proto3" json:"credentialStatusCount,omitempty"`
+	DidDocumentVersions     []*DidDocumentState           `protobuf:"bytes,10,rep,name=didDocumentVersions,proto3" json:"didDocumentVersions,omitempty"`
+	CredentialStatusLists   []*CredentialStatusListState  `protobuf:"bytes,11,rep,name=credentialStatusLists,proto3" json:"credentialStatusLists,omitempty"`
+	PendingDidRecoveries    []*PendingDidRecovery         `protobuf:"bytes,12,rep,name=pendingDidRecoveries,proto3" json:"pendingDidRecoveries,omitempty"`
+	Accreditations          []*AccreditationState         `protobuf:"bytes,13,rep,name=accreditations,proto3" json:"accreditations,omitempty"`
+	CredentialStatusBatches []*CredentialStatusBatchState `protobuf:"bytes,14,rep,name=credentialStatusBatches,proto3" json:"credentialStatusBatches,omitempty"`
+	DidRecoveryProofs       []*DidRecoveryProofEntry      `protobuf:"bytes,15,rep,name=didRecoveryProofs,proto3" json:"didRecoveryProofs,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +94,205 @@ func (m *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (m *GenesisState) GetDidDocuments() []*DidDocumentState {
+	if m != nil {
+		return m.DidDocuments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCredentialSchemas() []*CredentialSchemaState {
+	if m != nil {
+		return m.CredentialSchemas
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCredentialStatuses() []*CredentialStatusState {
+	if m != nil {
+		return m.CredentialStatuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockchainAccountIds() []*BlockchainAccountIdEntry {
+	if m != nil {
+		return m.BlockchainAccountIds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDidDocumentCount() uint64 {
+	if m != nil {
+		return m.DidDocumentCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCredentialSchemaCount() uint64 {
+	if m != nil {
+		return m.CredentialSchemaCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCredentialStatusCount() uint64 {
+	if m != nil {
+		return m.CredentialStatusCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDidDocumentVersions() []*DidDocumentState {
+	if m != nil {
+		return m.DidDocumentVersions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCredentialStatusLists() []*CredentialStatusListState {
+	if m != nil {
+		return m.CredentialStatusLists
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingDidRecoveries() []*PendingDidRecovery {
+	if m != nil {
+		return m.PendingDidRecoveries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccreditations() []*AccreditationState {
+	if m != nil {
+		return m.Accreditations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCredentialStatusBatches() []*CredentialStatusBatchState {
+	if m != nil {
+		return m.CredentialStatusBatches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDidRecoveryProofs() []*DidRecoveryProofEntry {
+	if m != nil {
+		return m.DidRecoveryProofs
+	}
+	return nil
+}
+
+// BlockchainAccountIdEntry maps a CAIP-10 blockchainAccountId to the DID Id it belongs to
+type BlockchainAccountIdEntry struct {
+	BlockchainAccountId string `protobuf:"bytes,1,opt,name=blockchainAccountId,proto3" json:"blockchainAccountId,omitempty"`
+	DidId               string `protobuf:"bytes,2,opt,name=didId,proto3" json:"didId,omitempty"`
+}
+
+func (m *BlockchainAccountIdEntry) Reset()         { *m = BlockchainAccountIdEntry{} }
+func (m *BlockchainAccountIdEntry) String() string { return proto.CompactTextString(m) }
+func (*BlockchainAccountIdEntry) ProtoMessage()    {}
+func (*BlockchainAccountIdEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fdc77e3475ca247, []int{1}
+}
+func (m *BlockchainAccountIdEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockchainAccountIdEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockchainAccountIdEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockchainAccountIdEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockchainAccountIdEntry.Merge(m, src)
+}
+func (m *BlockchainAccountIdEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockchainAccountIdEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockchainAccountIdEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockchainAccountIdEntry proto.InternalMessageInfo
+
+func (m *BlockchainAccountIdEntry) GetBlockchainAccountId() string {
+	if m != nil {
+		return m.BlockchainAccountId
+	}
+	return ""
+}
+
+func (m *BlockchainAccountIdEntry) GetDidId() string {
+	if m != nil {
+		return m.DidId
+	}
+	return ""
+}
+
+// DidRecoveryProofEntry records a proof which has initiated a recovery of the DID Document, such that the
+// recovery cannot be initiated again by replaying the proof
+type DidRecoveryProofEntry struct {
+	DidId string `protobuf:"bytes,1,opt,name=didId,proto3" json:"didId,omitempty"`
+	// Hex encoded SHA-256 hash of the proof value
+	ProofValueHash string `protobuf:"bytes,2,opt,name=proofValueHash,proto3" json:"proofValueHash,omitempty"`
+}
+
+func (m *DidRecoveryProofEntry) Reset()         { *m = DidRecoveryProofEntry{} }
+func (m *DidRecoveryProofEntry) String() string { return proto.CompactTextString(m) }
+func (*DidRecoveryProofEntry) ProtoMessage()    {}
+func (*DidRecoveryProofEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fdc77e3475ca247, []int{2}
+}
+func (m *DidRecoveryProofEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidRecoveryProofEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidRecoveryProofEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DidRecoveryProofEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidRecoveryProofEntry.Merge(m, src)
+}
+func (m *DidRecoveryProofEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidRecoveryProofEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidRecoveryProofEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidRecoveryProofEntry proto.InternalMessageInfo
+
+func (m *DidRecoveryProofEntry) GetDidId() string {
+	if m != nil {
+		return m.DidId
+	}
+	return ""
+}
+
+func (m *DidRecoveryProofEntry) GetProofValueHash() string {
+	if m != nil {
+		return m.ProofValueHash
+	}
+	return ""
+}
+
 // Param defines the ssi module's params.
 type Params struct {
 	RegisterDidFee              *types.Coin `protobuf:"bytes,1,opt,name=register_did_fee,json=registerDidFee,proto3" json:"register_did_fee,omitempty"`
@@ -86,13 +302,19 @@ type Params struct {
 	UpdateCredentialSchemaFee   *types.Coin `protobuf:"bytes,5,opt,name=update_credential_schema_fee,json=updateCredentialSchemaFee,proto3" json:"update_credential_schema_fee,omitempty"`
 	RegisterCredentialStatusFee *types.Coin `protobuf:"bytes,6,opt,name=register_credential_status_fee,json=registerCredentialStatusFee,proto3" json:"register_credential_status_fee,omitempty"`
 	UpdateCredentialStatusFee   *types.Coin `protobuf:"bytes,7,opt,name=update_credential_status_fee,json=updateCredentialStatusFee,proto3" json:"update_credential_status_fee,omitempty"`
+	// Fee charged for every Credential Status of MsgRegisterCredentialStatusBatch
+	RegisterCredentialStatusBatchItemFee *types.Coin `protobuf:"bytes,8,opt,name=register_credential_status_batch_item_fee,json=registerCredentialStatusBatchItemFee,proto3" json:"register_credential_status_batch_item_fee,omitempty"`
+	// Maximum number of Credential Statuses in MsgRegisterCredentialStatusBatch
+	MaxCredentialStatusBatchSize uint32 `protobuf:"varint,9,opt,name=max_credential_status_batch_size,json=maxCredentialStatusBatchSize,proto3" json:"max_credential_status_batch_size,omitempty"`
+	// Delay after which an initiated DID recovery takes effect
+	DidRecoveryDelay time.Duration `protobuf:"bytes,10,opt,name=did_recovery_delay,json=didRecoveryDelay,proto3,stdduration" json:"did_recovery_delay"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3fdc77e3475ca247, []int{1}
+	return fileDescriptor_3fdc77e3475ca247, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -170,41 +392,95 @@ func (m *Params) GetUpdateCredentialStatusFee() *types.Coin {
 	return nil
 }
 
+func (m *Params) GetRegisterCredentialStatusBatchItemFee() *types.Coin {
+	if m != nil {
+		return m.RegisterCredentialStatusBatchItemFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxCredentialStatusBatchSize() uint32 {
+	if m != nil {
+		return m.MaxCredentialStatusBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetDidRecoveryDelay() time.Duration {
+	if m != nil {
+		return m.DidRecoveryDelay
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "hypersign.ssi.v1.GenesisState")
+	proto.RegisterType((*BlockchainAccountIdEntry)(nil), "hypersign.ssi.v1.BlockchainAccountIdEntry")
+	proto.RegisterType((*DidRecoveryProofEntry)(nil), "hypersign.ssi.v1.DidRecoveryProofEntry")
 	proto.RegisterType((*Params)(nil), "hypersign.ssi.v1.Params")
 }
 
 func init() { proto.RegisterFile("hypersign/ssi/v1/genesis.proto", fileDescriptor_3fdc77e3475ca247) }
 
 var fileDescriptor_3fdc77e3475ca247 = []byte{
-	// 402 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0xd3, 0xc1, 0x6a, 0xdb, 0x30,
-	0x18, 0x07, 0xf0, 0xb8, 0xeb, 0x3c, 0xa6, 0x96, 0x10, 0xcc, 0x0e, 0x69, 0x37, 0x44, 0xe9, 0x61,
-	0xf4, 0x52, 0x69, 0xce, 0x1e, 0x60, 0xb0, 0x8c, 0xf5, 0x32, 0xc6, 0x48, 0x6f, 0x3d, 0x2c, 0xc8,
-	0xd2, 0x37, 0x5b, 0x10, 0x5b, 0xc6, 0x9f, 0x62, 0x96, 0xb7, 0xd8, 0x63, 0xed, 0xb6, 0x1c, 0x77,
-	0x1c, 0xc9, 0x8b, 0x8c, 0x48, 0x8e, 0x33, 0x42, 0x82, 0x59, 0x6f, 0x46, 0x9f, 0xfe, 0x3f, 0xff,
-	0x05, 0x12, 0xa1, 0xd9, 0xa2, 0x84, 0x0a, 0x75, 0x5a, 0x70, 0x44, 0xcd, 0xeb, 0x98, 0xa7, 0x50,
-	0x00, 0x6a, 0x64, 0x65, 0x65, 0xac, 0x89, 0x06, 0xed, 0x9c, 0x21, 0x6a, 0x56, 0xc7, 0x97, 0x2f,
-	0x52, 0x93, 0x1a, 0x37, 0xe4, 0x9b, 0x2f, 0xbf, 0xef, 0x92, 0x4a, 0x83, 0xb9, 0x41, 0x9e, 0x08,
-	0x04, 0x5e, 0xc7, 0x09, 0x58, 0x11, 0x73, 0x69, 0x74, 0xe1, 0xe7, 0xd7, 0x19, 0x39, 0xbf, 0xf3,
-	0xf0, 0xbd, 0x15, 0x16, 0xa2, 0xd7, 0xa4, 0x2f, 0x33, 0xa1, 0x8b, 0xcf, 0x22, 0x07, 0x2c, 0x85,
-	0x84, 0x61, 0x70, 0x15, 0xdc, 0x3c, 0x9f, 0xec, 0xad, 0x46, 0x6f, 0x48, 0x58, 0x8a, 0x4a, 0xe4,
-	0x38, 0x3c, 0xb9, 0x0a, 0x6e, 0xce, 0x46, 0x43, 0xb6, 0x5f, 0x88, 0x7d, 0x71, 0xf3, 0x49, 0xb3,
-	0xef, 0xfa, 0xd7, 0x29, 0x09, 0xfd, 0x52, 0x34, 0x26, 0x83, 0x0a, 0x52, 0x8d, 0x16, 0xaa, 0xa9,
-	0xd2, 0x6a, 0xfa, 0x0d, 0xfc, 0x6f, 0xce, 0x46, 0x17, 0xcc, 0xf7, 0x65, 0x9b, 0xbe, 0xac, 0xe9,
-	0xcb, 0xc6, 0x46, 0x17, 0x93, 0xfe, 0x36, 0xf2, 0x41, 0xab, 0x8f, 0x00, 0xd1, 0x3b, 0xd2, 0x9f,
-	0x97, 0x4a, 0x58, 0x68, 0x89, 0x93, 0x2e, 0xe2, 0xdc, 0x07, 0x1a, 0xe0, 0x8e, 0x44, 0x0a, 0x84,
-	0xb4, 0xba, 0xfe, 0x17, 0x79, 0xd2, 0x85, 0x0c, 0x76, 0xa1, 0x06, 0xfa, 0x4a, 0x68, 0x7b, 0x1c,
-	0x59, 0x81, 0x82, 0xc2, 0x6a, 0x31, 0x9b, 0xa2, 0xcc, 0x20, 0x17, 0x0e, 0x3d, 0xed, 0x42, 0x5f,
-	0x6e, 0x81, 0x71, 0x9b, 0xbf, 0x77, 0xf1, 0x8d, 0xff, 0x40, 0x5e, 0x35, 0x27, 0x3d, 0xac, 0x3f,
-	0xed, 0xd2, 0x2f, 0x7c, 0xfc, 0x90, 0x7d, 0xac, 0xbb, 0x15, 0x76, 0x8e, 0x4e, 0x0f, 0x1f, 0xd3,
-	0xdd, 0xc5, 0x8f, 0x77, 0xdf, 0xe9, 0xcf, 0xfe, 0xbf, 0xfb, 0xd6, 0x7e, 0xff, 0xe9, 0xe7, 0x8a,
-	0x06, 0xcb, 0x15, 0x0d, 0xfe, 0xac, 0x68, 0xf0, 0x63, 0x4d, 0x7b, 0xcb, 0x35, 0xed, 0xfd, 0x5e,
-	0xd3, 0xde, 0xc3, 0x28, 0xd5, 0x36, 0x9b, 0x27, 0x4c, 0x9a, 0x9c, 0xb7, 0xf7, 0xf2, 0xd6, 0xdd,
-	0x78, 0x69, 0x66, 0x3c, 0xd3, 0xea, 0xb6, 0x30, 0x0a, 0xf8, 0x77, 0xf7, 0xb8, 0xec, 0xa2, 0x04,
-	0x4c, 0x42, 0x37, 0x7e, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0x92, 0xb3, 0xfe, 0x19, 0x7a, 0x03,
-	0x00, 0x00,
+	// 897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x6f, 0xdb, 0x36,
+	0x14, 0xc7, 0xa3, 0x26, 0x71, 0x13, 0x26, 0xf5, 0x32, 0x36, 0xc5, 0xd8, 0xac, 0x50, 0x0d, 0x2f,
+	0xd8, 0xbc, 0x6e, 0x91, 0x9a, 0x6c, 0xf7, 0xa1, 0x89, 0x97, 0x2e, 0x40, 0x30, 0x74, 0xea, 0xda,
+	0x0d, 0x3d, 0xd4, 0xa0, 0xc9, 0x67, 0x99, 0x98, 0x25, 0x1a, 0x22, 0x6d, 0xc4, 0xfd, 0x2b, 0x76,
+	0xdc, 0x9f, 0xd4, 0x63, 0x8f, 0x3b, 0x75, 0x43, 0xf2, 0x4f, 0xec, 0x38, 0x88, 0x94, 0x7f, 0x44,
+	0x92, 0xa7, 0xac, 0x37, 0x9b, 0xef, 0xfb, 0xfd, 0xf0, 0x3d, 0x8a, 0x7c, 0x24, 0x72, 0xfb, 0x93,
+	0x21, 0x24, 0x4a, 0x84, 0xb1, 0xaf, 0x94, 0xf0, 0xc7, 0x87, 0x7e, 0x08, 0x31, 0x28, 0xa1, 0xbc,
+	0x61, 0x22, 0xb5, 0xc4, 0x3b, 0xb3, 0xb8, 0xa7, 0x94, 0xf0, 0xc6, 0x87, 0x7b, 0xbb, 0xa1, 0x0c,
+	0xa5, 0x09, 0xfa, 0xe9, 0x2f, 0xab, 0xdb, 0x73, 0x43, 0x29, 0xc3, 0x01, 0xf8, 0xe6, 0x5f, 0x77,
+	0xd4, 0xf3, 0xf9, 0x28, 0xa1, 0x5a, 0xc8, 0x78, 0x1a, 0x67, 0x52, 0x45, 0x52, 0xf9, 0x5d, 0xaa,
+	0xc0, 0x1f, 0x1f, 0x76, 0x41, 0xd3, 0x43, 0x9f, 0x49, 0x31, 0x8d, 0xef, 0x15, 0xf2, 0xe0, 0x82,
+	0x67, 0xb1, 0xcf, 0xca, 0x62, 0x9d, 0x04, 0x98, 0x1c, 0x43, 0x32, 0xc9, 0x44, 0xad, 0x82, 0x88,
+	0x25, 0xc0, 0x21, 0xd6, 0x82, 0x0e, 0x3a, 0x8a, 0xf5, 0x21, 0xa2, 0x37, 0x52, 0x6a, 0xaa, 0x47,
+	0x59, 0xf1, 0x7b, 0x07, 0xd5, 0xca, 0xce, 0x40, 0x28, 0x9d, 0xc9, 0xf7, 0x0b, 0x72, 0xca, 0x52,
+	0x83, 0xd0, 0x0b, 0x2b, 0xd1, 0xfc, 0x67, 0x03, 0x6d, 0x3f, 0xb5, 0x6b, 0xfc, 0x5c, 0x53, 0x0d,
+	0xf8, 0x73, 0x54, 0x67, 0x7d, 0x2a, 0xe2, 0x1f, 0x69, 0x04, 0x6a, 0x48, 0x19, 0x10, 0xa7, 0xe1,
+	0xb4, 0x36, 0x83, 0xdc, 0x28, 0x7e, 0x8c, 0x6a, 0x43, 0x9a, 0xd0, 0x48, 0x91, 0x5b, 0x0d, 0xa7,
+	0xb5, 0x75, 0x44, 0xbc, 0xfc, 0xb7, 0xf1, 0x9e, 0x99, 0x78, 0x90, 0xe9, 0xf0, 0x29, 0xda, 0xe6,
+	0x82, 0xb7, 0x25, 0x1b, 0x45, 0x10, 0x6b, 0x45, 0x56, 0x1b, 0xab, 0xad, 0xad, 0xa3, 0x66, 0xd1,
+	0xd7, 0x9e, 0xab, 0x4c, 0x4e, 0xc1, 0x35, 0x1f, 0x7e, 0x81, 0x3e, 0x9e, 0x17, 0xfe, 0xdc, 0xac,
+	0xa5, 0x22, 0x6b, 0x06, 0xf6, 0x45, 0x11, 0x76, 0x92, 0x93, 0x5a, 0x62, 0x91, 0x80, 0x7f, 0x41,
+	0x78, 0x61, 0xd0, 0x2c, 0x27, 0x28, 0xb2, 0x7e, 0x03, 0xae, 0xd1, 0x5a, 0x6e, 0x09, 0x02, 0xbf,
+	0x46, 0xbb, 0xdd, 0x81, 0x64, 0xbf, 0x99, 0x05, 0x7c, 0xc2, 0x98, 0x1c, 0xc5, 0xfa, 0x8c, 0x2b,
+	0x52, 0x33, 0xe8, 0x47, 0x45, 0xf4, 0x71, 0x51, 0xfd, 0x7d, 0xac, 0x93, 0x49, 0x50, 0xca, 0xc1,
+	0x8f, 0xd0, 0xce, 0xc2, 0xfa, 0x9c, 0xa4, 0xc3, 0xe4, 0x76, 0xc3, 0x69, 0xad, 0x05, 0x85, 0x71,
+	0xfc, 0x2d, 0xba, 0x97, 0xaf, 0xdc, 0x1a, 0x36, 0x8c, 0xa1, 0x3c, 0x98, 0x73, 0x99, 0xba, 0xac,
+	0x6b, 0xb3, 0xe0, 0x9a, 0x07, 0xf1, 0xcf, 0xe8, 0xee, 0xc2, 0xfc, 0x2f, 0xd3, 0x1a, 0x65, 0xac,
+	0x08, 0xba, 0xf1, 0x67, 0x2f, 0xb3, 0x63, 0x5a, 0xcc, 0xe5, 0x5c, 0x28, 0xad, 0xc8, 0x96, 0xe1,
+	0x7e, 0x55, 0xfd, 0xa5, 0x52, 0xb9, 0x9d, 0xa0, 0x9c, 0x84, 0x7f, 0x45, 0xbb, 0x43, 0x88, 0xb9,
+	0x88, 0xc3, 0xb6, 0xe0, 0x81, 0x3d, 0xd8, 0x02, 0x14, 0xd9, 0x36, 0x33, 0xec, 0x97, 0x6c, 0xf4,
+	0xbc, 0x7a, 0x12, 0x94, 0x12, 0xf0, 0x39, 0xaa, 0x5f, 0x3b, 0x84, 0x8a, 0xdc, 0x59, 0xc6, 0x7c,
+	0xb2, 0xa8, 0xb3, 0xe9, 0xe6, 0xbc, 0xb8, 0x87, 0x3e, 0xc9, 0x17, 0x70, 0x4c, 0x35, 0xeb, 0x83,
+	0x22, 0x75, 0x83, 0xfd, 0xba, 0x7a, 0x31, 0x8c, 0xc1, 0xe2, 0x97, 0xc1, 0xd2, 0x03, 0xc7, 0xe7,
+	0xa5, 0x3d, 0x4b, 0xa4, 0xec, 0x29, 0xf2, 0xd1, 0xb2, 0x83, 0xd1, 0xce, 0x49, 0xed, 0xd6, 0x2d,
+	0x12, 0x9a, 0x5d, 0x44, 0x96, 0xed, 0x74, 0xfc, 0x18, 0xdd, 0x2d, 0xd9, 0xeb, 0x59, 0x2b, 0x2a,
+	0x0b, 0xe1, 0x5d, 0xb4, 0xce, 0x05, 0x3f, 0xe3, 0xa6, 0x1d, 0x6d, 0x06, 0xf6, 0x4f, 0xf3, 0x05,
+	0xba, 0x57, 0x9a, 0xcf, 0x5c, 0xee, 0x2c, 0xc8, 0xd3, 0xe6, 0x37, 0x4c, 0x35, 0x2f, 0xe9, 0x60,
+	0x04, 0x3f, 0x50, 0xd5, 0xcf, 0x68, 0xb9, 0xd1, 0xe6, 0xfb, 0x1a, 0xaa, 0xd9, 0xee, 0x86, 0x4f,
+	0xd0, 0x4e, 0x02, 0xa1, 0x50, 0x1a, 0x92, 0x4e, 0x7a, 0x11, 0xf4, 0xc0, 0x76, 0xcc, 0xad, 0xa3,
+	0xfb, 0x9e, 0xbd, 0x65, 0xbc, 0xf4, 0x96, 0xf1, 0xb2, 0x5b, 0xc6, 0x3b, 0x91, 0x22, 0x0e, 0xea,
+	0x53, 0x4b, 0x5b, 0xf0, 0x53, 0x00, 0xfc, 0x1d, 0xaa, 0x8f, 0x86, 0x9c, 0x6a, 0x98, 0x21, 0x6e,
+	0x55, 0x21, 0xb6, 0xad, 0x21, 0x03, 0x3c, 0x45, 0x98, 0x03, 0x65, 0x5a, 0x8c, 0x17, 0x21, 0xab,
+	0x55, 0x90, 0x9d, 0xb9, 0x29, 0x03, 0xbd, 0x46, 0xee, 0xac, 0x9c, 0xc2, 0x95, 0x65, 0xa0, 0x6b,
+	0x55, 0xd0, 0x4f, 0xa7, 0x80, 0x7c, 0xeb, 0x4d, 0xf9, 0xaf, 0xd0, 0x83, 0xac, 0xd2, 0x72, 0xfa,
+	0x7a, 0x15, 0xfd, 0xbe, 0xb5, 0x97, 0xb1, 0x97, 0xe5, 0x6e, 0xaf, 0xc6, 0x94, 0x5e, 0xfb, 0x90,
+	0xdc, 0x8d, 0x7d, 0x79, 0xee, 0x73, 0xfa, 0xed, 0xff, 0x9f, 0xfb, 0x8c, 0x9d, 0xa0, 0x2f, 0xff,
+	0x23, 0xf7, 0x6e, 0x7a, 0x12, 0x3b, 0x42, 0x43, 0x64, 0x26, 0xda, 0xa8, 0x9a, 0x68, 0x7f, 0x59,
+	0x19, 0xe6, 0x48, 0x9f, 0x69, 0x88, 0xd2, 0x39, 0x4f, 0x51, 0x23, 0xa2, 0x17, 0x4b, 0xa7, 0x53,
+	0xe2, 0x0d, 0x98, 0x0e, 0x7f, 0x27, 0x78, 0x10, 0xd1, 0x8b, 0xf2, 0xce, 0x21, 0xde, 0x00, 0xfe,
+	0x09, 0xe1, 0xc5, 0x27, 0x50, 0x87, 0xc3, 0x80, 0x4e, 0x08, 0xca, 0x92, 0xb4, 0x4f, 0x31, 0x6f,
+	0xfa, 0x14, 0xf3, 0xda, 0xd9, 0x53, 0xec, 0x78, 0xe3, 0xed, 0xfb, 0x87, 0x2b, 0x7f, 0xfc, 0xf5,
+	0xd0, 0x31, 0xf7, 0xd4, 0xf4, 0x8c, 0xb6, 0x53, 0xf3, 0xf1, 0xf9, 0xdb, 0x4b, 0xd7, 0x79, 0x77,
+	0xe9, 0x3a, 0x7f, 0x5f, 0xba, 0xce, 0xef, 0x57, 0xee, 0xca, 0xbb, 0x2b, 0x77, 0xe5, 0xcf, 0x2b,
+	0x77, 0xe5, 0xd5, 0x51, 0x28, 0x74, 0x7f, 0xd4, 0xf5, 0x98, 0x8c, 0xfc, 0x59, 0xef, 0x39, 0x30,
+	0x74, 0x26, 0x07, 0x7e, 0x5f, 0xf0, 0x83, 0x58, 0x72, 0xf0, 0x2f, 0xcc, 0xab, 0x47, 0x4f, 0x86,
+	0xa0, 0xba, 0x35, 0x13, 0xfe, 0xe6, 0xdf, 0x01, 0x00, 0x81, 0x82, 0x43, 0x1a, 0x5f, 0x0a, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -227,6 +503,161 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DidRecoveryProofs) > 0 {
+		for iNdEx := len(m.DidRecoveryProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidRecoveryProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.CredentialStatusBatches) > 0 {
+		for iNdEx := len(m.CredentialStatusBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredentialStatusBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Accreditations) > 0 {
+		for iNdEx := len(m.Accreditations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accreditations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PendingDidRecoveries) > 0 {
+		for iNdEx := len(m.PendingDidRecoveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDidRecoveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.CredentialStatusLists) > 0 {
+		for iNdEx := len(m.CredentialStatusLists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredentialStatusLists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DidDocumentVersions) > 0 {
+		for iNdEx := len(m.DidDocumentVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocumentVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.CredentialStatusCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CredentialStatusCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CredentialSchemaCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CredentialSchemaCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DidDocumentCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DidDocumentCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BlockchainAccountIds) > 0 {
+		for iNdEx := len(m.BlockchainAccountIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockchainAccountIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CredentialStatuses) > 0 {
+		for iNdEx := len(m.CredentialStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredentialStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CredentialSchemas) > 0 {
+		for iNdEx := len(m.CredentialSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredentialSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DidDocuments) > 0 {
+		for iNdEx := len(m.DidDocuments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocuments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Params != nil {
 		{
 			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
@@ -249,6 +680,80 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockchainAccountIdEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockchainAccountIdEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockchainAccountIdEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DidId) > 0 {
+		i -= len(m.DidId)
+		copy(dAtA[i:], m.DidId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DidId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockchainAccountId) > 0 {
+		i -= len(m.BlockchainAccountId)
+		copy(dAtA[i:], m.BlockchainAccountId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BlockchainAccountId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DidRecoveryProofEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidRecoveryProofEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidRecoveryProofEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofValueHash) > 0 {
+		i -= len(m.ProofValueHash)
+		copy(dAtA[i:], m.ProofValueHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ProofValueHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidId) > 0 {
+		i -= len(m.DidId)
+		copy(dAtA[i:], m.DidId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DidId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -269,6 +774,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DidRecoveryDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DidRecoveryDelay):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x52
+	if m.MaxCredentialStatusBatchSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxCredentialStatusBatchSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RegisterCredentialStatusBatchItemFee != nil {
+		{
+			size, err := m.RegisterCredentialStatusBatchItemFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.UpdateCredentialStatusFee != nil {
 		{
 			size, err := m.UpdateCredentialStatusFee.MarshalToSizedBuffer(dAtA[:i])
@@ -287,147 +817,888 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			if err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.UpdateCredentialSchemaFee != nil {
-		{
-			size, err := m.UpdateCredentialSchemaFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UpdateCredentialSchemaFee != nil {
+		{
+			size, err := m.UpdateCredentialSchemaFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RegisterCredentialSchemaFee != nil {
+		{
+			size, err := m.RegisterCredentialSchemaFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DeactivateDidFee != nil {
+		{
+			size, err := m.DeactivateDidFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UpdateDidFee != nil {
+		{
+			size, err := m.UpdateDidFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RegisterDidFee != nil {
+		{
+			size, err := m.RegisterDidFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainNamespace)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.DidDocuments) > 0 {
+		for _, e := range m.DidDocuments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CredentialSchemas) > 0 {
+		for _, e := range m.CredentialSchemas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CredentialStatuses) > 0 {
+		for _, e := range m.CredentialStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockchainAccountIds) > 0 {
+		for _, e := range m.BlockchainAccountIds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DidDocumentCount != 0 {
+		n += 1 + sovGenesis(uint64(m.DidDocumentCount))
+	}
+	if m.CredentialSchemaCount != 0 {
+		n += 1 + sovGenesis(uint64(m.CredentialSchemaCount))
+	}
+	if m.CredentialStatusCount != 0 {
+		n += 1 + sovGenesis(uint64(m.CredentialStatusCount))
+	}
+	if len(m.DidDocumentVersions) > 0 {
+		for _, e := range m.DidDocumentVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CredentialStatusLists) > 0 {
+		for _, e := range m.CredentialStatusLists {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingDidRecoveries) > 0 {
+		for _, e := range m.PendingDidRecoveries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Accreditations) > 0 {
+		for _, e := range m.Accreditations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CredentialStatusBatches) > 0 {
+		for _, e := range m.CredentialStatusBatches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DidRecoveryProofs) > 0 {
+		for _, e := range m.DidRecoveryProofs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockchainAccountIdEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockchainAccountId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.DidId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *DidRecoveryProofEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DidId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ProofValueHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RegisterDidFee != nil {
+		l = m.RegisterDidFee.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.UpdateDidFee != nil {
+		l = m.UpdateDidFee.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.DeactivateDidFee != nil {
+		l = m.DeactivateDidFee.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RegisterCredentialSchemaFee != nil {
+		l = m.RegisterCredentialSchemaFee.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.UpdateCredentialSchemaFee != nil {
+		l = m.UpdateCredentialSchemaFee.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RegisterCredentialStatusFee != nil {
+		l = m.RegisterCredentialStatusFee.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.UpdateCredentialStatusFee != nil {
+		l = m.UpdateCredentialStatusFee.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RegisterCredentialStatusBatchItemFee != nil {
+		l = m.RegisterCredentialStatusBatchItemFee.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxCredentialStatusBatchSize != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxCredentialStatusBatchSize))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DidRecoveryDelay)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocuments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocuments = append(m.DidDocuments, &DidDocumentState{})
+			if err := m.DidDocuments[len(m.DidDocuments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialSchemas = append(m.CredentialSchemas, &CredentialSchemaState{})
+			if err := m.CredentialSchemas[len(m.CredentialSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialStatuses = append(m.CredentialStatuses, &CredentialStatusState{})
+			if err := m.CredentialStatuses[len(m.CredentialStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockchainAccountIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockchainAccountIds = append(m.BlockchainAccountIds, &BlockchainAccountIdEntry{})
+			if err := m.BlockchainAccountIds[len(m.BlockchainAccountIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentCount", wireType)
+			}
+			m.DidDocumentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DidDocumentCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaCount", wireType)
+			}
+			m.CredentialSchemaCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CredentialSchemaCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusCount", wireType)
+			}
+			m.CredentialStatusCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CredentialStatusCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocumentVersions = append(m.DidDocumentVersions, &DidDocumentState{})
+			if err := m.DidDocumentVersions[len(m.DidDocumentVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusLists", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialStatusLists = append(m.CredentialStatusLists, &CredentialStatusListState{})
+			if err := m.CredentialStatusLists[len(m.CredentialStatusLists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDidRecoveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingDidRecoveries = append(m.PendingDidRecoveries, &PendingDidRecovery{})
+			if err := m.PendingDidRecoveries[len(m.PendingDidRecoveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accreditations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accreditations = append(m.Accreditations, &AccreditationState{})
+			if err := m.Accreditations[len(m.Accreditations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialStatusBatches = append(m.CredentialStatusBatches, &CredentialStatusBatchState{})
+			if err := m.CredentialStatusBatches[len(m.CredentialStatusBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidRecoveryProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidRecoveryProofs = append(m.DidRecoveryProofs, &DidRecoveryProofEntry{})
+			if err := m.DidRecoveryProofs[len(m.DidRecoveryProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockchainAccountIdEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockchainAccountIdEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockchainAccountIdEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockchainAccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockchainAccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.RegisterCredentialSchemaFee != nil {
-		{
-			size, err := m.RegisterCredentialSchemaFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.DeactivateDidFee != nil {
-		{
-			size, err := m.DeactivateDidFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.UpdateDidFee != nil {
-		{
-			size, err := m.UpdateDidFee.MarshalToSizedBuffer(dAtA[:i])
+			m.DidId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.RegisterDidFee != nil {
-		{
-			size, err := m.RegisterDidFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainNamespace)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovGenesis(uint64(l))
 	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RegisterDidFee != nil {
-		l = m.RegisterDidFee.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.UpdateDidFee != nil {
-		l = m.UpdateDidFee.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.DeactivateDidFee != nil {
-		l = m.DeactivateDidFee.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.RegisterCredentialSchemaFee != nil {
-		l = m.RegisterCredentialSchemaFee.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.UpdateCredentialSchemaFee != nil {
-		l = m.UpdateCredentialSchemaFee.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.RegisterCredentialStatusFee != nil {
-		l = m.RegisterCredentialStatusFee.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.UpdateCredentialStatusFee != nil {
-		l = m.UpdateCredentialStatusFee.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *DidRecoveryProofEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -450,15 +1721,15 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: DidRecoveryProofEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DidRecoveryProofEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DidId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -486,13 +1757,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainNamespace = string(dAtA[iNdEx:postIndex])
+			m.DidId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofValueHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -502,27 +1773,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProofValueHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -826,6 +2093,94 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterCredentialStatusBatchItemFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegisterCredentialStatusBatchItemFee == nil {
+				m.RegisterCredentialStatusBatchItemFee = &types.Coin{}
+			}
+			if err := m.RegisterCredentialStatusBatchItemFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCredentialStatusBatchSize", wireType)
+			}
+			m.MaxCredentialStatusBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCredentialStatusBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidRecoveryDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DidRecoveryDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
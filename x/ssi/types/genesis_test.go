@@ -46,6 +46,77 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid DID Document state without metadata",
+			genState: &types.GenesisState{
+				ChainNamespace: "devnet",
+				DidDocuments: []*types.DidDocumentState{
+					{
+						DidDocument: &types.DidDocument{
+							Id: "did:hid:devnet:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid blockchainAccountId entry referring to an unknown DID Document",
+			genState: &types.GenesisState{
+				ChainNamespace: "devnet",
+				BlockchainAccountIds: []*types.BlockchainAccountIdEntry{
+					{
+						BlockchainAccountId: "eip155:1:0x6d7e4d3c3bf3e3b2c4e7e3cf5b0b6c4b4f7d2b4e",
+						DidId:               "did:hid:devnet:0x6d7e4d3c3bf3e3b2c4e7e3cf5b0b6c4b4f7d2b4e",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid expired Credential Status without an expiration date",
+			genState: &types.GenesisState{
+				ChainNamespace: "devnet",
+				DidDocuments: []*types.DidDocumentState{
+					{
+						DidDocument: &types.DidDocument{
+							Id: "did:hid:devnet:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK",
+						},
+						DidDocumentMetadata: &types.DidDocumentMetadata{VersionId: "1"},
+					},
+				},
+				CredentialStatuses: []*types.CredentialStatusState{
+					{
+						CredentialStatusDocument: &types.CredentialStatusDocument{
+							Id:     "vc:hid:devnet:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK",
+							Issuer: "did:hid:devnet:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK",
+						},
+						CredentialStatusProof: &types.DocumentProof{},
+						Expired:               true,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid accreditation of an accredited issuer absent in genesis state",
+			genState: &types.GenesisState{
+				ChainNamespace: "devnet",
+				Accreditations: []*types.AccreditationState{
+					{
+						AccreditationDocument: &types.AccreditationDocument{
+							Id:                 "acc:hid:devnet:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK",
+							Accreditor:         "hid10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+							AccreditedIssuer:   "did:hid:devnet:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK",
+							CredentialSchemaId: "sch:hid:devnet:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK:1.0",
+							ValidFrom:          "2023-01-01T00:00:00Z",
+							ValidUntil:         "2024-01-01T00:00:00Z",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -1,5 +1,13 @@
 package types
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "ssi"
@@ -21,6 +29,8 @@ const (
 	DidKey      = "Did-value-"
 	DidCountKey = "Did-count-"
 
+	DidVersionKey = "Did-version-"
+
 	ChainNamespaceKey = "Did-namespace-"
 
 	SchemaKey      = "Schema-value-"
@@ -29,10 +39,29 @@ const (
 	CredKey      = "Cred-value-"
 	CredCountKey = "Cred-count-"
 
+	CredBatchKey = "CredBatch-value-"
+
+	CredStatusListKey = "CredStatusList-value-"
+
+	AccreditationKey = "Accreditation-value-"
+
+	DidRecoveryKey      = "DidRecovery-value-"
+	DidRecoveryQueueKey = "DidRecovery-queue-"
+	DidRecoveryProofKey = "DidRecovery-proof-"
+
 	BlockchainAccountIdStoreKey = "blockchainaddrstorekey"
+
+	ParamsKey = "Params-value-"
+
+	DidControllerIndexKey = "Did-controller-"
+	SchemaAuthorIndexKey  = "Schema-author-"
+	CredIssuerIndexKey    = "Cred-issuer-"
+	CredExpiryIndexKey    = "Cred-expiry-"
+
+	AccreditationIssuerIndexKey = "Accreditation-issuer-"
 )
 
-// Fixed Fee Param Keys
+// Fixed Fee Param Keys of legacy x/params subspace
 
 var (
 	ParamStoreKeyRegisterDidFee              = []byte("RegisterDidFee")
@@ -47,3 +76,52 @@ var (
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// GetDidDocumentKey returns the key of the latest DID Document state in the x/ssi module store
+func GetDidDocumentKey(didId string) []byte {
+	return KeyPrefix(DidKey + didId)
+}
+
+// GetDidControllerIndexPrefix returns the store prefix of DID Documents controlled by the input DID
+func GetDidControllerIndexPrefix(controller string) []byte {
+	return KeyPrefix(DidControllerIndexKey + controller + "/")
+}
+
+// GetSchemaAuthorIndexPrefix returns the store prefix of Credential Schemas authored by the input DID
+func GetSchemaAuthorIndexPrefix(author string) []byte {
+	return KeyPrefix(SchemaAuthorIndexKey + author + "/")
+}
+
+// GetCredIssuerIndexPrefix returns the store prefix of Credential Statuses issued by the input DID
+func GetCredIssuerIndexPrefix(issuer string) []byte {
+	return KeyPrefix(CredIssuerIndexKey + issuer + "/")
+}
+
+// GetAccreditationIssuerIndexPrefix returns the store prefix of accreditations of the input DID for a Credential Schema
+func GetAccreditationIssuerIndexPrefix(issuer string, credentialSchemaId string) []byte {
+	return KeyPrefix(AccreditationIssuerIndexKey + issuer + "/" + credentialSchemaId + "/")
+}
+
+// GetCredExpiryIndexKey returns the key of a Credential Status in the expiry index, relative to the
+// CredExpiryIndexKey prefix. Keys are ordered by the expiration time, as sdk.FormatTimeBytes has a fixed length.
+func GetCredExpiryIndexKey(expirationTime time.Time, credId string) []byte {
+	return append(sdk.FormatTimeBytes(expirationTime), []byte(credId)...)
+}
+
+// GetDidRecoveryQueueKey returns the key of a pending DID recovery in the recovery queue, relative to the
+// DidRecoveryQueueKey prefix. Keys are ordered by the time at which the recovery takes effect.
+func GetDidRecoveryQueueKey(effectiveTime time.Time, didId string) []byte {
+	return append(sdk.FormatTimeBytes(effectiveTime), []byte(didId)...)
+}
+
+// GetDidRecoveryProofValueHash returns the hex encoded SHA-256 hash of the value of a proof initiating a DID recovery
+func GetDidRecoveryProofValueHash(proofValue string) string {
+	proofValueHash := sha256.Sum256([]byte(proofValue))
+	return hex.EncodeToString(proofValueHash[:])
+}
+
+// GetDidRecoveryProofKey returns the key of a proof which has initiated a recovery of the DID Document, relative to
+// the DidRecoveryProofKey prefix
+func GetDidRecoveryProofKey(didId string, proofValueHash string) []byte {
+	return []byte(didId + "/" + proofValueHash)
+}
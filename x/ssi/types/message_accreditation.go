@@ -0,0 +1,102 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgRegisterAccreditation Type Methods
+
+const TypeMsgRegisterAccreditation = "register_accreditation"
+
+var _ sdk.Msg = &MsgRegisterAccreditation{}
+
+func NewMsgRegisterAccreditation(
+	accreditationDoc *AccreditationDocument,
+	accreditationProof *DocumentProof,
+	txAuthor string,
+) *MsgRegisterAccreditation {
+	return &MsgRegisterAccreditation{
+		AccreditationDocument: accreditationDoc,
+		AccreditationProof:    accreditationProof,
+		TxAuthor:              txAuthor,
+	}
+}
+
+func (msg *MsgRegisterAccreditation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRegisterAccreditation) Type() string {
+	return TypeMsgRegisterAccreditation
+}
+
+func (msg *MsgRegisterAccreditation) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRegisterAccreditation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRegisterAccreditation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+func (msg *AccreditationDocument) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+// MsgRegisterGovernanceAccreditation Type Methods
+
+const TypeMsgRegisterGovernanceAccreditation = "register_governance_accreditation"
+
+var _ sdk.Msg = &MsgRegisterGovernanceAccreditation{}
+
+func NewMsgRegisterGovernanceAccreditation(authority string, accreditationDoc *AccreditationDocument) *MsgRegisterGovernanceAccreditation {
+	return &MsgRegisterGovernanceAccreditation{
+		Authority:             authority,
+		AccreditationDocument: accreditationDoc,
+	}
+}
+
+func (msg *MsgRegisterGovernanceAccreditation) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRegisterGovernanceAccreditation) Type() string {
+	return TypeMsgRegisterGovernanceAccreditation
+}
+
+func (msg *MsgRegisterGovernanceAccreditation) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRegisterGovernanceAccreditation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRegisterGovernanceAccreditation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %v", err)
+	}
+	if msg.AccreditationDocument == nil {
+		return errors.Wrap(ErrInvalidAccreditation, "accreditation document must be provided")
+	}
+	return msg.AccreditationDocument.Validate()
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -89,3 +92,69 @@ func (msg *MsgRegisterCredentialStatus) ValidateBasic() error {
 	}
 	return nil
 }
+
+// Register Credential Status Batch
+
+const TypeMsgRegisterCredentialStatusBatch = "register_credential_status_batch"
+
+var _ sdk.Msg = &MsgRegisterCredentialStatusBatch{}
+
+func NewMsgRegisterCredentialStatusBatch(
+	credentialStatusBatchDocument *CredentialStatusBatchDocument,
+	credentialStatusBatchProof *DocumentProof,
+	txAuthor string,
+) *MsgRegisterCredentialStatusBatch {
+	return &MsgRegisterCredentialStatusBatch{
+		CredentialStatusBatchDocument: credentialStatusBatchDocument,
+		CredentialStatusBatchProof:    credentialStatusBatchProof,
+		TxAuthor:                      txAuthor,
+	}
+}
+
+func (msg *MsgRegisterCredentialStatusBatch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRegisterCredentialStatusBatch) Type() string {
+	return TypeMsgRegisterCredentialStatusBatch
+}
+
+func (msg *MsgRegisterCredentialStatusBatch) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRegisterCredentialStatusBatch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *CredentialStatusBatchDocument) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+// GetId returns the issuer of the Credential Status Batch, as the batch does not have an id of its own
+func (msg *CredentialStatusBatchDocument) GetId() string {
+	return msg.GetIssuer()
+}
+
+// GetCredentialStatusBatchId returns the id under which the Credential Status Batch Document is stored, which is
+// the hex encoded SHA-256 hash of its protobuf encoding
+func GetCredentialStatusBatchId(credentialStatusBatch *CredentialStatusBatchDocument) string {
+	hash := sha256.Sum256(ModuleCdc.MustMarshal(credentialStatusBatch))
+	return hex.EncodeToString(hash[:])
+}
+
+func (msg *MsgRegisterCredentialStatusBatch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid transaction author's address (%s)", err)
+	}
+	if len(msg.GetCredentialStatusBatchDocument().GetCredentialStatuses()) == 0 {
+		return errors.Wrap(ErrInvalidCredentialStatusBatch, "batch must contain atleast one credential status")
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Register Credential Status List
+
+const TypeMsgRegisterCredentialStatusList = "register_credential_status_list"
+
+var _ sdk.Msg = &MsgRegisterCredentialStatusList{}
+
+func NewMsgRegisterCredentialStatusList(
+	credentialStatusListDocument *CredentialStatusListDocument,
+	credentialStatusListProof *DocumentProof,
+	txAuthor string,
+) *MsgRegisterCredentialStatusList {
+	return &MsgRegisterCredentialStatusList{
+		CredentialStatusListDocument: credentialStatusListDocument,
+		CredentialStatusListProof:    credentialStatusListProof,
+		TxAuthor:                     txAuthor,
+	}
+}
+
+func (msg *MsgRegisterCredentialStatusList) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRegisterCredentialStatusList) Type() string {
+	return TypeMsgRegisterCredentialStatusList
+}
+
+func (msg *MsgRegisterCredentialStatusList) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRegisterCredentialStatusList) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRegisterCredentialStatusList) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid transaction author's address (%s)", err)
+	}
+	return nil
+}
+
+// Update Credential Status List
+
+const TypeMsgUpdateCredentialStatusList = "update_credential_status_list"
+
+var _ sdk.Msg = &MsgUpdateCredentialStatusList{}
+
+func NewMsgUpdateCredentialStatusList(
+	credentialStatusListDocument *CredentialStatusListDocument,
+	credentialStatusListProof *DocumentProof,
+	txAuthor string,
+) *MsgUpdateCredentialStatusList {
+	return &MsgUpdateCredentialStatusList{
+		CredentialStatusListDocument: credentialStatusListDocument,
+		CredentialStatusListProof:    credentialStatusListProof,
+		TxAuthor:                     txAuthor,
+	}
+}
+
+func (msg *MsgUpdateCredentialStatusList) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateCredentialStatusList) Type() string {
+	return TypeMsgUpdateCredentialStatusList
+}
+
+func (msg *MsgUpdateCredentialStatusList) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateCredentialStatusList) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateCredentialStatusList) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid transaction author's address (%s)", err)
+	}
+	return nil
+}
+
+func (msg *CredentialStatusListDocument) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
@@ -149,6 +149,151 @@ func (msg *MsgDeactivateDID) ValidateBasic() error {
 	return nil
 }
 
+// MsgInitiateDidRecovery Type Methods
+
+const TypeMsgInitiateDidRecovery = "initiate_did_recovery"
+
+var _ sdk.Msg = &MsgInitiateDidRecovery{}
+
+func NewMsgInitiateDidRecovery(
+	didDoc *DidDocument,
+	documentProofs []*DocumentProof,
+	versionId string,
+	txAuthor string,
+) *MsgInitiateDidRecovery {
+	return &MsgInitiateDidRecovery{
+		DidDocument:       didDoc,
+		DidDocumentProofs: documentProofs,
+		VersionId:         versionId,
+		TxAuthor:          txAuthor,
+	}
+}
+
+func (msg *MsgInitiateDidRecovery) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgInitiateDidRecovery) Type() string {
+	return TypeMsgInitiateDidRecovery
+}
+
+func (msg *MsgInitiateDidRecovery) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgInitiateDidRecovery) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgInitiateDidRecovery) ValidateBasic() error {
+	didDoc := msg.DidDocument
+	if err := didDoc.ValidateDidDocument(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MsgCancelDidRecovery Type Methods
+
+const TypeMsgCancelDidRecovery = "cancel_did_recovery"
+
+var _ sdk.Msg = &MsgCancelDidRecovery{}
+
+func NewMsgCancelDidRecovery(didId string, documentProofs []*DocumentProof, txAuthor string) *MsgCancelDidRecovery {
+	return &MsgCancelDidRecovery{
+		DidDocumentId:     didId,
+		DidDocumentProofs: documentProofs,
+		TxAuthor:          txAuthor,
+	}
+}
+
+func (msg *MsgCancelDidRecovery) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelDidRecovery) Type() string {
+	return TypeMsgCancelDidRecovery
+}
+
+func (msg *MsgCancelDidRecovery) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelDidRecovery) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelDidRecovery) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+// MsgMarkVerificationMethodCompromised Type Methods
+
+const TypeMsgMarkVerificationMethodCompromised = "mark_verification_method_compromised"
+
+var _ sdk.Msg = &MsgMarkVerificationMethodCompromised{}
+
+func NewMsgMarkVerificationMethodCompromised(
+	compromiseDoc *VerificationMethodCompromiseDocument,
+	documentProofs []*DocumentProof,
+	versionId string,
+	txAuthor string,
+) *MsgMarkVerificationMethodCompromised {
+	return &MsgMarkVerificationMethodCompromised{
+		CompromiseDocument:       compromiseDoc,
+		CompromiseDocumentProofs: documentProofs,
+		VersionId:                versionId,
+		TxAuthor:                 txAuthor,
+	}
+}
+
+func (msg *MsgMarkVerificationMethodCompromised) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgMarkVerificationMethodCompromised) Type() string {
+	return TypeMsgMarkVerificationMethodCompromised
+}
+
+func (msg *MsgMarkVerificationMethodCompromised) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgMarkVerificationMethodCompromised) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgMarkVerificationMethodCompromised) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+func (msg *VerificationMethodCompromiseDocument) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
 func CreateNewMetadata(ctx sdk.Context) DidDocumentMetadata {
 	return DidDocumentMetadata{
 		VersionId:   strings.ToUpper(hex.EncodeToString(tmhash.Sum([]byte(ctx.TxBytes())))),
@@ -90,3 +90,54 @@ func (msg *MsgUpdateCredentialSchema) ValidateBasic() error {
 	}
 	return nil
 }
+
+// Update Credential Schema Status
+
+const TypeMsgUpdateCredentialSchemaStatus = "update_credential_schema_status"
+
+var _ sdk.Msg = &MsgUpdateCredentialSchemaStatus{}
+
+func NewMsgUpdateCredentialSchemaStatus(
+	schemaStatusDoc *CredentialSchemaStatusDocument,
+	schemaStatusProof *DocumentProof,
+	txAuthor string,
+) *MsgUpdateCredentialSchemaStatus {
+	return &MsgUpdateCredentialSchemaStatus{
+		CredentialSchemaStatusDocument: schemaStatusDoc,
+		CredentialSchemaStatusProof:    schemaStatusProof,
+		TxAuthor:                       txAuthor,
+	}
+}
+
+func (msg *MsgUpdateCredentialSchemaStatus) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateCredentialSchemaStatus) Type() string {
+	return TypeMsgUpdateCredentialSchemaStatus
+}
+
+func (msg *MsgUpdateCredentialSchemaStatus) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateCredentialSchemaStatus) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *CredentialSchemaStatusDocument) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshal(msg)
+}
+
+func (msg *MsgUpdateCredentialSchemaStatus) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.TxAuthor)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
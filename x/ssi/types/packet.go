@@ -0,0 +1,63 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PortID is the port to which the ssi IBC application is bound
+	PortID = ModuleName
+
+	// Version is the version of the ssi IBC application
+	Version = "ssi-1"
+)
+
+// Packet types of the ssi IBC application
+const (
+	DidResolutionPacketType    = "did_resolution"
+	CredentialStatusPacketType = "credential_status"
+)
+
+// GetBytes returns the sorted JSON encoding of the packet data, which is sent over the channel
+func (p SsiPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&p))
+}
+
+// GetPacketType returns the type of the packet data
+func (p SsiPacketData) GetPacketType() string {
+	switch p.Packet.(type) {
+	case *SsiPacketData_DidResolutionPacket:
+		return DidResolutionPacketType
+	case *SsiPacketData_CredentialStatusPacket:
+		return CredentialStatusPacketType
+	default:
+		return ""
+	}
+}
+
+// ValidateBasic performs stateless checks of the packet data
+func (p SsiPacketData) ValidateBasic() error {
+	switch packet := p.Packet.(type) {
+	case *SsiPacketData_DidResolutionPacket:
+		if packet.DidResolutionPacket.DidId == "" {
+			return errors.Wrap(ErrInvalidPacket, "didId cannot be empty")
+		}
+	case *SsiPacketData_CredentialStatusPacket:
+		if packet.CredentialStatusPacket.CredId == "" {
+			return errors.Wrap(ErrInvalidPacket, "credId cannot be empty")
+		}
+	default:
+		return errors.Wrapf(ErrInvalidPacket, "unrecognized packet type %T", packet)
+	}
+	return nil
+}
+
+// DecodeSsiPacketData decodes the JSON encoded packet data received over the channel
+func DecodeSsiPacketData(bz []byte) (SsiPacketData, error) {
+	var packetData SsiPacketData
+	if err := ModuleCdc.UnmarshalJSON(bz, &packetData); err != nil {
+		return SsiPacketData{}, errors.Wrap(ErrInvalidPacket, err.Error())
+	}
+	return packetData, nil
+}
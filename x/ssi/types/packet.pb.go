@@ -0,0 +1,1404 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hypersign/ssi/v1/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SsiPacketData is the query packet sent by counterparty chains over the ssi port
+type SsiPacketData struct {
+	// Types that are valid to be assigned to Packet:
+	//	*SsiPacketData_DidResolutionPacket
+	//	*SsiPacketData_CredentialStatusPacket
+	Packet isSsiPacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *SsiPacketData) Reset()         { *m = SsiPacketData{} }
+func (m *SsiPacketData) String() string { return proto.CompactTextString(m) }
+func (*SsiPacketData) ProtoMessage()    {}
+func (*SsiPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_301db9610fd4563e, []int{0}
+}
+func (m *SsiPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SsiPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SsiPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SsiPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SsiPacketData.Merge(m, src)
+}
+func (m *SsiPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *SsiPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_SsiPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SsiPacketData proto.InternalMessageInfo
+
+type isSsiPacketData_Packet interface {
+	isSsiPacketData_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type SsiPacketData_DidResolutionPacket struct {
+	DidResolutionPacket *DidResolutionPacketData `protobuf:"bytes,1,opt,name=didResolutionPacket,proto3,oneof" json:"didResolutionPacket,omitempty"`
+}
+type SsiPacketData_CredentialStatusPacket struct {
+	CredentialStatusPacket *CredentialStatusPacketData `protobuf:"bytes,2,opt,name=credentialStatusPacket,proto3,oneof" json:"credentialStatusPacket,omitempty"`
+}
+
+func (*SsiPacketData_DidResolutionPacket) isSsiPacketData_Packet()    {}
+func (*SsiPacketData_CredentialStatusPacket) isSsiPacketData_Packet() {}
+
+func (m *SsiPacketData) GetPacket() isSsiPacketData_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *SsiPacketData) GetDidResolutionPacket() *DidResolutionPacketData {
+	if x, ok := m.GetPacket().(*SsiPacketData_DidResolutionPacket); ok {
+		return x.DidResolutionPacket
+	}
+	return nil
+}
+
+func (m *SsiPacketData) GetCredentialStatusPacket() *CredentialStatusPacketData {
+	if x, ok := m.GetPacket().(*SsiPacketData_CredentialStatusPacket); ok {
+		return x.CredentialStatusPacket
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SsiPacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SsiPacketData_DidResolutionPacket)(nil),
+		(*SsiPacketData_CredentialStatusPacket)(nil),
+	}
+}
+
+// DidResolutionPacketData requests the resolution of a DID, with the same options as the ResolveDid query
+type DidResolutionPacketData struct {
+	DidId       string `protobuf:"bytes,1,opt,name=didId,proto3" json:"didId,omitempty"`
+	VersionId   string `protobuf:"bytes,2,opt,name=versionId,proto3" json:"versionId,omitempty"`
+	VersionTime string `protobuf:"bytes,3,opt,name=versionTime,proto3" json:"versionTime,omitempty"`
+	Accept      string `protobuf:"bytes,4,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (m *DidResolutionPacketData) Reset()         { *m = DidResolutionPacketData{} }
+func (m *DidResolutionPacketData) String() string { return proto.CompactTextString(m) }
+func (*DidResolutionPacketData) ProtoMessage()    {}
+func (*DidResolutionPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_301db9610fd4563e, []int{1}
+}
+func (m *DidResolutionPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidResolutionPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidResolutionPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DidResolutionPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidResolutionPacketData.Merge(m, src)
+}
+func (m *DidResolutionPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidResolutionPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidResolutionPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidResolutionPacketData proto.InternalMessageInfo
+
+func (m *DidResolutionPacketData) GetDidId() string {
+	if m != nil {
+		return m.DidId
+	}
+	return ""
+}
+
+func (m *DidResolutionPacketData) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *DidResolutionPacketData) GetVersionTime() string {
+	if m != nil {
+		return m.VersionTime
+	}
+	return ""
+}
+
+func (m *DidResolutionPacketData) GetAccept() string {
+	if m != nil {
+		return m.Accept
+	}
+	return ""
+}
+
+// DidResolutionPacketAck is the acknowledgement of DidResolutionPacketData
+type DidResolutionPacketAck struct {
+	DidResolution *QueryResolveDidResponse `protobuf:"bytes,1,opt,name=didResolution,proto3" json:"didResolution,omitempty"`
+	// Block height of hid-node at which the DID was resolved
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *DidResolutionPacketAck) Reset()         { *m = DidResolutionPacketAck{} }
+func (m *DidResolutionPacketAck) String() string { return proto.CompactTextString(m) }
+func (*DidResolutionPacketAck) ProtoMessage()    {}
+func (*DidResolutionPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_301db9610fd4563e, []int{2}
+}
+func (m *DidResolutionPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidResolutionPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidResolutionPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DidResolutionPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidResolutionPacketAck.Merge(m, src)
+}
+func (m *DidResolutionPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidResolutionPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidResolutionPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DidResolutionPacketAck proto.InternalMessageInfo
+
+func (m *DidResolutionPacketAck) GetDidResolution() *QueryResolveDidResponse {
+	if m != nil {
+		return m.DidResolution
+	}
+	return nil
+}
+
+func (m *DidResolutionPacketAck) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// CredentialStatusPacketData requests the status of a Verifiable Credential
+type CredentialStatusPacketData struct {
+	CredId string `protobuf:"bytes,1,opt,name=credId,proto3" json:"credId,omitempty"`
+}
+
+func (m *CredentialStatusPacketData) Reset()         { *m = CredentialStatusPacketData{} }
+func (m *CredentialStatusPacketData) String() string { return proto.CompactTextString(m) }
+func (*CredentialStatusPacketData) ProtoMessage()    {}
+func (*CredentialStatusPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_301db9610fd4563e, []int{3}
+}
+func (m *CredentialStatusPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CredentialStatusPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CredentialStatusPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CredentialStatusPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialStatusPacketData.Merge(m, src)
+}
+func (m *CredentialStatusPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredentialStatusPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialStatusPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialStatusPacketData proto.InternalMessageInfo
+
+func (m *CredentialStatusPacketData) GetCredId() string {
+	if m != nil {
+		return m.CredId
+	}
+	return ""
+}
+
+// CredentialStatusPacketAck is the acknowledgement of CredentialStatusPacketData
+type CredentialStatusPacketAck struct {
+	CredentialStatus *CredentialStatusState `protobuf:"bytes,1,opt,name=credentialStatus,proto3" json:"credentialStatus,omitempty"`
+	// Block height of hid-node at which the Credential Status was queried
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CredentialStatusPacketAck) Reset()         { *m = CredentialStatusPacketAck{} }
+func (m *CredentialStatusPacketAck) String() string { return proto.CompactTextString(m) }
+func (*CredentialStatusPacketAck) ProtoMessage()    {}
+func (*CredentialStatusPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_301db9610fd4563e, []int{4}
+}
+func (m *CredentialStatusPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CredentialStatusPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CredentialStatusPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CredentialStatusPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialStatusPacketAck.Merge(m, src)
+}
+func (m *CredentialStatusPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *CredentialStatusPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialStatusPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialStatusPacketAck proto.InternalMessageInfo
+
+func (m *CredentialStatusPacketAck) GetCredentialStatus() *CredentialStatusState {
+	if m != nil {
+		return m.CredentialStatus
+	}
+	return nil
+}
+
+func (m *CredentialStatusPacketAck) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SsiPacketData)(nil), "hypersign.ssi.v1.SsiPacketData")
+	proto.RegisterType((*DidResolutionPacketData)(nil), "hypersign.ssi.v1.DidResolutionPacketData")
+	proto.RegisterType((*DidResolutionPacketAck)(nil), "hypersign.ssi.v1.DidResolutionPacketAck")
+	proto.RegisterType((*CredentialStatusPacketData)(nil), "hypersign.ssi.v1.CredentialStatusPacketData")
+	proto.RegisterType((*CredentialStatusPacketAck)(nil), "hypersign.ssi.v1.CredentialStatusPacketAck")
+}
+
+func init() { proto.RegisterFile("hypersign/ssi/v1/packet.proto", fileDescriptor_301db9610fd4563e) }
+
+var fileDescriptor_301db9610fd4563e = []byte{
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x41, 0x6f, 0xda, 0x30,
+	0x18, 0x8d, 0xc7, 0x86, 0x86, 0x11, 0x12, 0xf2, 0x26, 0xc6, 0x10, 0x8b, 0x50, 0x2e, 0x63, 0xd2,
+	0x48, 0x04, 0xdb, 0x1f, 0x18, 0xe3, 0x30, 0xa4, 0x49, 0xdb, 0xc2, 0x4e, 0x95, 0xaa, 0x2a, 0xc4,
+	0x2e, 0xb1, 0x80, 0x38, 0x8d, 0x9d, 0xa8, 0x1c, 0x7b, 0x6a, 0x8f, 0xfd, 0x59, 0x3d, 0x72, 0xec,
+	0x11, 0xc1, 0x1f, 0xa9, 0xec, 0x44, 0x50, 0x48, 0xa2, 0x5e, 0x22, 0x7d, 0x7e, 0xdf, 0xf7, 0xde,
+	0xf3, 0xfb, 0x62, 0xf8, 0xc9, 0x5b, 0x05, 0x24, 0xe4, 0x74, 0xe6, 0x5b, 0x9c, 0x53, 0x2b, 0xee,
+	0x5b, 0x81, 0xe3, 0xce, 0x89, 0x30, 0x83, 0x90, 0x09, 0x86, 0xea, 0x7b, 0xd8, 0xe4, 0x9c, 0x9a,
+	0x71, 0xbf, 0xd5, 0xce, 0x0c, 0x5c, 0x45, 0x24, 0x5c, 0x25, 0xfd, 0xad, 0x6e, 0x06, 0x75, 0x43,
+	0x82, 0x89, 0x2f, 0xa8, 0xb3, 0xb8, 0xe0, 0xc2, 0x11, 0x11, 0x4f, 0x3a, 0x8d, 0x0d, 0x80, 0xb5,
+	0x09, 0xa7, 0x7f, 0x95, 0xda, 0xc8, 0x11, 0x0e, 0x3a, 0x87, 0xef, 0x30, 0xc5, 0x36, 0xe1, 0x6c,
+	0x11, 0x09, 0xca, 0xfc, 0x04, 0x6a, 0x82, 0x0e, 0xe8, 0x56, 0x07, 0x5f, 0xcc, 0x53, 0x27, 0xe6,
+	0x28, 0xdb, 0x2c, 0x79, 0x7e, 0x69, 0x76, 0x1e, 0x0f, 0xba, 0x84, 0x8d, 0x83, 0x97, 0x89, 0xb2,
+	0x92, 0x2a, 0xbc, 0x52, 0x0a, 0x5f, 0xb3, 0x0a, 0x3f, 0x73, 0xfb, 0x53, 0x91, 0x02, 0xb6, 0xe1,
+	0x5b, 0x58, 0x4e, 0x22, 0x34, 0x6e, 0x01, 0xfc, 0x50, 0x60, 0x12, 0xbd, 0x87, 0x6f, 0x30, 0xc5,
+	0x63, 0xac, 0xae, 0x57, 0xb1, 0x93, 0x02, 0xb5, 0x61, 0x25, 0x96, 0x16, 0x98, 0x3f, 0xc6, 0xca,
+	0x56, 0xc5, 0x3e, 0x1c, 0xa0, 0x0e, 0xac, 0xa6, 0xc5, 0x7f, 0xba, 0x24, 0xcd, 0x92, 0xc2, 0x9f,
+	0x1f, 0xa1, 0x06, 0x2c, 0x3b, 0xae, 0x4b, 0x02, 0xd1, 0x7c, 0xad, 0xc0, 0xb4, 0x32, 0x6e, 0x00,
+	0x6c, 0xe4, 0x38, 0xf9, 0xe1, 0xce, 0xd1, 0x1f, 0x58, 0x3b, 0x4a, 0xab, 0x38, 0xef, 0x7f, 0x72,
+	0xcf, 0xaa, 0x31, 0x26, 0x09, 0x59, 0xc0, 0x7c, 0x4e, 0xec, 0xe3, 0x79, 0xe9, 0xc1, 0x23, 0x74,
+	0xe6, 0x25, 0xb9, 0x96, 0xec, 0xb4, 0x32, 0xbe, 0xc3, 0x56, 0x71, 0x9e, 0x72, 0x4a, 0xe6, 0xb9,
+	0x0f, 0x24, 0xad, 0x8c, 0x3b, 0x00, 0x3f, 0xe6, 0x8f, 0x49, 0xf3, 0x13, 0x58, 0x3f, 0xdd, 0x42,
+	0xea, 0xff, 0xf3, 0xcb, 0xdb, 0x94, 0x5f, 0x62, 0x67, 0x08, 0x8a, 0x2e, 0x30, 0xfc, 0xfd, 0xb0,
+	0xd5, 0xc1, 0x7a, 0xab, 0x83, 0xcd, 0x56, 0x07, 0xf7, 0x3b, 0x5d, 0x5b, 0xef, 0x74, 0xed, 0x71,
+	0xa7, 0x6b, 0x67, 0x83, 0x19, 0x15, 0x5e, 0x34, 0x35, 0x5d, 0xb6, 0xb4, 0xf6, 0xb2, 0x3d, 0xf5,
+	0x9f, 0xbb, 0x6c, 0x61, 0x79, 0x14, 0xf7, 0x7c, 0x86, 0x89, 0x75, 0xad, 0x1e, 0x85, 0x58, 0x05,
+	0x84, 0x4f, 0xcb, 0x0a, 0xfe, 0xf6, 0x34, 0x00, 0xd5, 0xb1, 0xb5, 0xdf, 0x81, 0x03, 0x00, 0x00,
+}
+
+func (m *SsiPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SsiPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SsiPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SsiPacketData_DidResolutionPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SsiPacketData_DidResolutionPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DidResolutionPacket != nil {
+		{
+			size, err := m.DidResolutionPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SsiPacketData_CredentialStatusPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SsiPacketData_CredentialStatusPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CredentialStatusPacket != nil {
+		{
+			size, err := m.CredentialStatusPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *DidResolutionPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidResolutionPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidResolutionPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accept) > 0 {
+		i -= len(m.Accept)
+		copy(dAtA[i:], m.Accept)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Accept)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VersionTime) > 0 {
+		i -= len(m.VersionTime)
+		copy(dAtA[i:], m.VersionTime)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.VersionTime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidId) > 0 {
+		i -= len(m.DidId)
+		copy(dAtA[i:], m.DidId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.DidId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DidResolutionPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidResolutionPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidResolutionPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DidResolution != nil {
+		{
+			size, err := m.DidResolution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CredentialStatusPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialStatusPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialStatusPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredId) > 0 {
+		i -= len(m.CredId)
+		copy(dAtA[i:], m.CredId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.CredId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CredentialStatusPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CredentialStatusPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CredentialStatusPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CredentialStatus != nil {
+		{
+			size, err := m.CredentialStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SsiPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *SsiPacketData_DidResolutionPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidResolutionPacket != nil {
+		l = m.DidResolutionPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *SsiPacketData_CredentialStatusPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredentialStatusPacket != nil {
+		l = m.CredentialStatusPacket.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *DidResolutionPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DidId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.VersionTime)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Accept)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *DidResolutionPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidResolution != nil {
+		l = m.DidResolution.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *CredentialStatusPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CredId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *CredentialStatusPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredentialStatus != nil {
+		l = m.CredentialStatus.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SsiPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SsiPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SsiPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidResolutionPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DidResolutionPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &SsiPacketData_DidResolutionPacket{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CredentialStatusPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &SsiPacketData_CredentialStatusPacket{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DidResolutionPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidResolutionPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidResolutionPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accept", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accept = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DidResolutionPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidResolutionPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidResolutionPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidResolution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidResolution == nil {
+				m.DidResolution = &QueryResolveDidResponse{}
+			}
+			if err := m.DidResolution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CredentialStatusPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredentialStatusPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredentialStatusPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CredentialStatusPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CredentialStatusPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CredentialStatusPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialStatus == nil {
+				m.CredentialStatus = &CredentialStatusState{}
+			}
+			if err := m.CredentialStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,33 +2,121 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var (
-	DefaultRegisterDIDFee              = sdk.NewInt64Coin("uhid", 4000)
-	DefaultUpdateDIDFee                = sdk.NewInt64Coin("uhid", 1000)
-	DefaultDeactivateDIDFee            = sdk.NewInt64Coin("uhid", 1000)
-	DefaultRegisterCredentialSchemaFee = sdk.NewInt64Coin("uhid", 2000)
-	DefaultUpdateCredentialSchemaFee   = sdk.NewInt64Coin("uhid", 2000)
-	DefaultRegisterCredentialStatusFee = sdk.NewInt64Coin("uhid", 2000)
-	DefaultUpdateCredentialStatusFee   = sdk.NewInt64Coin("uhid", 2000)
+	DefaultRegisterDIDFee                       = sdk.NewInt64Coin("uhid", 4000)
+	DefaultUpdateDIDFee                         = sdk.NewInt64Coin("uhid", 1000)
+	DefaultDeactivateDIDFee                     = sdk.NewInt64Coin("uhid", 1000)
+	DefaultRegisterCredentialSchemaFee          = sdk.NewInt64Coin("uhid", 2000)
+	DefaultUpdateCredentialSchemaFee            = sdk.NewInt64Coin("uhid", 2000)
+	DefaultRegisterCredentialStatusFee          = sdk.NewInt64Coin("uhid", 2000)
+	DefaultUpdateCredentialStatusFee            = sdk.NewInt64Coin("uhid", 2000)
+	DefaultRegisterCredentialStatusBatchItemFee = sdk.NewInt64Coin("uhid", 200)
 )
 
+// DefaultMaxCredentialStatusBatchSize is the default maximum number of Credential Statuses
+// which can be registered in a single MsgRegisterCredentialStatusBatch
+const DefaultMaxCredentialStatusBatchSize uint32 = 1000
+
+// DefaultDidRecoveryDelay is the default delay after which an initiated DID recovery takes effect
+const DefaultDidRecoveryDelay = 7 * 24 * time.Hour
+
 func DefaultParams() *Params {
 	return &Params{
-		RegisterDidFee:              &DefaultRegisterDIDFee,
-		UpdateDidFee:                &DefaultUpdateDIDFee,
-		DeactivateDidFee:            &DefaultDeactivateDIDFee,
-		RegisterCredentialSchemaFee: &DefaultRegisterCredentialSchemaFee,
-		UpdateCredentialSchemaFee:   &DefaultUpdateCredentialSchemaFee,
-		RegisterCredentialStatusFee: &DefaultRegisterCredentialStatusFee,
-		UpdateCredentialStatusFee:   &DefaultUpdateCredentialStatusFee,
+		RegisterDidFee:                       &DefaultRegisterDIDFee,
+		UpdateDidFee:                         &DefaultUpdateDIDFee,
+		DeactivateDidFee:                     &DefaultDeactivateDIDFee,
+		RegisterCredentialSchemaFee:          &DefaultRegisterCredentialSchemaFee,
+		UpdateCredentialSchemaFee:            &DefaultUpdateCredentialSchemaFee,
+		RegisterCredentialStatusFee:          &DefaultRegisterCredentialStatusFee,
+		UpdateCredentialStatusFee:            &DefaultUpdateCredentialStatusFee,
+		RegisterCredentialStatusBatchItemFee: &DefaultRegisterCredentialStatusBatchItemFee,
+		MaxCredentialStatusBatchSize:         DefaultMaxCredentialStatusBatchSize,
+		DidRecoveryDelay:                     DefaultDidRecoveryDelay,
 	}
 }
 
+// Validate validates the set of params
+func (p Params) Validate() error {
+	for name, fee := range map[string]*sdk.Coin{
+		"register_did_fee":                          p.RegisterDidFee,
+		"update_did_fee":                            p.UpdateDidFee,
+		"deactivate_did_fee":                        p.DeactivateDidFee,
+		"register_credential_schema_fee":            p.RegisterCredentialSchemaFee,
+		"update_credential_schema_fee":              p.UpdateCredentialSchemaFee,
+		"register_credential_status_fee":            p.RegisterCredentialStatusFee,
+		"update_credential_status_fee":              p.UpdateCredentialStatusFee,
+		"register_credential_status_batch_item_fee": p.RegisterCredentialStatusBatchItemFee,
+	} {
+		if fee == nil {
+			return fmt.Errorf("%v cannot be empty", name)
+		}
+		if err := validateFeeParams(*fee); err != nil {
+			return fmt.Errorf("%v: %v", name, err)
+		}
+	}
+
+	if p.MaxCredentialStatusBatchSize == 0 {
+		return fmt.Errorf("max_credential_status_batch_size must be positive")
+	}
+
+	if p.DidRecoveryDelay <= 0 {
+		return fmt.Errorf("did_recovery_delay must be positive")
+	}
+
+	return nil
+}
+
+// GetFixedFee returns the fixed fee of the input SSI message, or nil if the message does not incur one
+func (p Params) GetFixedFee(msg sdk.Msg) *sdk.Coin {
+	switch msg := msg.(type) {
+	case *MsgRegisterDID:
+		return p.RegisterDidFee
+	case *MsgUpdateDID:
+		return p.UpdateDidFee
+	case *MsgDeactivateDID:
+		return p.DeactivateDidFee
+	// Initiating and cancelling a DID recovery, and marking a Verification Method
+	// as compromised are charged the same as a DID update
+	case *MsgInitiateDidRecovery, *MsgCancelDidRecovery, *MsgMarkVerificationMethodCompromised:
+		return p.UpdateDidFee
+	case *MsgRegisterCredentialSchema:
+		return p.RegisterCredentialSchemaFee
+	// Changing the status of a Credential Schema is charged the same as a Credential Schema update
+	case *MsgUpdateCredentialSchema, *MsgUpdateCredentialSchemaStatus:
+		return p.UpdateCredentialSchemaFee
+	case *MsgRegisterCredentialStatus:
+		return p.RegisterCredentialStatusFee
+	case *MsgUpdateCredentialStatus:
+		return p.UpdateCredentialStatusFee
+	// A Credential Status Batch is charged for every Credential Status it carries
+	case *MsgRegisterCredentialStatusBatch:
+		if p.RegisterCredentialStatusBatchItemFee == nil {
+			return nil
+		}
+		batchSize := len(msg.GetCredentialStatusBatchDocument().GetCredentialStatuses())
+		batchFee := sdk.NewCoin(p.RegisterCredentialStatusBatchItemFee.Denom, p.RegisterCredentialStatusBatchItemFee.Amount.MulRaw(int64(batchSize)))
+		return &batchFee
+	// A Credential Status List carries the statuses of many Credentials, and is charged
+	// the same as a single Credential Status
+	case *MsgRegisterCredentialStatusList:
+		return p.RegisterCredentialStatusFee
+	case *MsgUpdateCredentialStatusList:
+		return p.UpdateCredentialStatusFee
+	// Registering an accreditation is charged the same as a Credential Schema registration
+	case *MsgRegisterAccreditation:
+		return p.RegisterCredentialSchemaFee
+	}
+	return nil
+}
+
+// ParamKeyTable returns the key table of legacy x/params subspace of x/ssi module. It is only
+// used to migrate the params to module store.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable(
 		paramtypes.NewParamSetPair(ParamStoreKeyRegisterDidFee, sdk.Coin{}, validateFeeParams),
@@ -52,5 +140,9 @@ func validateFeeParams(i interface{}) error {
 		return fmt.Errorf("fee param denom must be 'uhid', got %v", v.Denom)
 	}
 
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("fee param amount must not be negative, got %v", v.Amount)
+	}
+
 	return nil
 }
@@ -24,6 +24,20 @@ var validProofPurposes []string = []string{
 	capabilityDelegation,
 }
 
+// Checks performed while verifying the proof of a SSI Document through the VerifyDocumentProof query,
+// in the order they are performed. The credentialStatusBatch check is performed only for the Credential
+// Statuses registered through a batch.
+const (
+	ProofCheckDocumentProof                = "documentProof"
+	ProofCheckContext                      = "context"
+	ProofCheckCredentialStatusBatch        = "credentialStatusBatch"
+	ProofCheckVerificationMethod           = "verificationMethod"
+	ProofCheckVerificationMethodCompromise = "verificationMethodCompromise"
+	ProofCheckProofType                    = "proofType"
+	ProofCheckCanonicalization             = "canonicalization"
+	ProofCheckSignature                    = "signature"
+)
+
 // Validate Document Proof
 func (proof *DocumentProof) Validate() error {
 	// Validate Proof Type
@@ -6,8 +6,11 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -30,6 +33,86 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 type QuerySSIFeeRequest struct {
 }
 
@@ -37,7 +120,7 @@ func (m *QuerySSIFeeRequest) Reset()         { *m = QuerySSIFeeRequest{} }
 func (m *QuerySSIFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySSIFeeRequest) ProtoMessage()    {}
 func (*QuerySSIFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_faf2a72d2769ce79, []int{0}
+	return fileDescriptor_faf2a72d2769ce79, []int{2}
 }
 func (m *QuerySSIFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -67,20 +150,21 @@ func (m *QuerySSIFeeRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_QuerySSIFeeRequest proto.InternalMessageInfo
 
 type QuerySSIFeeResponse struct {
-	RegisterDidFee              *types.Coin `protobuf:"bytes,1,opt,name=register_did_fee,json=registerDidFee,proto3" json:"register_did_fee,omitempty"`
-	UpdateDidFee                *types.Coin `protobuf:"bytes,2,opt,name=update_did_fee,json=updateDidFee,proto3" json:"update_did_fee,omitempty"`
-	DeactivateDidFee            *types.Coin `protobuf:"bytes,3,opt,name=deactivate_did_fee,json=deactivateDidFee,proto3" json:"deactivate_did_fee,omitempty"`
-	RegisterCredentialSchemaFee *types.Coin `protobuf:"bytes,4,opt,name=register_credential_schema_fee,json=registerCredentialSchemaFee,proto3" json:"register_credential_schema_fee,omitempty"`
-	UpdateCredentialSchemaFee   *types.Coin `protobuf:"bytes,5,opt,name=update_credential_schema_fee,json=updateCredentialSchemaFee,proto3" json:"update_credential_schema_fee,omitempty"`
-	RegisterCredentialStatusFee *types.Coin `protobuf:"bytes,6,opt,name=register_credential_status_fee,json=registerCredentialStatusFee,proto3" json:"register_credential_status_fee,omitempty"`
-	UpdateCredentialStatusFee   *types.Coin `protobuf:"bytes,7,opt,name=update_credential_status_fee,json=updateCredentialStatusFee,proto3" json:"update_credential_status_fee,omitempty"`
+	RegisterDidFee                       *types.Coin `protobuf:"bytes,1,opt,name=register_did_fee,json=registerDidFee,proto3" json:"register_did_fee,omitempty"`
+	UpdateDidFee                         *types.Coin `protobuf:"bytes,2,opt,name=update_did_fee,json=updateDidFee,proto3" json:"update_did_fee,omitempty"`
+	DeactivateDidFee                     *types.Coin `protobuf:"bytes,3,opt,name=deactivate_did_fee,json=deactivateDidFee,proto3" json:"deactivate_did_fee,omitempty"`
+	RegisterCredentialSchemaFee          *types.Coin `protobuf:"bytes,4,opt,name=register_credential_schema_fee,json=registerCredentialSchemaFee,proto3" json:"register_credential_schema_fee,omitempty"`
+	UpdateCredentialSchemaFee            *types.Coin `protobuf:"bytes,5,opt,name=update_credential_schema_fee,json=updateCredentialSchemaFee,proto3" json:"update_credential_schema_fee,omitempty"`
+	RegisterCredentialStatusFee          *types.Coin `protobuf:"bytes,6,opt,name=register_credential_status_fee,json=registerCredentialStatusFee,proto3" json:"register_credential_status_fee,omitempty"`
+	UpdateCredentialStatusFee            *types.Coin `protobuf:"bytes,7,opt,name=update_credential_status_fee,json=updateCredentialStatusFee,proto3" json:"update_credential_status_fee,omitempty"`
+	RegisterCredentialStatusBatchItemFee *types.Coin `protobuf:"bytes,8,opt,name=register_credential_status_batch_item_fee,json=registerCredentialStatusBatchItemFee,proto3" json:"register_credential_status_batch_item_fee,omitempty"`
 }
 
 func (m *QuerySSIFeeResponse) Reset()         { *m = QuerySSIFeeResponse{} }
 func (m *QuerySSIFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySSIFeeResponse) ProtoMessage()    {}
 func (*QuerySSIFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_faf2a72d2769ce79, []int{1}
+	return fileDescriptor_faf2a72d2769ce79, []int{3}
 }
 func (m *QuerySSIFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,15 +242,29 @@ func (m *QuerySSIFeeResponse) GetUpdateCredentialStatusFee() *types.Coin {
 	return nil
 }
 
+func (m *QuerySSIFeeResponse) GetRegisterCredentialStatusBatchItemFee() *types.Coin {
+	if m != nil {
+		return m.RegisterCredentialStatusBatchItemFee
+	}
+	return nil
+}
+
+// QueryCredentialSchemaRequest queries a Credential Schema by its id. If schemaId carries a version number, only
+// that version is returned. If schemaId is a base id, every version is returned unless either `version` or
+// `latest` is specified.
 type QueryCredentialSchemaRequest struct {
 	SchemaId string `protobuf:"bytes,1,opt,name=schemaId,proto3" json:"schemaId,omitempty"`
+	// Version number of the schema to be queried, if schemaId is a base id
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Query only the highest version of the schema, if schemaId is a base id
+	Latest bool `protobuf:"varint,3,opt,name=latest,proto3" json:"latest,omitempty"`
 }
 
 func (m *QueryCredentialSchemaRequest) Reset()         { *m = QueryCredentialSchemaRequest{} }
 func (m *QueryCredentialSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCredentialSchemaRequest) ProtoMessage()    {}
 func (*QueryCredentialSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_faf2a72d2769ce79, []int{2}
+	return fileDescriptor_faf2a72d2769ce79, []int{4}
 }
 func (m *QueryCredentialSchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,6 +300,20 @@ func (m *QueryCredentialSchemaRequest) GetSchemaId() string {
 	return ""
 }
 
+func (m *QueryCredentialSchemaRequest) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *QueryCredentialSchemaRequest) GetLatest() bool {
+	if m != nil {
+		return m.Latest
+	}
+	return false
+}
+
 type QueryCredentialSchemaResponse struct {
 	CredentialSchemas []*CredentialSchemaState `protobuf:"bytes,1,rep,name=credentialSchemas,proto3" json:"credentialSchemas,omitempty"`
 }
@@ -210,7 +322,7 @@ func (m *QueryCredentialSchemaResponse) Reset()         { *m = QueryCredentialSc
 func (m *QueryCredentialSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCredentialSchemaResponse) ProtoMessage()    {}
 func (*QueryCredentialSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_faf2a72d2769ce79, []int{3}
+	return fileDescriptor_faf2a72d2769ce79, []int{5}
 }
 func (m *QueryCredentialSchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,7 +366,7 @@ func (m *QueryCredentialSchemasRequest) Reset()         { *m = QueryCredentialSc
 func (m *QueryCredentialSchemasRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCredentialSchemasRequest) ProtoMessage()    {}
 func (*QueryCredentialSchemasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_faf2a72d2769ce79, []int{4}
+	return fileDescriptor_faf2a72d2769ce79, []int{6}
 }
 func (m *QueryCredentialSchemasRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,7 +411,7 @@ func (m *QueryCredentialSchemasResponse) Reset()         { *m = QueryCredentialS
 func (m *QueryCredentialSchemasResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCredentialSchemasResponse) ProtoMessage()    {}
 func (*QueryCredentialSchemasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_faf2a72d2769ce79, []int{5}
+	return fileDescriptor_faf2a72d2769ce79, []int{7}
 }
 func (m *QueryCredentialSchemasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +462,7 @@ func (m *QueryCredentialStatusRequest) Reset()         { *m = QueryCredentialSta
 func (m *QueryCredentialStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCredentialStatusRequest) ProtoMessage()    {}
 func (*QueryCredentialStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_faf2a72d2769ce79, []int{6}
+	return fileDescriptor_faf2a72d2769ce79, []int{8}
 }
 func (m *QueryCredentialStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +506,7 @@ func (m *QueryCredentialStatusResponse) Reset()         { *m = QueryCredentialSt
 func (m *QueryCredentialStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCredentialStatusResponse) ProtoMessage()    {}
 func (*QueryCredentialStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_faf2a72d2769ce79, []int{7}
+	return fileDescriptor_faf2a72d2769ce79, []int{9}
 }
 func (m *QueryCredentialStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,7 +550,7 @@ func (m *QueryCredentialStatusesRequest) Reset()         { *m = QueryCredentialS
 func (m *QueryCredentialStatusesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCredentialStatusesRequest) ProtoMessage()    {}
 func (*QueryCredentialStatusesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_faf2a72d2769ce79, []int{8}
+	return fileDescriptor_faf2a72d2769ce79, []int{10}
 }
 func (m *QueryCredentialStatusesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -483,7 +595,7 @@ func (m *QueryCredentialStatusesResponse) Reset()         { *m = QueryCredential
 func (m *QueryCredentialStatusesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCredentialStatusesResponse) ProtoMessage()    {}
 func (*QueryCredentialStatusesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_faf2a72d2769ce79, []int{9}
+	return fileDescriptor_faf2a72d2769ce79, []int{11}
 }
 func (m *QueryCredentialStatusesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,22 +638,22 @@ func (m *QueryCredentialStatusesResponse) GetCredentialStatuses() []*CredentialS
 	return nil
 }
 
-type QueryDidDocumentRequest struct {
-	DidId string `protobuf:"bytes,1,opt,name=didId,proto3" json:"didId,omitempty"`
+type QueryCredentialStatusBatchRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryDidDocumentRequest) Reset()         { *m = QueryDidDocumentRequest{} }
-func (m *QueryDidDocumentRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDidDocumentRequest) ProtoMessage()    {}
-func (*QueryDidDocumentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_faf2a72d2769ce79, []int{10}
+func (m *QueryCredentialStatusBatchRequest) Reset()         { *m = QueryCredentialStatusBatchRequest{} }
+func (m *QueryCredentialStatusBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCredentialStatusBatchRequest) ProtoMessage()    {}
+func (*QueryCredentialStatusBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{12}
 }
-func (m *QueryDidDocumentRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCredentialStatusBatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDidDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCredentialStatusBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDidDocumentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCredentialStatusBatchRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -551,42 +663,41 @@ func (m *QueryDidDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryDidDocumentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDidDocumentRequest.Merge(m, src)
+func (m *QueryCredentialStatusBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCredentialStatusBatchRequest.Merge(m, src)
 }
-func (m *QueryDidDocumentRequest) XXX_Size() int {
+func (m *QueryCredentialStatusBatchRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDidDocumentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDidDocumentRequest.DiscardUnknown(m)
+func (m *QueryCredentialStatusBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCredentialStatusBatchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDidDocumentRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCredentialStatusBatchRequest proto.InternalMessageInfo
 
-func (m *QueryDidDocumentRequest) GetDidId() string {
+func (m *QueryCredentialStatusBatchRequest) GetId() string {
 	if m != nil {
-		return m.DidId
+		return m.Id
 	}
 	return ""
 }
 
-type QueryDidDocumentResponse struct {
-	DidDocument         *DidDocument         `protobuf:"bytes,1,opt,name=didDocument,proto3" json:"didDocument,omitempty"`
-	DidDocumentMetadata *DidDocumentMetadata `protobuf:"bytes,2,opt,name=didDocumentMetadata,proto3" json:"didDocumentMetadata,omitempty"`
+type QueryCredentialStatusBatchResponse struct {
+	CredentialStatusBatch *CredentialStatusBatchState `protobuf:"bytes,1,opt,name=credentialStatusBatch,proto3" json:"credentialStatusBatch,omitempty"`
 }
 
-func (m *QueryDidDocumentResponse) Reset()         { *m = QueryDidDocumentResponse{} }
-func (m *QueryDidDocumentResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDidDocumentResponse) ProtoMessage()    {}
-func (*QueryDidDocumentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_faf2a72d2769ce79, []int{11}
+func (m *QueryCredentialStatusBatchResponse) Reset()         { *m = QueryCredentialStatusBatchResponse{} }
+func (m *QueryCredentialStatusBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCredentialStatusBatchResponse) ProtoMessage()    {}
+func (*QueryCredentialStatusBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{13}
 }
-func (m *QueryDidDocumentResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCredentialStatusBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDidDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCredentialStatusBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDidDocumentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCredentialStatusBatchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -596,48 +707,41 @@ func (m *QueryDidDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryDidDocumentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDidDocumentResponse.Merge(m, src)
+func (m *QueryCredentialStatusBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCredentialStatusBatchResponse.Merge(m, src)
 }
-func (m *QueryDidDocumentResponse) XXX_Size() int {
+func (m *QueryCredentialStatusBatchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDidDocumentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDidDocumentResponse.DiscardUnknown(m)
+func (m *QueryCredentialStatusBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCredentialStatusBatchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDidDocumentResponse proto.InternalMessageInfo
-
-func (m *QueryDidDocumentResponse) GetDidDocument() *DidDocument {
-	if m != nil {
-		return m.DidDocument
-	}
-	return nil
-}
+var xxx_messageInfo_QueryCredentialStatusBatchResponse proto.InternalMessageInfo
 
-func (m *QueryDidDocumentResponse) GetDidDocumentMetadata() *DidDocumentMetadata {
+func (m *QueryCredentialStatusBatchResponse) GetCredentialStatusBatch() *CredentialStatusBatchState {
 	if m != nil {
-		return m.DidDocumentMetadata
+		return m.CredentialStatusBatch
 	}
 	return nil
 }
 
-type QueryDidDocumentsRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryCredentialStatusListRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryDidDocumentsRequest) Reset()         { *m = QueryDidDocumentsRequest{} }
-func (m *QueryDidDocumentsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDidDocumentsRequest) ProtoMessage()    {}
-func (*QueryDidDocumentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_faf2a72d2769ce79, []int{12}
+func (m *QueryCredentialStatusListRequest) Reset()         { *m = QueryCredentialStatusListRequest{} }
+func (m *QueryCredentialStatusListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCredentialStatusListRequest) ProtoMessage()    {}
+func (*QueryCredentialStatusListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{14}
 }
-func (m *QueryDidDocumentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCredentialStatusListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDidDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCredentialStatusListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDidDocumentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCredentialStatusListRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -647,42 +751,45 @@ func (m *QueryDidDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryDidDocumentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDidDocumentsRequest.Merge(m, src)
+func (m *QueryCredentialStatusListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCredentialStatusListRequest.Merge(m, src)
 }
-func (m *QueryDidDocumentsRequest) XXX_Size() int {
+func (m *QueryCredentialStatusListRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDidDocumentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDidDocumentsRequest.DiscardUnknown(m)
+func (m *QueryCredentialStatusListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCredentialStatusListRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDidDocumentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCredentialStatusListRequest proto.InternalMessageInfo
 
-func (m *QueryDidDocumentsRequest) GetPagination() *query.PageRequest {
+func (m *QueryCredentialStatusListRequest) GetId() string {
 	if m != nil {
-		return m.Pagination
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-type QueryDidDocumentsResponse struct {
-	Count        uint64              `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	DidDocuments []*DidDocumentState `protobuf:"bytes,2,rep,name=didDocuments,proto3" json:"didDocuments,omitempty"`
+// QueryCredentialStatusListResponse has the W3C representation of Credential Status List, and the
+// Credential Status List Document registered by the issuer along with its proof, which verifies
+// against the document.
+type QueryCredentialStatusListResponse struct {
+	BitstringStatusListCredential *BitstringStatusListCredential `protobuf:"bytes,1,opt,name=bitstringStatusListCredential,proto3" json:"bitstringStatusListCredential,omitempty"`
+	CredentialStatusList          *CredentialStatusListState     `protobuf:"bytes,2,opt,name=credentialStatusList,proto3" json:"credentialStatusList,omitempty"`
 }
 
-func (m *QueryDidDocumentsResponse) Reset()         { *m = QueryDidDocumentsResponse{} }
-func (m *QueryDidDocumentsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDidDocumentsResponse) ProtoMessage()    {}
-func (*QueryDidDocumentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_faf2a72d2769ce79, []int{13}
+func (m *QueryCredentialStatusListResponse) Reset()         { *m = QueryCredentialStatusListResponse{} }
+func (m *QueryCredentialStatusListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCredentialStatusListResponse) ProtoMessage()    {}
+func (*QueryCredentialStatusListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{15}
 }
-func (m *QueryDidDocumentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCredentialStatusListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDidDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCredentialStatusListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDidDocumentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCredentialStatusListResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -692,1235 +799,10266 @@ func (m *QueryDidDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryDidDocumentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDidDocumentsResponse.Merge(m, src)
+func (m *QueryCredentialStatusListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCredentialStatusListResponse.Merge(m, src)
 }
-func (m *QueryDidDocumentsResponse) XXX_Size() int {
+func (m *QueryCredentialStatusListResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDidDocumentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDidDocumentsResponse.DiscardUnknown(m)
+func (m *QueryCredentialStatusListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCredentialStatusListResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDidDocumentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCredentialStatusListResponse proto.InternalMessageInfo
 
-func (m *QueryDidDocumentsResponse) GetCount() uint64 {
+func (m *QueryCredentialStatusListResponse) GetBitstringStatusListCredential() *BitstringStatusListCredential {
 	if m != nil {
-		return m.Count
+		return m.BitstringStatusListCredential
 	}
-	return 0
+	return nil
 }
 
-func (m *QueryDidDocumentsResponse) GetDidDocuments() []*DidDocumentState {
+func (m *QueryCredentialStatusListResponse) GetCredentialStatusList() *CredentialStatusListState {
 	if m != nil {
-		return m.DidDocuments
+		return m.CredentialStatusList
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QuerySSIFeeRequest)(nil), "hypersign.ssi.v1.QuerySSIFeeRequest")
-	proto.RegisterType((*QuerySSIFeeResponse)(nil), "hypersign.ssi.v1.QuerySSIFeeResponse")
-	proto.RegisterType((*QueryCredentialSchemaRequest)(nil), "hypersign.ssi.v1.QueryCredentialSchemaRequest")
-	proto.RegisterType((*QueryCredentialSchemaResponse)(nil), "hypersign.ssi.v1.QueryCredentialSchemaResponse")
-	proto.RegisterType((*QueryCredentialSchemasRequest)(nil), "hypersign.ssi.v1.QueryCredentialSchemasRequest")
-	proto.RegisterType((*QueryCredentialSchemasResponse)(nil), "hypersign.ssi.v1.QueryCredentialSchemasResponse")
-	proto.RegisterType((*QueryCredentialStatusRequest)(nil), "hypersign.ssi.v1.QueryCredentialStatusRequest")
-	proto.RegisterType((*QueryCredentialStatusResponse)(nil), "hypersign.ssi.v1.QueryCredentialStatusResponse")
-	proto.RegisterType((*QueryCredentialStatusesRequest)(nil), "hypersign.ssi.v1.QueryCredentialStatusesRequest")
-	proto.RegisterType((*QueryCredentialStatusesResponse)(nil), "hypersign.ssi.v1.QueryCredentialStatusesResponse")
-	proto.RegisterType((*QueryDidDocumentRequest)(nil), "hypersign.ssi.v1.QueryDidDocumentRequest")
-	proto.RegisterType((*QueryDidDocumentResponse)(nil), "hypersign.ssi.v1.QueryDidDocumentResponse")
-	proto.RegisterType((*QueryDidDocumentsRequest)(nil), "hypersign.ssi.v1.QueryDidDocumentsRequest")
-	proto.RegisterType((*QueryDidDocumentsResponse)(nil), "hypersign.ssi.v1.QueryDidDocumentsResponse")
+type QueryAccreditationRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("hypersign/ssi/v1/query.proto", fileDescriptor_faf2a72d2769ce79) }
-
-var fileDescriptor_faf2a72d2769ce79 = []byte{
-	// 944 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xeb, 0x6e, 0x5b, 0xd8, 0xd7, 0x6a, 0xe9, 0xce, 0x56, 0xd0, 0x9a, 0xae, 0x59, 0x19,
-	0xba, 0x84, 0x94, 0xda, 0x4d, 0x22, 0x90, 0x80, 0xc3, 0x4a, 0xdb, 0x28, 0xa8, 0x12, 0x48, 0x90,
-	0x08, 0xad, 0xb4, 0x07, 0x2a, 0xc7, 0x33, 0x75, 0x46, 0x6a, 0x3c, 0xd9, 0xcc, 0x24, 0xda, 0xa8,
-	0xea, 0x85, 0x33, 0x48, 0x2b, 0xe0, 0xca, 0x95, 0x1b, 0x17, 0xb4, 0x1f, 0x82, 0xe3, 0x4a, 0x5c,
-	0x38, 0x70, 0x40, 0x2d, 0x1f, 0x04, 0x65, 0x3c, 0x76, 0x9c, 0x4c, 0x1c, 0x7b, 0xd9, 0x1e, 0xed,
-	0x79, 0xff, 0xff, 0xfb, 0xbd, 0x79, 0x33, 0xcf, 0x86, 0xdd, 0xce, 0xa8, 0x47, 0xfa, 0x9c, 0x06,
-	0xa1, 0xcb, 0x39, 0x75, 0x87, 0x15, 0xf7, 0xc9, 0x80, 0xf4, 0x47, 0x4e, 0xaf, 0xcf, 0x04, 0x43,
-	0x9b, 0xc9, 0xaa, 0xc3, 0x39, 0x75, 0x86, 0x15, 0x73, 0x37, 0x60, 0x2c, 0x38, 0x23, 0xae, 0xd7,
-	0xa3, 0xae, 0x17, 0x86, 0x4c, 0x78, 0x82, 0xb2, 0x90, 0x47, 0xf1, 0x66, 0xd9, 0x67, 0xbc, 0xcb,
-	0xb8, 0xdb, 0xf6, 0x38, 0x89, 0x8c, 0xdc, 0x61, 0xa5, 0x4d, 0x84, 0x57, 0x71, 0x7b, 0x5e, 0x40,
-	0x43, 0x19, 0xac, 0x62, 0x4b, 0x5a, 0x66, 0xbf, 0x4f, 0x30, 0x09, 0x05, 0xf5, 0xce, 0x4e, 0xb8,
-	0xdf, 0x21, 0x5d, 0x4f, 0x45, 0x9a, 0x5a, 0x24, 0xa6, 0x58, 0xad, 0x59, 0xe9, 0x8c, 0x71, 0x2e,
-	0x9f, 0xd1, 0x62, 0x59, 0x84, 0x27, 0x06, 0x8a, 0xdd, 0xde, 0x02, 0xf4, 0xf5, 0x98, 0xb8, 0xd5,
-	0x3a, 0x6e, 0x10, 0xd2, 0x24, 0x4f, 0x06, 0x84, 0x0b, 0xfb, 0xef, 0x15, 0xb8, 0x33, 0xf5, 0x9a,
-	0xf7, 0x58, 0xc8, 0x09, 0x3a, 0x82, 0xcd, 0x3e, 0x09, 0x28, 0x17, 0xa4, 0x7f, 0x82, 0x29, 0x3e,
-	0x39, 0x25, 0x64, 0xdb, 0xb8, 0x67, 0x94, 0xd6, 0xab, 0x3b, 0x4e, 0x84, 0xe4, 0x8c, 0x91, 0x1c,
-	0x85, 0xe4, 0x1c, 0x31, 0x1a, 0x36, 0x6f, 0xc5, 0x92, 0x3a, 0xc5, 0x0d, 0x42, 0xd0, 0x03, 0xb8,
-	0x35, 0xe8, 0x61, 0x4f, 0x90, 0xc4, 0x62, 0x39, 0xcf, 0x62, 0x23, 0x12, 0x28, 0x83, 0xcf, 0x01,
-	0x61, 0xe2, 0xf9, 0x82, 0x0e, 0xd3, 0x26, 0x37, 0xf2, 0x4c, 0x36, 0x27, 0x22, 0x65, 0xf4, 0x2d,
-	0x58, 0x49, 0x39, 0x5a, 0x1b, 0xa4, 0xe9, 0x4a, 0x9e, 0xe9, 0xdb, 0xb1, 0xc1, 0x51, 0xa2, 0x6f,
-	0x49, 0xf9, 0xd8, 0xff, 0x31, 0xec, 0xaa, 0x4a, 0xe7, 0xbb, 0xaf, 0xe6, 0xb9, 0xef, 0x44, 0xf2,
-	0x79, 0xde, 0x59, 0xec, 0xb2, 0xb9, 0xd2, 0x7d, 0xed, 0xff, 0xb0, 0x4b, 0x79, 0x36, 0xfb, 0xc4,
-	0xfd, 0xb5, 0x97, 0x67, 0x8f, 0xbd, 0xed, 0x4f, 0x61, 0x57, 0x9e, 0xae, 0xd9, 0xba, 0xd4, 0xf1,
-	0x43, 0x26, 0xbc, 0x1e, 0xed, 0xd2, 0x31, 0x96, 0xc7, 0xeb, 0x66, 0x33, 0x79, 0xb6, 0x87, 0x70,
-	0x37, 0x43, 0xab, 0xce, 0xe8, 0x37, 0x70, 0xdb, 0x9f, 0x59, 0xe3, 0xdb, 0xc6, 0xbd, 0x1b, 0xa5,
-	0xf5, 0xea, 0xfb, 0xce, 0xec, 0xcd, 0x76, 0x66, 0x6d, 0xc6, 0x90, 0xa4, 0xa9, 0x3b, 0xd8, 0x41,
-	0x46, 0x5e, 0x1e, 0x43, 0x37, 0x00, 0x26, 0xb7, 0x5d, 0xdd, 0x8a, 0xfb, 0x53, 0xdb, 0x13, 0xcd,
-	0x98, 0x78, 0x93, 0xbe, 0xf2, 0x82, 0xf8, 0xbe, 0x35, 0x53, 0x4a, 0xfb, 0x07, 0x03, 0xac, 0xac,
-	0x4c, 0xaa, 0xc4, 0x2d, 0x58, 0xf5, 0xd9, 0x20, 0x14, 0x32, 0xcb, 0x4a, 0x33, 0x7a, 0x98, 0x5f,
-	0xf8, 0xf2, 0x2b, 0x17, 0xfe, 0xb1, 0xde, 0x2c, 0xd9, 0xc8, 0xb8, 0xee, 0x37, 0x61, 0x6d, 0x2c,
-	0x4a, 0x5a, 0xa5, 0x9e, 0x6c, 0xa1, 0x6f, 0x98, 0xd2, 0xa9, 0x2a, 0x5a, 0xb0, 0xe9, 0xcf, 0xac,
-	0xa9, 0x6d, 0x5b, 0x8c, 0x2b, 0x23, 0x23, 0x5c, 0xcd, 0xc0, 0xee, 0xe8, 0x9b, 0x27, 0x17, 0xc8,
-	0xb5, 0xf7, 0xe9, 0x99, 0x01, 0xef, 0x64, 0xa6, 0x5a, 0xd8, 0xa8, 0x47, 0x80, 0x7c, 0x4d, 0x53,
-	0xa8, 0x53, 0xa9, 0xd2, 0xe7, 0x58, 0xd8, 0x2e, 0xbc, 0x25, 0x89, 0xea, 0x14, 0xd7, 0x99, 0x3f,
-	0xe8, 0x92, 0x50, 0xc4, 0x55, 0x6f, 0xc1, 0x2a, 0xa6, 0x93, 0x26, 0x45, 0x0f, 0xf6, 0x73, 0x03,
-	0xb6, 0x75, 0x85, 0x82, 0x7f, 0x00, 0xeb, 0x78, 0xf2, 0x5a, 0xed, 0xd4, 0x5d, 0x9d, 0x2f, 0xad,
-	0x4d, 0x2b, 0xd0, 0x23, 0xb8, 0x93, 0x7a, 0xfc, 0x92, 0x08, 0x0f, 0x7b, 0xc2, 0x53, 0xd3, 0x7e,
-	0x6f, 0xa1, 0x51, 0x1c, 0xdc, 0x9c, 0xe7, 0x60, 0xb7, 0x75, 0xea, 0x6b, 0x6f, 0xef, 0x08, 0x76,
-	0xe6, 0xe4, 0x58, 0xd8, 0xd7, 0x06, 0x6c, 0xa4, 0x68, 0xe3, 0x8e, 0xda, 0x0b, 0x0b, 0x8d, 0x9a,
-	0x39, 0xa5, 0xab, 0xfe, 0x78, 0x13, 0x56, 0x65, 0x6e, 0xf4, 0xbb, 0x01, 0x5b, 0xb3, 0x17, 0xf5,
-	0xe1, 0xe8, 0xb8, 0x8e, 0x1c, 0xdd, 0x74, 0xd1, 0x44, 0x35, 0xdd, 0xc2, 0xf1, 0x51, 0x85, 0xf6,
-	0x27, 0xdf, 0xfd, 0xf9, 0xef, 0x4f, 0xcb, 0x35, 0x54, 0x71, 0x13, 0xe1, 0x81, 0xfc, 0x63, 0xf0,
-	0xd9, 0x99, 0xdb, 0xa1, 0x38, 0x64, 0x98, 0xc8, 0x7f, 0x8b, 0x68, 0x30, 0xbb, 0xe7, 0xf1, 0x80,
-	0xbe, 0x40, 0xbf, 0x1a, 0x70, 0x5b, 0x9b, 0x5d, 0xa8, 0x28, 0x41, 0xdc, 0x48, 0xf3, 0xb0, 0xb8,
-	0x40, 0x31, 0x3b, 0x92, 0xb9, 0x84, 0xee, 0x17, 0x63, 0x46, 0xbf, 0x18, 0xf0, 0x46, 0xaa, 0x15,
-	0x72, 0x63, 0x3f, 0xc8, 0xc8, 0xaa, 0x5f, 0x29, 0xb3, 0x5c, 0x24, 0x54, 0xa1, 0xd5, 0x24, 0xda,
-	0x01, 0xda, 0xcf, 0x43, 0xc3, 0x14, 0xbb, 0xe7, 0xf2, 0x72, 0x5e, 0xa0, 0x9f, 0x0d, 0xd8, 0x48,
-	0x1f, 0x3f, 0x54, 0x20, 0x63, 0xb2, 0x7d, 0xfb, 0x85, 0x62, 0x15, 0xde, 0xbe, 0xc4, 0xdb, 0x43,
-	0xef, 0x16, 0xc0, 0x43, 0xcf, 0xa7, 0x0f, 0xa5, 0x1c, 0x3e, 0x45, 0x0f, 0x65, 0xfa, 0xcb, 0x51,
-	0xe4, 0x50, 0x4e, 0x7d, 0x31, 0xec, 0xcf, 0x24, 0xe6, 0x47, 0xa8, 0x96, 0x87, 0x39, 0x99, 0x8d,
-	0xee, 0x79, 0xf4, 0x39, 0xba, 0x40, 0xbf, 0x19, 0x80, 0xf4, 0x51, 0x8d, 0x0e, 0x0b, 0x42, 0x24,
-	0x1f, 0x10, 0xb3, 0xf2, 0x12, 0x0a, 0x05, 0x5e, 0x95, 0xe0, 0x1f, 0xa2, 0x72, 0x71, 0x70, 0xf4,
-	0xbd, 0x01, 0xeb, 0xa9, 0x7f, 0x70, 0xf4, 0x5e, 0x46, 0xda, 0xa9, 0x3f, 0x77, 0x73, 0x2f, 0x27,
-	0x4a, 0x01, 0x1d, 0x4a, 0xa0, 0x32, 0x2a, 0xe5, 0x01, 0x9d, 0xd2, 0xa7, 0x04, 0x9f, 0x12, 0xf2,
-	0xf0, 0x8b, 0x3f, 0x2e, 0x2d, 0xe3, 0xc5, 0xa5, 0x65, 0xfc, 0x73, 0x69, 0x19, 0xcf, 0xae, 0xac,
-	0xa5, 0x17, 0x57, 0xd6, 0xd2, 0x5f, 0x57, 0xd6, 0xd2, 0xe3, 0x6a, 0x40, 0x45, 0x67, 0xd0, 0x76,
-	0x7c, 0xd6, 0xcd, 0x70, 0x3b, 0x90, 0x76, 0x4f, 0xa5, 0xa1, 0x18, 0xf5, 0x08, 0x6f, 0xaf, 0xc9,
-	0xe5, 0xda, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xc6, 0x99, 0xe7, 0x89, 0x0d, 0x00, 0x00,
+func (m *QueryAccreditationRequest) Reset()         { *m = QueryAccreditationRequest{} }
+func (m *QueryAccreditationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccreditationRequest) ProtoMessage()    {}
+func (*QueryAccreditationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{16}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Get the Schema Document for a specified schema id
-	CredentialSchemaByID(ctx context.Context, in *QueryCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryCredentialSchemaResponse, error)
-	// Get the count and list of registered Schemas
-	CredentialSchemas(ctx context.Context, in *QueryCredentialSchemasRequest, opts ...grpc.CallOption) (*QueryCredentialSchemasResponse, error)
-	// Get the Did Document for a specified DID id
-	DidDocumentByID(ctx context.Context, in *QueryDidDocumentRequest, opts ...grpc.CallOption) (*QueryDidDocumentResponse, error)
-	// Get the count and list of registered Did Documents
-	DidDocuments(ctx context.Context, in *QueryDidDocumentsRequest, opts ...grpc.CallOption) (*QueryDidDocumentsResponse, error)
-	// Get the Credential Status for a given credential id
-	CredentialStatusByID(ctx context.Context, in *QueryCredentialStatusRequest, opts ...grpc.CallOption) (*QueryCredentialStatusResponse, error)
-	// Get all the registed Credential Statuses
-	CredentialStatuses(ctx context.Context, in *QueryCredentialStatusesRequest, opts ...grpc.CallOption) (*QueryCredentialStatusesResponse, error)
-	// Get the list of fixed fees for every x/ssi module transactions
-	QuerySSIFee(ctx context.Context, in *QuerySSIFeeRequest, opts ...grpc.CallOption) (*QuerySSIFeeResponse, error)
+func (m *QueryAccreditationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAccreditationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccreditationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryAccreditationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccreditationRequest.Merge(m, src)
+}
+func (m *QueryAccreditationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccreditationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccreditationRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) CredentialSchemaByID(ctx context.Context, in *QueryCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryCredentialSchemaResponse, error) {
-	out := new(QueryCredentialSchemaResponse)
-	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/CredentialSchemaByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccreditationRequest proto.InternalMessageInfo
+
+func (m *QueryAccreditationRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) CredentialSchemas(ctx context.Context, in *QueryCredentialSchemasRequest, opts ...grpc.CallOption) (*QueryCredentialSchemasResponse, error) {
-	out := new(QueryCredentialSchemasResponse)
-	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/CredentialSchemas", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAccreditationResponse struct {
+	Accreditation *AccreditationState `protobuf:"bytes,1,opt,name=accreditation,proto3" json:"accreditation,omitempty"`
 }
 
-func (c *queryClient) DidDocumentByID(ctx context.Context, in *QueryDidDocumentRequest, opts ...grpc.CallOption) (*QueryDidDocumentResponse, error) {
-	out := new(QueryDidDocumentResponse)
-	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/DidDocumentByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAccreditationResponse) Reset()         { *m = QueryAccreditationResponse{} }
+func (m *QueryAccreditationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccreditationResponse) ProtoMessage()    {}
+func (*QueryAccreditationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{17}
+}
+func (m *QueryAccreditationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccreditationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccreditationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAccreditationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccreditationResponse.Merge(m, src)
+}
+func (m *QueryAccreditationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccreditationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccreditationResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DidDocuments(ctx context.Context, in *QueryDidDocumentsRequest, opts ...grpc.CallOption) (*QueryDidDocumentsResponse, error) {
-	out := new(QueryDidDocumentsResponse)
-	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/DidDocuments", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccreditationResponse proto.InternalMessageInfo
+
+func (m *QueryAccreditationResponse) GetAccreditation() *AccreditationState {
+	if m != nil {
+		return m.Accreditation
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) CredentialStatusByID(ctx context.Context, in *QueryCredentialStatusRequest, opts ...grpc.CallOption) (*QueryCredentialStatusResponse, error) {
-	out := new(QueryCredentialStatusResponse)
-	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/CredentialStatusByID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryIssuerAccreditationRequest checks the accreditation of `issuer` for `credentialSchemaId` at `time`, which is
+// an RFC3339 date. The time of the latest block is considered if `time` is not provided.
+type QueryIssuerAccreditationRequest struct {
+	Issuer             string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	CredentialSchemaId string `protobuf:"bytes,2,opt,name=credentialSchemaId,proto3" json:"credentialSchemaId,omitempty"`
+	Time               string `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
 }
 
-func (c *queryClient) CredentialStatuses(ctx context.Context, in *QueryCredentialStatusesRequest, opts ...grpc.CallOption) (*QueryCredentialStatusesResponse, error) {
-	out := new(QueryCredentialStatusesResponse)
-	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/CredentialStatuses", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIssuerAccreditationRequest) Reset()         { *m = QueryIssuerAccreditationRequest{} }
+func (m *QueryIssuerAccreditationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuerAccreditationRequest) ProtoMessage()    {}
+func (*QueryIssuerAccreditationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{18}
+}
+func (m *QueryIssuerAccreditationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIssuerAccreditationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIssuerAccreditationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryIssuerAccreditationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuerAccreditationRequest.Merge(m, src)
+}
+func (m *QueryIssuerAccreditationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIssuerAccreditationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuerAccreditationRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QuerySSIFee(ctx context.Context, in *QuerySSIFeeRequest, opts ...grpc.CallOption) (*QuerySSIFeeResponse, error) {
-	out := new(QuerySSIFeeResponse)
-	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/QuerySSIFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIssuerAccreditationRequest proto.InternalMessageInfo
+
+func (m *QueryIssuerAccreditationRequest) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Get the Schema Document for a specified schema id
-	CredentialSchemaByID(context.Context, *QueryCredentialSchemaRequest) (*QueryCredentialSchemaResponse, error)
-	// Get the count and list of registered Schemas
-	CredentialSchemas(context.Context, *QueryCredentialSchemasRequest) (*QueryCredentialSchemasResponse, error)
-	// Get the Did Document for a specified DID id
-	DidDocumentByID(context.Context, *QueryDidDocumentRequest) (*QueryDidDocumentResponse, error)
-	// Get the count and list of registered Did Documents
-	DidDocuments(context.Context, *QueryDidDocumentsRequest) (*QueryDidDocumentsResponse, error)
-	// Get the Credential Status for a given credential id
-	CredentialStatusByID(context.Context, *QueryCredentialStatusRequest) (*QueryCredentialStatusResponse, error)
-	// Get all the registed Credential Statuses
-	CredentialStatuses(context.Context, *QueryCredentialStatusesRequest) (*QueryCredentialStatusesResponse, error)
-	// Get the list of fixed fees for every x/ssi module transactions
-	QuerySSIFee(context.Context, *QuerySSIFeeRequest) (*QuerySSIFeeResponse, error)
+func (m *QueryIssuerAccreditationRequest) GetCredentialSchemaId() string {
+	if m != nil {
+		return m.CredentialSchemaId
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryIssuerAccreditationRequest) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
 }
 
-func (*UnimplementedQueryServer) CredentialSchemaByID(ctx context.Context, req *QueryCredentialSchemaRequest) (*QueryCredentialSchemaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CredentialSchemaByID not implemented")
+// QueryIssuerAccreditationResponse has the chain of accreditations from the one of issuer to the one issued by the
+// Credential Schema author or the x/ssi module authority, if the issuer is accredited
+type QueryIssuerAccreditationResponse struct {
+	Accredited         bool                  `protobuf:"varint,1,opt,name=accredited,proto3" json:"accredited,omitempty"`
+	AccreditationChain []*AccreditationState `protobuf:"bytes,2,rep,name=accreditationChain,proto3" json:"accreditationChain,omitempty"`
 }
-func (*UnimplementedQueryServer) CredentialSchemas(ctx context.Context, req *QueryCredentialSchemasRequest) (*QueryCredentialSchemasResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CredentialSchemas not implemented")
+
+func (m *QueryIssuerAccreditationResponse) Reset()         { *m = QueryIssuerAccreditationResponse{} }
+func (m *QueryIssuerAccreditationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIssuerAccreditationResponse) ProtoMessage()    {}
+func (*QueryIssuerAccreditationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{19}
 }
-func (*UnimplementedQueryServer) DidDocumentByID(ctx context.Context, req *QueryDidDocumentRequest) (*QueryDidDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DidDocumentByID not implemented")
+func (m *QueryIssuerAccreditationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DidDocuments(ctx context.Context, req *QueryDidDocumentsRequest) (*QueryDidDocumentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DidDocuments not implemented")
+func (m *QueryIssuerAccreditationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIssuerAccreditationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) CredentialStatusByID(ctx context.Context, req *QueryCredentialStatusRequest) (*QueryCredentialStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CredentialStatusByID not implemented")
+func (m *QueryIssuerAccreditationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIssuerAccreditationResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) CredentialStatuses(ctx context.Context, req *QueryCredentialStatusesRequest) (*QueryCredentialStatusesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CredentialStatuses not implemented")
+func (m *QueryIssuerAccreditationResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QuerySSIFee(ctx context.Context, req *QuerySSIFeeRequest) (*QuerySSIFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySSIFee not implemented")
+func (m *QueryIssuerAccreditationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIssuerAccreditationResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryIssuerAccreditationResponse proto.InternalMessageInfo
 
-func _Query_CredentialSchemaByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCredentialSchemaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CredentialSchemaByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hypersign.ssi.v1.Query/CredentialSchemaByID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CredentialSchemaByID(ctx, req.(*QueryCredentialSchemaRequest))
+func (m *QueryIssuerAccreditationResponse) GetAccredited() bool {
+	if m != nil {
+		return m.Accredited
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_CredentialSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCredentialSchemasRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CredentialSchemas(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hypersign.ssi.v1.Query/CredentialSchemas",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CredentialSchemas(ctx, req.(*QueryCredentialSchemasRequest))
+func (m *QueryIssuerAccreditationResponse) GetAccreditationChain() []*AccreditationState {
+	if m != nil {
+		return m.AccreditationChain
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DidDocumentByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDidDocumentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DidDocumentByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hypersign.ssi.v1.Query/DidDocumentByID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DidDocumentByID(ctx, req.(*QueryDidDocumentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryDidDocumentRequest struct {
+	DidId string `protobuf:"bytes,1,opt,name=didId,proto3" json:"didId,omitempty"`
+	// Resolve the version of Did Document with the specified version id
+	VersionId string `protobuf:"bytes,2,opt,name=versionId,proto3" json:"versionId,omitempty"`
+	// Resolve the version of Did Document which was active at the specified time (RFC3339)
+	VersionTime string `protobuf:"bytes,3,opt,name=versionTime,proto3" json:"versionTime,omitempty"`
+	// Include the ICS-23 Merkle proof of the Did Document in state. It cannot be combined with versionId
+	// and versionTime, as only the latest version of Did Document is proven.
+	Prove bool `protobuf:"varint,4,opt,name=prove,proto3" json:"prove,omitempty"`
 }
 
-func _Query_DidDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDidDocumentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DidDocuments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hypersign.ssi.v1.Query/DidDocuments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DidDocuments(ctx, req.(*QueryDidDocumentsRequest))
+func (m *QueryDidDocumentRequest) Reset()         { *m = QueryDidDocumentRequest{} }
+func (m *QueryDidDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocumentRequest) ProtoMessage()    {}
+func (*QueryDidDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{20}
+}
+func (m *QueryDidDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocumentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDidDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocumentRequest.Merge(m, src)
+}
+func (m *QueryDidDocumentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocumentRequest.DiscardUnknown(m)
 }
 
-func _Query_CredentialStatusByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCredentialStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CredentialStatusByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hypersign.ssi.v1.Query/CredentialStatusByID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CredentialStatusByID(ctx, req.(*QueryCredentialStatusRequest))
+var xxx_messageInfo_QueryDidDocumentRequest proto.InternalMessageInfo
+
+func (m *QueryDidDocumentRequest) GetDidId() string {
+	if m != nil {
+		return m.DidId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_CredentialStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCredentialStatusesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CredentialStatuses(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hypersign.ssi.v1.Query/CredentialStatuses",
+func (m *QueryDidDocumentRequest) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CredentialStatuses(ctx, req.(*QueryCredentialStatusesRequest))
+	return ""
+}
+
+func (m *QueryDidDocumentRequest) GetVersionTime() string {
+	if m != nil {
+		return m.VersionTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QuerySSIFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySSIFeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryDidDocumentRequest) GetProve() bool {
+	if m != nil {
+		return m.Prove
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySSIFee(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hypersign.ssi.v1.Query/QuerySSIFee",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySSIFee(ctx, req.(*QuerySSIFeeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "hypersign.ssi.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CredentialSchemaByID",
-			Handler:    _Query_CredentialSchemaByID_Handler,
-		},
-		{
-			MethodName: "CredentialSchemas",
-			Handler:    _Query_CredentialSchemas_Handler,
-		},
-		{
-			MethodName: "DidDocumentByID",
-			Handler:    _Query_DidDocumentByID_Handler,
-		},
-		{
-			MethodName: "DidDocuments",
-			Handler:    _Query_DidDocuments_Handler,
-		},
-		{
-			MethodName: "CredentialStatusByID",
-			Handler:    _Query_CredentialStatusByID_Handler,
-		},
-		{
-			MethodName: "CredentialStatuses",
-			Handler:    _Query_CredentialStatuses_Handler,
-		},
-		{
-			MethodName: "QuerySSIFee",
-			Handler:    _Query_QuerySSIFee_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "hypersign/ssi/v1/query.proto",
+type QueryDidDocumentResponse struct {
+	DidDocument         *DidDocument         `protobuf:"bytes,1,opt,name=didDocument,proto3" json:"didDocument,omitempty"`
+	DidDocumentMetadata *DidDocumentMetadata `protobuf:"bytes,2,opt,name=didDocumentMetadata,proto3" json:"didDocumentMetadata,omitempty"`
+	// Merkle proof of the Did Document state against the app hash of block height, if requested
+	Proof *crypto.ProofOps `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// Block height at which the Did Document was resolved, if the proof is requested
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// Encoded Did Document state as stored, over which the proof is verified, if the proof is requested
+	DidDocumentStateValue []byte `protobuf:"bytes,5,opt,name=didDocumentStateValue,proto3" json:"didDocumentStateValue,omitempty"`
 }
 
-func (m *QuerySSIFeeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDidDocumentResponse) Reset()         { *m = QueryDidDocumentResponse{} }
+func (m *QueryDidDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocumentResponse) ProtoMessage()    {}
+func (*QueryDidDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{21}
+}
+func (m *QueryDidDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QuerySSIFeeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDidDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocumentResponse.Merge(m, src)
 }
-
-func (m *QuerySSIFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryDidDocumentResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QuerySSIFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDidDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocumentResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySSIFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryDidDocumentResponse proto.InternalMessageInfo
 
-func (m *QuerySSIFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdateCredentialStatusFee != nil {
-		{
-			size, err := m.UpdateCredentialStatusFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.RegisterCredentialStatusFee != nil {
-		{
-			size, err := m.RegisterCredentialStatusFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.UpdateCredentialSchemaFee != nil {
-		{
-			size, err := m.UpdateCredentialSchemaFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.RegisterCredentialSchemaFee != nil {
-		{
-			size, err := m.RegisterCredentialSchemaFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.DeactivateDidFee != nil {
-		{
-			size, err := m.DeactivateDidFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.UpdateDidFee != nil {
-		{
-			size, err := m.UpdateDidFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.RegisterDidFee != nil {
-		{
-			size, err := m.RegisterDidFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDidDocumentResponse) GetDidDocument() *DidDocument {
+	if m != nil {
+		return m.DidDocument
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryCredentialSchemaRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDidDocumentResponse) GetDidDocumentMetadata() *DidDocumentMetadata {
+	if m != nil {
+		return m.DidDocumentMetadata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCredentialSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDidDocumentResponse) GetProof() *crypto.ProofOps {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
 }
 
-func (m *QueryCredentialSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SchemaId) > 0 {
-		i -= len(m.SchemaId)
-		copy(dAtA[i:], m.SchemaId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SchemaId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDidDocumentResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryCredentialSchemaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDidDocumentResponse) GetDidDocumentStateValue() []byte {
+	if m != nil {
+		return m.DidDocumentStateValue
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCredentialSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDidDocumentsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCredentialSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CredentialSchemas) > 0 {
-		for iNdEx := len(m.CredentialSchemas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CredentialSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDidDocumentsRequest) Reset()         { *m = QueryDidDocumentsRequest{} }
+func (m *QueryDidDocumentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocumentsRequest) ProtoMessage()    {}
+func (*QueryDidDocumentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{22}
+}
+func (m *QueryDidDocumentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocumentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDidDocumentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocumentsRequest.Merge(m, src)
+}
+func (m *QueryDidDocumentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocumentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocumentsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCredentialSchemasRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDidDocumentsRequest proto.InternalMessageInfo
+
+func (m *QueryDidDocumentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCredentialSchemasRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDidDocumentsResponse struct {
+	Count        uint64              `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	DidDocuments []*DidDocumentState `protobuf:"bytes,2,rep,name=didDocuments,proto3" json:"didDocuments,omitempty"`
 }
 
-func (m *QueryCredentialSchemasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDidDocumentsResponse) Reset()         { *m = QueryDidDocumentsResponse{} }
+func (m *QueryDidDocumentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocumentsResponse) ProtoMessage()    {}
+func (*QueryDidDocumentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{23}
+}
+func (m *QueryDidDocumentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocumentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDidDocumentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocumentsResponse.Merge(m, src)
+}
+func (m *QueryDidDocumentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocumentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocumentsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCredentialSchemasResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDidDocumentsResponse proto.InternalMessageInfo
+
+func (m *QueryDidDocumentsResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryCredentialSchemasResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDidDocumentsResponse) GetDidDocuments() []*DidDocumentState {
+	if m != nil {
+		return m.DidDocuments
+	}
+	return nil
 }
 
-func (m *QueryCredentialSchemasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CredentialSchemas) > 0 {
-		for iNdEx := len(m.CredentialSchemas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CredentialSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+type QueryResolveDidRequest struct {
+	DidId       string `protobuf:"bytes,1,opt,name=didId,proto3" json:"didId,omitempty"`
+	VersionId   string `protobuf:"bytes,2,opt,name=versionId,proto3" json:"versionId,omitempty"`
+	VersionTime string `protobuf:"bytes,3,opt,name=versionTime,proto3" json:"versionTime,omitempty"`
+	// Media type of the DID Document representation, defaults to application/did+ld+json
+	Accept string `protobuf:"bytes,4,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (m *QueryResolveDidRequest) Reset()         { *m = QueryResolveDidRequest{} }
+func (m *QueryResolveDidRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveDidRequest) ProtoMessage()    {}
+func (*QueryResolveDidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{24}
+}
+func (m *QueryResolveDidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveDidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveDidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryResolveDidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveDidRequest.Merge(m, src)
+}
+func (m *QueryResolveDidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveDidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveDidRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCredentialStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryResolveDidRequest proto.InternalMessageInfo
+
+func (m *QueryResolveDidRequest) GetDidId() string {
+	if m != nil {
+		return m.DidId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCredentialStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryResolveDidRequest) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
 }
 
-func (m *QueryCredentialStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CredId) > 0 {
-		i -= len(m.CredId)
-		copy(dAtA[i:], m.CredId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryResolveDidRequest) GetVersionTime() string {
+	if m != nil {
+		return m.VersionTime
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryCredentialStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryResolveDidRequest) GetAccept() string {
+	if m != nil {
+		return m.Accept
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryCredentialStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryResolveDidResponse struct {
+	Context               []string               `protobuf:"bytes,1,rep,name=context,json=@context,proto3" json:"@context"`
+	DidResolutionMetadata *DidResolutionMetadata `protobuf:"bytes,2,opt,name=didResolutionMetadata,proto3" json:"didResolutionMetadata,omitempty"`
+	DidDocument           *DidDocument           `protobuf:"bytes,3,opt,name=didDocument,proto3" json:"didDocument,omitempty"`
+	DidDocumentMetadata   *DidDocumentMetadata   `protobuf:"bytes,4,opt,name=didDocumentMetadata,proto3" json:"didDocumentMetadata,omitempty"`
 }
 
-func (m *QueryCredentialStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CredentialStatus != nil {
-		{
-			size, err := m.CredentialStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryResolveDidResponse) Reset()         { *m = QueryResolveDidResponse{} }
+func (m *QueryResolveDidResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveDidResponse) ProtoMessage()    {}
+func (*QueryResolveDidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{25}
+}
+func (m *QueryResolveDidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveDidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveDidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryCredentialStatusesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryResolveDidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveDidResponse.Merge(m, src)
 }
-
-func (m *QueryCredentialStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryResolveDidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveDidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveDidResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCredentialStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryResolveDidResponse proto.InternalMessageInfo
+
+func (m *QueryResolveDidResponse) GetContext() []string {
+	if m != nil {
+		return m.Context
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryCredentialStatusesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryResolveDidResponse) GetDidResolutionMetadata() *DidResolutionMetadata {
+	if m != nil {
+		return m.DidResolutionMetadata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryCredentialStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryResolveDidResponse) GetDidDocument() *DidDocument {
+	if m != nil {
+		return m.DidDocument
+	}
+	return nil
 }
 
-func (m *QueryCredentialStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CredentialStatuses) > 0 {
-		for iNdEx := len(m.CredentialStatuses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CredentialStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryResolveDidResponse) GetDidDocumentMetadata() *DidDocumentMetadata {
+	if m != nil {
+		return m.DidDocumentMetadata
+	}
+	return nil
+}
+
+type QueryDereferenceDidUrlRequest struct {
+	DidUrl string `protobuf:"bytes,1,opt,name=didUrl,proto3" json:"didUrl,omitempty"`
+	// Media type of the dereferenced resource, defaults to application/did+ld+json
+	Accept string `protobuf:"bytes,2,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (m *QueryDereferenceDidUrlRequest) Reset()         { *m = QueryDereferenceDidUrlRequest{} }
+func (m *QueryDereferenceDidUrlRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDereferenceDidUrlRequest) ProtoMessage()    {}
+func (*QueryDereferenceDidUrlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{26}
+}
+func (m *QueryDereferenceDidUrlRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDereferenceDidUrlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDereferenceDidUrlRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryDereferenceDidUrlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDereferenceDidUrlRequest.Merge(m, src)
+}
+func (m *QueryDereferenceDidUrlRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDereferenceDidUrlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDereferenceDidUrlRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDereferenceDidUrlRequest proto.InternalMessageInfo
+
+func (m *QueryDereferenceDidUrlRequest) GetDidUrl() string {
+	if m != nil {
+		return m.DidUrl
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDidDocumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDereferenceDidUrlRequest) GetAccept() string {
+	if m != nil {
+		return m.Accept
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDidDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDereferenceDidUrlResponse holds the result of DID URL dereferencing. Depending on the DID URL, only
+// one of didDocument, verificationMethod, service and contentUrl is populated.
+type QueryDereferenceDidUrlResponse struct {
+	Context               []string               `protobuf:"bytes,1,rep,name=context,json=@context,proto3" json:"@context"`
+	DereferencingMetadata *DidResolutionMetadata `protobuf:"bytes,2,opt,name=dereferencingMetadata,proto3" json:"dereferencingMetadata,omitempty"`
+	DidDocument           *DidDocument           `protobuf:"bytes,3,opt,name=didDocument,proto3" json:"didDocument,omitempty"`
+	VerificationMethod    *VerificationMethod    `protobuf:"bytes,4,opt,name=verificationMethod,proto3" json:"verificationMethod,omitempty"`
+	Service               *Service               `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
+	ContentUrl            string                 `protobuf:"bytes,6,opt,name=contentUrl,proto3" json:"contentUrl,omitempty"`
+	ContentMetadata       *DidDocumentMetadata   `protobuf:"bytes,7,opt,name=contentMetadata,proto3" json:"contentMetadata,omitempty"`
 }
 
-func (m *QueryDidDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DidId) > 0 {
-		i -= len(m.DidId)
-		copy(dAtA[i:], m.DidId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DidId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDereferenceDidUrlResponse) Reset()         { *m = QueryDereferenceDidUrlResponse{} }
+func (m *QueryDereferenceDidUrlResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDereferenceDidUrlResponse) ProtoMessage()    {}
+func (*QueryDereferenceDidUrlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{27}
+}
+func (m *QueryDereferenceDidUrlResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDereferenceDidUrlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDereferenceDidUrlResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDereferenceDidUrlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDereferenceDidUrlResponse.Merge(m, src)
+}
+func (m *QueryDereferenceDidUrlResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDereferenceDidUrlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDereferenceDidUrlResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDidDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDereferenceDidUrlResponse proto.InternalMessageInfo
+
+func (m *QueryDereferenceDidUrlResponse) GetContext() []string {
+	if m != nil {
+		return m.Context
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDidDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDereferenceDidUrlResponse) GetDereferencingMetadata() *DidResolutionMetadata {
+	if m != nil {
+		return m.DereferencingMetadata
+	}
+	return nil
 }
 
-func (m *QueryDidDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DidDocumentMetadata != nil {
-		{
-			size, err := m.DidDocumentMetadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.DidDocument != nil {
-		{
-			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDereferenceDidUrlResponse) GetDidDocument() *DidDocument {
+	if m != nil {
+		return m.DidDocument
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDidDocumentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDereferenceDidUrlResponse) GetVerificationMethod() *VerificationMethod {
+	if m != nil {
+		return m.VerificationMethod
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDidDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDereferenceDidUrlResponse) GetService() *Service {
+	if m != nil {
+		return m.Service
+	}
+	return nil
 }
 
-func (m *QueryDidDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDereferenceDidUrlResponse) GetContentUrl() string {
+	if m != nil {
+		return m.ContentUrl
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDidDocumentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDereferenceDidUrlResponse) GetContentMetadata() *DidDocumentMetadata {
+	if m != nil {
+		return m.ContentMetadata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDidDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDidDocumentVersionsRequest struct {
+	DidId      string             `protobuf:"bytes,1,opt,name=didId,proto3" json:"didId,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDidDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DidDocuments) > 0 {
-		for iNdEx := len(m.DidDocuments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DidDocuments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QueryDidDocumentVersionsRequest) Reset()         { *m = QueryDidDocumentVersionsRequest{} }
+func (m *QueryDidDocumentVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocumentVersionsRequest) ProtoMessage()    {}
+func (*QueryDidDocumentVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{28}
+}
+func (m *QueryDidDocumentVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocumentVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocumentVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDidDocumentVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocumentVersionsRequest.Merge(m, src)
+}
+func (m *QueryDidDocumentVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocumentVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocumentVersionsRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryDidDocumentVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryDidDocumentVersionsRequest) GetDidId() string {
+	if m != nil {
+		return m.DidId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QuerySSIFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryDidDocumentVersionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QuerySSIFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RegisterDidFee != nil {
-		l = m.RegisterDidFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.UpdateDidFee != nil {
-		l = m.UpdateDidFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.DeactivateDidFee != nil {
-		l = m.DeactivateDidFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.RegisterCredentialSchemaFee != nil {
-		l = m.RegisterCredentialSchemaFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.UpdateCredentialSchemaFee != nil {
-		l = m.UpdateCredentialSchemaFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.RegisterCredentialStatusFee != nil {
-		l = m.RegisterCredentialStatusFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.UpdateCredentialStatusFee != nil {
-		l = m.UpdateCredentialStatusFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryDidDocumentVersionsResponse struct {
+	DidDocumentVersions []*DidDocumentState `protobuf:"bytes,1,rep,name=didDocumentVersions,proto3" json:"didDocumentVersions,omitempty"`
+	Pagination          *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCredentialSchemaRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDidDocumentVersionsResponse) Reset()         { *m = QueryDidDocumentVersionsResponse{} }
+func (m *QueryDidDocumentVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocumentVersionsResponse) ProtoMessage()    {}
+func (*QueryDidDocumentVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{29}
+}
+func (m *QueryDidDocumentVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocumentVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocumentVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.SchemaId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDidDocumentVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocumentVersionsResponse.Merge(m, src)
+}
+func (m *QueryDidDocumentVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocumentVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocumentVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDidDocumentVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryDidDocumentVersionsResponse) GetDidDocumentVersions() []*DidDocumentState {
+	if m != nil {
+		return m.DidDocumentVersions
 	}
-	return n
+	return nil
 }
 
-func (m *QueryCredentialSchemaResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDidDocumentVersionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.CredentialSchemas) > 0 {
-		for _, e := range m.CredentialSchemas {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryPendingDidRecoveryRequest struct {
+	DidId string `protobuf:"bytes,1,opt,name=didId,proto3" json:"didId,omitempty"`
+}
+
+func (m *QueryPendingDidRecoveryRequest) Reset()         { *m = QueryPendingDidRecoveryRequest{} }
+func (m *QueryPendingDidRecoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDidRecoveryRequest) ProtoMessage()    {}
+func (*QueryPendingDidRecoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{30}
+}
+func (m *QueryPendingDidRecoveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDidRecoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDidRecoveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPendingDidRecoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDidRecoveryRequest.Merge(m, src)
+}
+func (m *QueryPendingDidRecoveryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDidRecoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDidRecoveryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCredentialSchemasRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryPendingDidRecoveryRequest proto.InternalMessageInfo
+
+func (m *QueryPendingDidRecoveryRequest) GetDidId() string {
+	if m != nil {
+		return m.DidId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryCredentialSchemasResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovQuery(uint64(m.Count))
-	}
-	if len(m.CredentialSchemas) > 0 {
-		for _, e := range m.CredentialSchemas {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+type QueryPendingDidRecoveryResponse struct {
+	PendingDidRecovery *PendingDidRecovery `protobuf:"bytes,1,opt,name=pendingDidRecovery,proto3" json:"pendingDidRecovery,omitempty"`
+}
+
+func (m *QueryPendingDidRecoveryResponse) Reset()         { *m = QueryPendingDidRecoveryResponse{} }
+func (m *QueryPendingDidRecoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDidRecoveryResponse) ProtoMessage()    {}
+func (*QueryPendingDidRecoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{31}
+}
+func (m *QueryPendingDidRecoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDidRecoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDidRecoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPendingDidRecoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDidRecoveryResponse.Merge(m, src)
+}
+func (m *QueryPendingDidRecoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDidRecoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDidRecoveryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCredentialStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CredId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryPendingDidRecoveryResponse proto.InternalMessageInfo
+
+func (m *QueryPendingDidRecoveryResponse) GetPendingDidRecovery() *PendingDidRecovery {
+	if m != nil {
+		return m.PendingDidRecovery
 	}
-	return n
+	return nil
 }
 
-func (m *QueryCredentialStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CredentialStatus != nil {
-		l = m.CredentialStatus.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryPendingDidRecoveriesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCredentialStatusesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPendingDidRecoveriesRequest) Reset()         { *m = QueryPendingDidRecoveriesRequest{} }
+func (m *QueryPendingDidRecoveriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDidRecoveriesRequest) ProtoMessage()    {}
+func (*QueryPendingDidRecoveriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{32}
+}
+func (m *QueryPendingDidRecoveriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDidRecoveriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDidRecoveriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPendingDidRecoveriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDidRecoveriesRequest.Merge(m, src)
+}
+func (m *QueryPendingDidRecoveriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDidRecoveriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDidRecoveriesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCredentialStatusesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovQuery(uint64(m.Count))
+var xxx_messageInfo_QueryPendingDidRecoveriesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingDidRecoveriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if len(m.CredentialStatuses) > 0 {
-		for _, e := range m.CredentialStatuses {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryPendingDidRecoveriesResponse struct {
+	PendingDidRecoveries []*PendingDidRecovery `protobuf:"bytes,1,rep,name=pendingDidRecoveries,proto3" json:"pendingDidRecoveries,omitempty"`
+	Pagination           *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingDidRecoveriesResponse) Reset()         { *m = QueryPendingDidRecoveriesResponse{} }
+func (m *QueryPendingDidRecoveriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingDidRecoveriesResponse) ProtoMessage()    {}
+func (*QueryPendingDidRecoveriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{33}
+}
+func (m *QueryPendingDidRecoveriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingDidRecoveriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingDidRecoveriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPendingDidRecoveriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingDidRecoveriesResponse.Merge(m, src)
+}
+func (m *QueryPendingDidRecoveriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingDidRecoveriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingDidRecoveriesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDidDocumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DidId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryPendingDidRecoveriesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingDidRecoveriesResponse) GetPendingDidRecoveries() []*PendingDidRecovery {
+	if m != nil {
+		return m.PendingDidRecoveries
 	}
-	return n
+	return nil
 }
 
-func (m *QueryDidDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DidDocument != nil {
-		l = m.DidDocument.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.DidDocumentMetadata != nil {
-		l = m.DidDocumentMetadata.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPendingDidRecoveriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryDidDocumentsRequest) Size() (n int) {
+type QueryDidDocumentsByControllerRequest struct {
+	Controller string             `protobuf:"bytes,1,opt,name=controller,proto3" json:"controller,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDidDocumentsByControllerRequest) Reset()         { *m = QueryDidDocumentsByControllerRequest{} }
+func (m *QueryDidDocumentsByControllerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocumentsByControllerRequest) ProtoMessage()    {}
+func (*QueryDidDocumentsByControllerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{34}
+}
+func (m *QueryDidDocumentsByControllerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocumentsByControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocumentsByControllerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDidDocumentsByControllerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocumentsByControllerRequest.Merge(m, src)
+}
+func (m *QueryDidDocumentsByControllerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocumentsByControllerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocumentsByControllerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDidDocumentsByControllerRequest proto.InternalMessageInfo
+
+func (m *QueryDidDocumentsByControllerRequest) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+func (m *QueryDidDocumentsByControllerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryDidDocumentsByControllerResponse struct {
+	DidDocuments []*DidDocumentState `protobuf:"bytes,1,rep,name=didDocuments,proto3" json:"didDocuments,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDidDocumentsByControllerResponse) Reset()         { *m = QueryDidDocumentsByControllerResponse{} }
+func (m *QueryDidDocumentsByControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDidDocumentsByControllerResponse) ProtoMessage()    {}
+func (*QueryDidDocumentsByControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{35}
+}
+func (m *QueryDidDocumentsByControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocumentsByControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocumentsByControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDidDocumentsByControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocumentsByControllerResponse.Merge(m, src)
+}
+func (m *QueryDidDocumentsByControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocumentsByControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocumentsByControllerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDidDocumentsByControllerResponse proto.InternalMessageInfo
+
+func (m *QueryDidDocumentsByControllerResponse) GetDidDocuments() []*DidDocumentState {
+	if m != nil {
+		return m.DidDocuments
+	}
+	return nil
+}
+
+func (m *QueryDidDocumentsByControllerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryDidDocumentByBlockchainAccountIdRequest struct {
+	BlockchainAccountId string `protobuf:"bytes,1,opt,name=blockchainAccountId,proto3" json:"blockchainAccountId,omitempty"`
+}
+
+func (m *QueryDidDocumentByBlockchainAccountIdRequest) Reset() {
+	*m = QueryDidDocumentByBlockchainAccountIdRequest{}
+}
+func (m *QueryDidDocumentByBlockchainAccountIdRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDidDocumentByBlockchainAccountIdRequest) ProtoMessage() {}
+func (*QueryDidDocumentByBlockchainAccountIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{36}
+}
+func (m *QueryDidDocumentByBlockchainAccountIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocumentByBlockchainAccountIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocumentByBlockchainAccountIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDidDocumentByBlockchainAccountIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocumentByBlockchainAccountIdRequest.Merge(m, src)
+}
+func (m *QueryDidDocumentByBlockchainAccountIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocumentByBlockchainAccountIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocumentByBlockchainAccountIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDidDocumentByBlockchainAccountIdRequest proto.InternalMessageInfo
+
+func (m *QueryDidDocumentByBlockchainAccountIdRequest) GetBlockchainAccountId() string {
+	if m != nil {
+		return m.BlockchainAccountId
+	}
+	return ""
+}
+
+type QueryDidDocumentByBlockchainAccountIdResponse struct {
+	DidDocument         *DidDocument         `protobuf:"bytes,1,opt,name=didDocument,proto3" json:"didDocument,omitempty"`
+	DidDocumentMetadata *DidDocumentMetadata `protobuf:"bytes,2,opt,name=didDocumentMetadata,proto3" json:"didDocumentMetadata,omitempty"`
+}
+
+func (m *QueryDidDocumentByBlockchainAccountIdResponse) Reset() {
+	*m = QueryDidDocumentByBlockchainAccountIdResponse{}
+}
+func (m *QueryDidDocumentByBlockchainAccountIdResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDidDocumentByBlockchainAccountIdResponse) ProtoMessage() {}
+func (*QueryDidDocumentByBlockchainAccountIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{37}
+}
+func (m *QueryDidDocumentByBlockchainAccountIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDidDocumentByBlockchainAccountIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDidDocumentByBlockchainAccountIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDidDocumentByBlockchainAccountIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDidDocumentByBlockchainAccountIdResponse.Merge(m, src)
+}
+func (m *QueryDidDocumentByBlockchainAccountIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDidDocumentByBlockchainAccountIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDidDocumentByBlockchainAccountIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDidDocumentByBlockchainAccountIdResponse proto.InternalMessageInfo
+
+func (m *QueryDidDocumentByBlockchainAccountIdResponse) GetDidDocument() *DidDocument {
+	if m != nil {
+		return m.DidDocument
+	}
+	return nil
+}
+
+func (m *QueryDidDocumentByBlockchainAccountIdResponse) GetDidDocumentMetadata() *DidDocumentMetadata {
+	if m != nil {
+		return m.DidDocumentMetadata
+	}
+	return nil
+}
+
+type QueryCredentialSchemasByAuthorRequest struct {
+	Author     string             `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCredentialSchemasByAuthorRequest) Reset()         { *m = QueryCredentialSchemasByAuthorRequest{} }
+func (m *QueryCredentialSchemasByAuthorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCredentialSchemasByAuthorRequest) ProtoMessage()    {}
+func (*QueryCredentialSchemasByAuthorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{38}
+}
+func (m *QueryCredentialSchemasByAuthorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCredentialSchemasByAuthorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCredentialSchemasByAuthorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCredentialSchemasByAuthorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCredentialSchemasByAuthorRequest.Merge(m, src)
+}
+func (m *QueryCredentialSchemasByAuthorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCredentialSchemasByAuthorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCredentialSchemasByAuthorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCredentialSchemasByAuthorRequest proto.InternalMessageInfo
+
+func (m *QueryCredentialSchemasByAuthorRequest) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *QueryCredentialSchemasByAuthorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCredentialSchemasByAuthorResponse struct {
+	CredentialSchemas []*CredentialSchemaState `protobuf:"bytes,1,rep,name=credentialSchemas,proto3" json:"credentialSchemas,omitempty"`
+	Pagination        *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCredentialSchemasByAuthorResponse) Reset() {
+	*m = QueryCredentialSchemasByAuthorResponse{}
+}
+func (m *QueryCredentialSchemasByAuthorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCredentialSchemasByAuthorResponse) ProtoMessage()    {}
+func (*QueryCredentialSchemasByAuthorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{39}
+}
+func (m *QueryCredentialSchemasByAuthorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCredentialSchemasByAuthorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCredentialSchemasByAuthorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCredentialSchemasByAuthorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCredentialSchemasByAuthorResponse.Merge(m, src)
+}
+func (m *QueryCredentialSchemasByAuthorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCredentialSchemasByAuthorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCredentialSchemasByAuthorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCredentialSchemasByAuthorResponse proto.InternalMessageInfo
+
+func (m *QueryCredentialSchemasByAuthorResponse) GetCredentialSchemas() []*CredentialSchemaState {
+	if m != nil {
+		return m.CredentialSchemas
+	}
+	return nil
+}
+
+func (m *QueryCredentialSchemasByAuthorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCredentialStatusesByIssuerRequest struct {
+	Issuer     string             `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCredentialStatusesByIssuerRequest) Reset() {
+	*m = QueryCredentialStatusesByIssuerRequest{}
+}
+func (m *QueryCredentialStatusesByIssuerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCredentialStatusesByIssuerRequest) ProtoMessage()    {}
+func (*QueryCredentialStatusesByIssuerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{40}
+}
+func (m *QueryCredentialStatusesByIssuerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCredentialStatusesByIssuerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCredentialStatusesByIssuerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCredentialStatusesByIssuerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCredentialStatusesByIssuerRequest.Merge(m, src)
+}
+func (m *QueryCredentialStatusesByIssuerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCredentialStatusesByIssuerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCredentialStatusesByIssuerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCredentialStatusesByIssuerRequest proto.InternalMessageInfo
+
+func (m *QueryCredentialStatusesByIssuerRequest) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *QueryCredentialStatusesByIssuerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCredentialStatusesByIssuerResponse struct {
+	CredentialStatuses []*CredentialStatusState `protobuf:"bytes,1,rep,name=credentialStatuses,proto3" json:"credentialStatuses,omitempty"`
+	Pagination         *query.PageResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCredentialStatusesByIssuerResponse) Reset() {
+	*m = QueryCredentialStatusesByIssuerResponse{}
+}
+func (m *QueryCredentialStatusesByIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCredentialStatusesByIssuerResponse) ProtoMessage()    {}
+func (*QueryCredentialStatusesByIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{41}
+}
+func (m *QueryCredentialStatusesByIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCredentialStatusesByIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCredentialStatusesByIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCredentialStatusesByIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCredentialStatusesByIssuerResponse.Merge(m, src)
+}
+func (m *QueryCredentialStatusesByIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCredentialStatusesByIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCredentialStatusesByIssuerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCredentialStatusesByIssuerResponse proto.InternalMessageInfo
+
+func (m *QueryCredentialStatusesByIssuerResponse) GetCredentialStatuses() []*CredentialStatusState {
+	if m != nil {
+		return m.CredentialStatuses
+	}
+	return nil
+}
+
+func (m *QueryCredentialStatusesByIssuerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryVerifyDocumentProofRequest carries exactly one of the SSI Documents, along with its proof
+type QueryVerifyDocumentProofRequest struct {
+	DidDocument              *DidDocument              `protobuf:"bytes,1,opt,name=didDocument,proto3" json:"didDocument,omitempty"`
+	CredentialSchemaDocument *CredentialSchemaDocument `protobuf:"bytes,2,opt,name=credentialSchemaDocument,proto3" json:"credentialSchemaDocument,omitempty"`
+	CredentialStatusDocument *CredentialStatusDocument `protobuf:"bytes,3,opt,name=credentialStatusDocument,proto3" json:"credentialStatusDocument,omitempty"`
+	DocumentProof            *DocumentProof            `protobuf:"bytes,4,opt,name=documentProof,proto3" json:"documentProof,omitempty"`
+}
+
+func (m *QueryVerifyDocumentProofRequest) Reset()         { *m = QueryVerifyDocumentProofRequest{} }
+func (m *QueryVerifyDocumentProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyDocumentProofRequest) ProtoMessage()    {}
+func (*QueryVerifyDocumentProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{42}
+}
+func (m *QueryVerifyDocumentProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyDocumentProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyDocumentProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyDocumentProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyDocumentProofRequest.Merge(m, src)
+}
+func (m *QueryVerifyDocumentProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyDocumentProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyDocumentProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyDocumentProofRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyDocumentProofRequest) GetDidDocument() *DidDocument {
+	if m != nil {
+		return m.DidDocument
+	}
+	return nil
+}
+
+func (m *QueryVerifyDocumentProofRequest) GetCredentialSchemaDocument() *CredentialSchemaDocument {
+	if m != nil {
+		return m.CredentialSchemaDocument
+	}
+	return nil
+}
+
+func (m *QueryVerifyDocumentProofRequest) GetCredentialStatusDocument() *CredentialStatusDocument {
+	if m != nil {
+		return m.CredentialStatusDocument
+	}
+	return nil
+}
+
+func (m *QueryVerifyDocumentProofRequest) GetDocumentProof() *DocumentProof {
+	if m != nil {
+		return m.DocumentProof
+	}
+	return nil
+}
+
+// QueryVerifyDocumentProofResponse reports the checks performed on the document proof, in the order they were
+// performed. Verification stops at the first failed check.
+type QueryVerifyDocumentProofResponse struct {
+	Verified bool                      `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	Checks   []*ProofVerificationCheck `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty"`
+	// Hex encoded bytes over which the signature is verified, derived from the canonized document and proof
+	SigningInput string `protobuf:"bytes,3,opt,name=signingInput,proto3" json:"signingInput,omitempty"`
+}
+
+func (m *QueryVerifyDocumentProofResponse) Reset()         { *m = QueryVerifyDocumentProofResponse{} }
+func (m *QueryVerifyDocumentProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyDocumentProofResponse) ProtoMessage()    {}
+func (*QueryVerifyDocumentProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{43}
+}
+func (m *QueryVerifyDocumentProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyDocumentProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyDocumentProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyDocumentProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyDocumentProofResponse.Merge(m, src)
+}
+func (m *QueryVerifyDocumentProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyDocumentProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyDocumentProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyDocumentProofResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyDocumentProofResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *QueryVerifyDocumentProofResponse) GetChecks() []*ProofVerificationCheck {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+func (m *QueryVerifyDocumentProofResponse) GetSigningInput() string {
+	if m != nil {
+		return m.SigningInput
+	}
+	return ""
+}
+
+type ProofVerificationCheck struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed  bool   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *ProofVerificationCheck) Reset()         { *m = ProofVerificationCheck{} }
+func (m *ProofVerificationCheck) String() string { return proto.CompactTextString(m) }
+func (*ProofVerificationCheck) ProtoMessage()    {}
+func (*ProofVerificationCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{44}
+}
+func (m *ProofVerificationCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofVerificationCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofVerificationCheck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProofVerificationCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofVerificationCheck.Merge(m, src)
+}
+func (m *ProofVerificationCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofVerificationCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofVerificationCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofVerificationCheck proto.InternalMessageInfo
+
+func (m *ProofVerificationCheck) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProofVerificationCheck) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *ProofVerificationCheck) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type QueryValidateSSIMsgRequest struct {
+	// x/ssi module message such as MsgRegisterDID, packed with its type url
+	Msg *types1.Any `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *QueryValidateSSIMsgRequest) Reset()         { *m = QueryValidateSSIMsgRequest{} }
+func (m *QueryValidateSSIMsgRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateSSIMsgRequest) ProtoMessage()    {}
+func (*QueryValidateSSIMsgRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{45}
+}
+func (m *QueryValidateSSIMsgRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateSSIMsgRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateSSIMsgRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateSSIMsgRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateSSIMsgRequest.Merge(m, src)
+}
+func (m *QueryValidateSSIMsgRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateSSIMsgRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateSSIMsgRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidateSSIMsgRequest proto.InternalMessageInfo
+
+func (m *QueryValidateSSIMsgRequest) GetMsg() *types1.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+type QueryValidateSSIMsgResponse struct {
+	Valid      bool               `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Violations []*SSIMsgViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (m *QueryValidateSSIMsgResponse) Reset()         { *m = QueryValidateSSIMsgResponse{} }
+func (m *QueryValidateSSIMsgResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateSSIMsgResponse) ProtoMessage()    {}
+func (*QueryValidateSSIMsgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{46}
+}
+func (m *QueryValidateSSIMsgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateSSIMsgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateSSIMsgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateSSIMsgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateSSIMsgResponse.Merge(m, src)
+}
+func (m *QueryValidateSSIMsgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateSSIMsgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateSSIMsgResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidateSSIMsgResponse proto.InternalMessageInfo
+
+func (m *QueryValidateSSIMsgResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *QueryValidateSSIMsgResponse) GetViolations() []*SSIMsgViolation {
+	if m != nil {
+		return m.Violations
+	}
+	return nil
+}
+
+// SSIMsgViolation is a validation failure of the message, along with the ABCI error code the transaction would fail with
+type SSIMsgViolation struct {
+	Codespace string `protobuf:"bytes,1,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code      uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *SSIMsgViolation) Reset()         { *m = SSIMsgViolation{} }
+func (m *SSIMsgViolation) String() string { return proto.CompactTextString(m) }
+func (*SSIMsgViolation) ProtoMessage()    {}
+func (*SSIMsgViolation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{47}
+}
+func (m *SSIMsgViolation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSIMsgViolation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSIMsgViolation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SSIMsgViolation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSIMsgViolation.Merge(m, src)
+}
+func (m *SSIMsgViolation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSIMsgViolation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSIMsgViolation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSIMsgViolation proto.InternalMessageInfo
+
+func (m *SSIMsgViolation) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *SSIMsgViolation) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *SSIMsgViolation) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type QueryVerifyCredentialRequest struct {
+	// JSON encoded W3C Verifiable Credential, along with its proof
+	Credential string `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+}
+
+func (m *QueryVerifyCredentialRequest) Reset()         { *m = QueryVerifyCredentialRequest{} }
+func (m *QueryVerifyCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyCredentialRequest) ProtoMessage()    {}
+func (*QueryVerifyCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{48}
+}
+func (m *QueryVerifyCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyCredentialRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyCredentialRequest.Merge(m, src)
+}
+func (m *QueryVerifyCredentialRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyCredentialRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyCredentialRequest) GetCredential() string {
+	if m != nil {
+		return m.Credential
+	}
+	return ""
+}
+
+// QueryVerifyCredentialResponse reports the checks performed on the Verifiable Credential, in the order they were
+// performed. Verification stops at the first failed check.
+type QueryVerifyCredentialResponse struct {
+	Verified bool                      `protobuf:"varint,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	Checks   []*ProofVerificationCheck `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks,omitempty"`
+}
+
+func (m *QueryVerifyCredentialResponse) Reset()         { *m = QueryVerifyCredentialResponse{} }
+func (m *QueryVerifyCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyCredentialResponse) ProtoMessage()    {}
+func (*QueryVerifyCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_faf2a72d2769ce79, []int{49}
+}
+func (m *QueryVerifyCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyCredentialResponse.Merge(m, src)
+}
+func (m *QueryVerifyCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyCredentialResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyCredentialResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *QueryVerifyCredentialResponse) GetChecks() []*ProofVerificationCheck {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "hypersign.ssi.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "hypersign.ssi.v1.QueryParamsResponse")
+	proto.RegisterType((*QuerySSIFeeRequest)(nil), "hypersign.ssi.v1.QuerySSIFeeRequest")
+	proto.RegisterType((*QuerySSIFeeResponse)(nil), "hypersign.ssi.v1.QuerySSIFeeResponse")
+	proto.RegisterType((*QueryCredentialSchemaRequest)(nil), "hypersign.ssi.v1.QueryCredentialSchemaRequest")
+	proto.RegisterType((*QueryCredentialSchemaResponse)(nil), "hypersign.ssi.v1.QueryCredentialSchemaResponse")
+	proto.RegisterType((*QueryCredentialSchemasRequest)(nil), "hypersign.ssi.v1.QueryCredentialSchemasRequest")
+	proto.RegisterType((*QueryCredentialSchemasResponse)(nil), "hypersign.ssi.v1.QueryCredentialSchemasResponse")
+	proto.RegisterType((*QueryCredentialStatusRequest)(nil), "hypersign.ssi.v1.QueryCredentialStatusRequest")
+	proto.RegisterType((*QueryCredentialStatusResponse)(nil), "hypersign.ssi.v1.QueryCredentialStatusResponse")
+	proto.RegisterType((*QueryCredentialStatusesRequest)(nil), "hypersign.ssi.v1.QueryCredentialStatusesRequest")
+	proto.RegisterType((*QueryCredentialStatusesResponse)(nil), "hypersign.ssi.v1.QueryCredentialStatusesResponse")
+	proto.RegisterType((*QueryCredentialStatusBatchRequest)(nil), "hypersign.ssi.v1.QueryCredentialStatusBatchRequest")
+	proto.RegisterType((*QueryCredentialStatusBatchResponse)(nil), "hypersign.ssi.v1.QueryCredentialStatusBatchResponse")
+	proto.RegisterType((*QueryCredentialStatusListRequest)(nil), "hypersign.ssi.v1.QueryCredentialStatusListRequest")
+	proto.RegisterType((*QueryCredentialStatusListResponse)(nil), "hypersign.ssi.v1.QueryCredentialStatusListResponse")
+	proto.RegisterType((*QueryAccreditationRequest)(nil), "hypersign.ssi.v1.QueryAccreditationRequest")
+	proto.RegisterType((*QueryAccreditationResponse)(nil), "hypersign.ssi.v1.QueryAccreditationResponse")
+	proto.RegisterType((*QueryIssuerAccreditationRequest)(nil), "hypersign.ssi.v1.QueryIssuerAccreditationRequest")
+	proto.RegisterType((*QueryIssuerAccreditationResponse)(nil), "hypersign.ssi.v1.QueryIssuerAccreditationResponse")
+	proto.RegisterType((*QueryDidDocumentRequest)(nil), "hypersign.ssi.v1.QueryDidDocumentRequest")
+	proto.RegisterType((*QueryDidDocumentResponse)(nil), "hypersign.ssi.v1.QueryDidDocumentResponse")
+	proto.RegisterType((*QueryDidDocumentsRequest)(nil), "hypersign.ssi.v1.QueryDidDocumentsRequest")
+	proto.RegisterType((*QueryDidDocumentsResponse)(nil), "hypersign.ssi.v1.QueryDidDocumentsResponse")
+	proto.RegisterType((*QueryResolveDidRequest)(nil), "hypersign.ssi.v1.QueryResolveDidRequest")
+	proto.RegisterType((*QueryResolveDidResponse)(nil), "hypersign.ssi.v1.QueryResolveDidResponse")
+	proto.RegisterType((*QueryDereferenceDidUrlRequest)(nil), "hypersign.ssi.v1.QueryDereferenceDidUrlRequest")
+	proto.RegisterType((*QueryDereferenceDidUrlResponse)(nil), "hypersign.ssi.v1.QueryDereferenceDidUrlResponse")
+	proto.RegisterType((*QueryDidDocumentVersionsRequest)(nil), "hypersign.ssi.v1.QueryDidDocumentVersionsRequest")
+	proto.RegisterType((*QueryDidDocumentVersionsResponse)(nil), "hypersign.ssi.v1.QueryDidDocumentVersionsResponse")
+	proto.RegisterType((*QueryPendingDidRecoveryRequest)(nil), "hypersign.ssi.v1.QueryPendingDidRecoveryRequest")
+	proto.RegisterType((*QueryPendingDidRecoveryResponse)(nil), "hypersign.ssi.v1.QueryPendingDidRecoveryResponse")
+	proto.RegisterType((*QueryPendingDidRecoveriesRequest)(nil), "hypersign.ssi.v1.QueryPendingDidRecoveriesRequest")
+	proto.RegisterType((*QueryPendingDidRecoveriesResponse)(nil), "hypersign.ssi.v1.QueryPendingDidRecoveriesResponse")
+	proto.RegisterType((*QueryDidDocumentsByControllerRequest)(nil), "hypersign.ssi.v1.QueryDidDocumentsByControllerRequest")
+	proto.RegisterType((*QueryDidDocumentsByControllerResponse)(nil), "hypersign.ssi.v1.QueryDidDocumentsByControllerResponse")
+	proto.RegisterType((*QueryDidDocumentByBlockchainAccountIdRequest)(nil), "hypersign.ssi.v1.QueryDidDocumentByBlockchainAccountIdRequest")
+	proto.RegisterType((*QueryDidDocumentByBlockchainAccountIdResponse)(nil), "hypersign.ssi.v1.QueryDidDocumentByBlockchainAccountIdResponse")
+	proto.RegisterType((*QueryCredentialSchemasByAuthorRequest)(nil), "hypersign.ssi.v1.QueryCredentialSchemasByAuthorRequest")
+	proto.RegisterType((*QueryCredentialSchemasByAuthorResponse)(nil), "hypersign.ssi.v1.QueryCredentialSchemasByAuthorResponse")
+	proto.RegisterType((*QueryCredentialStatusesByIssuerRequest)(nil), "hypersign.ssi.v1.QueryCredentialStatusesByIssuerRequest")
+	proto.RegisterType((*QueryCredentialStatusesByIssuerResponse)(nil), "hypersign.ssi.v1.QueryCredentialStatusesByIssuerResponse")
+	proto.RegisterType((*QueryVerifyDocumentProofRequest)(nil), "hypersign.ssi.v1.QueryVerifyDocumentProofRequest")
+	proto.RegisterType((*QueryVerifyDocumentProofResponse)(nil), "hypersign.ssi.v1.QueryVerifyDocumentProofResponse")
+	proto.RegisterType((*ProofVerificationCheck)(nil), "hypersign.ssi.v1.ProofVerificationCheck")
+	proto.RegisterType((*QueryValidateSSIMsgRequest)(nil), "hypersign.ssi.v1.QueryValidateSSIMsgRequest")
+	proto.RegisterType((*QueryValidateSSIMsgResponse)(nil), "hypersign.ssi.v1.QueryValidateSSIMsgResponse")
+	proto.RegisterType((*SSIMsgViolation)(nil), "hypersign.ssi.v1.SSIMsgViolation")
+	proto.RegisterType((*QueryVerifyCredentialRequest)(nil), "hypersign.ssi.v1.QueryVerifyCredentialRequest")
+	proto.RegisterType((*QueryVerifyCredentialResponse)(nil), "hypersign.ssi.v1.QueryVerifyCredentialResponse")
+}
+
+func init() { proto.RegisterFile("hypersign/ssi/v1/query.proto", fileDescriptor_faf2a72d2769ce79) }
+
+var fileDescriptor_faf2a72d2769ce79 = []byte{
+	// 2768 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0xdc, 0xc6,
+	0xf5, 0x37, 0x25, 0x5b, 0x96, 0x9e, 0x15, 0x7f, 0x8c, 0x14, 0x45, 0xa2, 0xad, 0xb5, 0xcd, 0xf8,
+	0x43, 0x96, 0xbd, 0x4b, 0x6b, 0xe5, 0xbf, 0x9c, 0x38, 0x8e, 0x1d, 0xaf, 0x14, 0xe7, 0xaf, 0x20,
+	0x86, 0x5d, 0xca, 0x71, 0x8a, 0x00, 0x89, 0x4a, 0x91, 0xa3, 0xdd, 0x69, 0x76, 0xc9, 0x0d, 0xc9,
+	0xdd, 0x7a, 0x21, 0x08, 0x05, 0x0a, 0xa4, 0xcd, 0xa1, 0x2d, 0x02, 0xb4, 0x87, 0xa2, 0x40, 0x6f,
+	0x45, 0x0e, 0x29, 0x7a, 0x68, 0xd1, 0xa0, 0x45, 0x7b, 0x2a, 0x0a, 0x14, 0x2e, 0x5a, 0x14, 0x06,
+	0x8a, 0x02, 0x3d, 0x05, 0x85, 0xdd, 0x5e, 0x7a, 0xef, 0xa1, 0xe8, 0xa5, 0xe0, 0xcc, 0x90, 0x4b,
+	0x2e, 0x87, 0x4b, 0xae, 0xa4, 0x14, 0x3d, 0xed, 0xce, 0xcc, 0x7b, 0x6f, 0x7e, 0xef, 0x63, 0x66,
+	0xde, 0x1b, 0x0e, 0x9c, 0xa8, 0x75, 0x9a, 0xd8, 0x71, 0x49, 0xd5, 0x52, 0x5d, 0x97, 0xa8, 0xed,
+	0x05, 0xf5, 0xfd, 0x16, 0x76, 0x3a, 0xa5, 0xa6, 0x63, 0x7b, 0x36, 0x3a, 0x1a, 0x8e, 0x96, 0x5c,
+	0x97, 0x94, 0xda, 0x0b, 0xf2, 0x64, 0xd5, 0xae, 0xda, 0x74, 0x50, 0xf5, 0xff, 0x31, 0x3a, 0xf9,
+	0x44, 0xd5, 0xb6, 0xab, 0x75, 0xac, 0xea, 0x4d, 0xa2, 0xea, 0x96, 0x65, 0x7b, 0xba, 0x47, 0x6c,
+	0xcb, 0xe5, 0xa3, 0x33, 0x7c, 0x94, 0xb6, 0x36, 0x5a, 0x9b, 0xaa, 0x6e, 0xf1, 0x09, 0xe4, 0x79,
+	0xc3, 0x76, 0x1b, 0xb6, 0xab, 0x6e, 0xe8, 0x2e, 0x66, 0x33, 0xab, 0xed, 0x85, 0x0d, 0xec, 0xe9,
+	0x0b, 0x6a, 0x53, 0xaf, 0x12, 0x8b, 0xca, 0xe1, 0xb4, 0x73, 0x09, 0xa8, 0x86, 0x83, 0x4d, 0x6c,
+	0x79, 0x44, 0xaf, 0xaf, 0xbb, 0x46, 0x0d, 0x37, 0x74, 0x4e, 0x29, 0x27, 0x28, 0x4d, 0x62, 0xf2,
+	0xb1, 0xe7, 0x45, 0x63, 0xeb, 0x0e, 0x36, 0xec, 0x76, 0xa8, 0xb7, 0x5c, 0x88, 0xc2, 0x0a, 0x00,
+	0x19, 0x36, 0xc9, 0x07, 0xc5, 0xd3, 0xbd, 0x56, 0xa0, 0x7b, 0x31, 0x9b, 0x72, 0xbd, 0x4e, 0x5c,
+	0x8f, 0x93, 0x9f, 0x49, 0x90, 0xeb, 0x86, 0xcf, 0x40, 0xbc, 0xa8, 0x25, 0x0a, 0x09, 0xaa, 0x2a,
+	0xb6, 0xb0, 0x4b, 0x82, 0x49, 0x93, 0x4e, 0x6d, 0x3a, 0xb6, 0xbd, 0xc9, 0x47, 0x67, 0x3d, 0x6c,
+	0x99, 0xd8, 0x69, 0x10, 0xcb, 0x53, 0x0d, 0xa7, 0xd3, 0xf4, 0xec, 0xe8, 0xb0, 0x32, 0x09, 0xe8,
+	0x0b, 0xbe, 0x23, 0xee, 0xe9, 0x8e, 0xde, 0x70, 0x35, 0xfc, 0x7e, 0x0b, 0xbb, 0x9e, 0x72, 0x07,
+	0x26, 0x62, 0xbd, 0x6e, 0xd3, 0xb6, 0x5c, 0x8c, 0x96, 0x60, 0xa4, 0x49, 0x7b, 0xa6, 0xa5, 0x53,
+	0xd2, 0xdc, 0xa1, 0xf2, 0x74, 0xa9, 0x37, 0x62, 0x4a, 0x8c, 0xa3, 0xb2, 0xff, 0xd1, 0x67, 0x27,
+	0xf7, 0x69, 0x9c, 0x3a, 0x9c, 0x64, 0x6d, 0x6d, 0xf5, 0x36, 0xc6, 0xc1, 0x24, 0x8f, 0x0f, 0xc0,
+	0x44, 0xac, 0x9b, 0xcf, 0xb2, 0x0c, 0x47, 0x1d, 0x5c, 0x25, 0xae, 0x87, 0x9d, 0x75, 0xdf, 0x5b,
+	0x9b, 0x18, 0xf3, 0xf9, 0x66, 0x4a, 0xcc, 0x53, 0x25, 0xdf, 0x53, 0x25, 0xee, 0xa9, 0xd2, 0xb2,
+	0x4d, 0x2c, 0xed, 0x70, 0xc0, 0xb2, 0x42, 0xcc, 0xdb, 0x18, 0xa3, 0x9b, 0x70, 0xb8, 0xd5, 0x34,
+	0x75, 0x0f, 0x87, 0x22, 0x86, 0xb2, 0x44, 0x8c, 0x33, 0x06, 0x2e, 0xe0, 0x35, 0x40, 0x26, 0xd6,
+	0x0d, 0x8f, 0xb4, 0xa3, 0x42, 0x86, 0xb3, 0x84, 0x1c, 0xed, 0x32, 0x71, 0x41, 0xef, 0x42, 0x21,
+	0x54, 0x27, 0x11, 0xc2, 0x54, 0xe8, 0xfe, 0x2c, 0xa1, 0xc7, 0x03, 0x01, 0xcb, 0x21, 0xff, 0x1a,
+	0x65, 0xf7, 0xe5, 0xbf, 0x0d, 0x27, 0xb8, 0xa6, 0x62, 0xe9, 0x07, 0xb2, 0xa4, 0xcf, 0x30, 0x76,
+	0x91, 0xec, 0x34, 0xec, 0x2c, 0x92, 0x7d, 0xe9, 0x23, 0x3b, 0xc1, 0x4e, 0xd9, 0xd3, 0xb1, 0x77,
+	0xa5, 0x1f, 0x1c, 0x1c, 0x7b, 0x28, 0xdb, 0x81, 0x0b, 0x7d, 0xb0, 0x6f, 0xe8, 0x9e, 0x51, 0x5b,
+	0x27, 0x1e, 0x6e, 0xd0, 0x89, 0x46, 0xb3, 0x26, 0x3a, 0x93, 0xa6, 0x46, 0xc5, 0x17, 0xb4, 0xea,
+	0xe1, 0xc6, 0x6d, 0x8c, 0x95, 0x3a, 0x9c, 0xa0, 0x11, 0xdd, 0x6b, 0x4b, 0x1e, 0xf2, 0x48, 0x86,
+	0x51, 0xe6, 0x99, 0x55, 0x93, 0x86, 0xf4, 0x98, 0x16, 0xb6, 0xd1, 0x34, 0x1c, 0x6c, 0x63, 0xc7,
+	0x25, 0xb6, 0x45, 0x43, 0x75, 0x4c, 0x0b, 0x9a, 0x68, 0x0a, 0x46, 0xea, 0xba, 0x87, 0x5d, 0x8f,
+	0x86, 0xdf, 0xa8, 0xc6, 0x5b, 0x4a, 0x1b, 0x66, 0x53, 0x66, 0xe3, 0x2b, 0xe9, 0x4d, 0x38, 0x66,
+	0xf4, 0x8c, 0xf9, 0x4b, 0x77, 0x78, 0xee, 0x50, 0xf9, 0x7c, 0x72, 0xe9, 0xf6, 0x8a, 0xf1, 0xf5,
+	0xc3, 0x5a, 0x52, 0x82, 0x52, 0x4d, 0x99, 0x37, 0xd8, 0x3e, 0xd0, 0x6d, 0x80, 0xee, 0x7e, 0xce,
+	0xd7, 0xee, 0xb9, 0x98, 0x6d, 0xd9, 0xb1, 0x13, 0x58, 0xf8, 0x9e, 0x5e, 0x0d, 0x76, 0x05, 0x2d,
+	0xc2, 0xa9, 0x7c, 0x4b, 0x82, 0x42, 0xda, 0x4c, 0x5c, 0xc5, 0x49, 0x38, 0x60, 0xd8, 0x2d, 0xcb,
+	0xa3, 0xb3, 0xec, 0xd7, 0x58, 0x43, 0xac, 0xf8, 0xd0, 0xae, 0x15, 0x5f, 0x4a, 0xba, 0x97, 0xc6,
+	0x40, 0xa0, 0xf7, 0x14, 0x8c, 0xf8, 0x4c, 0xa1, 0x73, 0x79, 0x4b, 0xf1, 0x60, 0x36, 0x85, 0x8f,
+	0x6b, 0xb1, 0x06, 0x47, 0x8d, 0x9e, 0x31, 0x6e, 0xb6, 0xfe, 0x70, 0x29, 0x25, 0x83, 0x9b, 0x10,
+	0xa0, 0xd4, 0x92, 0xc6, 0xa3, 0x03, 0x78, 0xcf, 0xfd, 0xf4, 0x91, 0x04, 0x27, 0x53, 0xa7, 0xea,
+	0xeb, 0xa8, 0xb7, 0x00, 0x19, 0x09, 0x9e, 0x5c, 0x9e, 0x8a, 0xa8, 0x2e, 0x10, 0xa1, 0x2c, 0xc2,
+	0x69, 0x21, 0x22, 0xba, 0x5c, 0x03, 0xfd, 0x0f, 0xc3, 0x10, 0x09, 0x7c, 0x35, 0x44, 0x4c, 0xe5,
+	0x43, 0x09, 0x94, 0x7e, 0x5c, 0x5c, 0x95, 0x0d, 0x78, 0xd6, 0x10, 0x11, 0x70, 0x0b, 0x5e, 0xca,
+	0xc6, 0x4d, 0xc9, 0x19, 0x78, 0xb1, 0x28, 0xa5, 0x0c, 0xa7, 0x84, 0x48, 0xde, 0x20, 0xae, 0x97,
+	0x06, 0xff, 0xdf, 0x12, 0x9c, 0xee, 0xc3, 0xc4, 0xd1, 0xb7, 0x60, 0x76, 0x83, 0x78, 0xae, 0xe7,
+	0x10, 0xab, 0xda, 0x1d, 0xee, 0xb2, 0x70, 0x2d, 0xd4, 0xa4, 0x16, 0x95, 0x7e, 0x6c, 0x5a, 0x7f,
+	0xa9, 0x68, 0x1d, 0x26, 0x0d, 0x01, 0x2c, 0x7e, 0x2c, 0x5f, 0xcc, 0xb6, 0x99, 0x4f, 0xcd, 0x4c,
+	0x26, 0x14, 0xa4, 0x5c, 0x84, 0x19, 0xaa, 0xfc, 0xad, 0x68, 0x0a, 0x95, 0x66, 0xaa, 0x1a, 0xc8,
+	0x22, 0x62, 0x6e, 0xa2, 0xd7, 0xe1, 0x99, 0x58, 0x22, 0xc6, 0x4d, 0x72, 0x26, 0x09, 0x32, 0xc6,
+	0xcf, 0xd0, 0xc5, 0x59, 0x95, 0x6d, 0xbe, 0x34, 0x56, 0x5d, 0xb7, 0x85, 0x1d, 0x21, 0xb8, 0x29,
+	0x18, 0x21, 0x74, 0x34, 0xd8, 0x36, 0x58, 0x0b, 0x95, 0x00, 0xf5, 0xee, 0x41, 0xab, 0x26, 0x3f,
+	0x1c, 0x04, 0x23, 0x08, 0xc1, 0x7e, 0x8f, 0x34, 0x58, 0x92, 0x32, 0xa6, 0xd1, 0xff, 0xca, 0xf7,
+	0x24, 0x38, 0x95, 0x3e, 0x3f, 0xd7, 0xb7, 0x00, 0x10, 0x80, 0xc6, 0xcc, 0x4a, 0xa3, 0x5a, 0xa4,
+	0x07, 0xdd, 0x07, 0x14, 0x53, 0x6a, 0xb9, 0xa6, 0x13, 0x8b, 0xaf, 0xd2, 0x7c, 0x46, 0x11, 0xf0,
+	0x2b, 0x1f, 0x48, 0xf0, 0x1c, 0x85, 0xb6, 0x42, 0xcc, 0x15, 0xdb, 0x68, 0x35, 0xb0, 0x15, 0x86,
+	0xf6, 0x24, 0x1c, 0x30, 0x49, 0x77, 0x23, 0x65, 0x0d, 0x74, 0x02, 0xc6, 0xf8, 0x99, 0x18, 0xda,
+	0xa1, 0xdb, 0x81, 0x4e, 0xc1, 0x21, 0xde, 0xb8, 0xdf, 0xb5, 0x42, 0xb4, 0xcb, 0x97, 0xda, 0x74,
+	0xec, 0x36, 0xcb, 0xb8, 0x46, 0x35, 0xd6, 0x50, 0x3e, 0x1d, 0x82, 0xe9, 0x24, 0x0e, 0x6e, 0x9a,
+	0x9b, 0x70, 0xc8, 0xec, 0x76, 0xf3, 0x40, 0x98, 0x4d, 0xea, 0x1c, 0xe5, 0x8d, 0x72, 0xa0, 0xb7,
+	0x60, 0x22, 0xd2, 0xbc, 0x83, 0x3d, 0xdd, 0xd4, 0x3d, 0x9d, 0x87, 0xfd, 0xd9, 0xbe, 0x82, 0x02,
+	0x62, 0x4d, 0x24, 0x01, 0x2d, 0x50, 0x65, 0xec, 0x4d, 0x9e, 0x93, 0x1e, 0x2f, 0x75, 0x13, 0xfd,
+	0x12, 0x4b, 0xf4, 0x4b, 0xf7, 0xfc, 0xf1, 0xbb, 0x4d, 0x57, 0x63, 0x94, 0x7e, 0xa0, 0xd5, 0x30,
+	0xa9, 0xd6, 0x3c, 0x6a, 0x80, 0x61, 0x8d, 0xb7, 0xd0, 0x15, 0x78, 0x36, 0x32, 0x03, 0xf5, 0xd8,
+	0x03, 0xbd, 0xde, 0x62, 0xb9, 0xe3, 0xb8, 0x26, 0x1e, 0x54, 0x36, 0x92, 0x66, 0xdb, 0xf3, 0x93,
+	0xa5, 0x03, 0x33, 0x82, 0x39, 0xfa, 0x1e, 0x29, 0xb7, 0x61, 0x3c, 0x82, 0x37, 0x38, 0x4c, 0x94,
+	0xbe, 0x96, 0x66, 0x41, 0x1a, 0xe3, 0x53, 0xbe, 0x2e, 0xc1, 0x14, 0x9d, 0x5b, 0xc3, 0xae, 0x5d,
+	0x6f, 0xfb, 0xe9, 0xfc, 0xe7, 0x1b, 0x9d, 0x53, 0x30, 0xa2, 0x1b, 0x06, 0x6e, 0x32, 0xef, 0x8c,
+	0x69, 0xbc, 0xa5, 0xfc, 0x6a, 0x08, 0x9e, 0x4b, 0x00, 0xe1, 0x26, 0x38, 0x0f, 0x07, 0x0d, 0xdb,
+	0xf2, 0xf0, 0x43, 0x8f, 0xe6, 0x75, 0x63, 0x95, 0xf1, 0x7f, 0x7c, 0x76, 0x72, 0xf4, 0x15, 0xde,
+	0xa7, 0x85, 0xff, 0xd0, 0x3b, 0xd4, 0xc5, 0x54, 0x42, 0xcb, 0xb7, 0x6c, 0x4f, 0x20, 0x9e, 0x17,
+	0x9a, 0x27, 0x49, 0xae, 0x89, 0xa5, 0xf4, 0x2e, 0x93, 0xe1, 0xbd, 0x5a, 0x26, 0xfb, 0x77, 0xbb,
+	0x4c, 0x94, 0xbb, 0x3c, 0xf7, 0x5a, 0xc1, 0x0e, 0xde, 0xc4, 0x0e, 0xb6, 0x0c, 0xdf, 0x80, 0x6f,
+	0x3a, 0xf5, 0xc8, 0xee, 0x6b, 0xd2, 0x8e, 0x60, 0xf7, 0x65, 0xad, 0x88, 0x3b, 0x86, 0x62, 0xee,
+	0xf8, 0xfb, 0x30, 0x14, 0xd2, 0x24, 0xee, 0xc4, 0x2b, 0xa1, 0x14, 0x62, 0x55, 0x77, 0xee, 0x15,
+	0x91, 0x94, 0xdd, 0x7b, 0xe5, 0x3e, 0xa0, 0x36, 0x76, 0xc8, 0x26, 0x31, 0x74, 0x3e, 0x5f, 0xcd,
+	0x36, 0xb9, 0x53, 0x04, 0x1b, 0xff, 0x83, 0x04, 0xad, 0x26, 0xe0, 0x47, 0x8b, 0x70, 0xd0, 0xc5,
+	0x4e, 0x9b, 0x18, 0xdd, 0xe2, 0x34, 0x21, 0x6a, 0x8d, 0x11, 0x68, 0x01, 0xa5, 0x7f, 0x46, 0x51,
+	0xab, 0x59, 0x9e, 0xef, 0xaa, 0x11, 0xea, 0x92, 0x48, 0x0f, 0xba, 0x0b, 0x47, 0x78, 0x2b, 0x34,
+	0xe2, 0xc1, 0x41, 0x82, 0xa7, 0x97, 0x5b, 0xf9, 0x2a, 0x3f, 0xb8, 0x23, 0xc4, 0x0f, 0xd8, 0x6a,
+	0x75, 0xfb, 0xef, 0x03, 0xf1, 0xbd, 0x6f, 0x68, 0xc7, 0x7b, 0xdf, 0xaf, 0x83, 0xa3, 0x5b, 0x88,
+	0x80, 0x87, 0xda, 0xfd, 0xd8, 0xba, 0x09, 0x86, 0xa7, 0xa5, 0xdc, 0x9b, 0x9e, 0x88, 0x1d, 0xbd,
+	0x26, 0x50, 0xe1, 0x7c, 0xa6, 0x0a, 0x0c, 0x52, 0x4c, 0x87, 0x25, 0xbe, 0x56, 0xee, 0x61, 0xcb,
+	0x24, 0x56, 0x95, 0x46, 0x2f, 0xbb, 0x7b, 0xeb, 0x6b, 0x43, 0xe5, 0x2b, 0x70, 0x32, 0x95, 0x2f,
+	0xd4, 0x1c, 0x35, 0x13, 0xa3, 0xe9, 0x99, 0x9a, 0x40, 0x92, 0x80, 0x5f, 0xf9, 0x32, 0xb7, 0x79,
+	0x82, 0x9c, 0xec, 0x7d, 0xd9, 0xf4, 0xdb, 0x20, 0x5f, 0x17, 0x4f, 0xc6, 0xf5, 0xfc, 0x22, 0x4c,
+	0x36, 0x05, 0xe3, 0xdc, 0xc5, 0xf9, 0x34, 0x15, 0x4a, 0xd8, 0x3b, 0x2f, 0x7f, 0x5b, 0x82, 0x33,
+	0x89, 0x63, 0xba, 0xd2, 0x59, 0xb6, 0x2d, 0xcf, 0xb1, 0xeb, 0x75, 0xec, 0x04, 0x96, 0xe3, 0x8b,
+	0x98, 0x75, 0x72, 0x8f, 0x47, 0x7a, 0xf6, 0x6c, 0xe9, 0xfc, 0x42, 0x82, 0xb3, 0x19, 0x80, 0xb8,
+	0x75, 0x7b, 0xb3, 0x05, 0x69, 0x67, 0xd9, 0xc2, 0xde, 0xd9, 0xf2, 0x4b, 0x70, 0xa9, 0x17, 0x79,
+	0xa5, 0x53, 0xa9, 0xdb, 0xc6, 0x7b, 0x86, 0x9f, 0x35, 0xdf, 0x32, 0x68, 0x96, 0xb3, 0x1a, 0xe6,
+	0x22, 0x97, 0x61, 0x62, 0x23, 0x39, 0xca, 0x6d, 0x2b, 0x1a, 0x52, 0x7e, 0x27, 0x41, 0x31, 0xe7,
+	0x14, 0xff, 0xeb, 0x49, 0xb0, 0xf2, 0x8d, 0xc0, 0xd1, 0x89, 0x1b, 0xa2, 0x4a, 0xe7, 0x56, 0xcb,
+	0xab, 0xd9, 0x4e, 0xe4, 0x98, 0xd7, 0x69, 0x47, 0x70, 0xcc, 0xb3, 0xd6, 0x9e, 0x85, 0xdc, 0x23,
+	0x09, 0xce, 0x65, 0x21, 0xf9, 0x5c, 0xaf, 0xe5, 0xf6, 0x2e, 0x04, 0x3f, 0x14, 0xa8, 0xc2, 0xef,
+	0x55, 0x2a, 0xbc, 0x8e, 0xcc, 0x2a, 0x5d, 0xf7, 0xca, 0xaa, 0xbf, 0x97, 0xe0, 0x7c, 0x26, 0x14,
+	0x6e, 0x56, 0xf1, 0x5d, 0x92, 0xb4, 0xeb, 0xbb, 0xa4, 0xbd, 0x33, 0xec, 0xbf, 0x86, 0xf8, 0xb1,
+	0x46, 0x13, 0xa5, 0x4e, 0x10, 0xcd, 0xb4, 0x4c, 0x0b, 0x2c, 0xba, 0xeb, 0xb5, 0xb6, 0x09, 0xd3,
+	0xbd, 0xb1, 0x11, 0x4a, 0x63, 0xd8, 0xe7, 0xb3, 0x83, 0x2c, 0x14, 0x9d, 0x2a, 0xab, 0x67, 0x1e,
+	0x6a, 0xab, 0x9e, 0x4c, 0x73, 0x3e, 0xdb, 0xe8, 0xc2, 0x79, 0x62, 0x23, 0xe8, 0x55, 0x78, 0xc6,
+	0x8c, 0x1a, 0x8a, 0xa7, 0x9f, 0x27, 0x05, 0x26, 0x89, 0xd9, 0x33, 0xce, 0xa5, 0xfc, 0x30, 0xc8,
+	0xa6, 0x84, 0xb6, 0xe7, 0x21, 0x24, 0xc3, 0x28, 0xcb, 0x57, 0xc3, 0x6b, 0x90, 0xb0, 0x8d, 0x5e,
+	0x81, 0x11, 0xa3, 0x86, 0x8d, 0xf7, 0x82, 0x8a, 0x72, 0x4e, 0x70, 0xf2, 0xfa, 0xc2, 0xa2, 0x49,
+	0xf0, 0xb2, 0xcf, 0xa0, 0x71, 0x3e, 0xa4, 0xc0, 0xb8, 0x4f, 0x4d, 0xac, 0xea, 0xaa, 0xd5, 0x6c,
+	0x79, 0xbc, 0x06, 0x8c, 0xf5, 0x29, 0xef, 0xc2, 0x94, 0x58, 0x8a, 0x7f, 0xbb, 0x63, 0xe9, 0x0d,
+	0xcc, 0x17, 0x1a, 0xfd, 0xef, 0x2f, 0xbf, 0xa6, 0xee, 0xba, 0x98, 0xd5, 0x9b, 0xa3, 0x1a, 0x6f,
+	0xf9, 0xdf, 0x12, 0x1a, 0xd8, 0x75, 0xf5, 0x6a, 0x50, 0x68, 0x06, 0x4d, 0x65, 0x85, 0x5f, 0x7c,
+	0x3d, 0xd0, 0xeb, 0xc4, 0xd4, 0x3d, 0xbc, 0xb6, 0xb6, 0x7a, 0xc7, 0xad, 0x06, 0xc1, 0x77, 0x0e,
+	0x86, 0x1b, 0x6e, 0x95, 0x07, 0xdd, 0x64, 0x89, 0x7d, 0xc9, 0x2d, 0x05, 0x5f, 0x72, 0x4b, 0xb7,
+	0xac, 0x8e, 0xe6, 0x13, 0x28, 0x6d, 0x38, 0x2e, 0x94, 0xd2, 0x2d, 0xcc, 0xdb, 0xfe, 0x08, 0xb7,
+	0x21, 0x6b, 0xa0, 0x5b, 0x00, 0x6d, 0x62, 0xd7, 0xa9, 0x4a, 0x81, 0x11, 0x4f, 0x0b, 0x32, 0x7f,
+	0x2a, 0xeb, 0x41, 0x40, 0xa9, 0x45, 0x98, 0x94, 0x77, 0xe0, 0x48, 0xcf, 0xb0, 0x5f, 0x75, 0x1b,
+	0xb6, 0x89, 0xdd, 0xa6, 0x6e, 0x04, 0xb6, 0xe9, 0x76, 0xf8, 0x46, 0xf3, 0x1b, 0xd4, 0x3c, 0xcf,
+	0x68, 0xf4, 0x7f, 0x1f, 0xe3, 0xdc, 0xe0, 0xf7, 0xfb, 0x2c, 0x44, 0x22, 0x77, 0x9b, 0x91, 0xf4,
+	0x25, 0x7e, 0x4f, 0x3a, 0xa6, 0x45, 0x7a, 0x94, 0x6d, 0x98, 0x4d, 0xe1, 0xff, 0x6f, 0xc4, 0x57,
+	0xf9, 0x13, 0x05, 0x0e, 0xd0, 0xf9, 0xd1, 0x4f, 0x25, 0x98, 0xec, 0x5d, 0xd2, 0x95, 0xce, 0xea,
+	0x0a, 0x2a, 0x25, 0x85, 0xf6, 0xfb, 0x60, 0x25, 0xab, 0xb9, 0xe9, 0x99, 0x86, 0xca, 0x8b, 0x5f,
+	0xfb, 0xd3, 0xdf, 0xbe, 0x33, 0xb4, 0x88, 0x16, 0xd4, 0x90, 0xb1, 0x48, 0xe3, 0xc7, 0xb0, 0xeb,
+	0x6a, 0x8d, 0x98, 0x96, 0x6d, 0x62, 0xfa, 0x99, 0x9a, 0x7d, 0xf7, 0x52, 0xb7, 0x82, 0xef, 0x5f,
+	0xdb, 0xe8, 0x63, 0x09, 0x8e, 0x2d, 0x27, 0x4e, 0xb5, 0xbc, 0x08, 0x82, 0xec, 0x5c, 0xbe, 0x9c,
+	0x9f, 0x81, 0x63, 0x2e, 0x51, 0xcc, 0x73, 0xe8, 0x5c, 0x3e, 0xcc, 0xe8, 0x07, 0x12, 0x1c, 0x89,
+	0xe5, 0x4e, 0xab, 0x2b, 0xe8, 0x42, 0xca, 0xac, 0xc9, 0xbb, 0x4d, 0x79, 0x3e, 0x0f, 0x29, 0x87,
+	0xb6, 0x48, 0xa1, 0x15, 0xd1, 0xc5, 0x2c, 0x68, 0x26, 0x31, 0xd5, 0x2d, 0x5a, 0x3b, 0x6d, 0xa3,
+	0xef, 0x4b, 0x00, 0xdd, 0xbb, 0x22, 0x34, 0x97, 0x32, 0x5f, 0xe2, 0x5e, 0x4b, 0xbe, 0x90, 0x83,
+	0x92, 0x03, 0xbb, 0x4a, 0x81, 0x2d, 0x20, 0x35, 0x0b, 0x98, 0xc3, 0x78, 0x43, 0x70, 0x3f, 0x92,
+	0xe0, 0x58, 0xe2, 0xe6, 0x24, 0xd5, 0xcb, 0x69, 0xb7, 0x36, 0xf2, 0xe5, 0xfc, 0x0c, 0x03, 0x9b,
+	0xb2, 0x2b, 0x02, 0xfd, 0x52, 0x82, 0x09, 0x41, 0xf9, 0x8d, 0x16, 0xb2, 0x7d, 0xd8, 0x73, 0x59,
+	0x20, 0x97, 0x07, 0x61, 0xe1, 0x98, 0xaf, 0x53, 0xcc, 0x4b, 0xe8, 0xca, 0x00, 0xee, 0x57, 0xdb,
+	0x01, 0xc8, 0x9f, 0x4b, 0x80, 0x92, 0xc5, 0x20, 0x4a, 0x33, 0x5d, 0x6a, 0x8d, 0x2e, 0x2f, 0x0c,
+	0xc0, 0xb1, 0x1b, 0xe4, 0xc1, 0xbb, 0x1c, 0xf4, 0xa9, 0x04, 0x93, 0xa2, 0xa2, 0x18, 0x95, 0xf3,
+	0x22, 0xe9, 0x96, 0xeb, 0xf2, 0xe2, 0x40, 0x3c, 0x1c, 0xff, 0x15, 0x8a, 0xbf, 0x84, 0x2e, 0xe5,
+	0xc0, 0x5f, 0x0c, 0x71, 0x7f, 0x57, 0x82, 0xf1, 0x68, 0xc9, 0x89, 0x72, 0xac, 0xf5, 0x10, 0xe7,
+	0xc5, 0x5c, 0xb4, 0x1c, 0xdf, 0x45, 0x8a, 0xef, 0x2c, 0x7a, 0x3e, 0x07, 0x3e, 0xf4, 0x58, 0x82,
+	0xe9, 0xb4, 0x4a, 0x18, 0x2d, 0xe5, 0x98, 0x56, 0x50, 0xcb, 0xcb, 0x57, 0x07, 0xe6, 0xe3, 0xd0,
+	0x97, 0x29, 0xf4, 0x97, 0xd1, 0x4b, 0x59, 0xd0, 0xbb, 0x17, 0x03, 0xea, 0x56, 0xf7, 0xff, 0x36,
+	0x55, 0xe9, 0x9f, 0x12, 0x9c, 0xca, 0xaa, 0x5f, 0xd1, 0x8d, 0x6c, 0x88, 0xfd, 0x6a, 0x6b, 0xf9,
+	0xe6, 0x8e, 0xf9, 0xb9, 0xaa, 0xf7, 0xa8, 0xaa, 0xaf, 0xa3, 0xff, 0xcf, 0x52, 0xb5, 0x5b, 0xa7,
+	0x17, 0x75, 0x26, 0x45, 0xdd, 0x12, 0xd4, 0xee, 0xdb, 0xe8, 0x8f, 0x12, 0xcc, 0xa4, 0x56, 0x98,
+	0xe8, 0x6a, 0xde, 0xb3, 0xaf, 0xa7, 0x3a, 0x96, 0x5f, 0x18, 0x9c, 0x91, 0xab, 0x78, 0x83, 0xaa,
+	0xf8, 0x02, 0x5a, 0xca, 0x52, 0x91, 0xd5, 0xdb, 0xea, 0x16, 0xfb, 0xdd, 0x0e, 0x0e, 0xd3, 0x3f,
+	0x4b, 0x20, 0xa7, 0x17, 0x77, 0x28, 0x07, 0x30, 0x71, 0x69, 0x2a, 0xbf, 0xb8, 0x03, 0x4e, 0xae,
+	0x53, 0x85, 0xea, 0x74, 0x1d, 0x5d, 0xcb, 0xd2, 0x89, 0x55, 0xbb, 0xea, 0x16, 0xfb, 0xdd, 0x8e,
+	0xbc, 0xf6, 0x43, 0x3f, 0x8b, 0xa7, 0x60, 0xec, 0xd3, 0x7e, 0xce, 0x14, 0x2c, 0xfa, 0xa8, 0x44,
+	0x56, 0x73, 0xd3, 0x73, 0xf4, 0x2f, 0x51, 0xf4, 0xff, 0x87, 0x16, 0x33, 0xd7, 0x57, 0x28, 0x41,
+	0xdd, 0x62, 0x2f, 0x55, 0xb6, 0xd1, 0x8f, 0x25, 0x40, 0x49, 0x0b, 0xa1, 0xcb, 0xb9, 0x8d, 0x99,
+	0x75, 0x66, 0xa4, 0x3f, 0x11, 0x51, 0xca, 0x14, 0xf8, 0x25, 0x34, 0x9f, 0x1f, 0x38, 0xfa, 0x43,
+	0x7c, 0x3d, 0x74, 0x5f, 0x50, 0x50, 0x5b, 0x2f, 0xe6, 0x04, 0x11, 0x7d, 0x15, 0x22, 0x5f, 0x19,
+	0x8c, 0x89, 0x83, 0x5f, 0xa1, 0xe0, 0x6f, 0xa0, 0xeb, 0xf9, 0xc1, 0x17, 0xd9, 0x5b, 0xb4, 0x22,
+	0x7d, 0x8b, 0xa6, 0x6e, 0x11, 0x73, 0x1b, 0x3d, 0x92, 0x60, 0x5a, 0xf4, 0xf0, 0x81, 0x6a, 0x53,
+	0xce, 0x09, 0x2c, 0xf2, 0x46, 0x44, 0x5e, 0x1c, 0x88, 0x67, 0xe0, 0x1d, 0x3a, 0xa1, 0x4b, 0x9d,
+	0xb8, 0x1e, 0x53, 0xe5, 0x13, 0x09, 0x8e, 0xc5, 0x5e, 0x02, 0x50, 0x1d, 0xd2, 0x0e, 0x39, 0xd1,
+	0xc3, 0x08, 0xf9, 0x52, 0x3e, 0x62, 0x8e, 0xfa, 0x1a, 0x45, 0x7d, 0x05, 0x95, 0x33, 0x77, 0xa2,
+	0x28, 0x3b, 0x03, 0xfb, 0x1b, 0x09, 0x26, 0x04, 0x2f, 0x24, 0x52, 0xf3, 0xbc, 0xf4, 0xd7, 0x1c,
+	0x72, 0x79, 0x10, 0x16, 0x0e, 0xfd, 0x55, 0x0a, 0xfd, 0x26, 0x7a, 0x79, 0xd0, 0x0d, 0x27, 0xa6,
+	0x8a, 0x9f, 0x36, 0x4d, 0x08, 0xae, 0x37, 0x52, 0xb5, 0x48, 0xbf, 0x86, 0x92, 0xcb, 0x83, 0xb0,
+	0xc4, 0x6b, 0x82, 0x6b, 0xd2, 0xbc, 0x92, 0x99, 0x36, 0xd1, 0xb2, 0xb7, 0x53, 0x64, 0xef, 0x12,
+	0x3e, 0x96, 0xe0, 0x70, 0xfc, 0x2a, 0x01, 0xa5, 0xb9, 0x5e, 0x78, 0x6f, 0x21, 0x17, 0x73, 0x52,
+	0xc7, 0x81, 0xe6, 0x40, 0xc9, 0xf9, 0x8b, 0x0d, 0xb7, 0x7a, 0x4d, 0x9a, 0x47, 0x3f, 0x91, 0xe0,
+	0x68, 0x6f, 0x71, 0x9f, 0xba, 0xa1, 0xa7, 0xdc, 0x22, 0xc8, 0x6a, 0x6e, 0xfa, 0x78, 0x2e, 0xed,
+	0xdb, 0x75, 0x21, 0xa7, 0x5d, 0x23, 0x3b, 0xe4, 0x07, 0x12, 0x8c, 0xb0, 0x57, 0xd9, 0xe8, 0x4c,
+	0x5a, 0x26, 0x1c, 0x7d, 0xfc, 0x2d, 0x9f, 0xcd, 0xa0, 0x1a, 0xb4, 0x6a, 0x66, 0x8f, 0xc0, 0xd1,
+	0x37, 0x25, 0x38, 0x14, 0x79, 0xee, 0x9d, 0x0a, 0x26, 0xf6, 0x48, 0x5c, 0x3e, 0x9b, 0x41, 0xc5,
+	0xc1, 0x5c, 0xa6, 0x60, 0xe6, 0xd1, 0x5c, 0x16, 0x98, 0x4d, 0xf2, 0x10, 0x9b, 0x9b, 0x18, 0x57,
+	0xde, 0x78, 0xf4, 0xa4, 0x20, 0x3d, 0x7e, 0x52, 0x90, 0xfe, 0xfa, 0xa4, 0x20, 0x7d, 0xf4, 0xb4,
+	0xb0, 0xef, 0xf1, 0xd3, 0xc2, 0xbe, 0xbf, 0x3c, 0x2d, 0xec, 0x7b, 0xbb, 0x5c, 0x25, 0x5e, 0xad,
+	0xb5, 0x51, 0x32, 0xec, 0x46, 0x8a, 0xb4, 0x22, 0x15, 0xf7, 0x90, 0x0a, 0xf4, 0x3a, 0x4d, 0xec,
+	0x6e, 0x8c, 0xd0, 0xe1, 0xc5, 0xff, 0x0c, 0x00, 0x8f, 0x21, 0x71, 0x3a, 0x61, 0x31, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Get the Schema Document for a specified schema id
+	CredentialSchemaByID(ctx context.Context, in *QueryCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryCredentialSchemaResponse, error)
+	// Get the count and list of registered Schemas
+	CredentialSchemas(ctx context.Context, in *QueryCredentialSchemasRequest, opts ...grpc.CallOption) (*QueryCredentialSchemasResponse, error)
+	// Get the Did Document for a specified DID id
+	DidDocumentByID(ctx context.Context, in *QueryDidDocumentRequest, opts ...grpc.CallOption) (*QueryDidDocumentResponse, error)
+	// Resolve a DID as per W3C DID Resolution specification
+	ResolveDid(ctx context.Context, in *QueryResolveDidRequest, opts ...grpc.CallOption) (*QueryResolveDidResponse, error)
+	// Dereference a DID URL as per W3C DID Core specification
+	DereferenceDidUrl(ctx context.Context, in *QueryDereferenceDidUrlRequest, opts ...grpc.CallOption) (*QueryDereferenceDidUrlResponse, error)
+	// Get every version of the Did Document for a specified DID id
+	DidDocumentVersions(ctx context.Context, in *QueryDidDocumentVersionsRequest, opts ...grpc.CallOption) (*QueryDidDocumentVersionsResponse, error)
+	// Get the pending recovery of a specified DID id
+	PendingDidRecovery(ctx context.Context, in *QueryPendingDidRecoveryRequest, opts ...grpc.CallOption) (*QueryPendingDidRecoveryResponse, error)
+	// Get the list of pending DID recoveries
+	PendingDidRecoveries(ctx context.Context, in *QueryPendingDidRecoveriesRequest, opts ...grpc.CallOption) (*QueryPendingDidRecoveriesResponse, error)
+	// Get the count and list of registered Did Documents
+	DidDocuments(ctx context.Context, in *QueryDidDocumentsRequest, opts ...grpc.CallOption) (*QueryDidDocumentsResponse, error)
+	// Get the Did Documents controlled by a specified DID
+	DidDocumentsByController(ctx context.Context, in *QueryDidDocumentsByControllerRequest, opts ...grpc.CallOption) (*QueryDidDocumentsByControllerResponse, error)
+	// Get the Did Document which owns a specified CAIP-10 blockchain account id
+	DidDocumentByBlockchainAccountId(ctx context.Context, in *QueryDidDocumentByBlockchainAccountIdRequest, opts ...grpc.CallOption) (*QueryDidDocumentByBlockchainAccountIdResponse, error)
+	// Get the Schemas authored by a specified DID
+	CredentialSchemasByAuthor(ctx context.Context, in *QueryCredentialSchemasByAuthorRequest, opts ...grpc.CallOption) (*QueryCredentialSchemasByAuthorResponse, error)
+	// Get the Credential Statuses issued by a specified DID
+	CredentialStatusesByIssuer(ctx context.Context, in *QueryCredentialStatusesByIssuerRequest, opts ...grpc.CallOption) (*QueryCredentialStatusesByIssuerResponse, error)
+	// Get the Credential Status for a given credential id
+	CredentialStatusByID(ctx context.Context, in *QueryCredentialStatusRequest, opts ...grpc.CallOption) (*QueryCredentialStatusResponse, error)
+	// Get all the registed Credential Statuses
+	CredentialStatuses(ctx context.Context, in *QueryCredentialStatusesRequest, opts ...grpc.CallOption) (*QueryCredentialStatusesResponse, error)
+	// Get the Credential Status Batch for a given id, through which Credential Statuses were registered
+	CredentialStatusBatchByID(ctx context.Context, in *QueryCredentialStatusBatchRequest, opts ...grpc.CallOption) (*QueryCredentialStatusBatchResponse, error)
+	// Get the Credential Status List for a given id, represented as a BitstringStatusListCredential
+	CredentialStatusListByID(ctx context.Context, in *QueryCredentialStatusListRequest, opts ...grpc.CallOption) (*QueryCredentialStatusListResponse, error)
+	// Get an Accreditation
+	AccreditationByID(ctx context.Context, in *QueryAccreditationRequest, opts ...grpc.CallOption) (*QueryAccreditationResponse, error)
+	// Check whether an issuer is accredited for a Credential Schema at a given time
+	IssuerAccreditation(ctx context.Context, in *QueryIssuerAccreditationRequest, opts ...grpc.CallOption) (*QueryIssuerAccreditationResponse, error)
+	// Verify the proof of a DID Document, Credential Schema or Credential Status without submitting a transaction
+	VerifyDocumentProof(ctx context.Context, in *QueryVerifyDocumentProofRequest, opts ...grpc.CallOption) (*QueryVerifyDocumentProofResponse, error)
+	// Run the validation of a x/ssi module message against the current state, without committing it
+	ValidateSSIMsg(ctx context.Context, in *QueryValidateSSIMsgRequest, opts ...grpc.CallOption) (*QueryValidateSSIMsgResponse, error)
+	// Verify a Verifiable Credential against the registered issuer DID, credential status and credential schema
+	VerifyCredential(ctx context.Context, in *QueryVerifyCredentialRequest, opts ...grpc.CallOption) (*QueryVerifyCredentialResponse, error)
+	// Get the parameters of x/ssi module
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Get the list of fixed fees for every x/ssi module transactions
+	QuerySSIFee(ctx context.Context, in *QuerySSIFeeRequest, opts ...grpc.CallOption) (*QuerySSIFeeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) CredentialSchemaByID(ctx context.Context, in *QueryCredentialSchemaRequest, opts ...grpc.CallOption) (*QueryCredentialSchemaResponse, error) {
+	out := new(QueryCredentialSchemaResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/CredentialSchemaByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialSchemas(ctx context.Context, in *QueryCredentialSchemasRequest, opts ...grpc.CallOption) (*QueryCredentialSchemasResponse, error) {
+	out := new(QueryCredentialSchemasResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/CredentialSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidDocumentByID(ctx context.Context, in *QueryDidDocumentRequest, opts ...grpc.CallOption) (*QueryDidDocumentResponse, error) {
+	out := new(QueryDidDocumentResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/DidDocumentByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResolveDid(ctx context.Context, in *QueryResolveDidRequest, opts ...grpc.CallOption) (*QueryResolveDidResponse, error) {
+	out := new(QueryResolveDidResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/ResolveDid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DereferenceDidUrl(ctx context.Context, in *QueryDereferenceDidUrlRequest, opts ...grpc.CallOption) (*QueryDereferenceDidUrlResponse, error) {
+	out := new(QueryDereferenceDidUrlResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/DereferenceDidUrl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidDocumentVersions(ctx context.Context, in *QueryDidDocumentVersionsRequest, opts ...grpc.CallOption) (*QueryDidDocumentVersionsResponse, error) {
+	out := new(QueryDidDocumentVersionsResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/DidDocumentVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingDidRecovery(ctx context.Context, in *QueryPendingDidRecoveryRequest, opts ...grpc.CallOption) (*QueryPendingDidRecoveryResponse, error) {
+	out := new(QueryPendingDidRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/PendingDidRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingDidRecoveries(ctx context.Context, in *QueryPendingDidRecoveriesRequest, opts ...grpc.CallOption) (*QueryPendingDidRecoveriesResponse, error) {
+	out := new(QueryPendingDidRecoveriesResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/PendingDidRecoveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidDocuments(ctx context.Context, in *QueryDidDocumentsRequest, opts ...grpc.CallOption) (*QueryDidDocumentsResponse, error) {
+	out := new(QueryDidDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/DidDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidDocumentsByController(ctx context.Context, in *QueryDidDocumentsByControllerRequest, opts ...grpc.CallOption) (*QueryDidDocumentsByControllerResponse, error) {
+	out := new(QueryDidDocumentsByControllerResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/DidDocumentsByController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DidDocumentByBlockchainAccountId(ctx context.Context, in *QueryDidDocumentByBlockchainAccountIdRequest, opts ...grpc.CallOption) (*QueryDidDocumentByBlockchainAccountIdResponse, error) {
+	out := new(QueryDidDocumentByBlockchainAccountIdResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/DidDocumentByBlockchainAccountId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialSchemasByAuthor(ctx context.Context, in *QueryCredentialSchemasByAuthorRequest, opts ...grpc.CallOption) (*QueryCredentialSchemasByAuthorResponse, error) {
+	out := new(QueryCredentialSchemasByAuthorResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/CredentialSchemasByAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialStatusesByIssuer(ctx context.Context, in *QueryCredentialStatusesByIssuerRequest, opts ...grpc.CallOption) (*QueryCredentialStatusesByIssuerResponse, error) {
+	out := new(QueryCredentialStatusesByIssuerResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/CredentialStatusesByIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialStatusByID(ctx context.Context, in *QueryCredentialStatusRequest, opts ...grpc.CallOption) (*QueryCredentialStatusResponse, error) {
+	out := new(QueryCredentialStatusResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/CredentialStatusByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialStatuses(ctx context.Context, in *QueryCredentialStatusesRequest, opts ...grpc.CallOption) (*QueryCredentialStatusesResponse, error) {
+	out := new(QueryCredentialStatusesResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/CredentialStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialStatusBatchByID(ctx context.Context, in *QueryCredentialStatusBatchRequest, opts ...grpc.CallOption) (*QueryCredentialStatusBatchResponse, error) {
+	out := new(QueryCredentialStatusBatchResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/CredentialStatusBatchByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CredentialStatusListByID(ctx context.Context, in *QueryCredentialStatusListRequest, opts ...grpc.CallOption) (*QueryCredentialStatusListResponse, error) {
+	out := new(QueryCredentialStatusListResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/CredentialStatusListByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccreditationByID(ctx context.Context, in *QueryAccreditationRequest, opts ...grpc.CallOption) (*QueryAccreditationResponse, error) {
+	out := new(QueryAccreditationResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/AccreditationByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IssuerAccreditation(ctx context.Context, in *QueryIssuerAccreditationRequest, opts ...grpc.CallOption) (*QueryIssuerAccreditationResponse, error) {
+	out := new(QueryIssuerAccreditationResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/IssuerAccreditation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyDocumentProof(ctx context.Context, in *QueryVerifyDocumentProofRequest, opts ...grpc.CallOption) (*QueryVerifyDocumentProofResponse, error) {
+	out := new(QueryVerifyDocumentProofResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/VerifyDocumentProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidateSSIMsg(ctx context.Context, in *QueryValidateSSIMsgRequest, opts ...grpc.CallOption) (*QueryValidateSSIMsgResponse, error) {
+	out := new(QueryValidateSSIMsgResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/ValidateSSIMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyCredential(ctx context.Context, in *QueryVerifyCredentialRequest, opts ...grpc.CallOption) (*QueryVerifyCredentialResponse, error) {
+	out := new(QueryVerifyCredentialResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/VerifyCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuerySSIFee(ctx context.Context, in *QuerySSIFeeRequest, opts ...grpc.CallOption) (*QuerySSIFeeResponse, error) {
+	out := new(QuerySSIFeeResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Query/QuerySSIFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Get the Schema Document for a specified schema id
+	CredentialSchemaByID(context.Context, *QueryCredentialSchemaRequest) (*QueryCredentialSchemaResponse, error)
+	// Get the count and list of registered Schemas
+	CredentialSchemas(context.Context, *QueryCredentialSchemasRequest) (*QueryCredentialSchemasResponse, error)
+	// Get the Did Document for a specified DID id
+	DidDocumentByID(context.Context, *QueryDidDocumentRequest) (*QueryDidDocumentResponse, error)
+	// Resolve a DID as per W3C DID Resolution specification
+	ResolveDid(context.Context, *QueryResolveDidRequest) (*QueryResolveDidResponse, error)
+	// Dereference a DID URL as per W3C DID Core specification
+	DereferenceDidUrl(context.Context, *QueryDereferenceDidUrlRequest) (*QueryDereferenceDidUrlResponse, error)
+	// Get every version of the Did Document for a specified DID id
+	DidDocumentVersions(context.Context, *QueryDidDocumentVersionsRequest) (*QueryDidDocumentVersionsResponse, error)
+	// Get the pending recovery of a specified DID id
+	PendingDidRecovery(context.Context, *QueryPendingDidRecoveryRequest) (*QueryPendingDidRecoveryResponse, error)
+	// Get the list of pending DID recoveries
+	PendingDidRecoveries(context.Context, *QueryPendingDidRecoveriesRequest) (*QueryPendingDidRecoveriesResponse, error)
+	// Get the count and list of registered Did Documents
+	DidDocuments(context.Context, *QueryDidDocumentsRequest) (*QueryDidDocumentsResponse, error)
+	// Get the Did Documents controlled by a specified DID
+	DidDocumentsByController(context.Context, *QueryDidDocumentsByControllerRequest) (*QueryDidDocumentsByControllerResponse, error)
+	// Get the Did Document which owns a specified CAIP-10 blockchain account id
+	DidDocumentByBlockchainAccountId(context.Context, *QueryDidDocumentByBlockchainAccountIdRequest) (*QueryDidDocumentByBlockchainAccountIdResponse, error)
+	// Get the Schemas authored by a specified DID
+	CredentialSchemasByAuthor(context.Context, *QueryCredentialSchemasByAuthorRequest) (*QueryCredentialSchemasByAuthorResponse, error)
+	// Get the Credential Statuses issued by a specified DID
+	CredentialStatusesByIssuer(context.Context, *QueryCredentialStatusesByIssuerRequest) (*QueryCredentialStatusesByIssuerResponse, error)
+	// Get the Credential Status for a given credential id
+	CredentialStatusByID(context.Context, *QueryCredentialStatusRequest) (*QueryCredentialStatusResponse, error)
+	// Get all the registed Credential Statuses
+	CredentialStatuses(context.Context, *QueryCredentialStatusesRequest) (*QueryCredentialStatusesResponse, error)
+	// Get the Credential Status Batch for a given id, through which Credential Statuses were registered
+	CredentialStatusBatchByID(context.Context, *QueryCredentialStatusBatchRequest) (*QueryCredentialStatusBatchResponse, error)
+	// Get the Credential Status List for a given id, represented as a BitstringStatusListCredential
+	CredentialStatusListByID(context.Context, *QueryCredentialStatusListRequest) (*QueryCredentialStatusListResponse, error)
+	// Get an Accreditation
+	AccreditationByID(context.Context, *QueryAccreditationRequest) (*QueryAccreditationResponse, error)
+	// Check whether an issuer is accredited for a Credential Schema at a given time
+	IssuerAccreditation(context.Context, *QueryIssuerAccreditationRequest) (*QueryIssuerAccreditationResponse, error)
+	// Verify the proof of a DID Document, Credential Schema or Credential Status without submitting a transaction
+	VerifyDocumentProof(context.Context, *QueryVerifyDocumentProofRequest) (*QueryVerifyDocumentProofResponse, error)
+	// Run the validation of a x/ssi module message against the current state, without committing it
+	ValidateSSIMsg(context.Context, *QueryValidateSSIMsgRequest) (*QueryValidateSSIMsgResponse, error)
+	// Verify a Verifiable Credential against the registered issuer DID, credential status and credential schema
+	VerifyCredential(context.Context, *QueryVerifyCredentialRequest) (*QueryVerifyCredentialResponse, error)
+	// Get the parameters of x/ssi module
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Get the list of fixed fees for every x/ssi module transactions
+	QuerySSIFee(context.Context, *QuerySSIFeeRequest) (*QuerySSIFeeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) CredentialSchemaByID(ctx context.Context, req *QueryCredentialSchemaRequest) (*QueryCredentialSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialSchemaByID not implemented")
+}
+func (*UnimplementedQueryServer) CredentialSchemas(ctx context.Context, req *QueryCredentialSchemasRequest) (*QueryCredentialSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialSchemas not implemented")
+}
+func (*UnimplementedQueryServer) DidDocumentByID(ctx context.Context, req *QueryDidDocumentRequest) (*QueryDidDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocumentByID not implemented")
+}
+func (*UnimplementedQueryServer) ResolveDid(ctx context.Context, req *QueryResolveDidRequest) (*QueryResolveDidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDid not implemented")
+}
+func (*UnimplementedQueryServer) DereferenceDidUrl(ctx context.Context, req *QueryDereferenceDidUrlRequest) (*QueryDereferenceDidUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DereferenceDidUrl not implemented")
+}
+func (*UnimplementedQueryServer) DidDocumentVersions(ctx context.Context, req *QueryDidDocumentVersionsRequest) (*QueryDidDocumentVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocumentVersions not implemented")
+}
+func (*UnimplementedQueryServer) PendingDidRecovery(ctx context.Context, req *QueryPendingDidRecoveryRequest) (*QueryPendingDidRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingDidRecovery not implemented")
+}
+func (*UnimplementedQueryServer) PendingDidRecoveries(ctx context.Context, req *QueryPendingDidRecoveriesRequest) (*QueryPendingDidRecoveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingDidRecoveries not implemented")
+}
+func (*UnimplementedQueryServer) DidDocuments(ctx context.Context, req *QueryDidDocumentsRequest) (*QueryDidDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocuments not implemented")
+}
+func (*UnimplementedQueryServer) DidDocumentsByController(ctx context.Context, req *QueryDidDocumentsByControllerRequest) (*QueryDidDocumentsByControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocumentsByController not implemented")
+}
+func (*UnimplementedQueryServer) DidDocumentByBlockchainAccountId(ctx context.Context, req *QueryDidDocumentByBlockchainAccountIdRequest) (*QueryDidDocumentByBlockchainAccountIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DidDocumentByBlockchainAccountId not implemented")
+}
+func (*UnimplementedQueryServer) CredentialSchemasByAuthor(ctx context.Context, req *QueryCredentialSchemasByAuthorRequest) (*QueryCredentialSchemasByAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialSchemasByAuthor not implemented")
+}
+func (*UnimplementedQueryServer) CredentialStatusesByIssuer(ctx context.Context, req *QueryCredentialStatusesByIssuerRequest) (*QueryCredentialStatusesByIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialStatusesByIssuer not implemented")
+}
+func (*UnimplementedQueryServer) CredentialStatusByID(ctx context.Context, req *QueryCredentialStatusRequest) (*QueryCredentialStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialStatusByID not implemented")
+}
+func (*UnimplementedQueryServer) CredentialStatuses(ctx context.Context, req *QueryCredentialStatusesRequest) (*QueryCredentialStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialStatuses not implemented")
+}
+func (*UnimplementedQueryServer) CredentialStatusBatchByID(ctx context.Context, req *QueryCredentialStatusBatchRequest) (*QueryCredentialStatusBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialStatusBatchByID not implemented")
+}
+func (*UnimplementedQueryServer) CredentialStatusListByID(ctx context.Context, req *QueryCredentialStatusListRequest) (*QueryCredentialStatusListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialStatusListByID not implemented")
+}
+func (*UnimplementedQueryServer) AccreditationByID(ctx context.Context, req *QueryAccreditationRequest) (*QueryAccreditationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccreditationByID not implemented")
+}
+func (*UnimplementedQueryServer) IssuerAccreditation(ctx context.Context, req *QueryIssuerAccreditationRequest) (*QueryIssuerAccreditationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuerAccreditation not implemented")
+}
+func (*UnimplementedQueryServer) VerifyDocumentProof(ctx context.Context, req *QueryVerifyDocumentProofRequest) (*QueryVerifyDocumentProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDocumentProof not implemented")
+}
+func (*UnimplementedQueryServer) ValidateSSIMsg(ctx context.Context, req *QueryValidateSSIMsgRequest) (*QueryValidateSSIMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSSIMsg not implemented")
+}
+func (*UnimplementedQueryServer) VerifyCredential(ctx context.Context, req *QueryVerifyCredentialRequest) (*QueryVerifyCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCredential not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) QuerySSIFee(ctx context.Context, req *QuerySSIFeeRequest) (*QuerySSIFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySSIFee not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_CredentialSchemaByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCredentialSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialSchemaByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/CredentialSchemaByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialSchemaByID(ctx, req.(*QueryCredentialSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCredentialSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/CredentialSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialSchemas(ctx, req.(*QueryCredentialSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidDocumentByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocumentByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/DidDocumentByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocumentByID(ctx, req.(*QueryDidDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResolveDid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveDidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveDid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/ResolveDid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveDid(ctx, req.(*QueryResolveDidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DereferenceDidUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDereferenceDidUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DereferenceDidUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/DereferenceDidUrl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DereferenceDidUrl(ctx, req.(*QueryDereferenceDidUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidDocumentVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocumentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocumentVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/DidDocumentVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocumentVersions(ctx, req.(*QueryDidDocumentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingDidRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingDidRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingDidRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/PendingDidRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingDidRecovery(ctx, req.(*QueryPendingDidRecoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingDidRecoveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingDidRecoveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingDidRecoveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/PendingDidRecoveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingDidRecoveries(ctx, req.(*QueryPendingDidRecoveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/DidDocuments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocuments(ctx, req.(*QueryDidDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidDocumentsByController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocumentsByControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocumentsByController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/DidDocumentsByController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocumentsByController(ctx, req.(*QueryDidDocumentsByControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DidDocumentByBlockchainAccountId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDidDocumentByBlockchainAccountIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DidDocumentByBlockchainAccountId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/DidDocumentByBlockchainAccountId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DidDocumentByBlockchainAccountId(ctx, req.(*QueryDidDocumentByBlockchainAccountIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialSchemasByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCredentialSchemasByAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialSchemasByAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/CredentialSchemasByAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialSchemasByAuthor(ctx, req.(*QueryCredentialSchemasByAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialStatusesByIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCredentialStatusesByIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialStatusesByIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/CredentialStatusesByIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialStatusesByIssuer(ctx, req.(*QueryCredentialStatusesByIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialStatusByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCredentialStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialStatusByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/CredentialStatusByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialStatusByID(ctx, req.(*QueryCredentialStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCredentialStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/CredentialStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialStatuses(ctx, req.(*QueryCredentialStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialStatusBatchByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCredentialStatusBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialStatusBatchByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/CredentialStatusBatchByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialStatusBatchByID(ctx, req.(*QueryCredentialStatusBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CredentialStatusListByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCredentialStatusListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CredentialStatusListByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/CredentialStatusListByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CredentialStatusListByID(ctx, req.(*QueryCredentialStatusListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccreditationByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccreditationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccreditationByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/AccreditationByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccreditationByID(ctx, req.(*QueryAccreditationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IssuerAccreditation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIssuerAccreditationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IssuerAccreditation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/IssuerAccreditation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IssuerAccreditation(ctx, req.(*QueryIssuerAccreditationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyDocumentProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyDocumentProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyDocumentProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/VerifyDocumentProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyDocumentProof(ctx, req.(*QueryVerifyDocumentProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidateSSIMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateSSIMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateSSIMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/ValidateSSIMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateSSIMsg(ctx, req.(*QueryValidateSSIMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/VerifyCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyCredential(ctx, req.(*QueryVerifyCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySSIFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySSIFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySSIFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Query/QuerySSIFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySSIFee(ctx, req.(*QuerySSIFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hypersign.ssi.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CredentialSchemaByID",
+			Handler:    _Query_CredentialSchemaByID_Handler,
+		},
+		{
+			MethodName: "CredentialSchemas",
+			Handler:    _Query_CredentialSchemas_Handler,
+		},
+		{
+			MethodName: "DidDocumentByID",
+			Handler:    _Query_DidDocumentByID_Handler,
+		},
+		{
+			MethodName: "ResolveDid",
+			Handler:    _Query_ResolveDid_Handler,
+		},
+		{
+			MethodName: "DereferenceDidUrl",
+			Handler:    _Query_DereferenceDidUrl_Handler,
+		},
+		{
+			MethodName: "DidDocumentVersions",
+			Handler:    _Query_DidDocumentVersions_Handler,
+		},
+		{
+			MethodName: "PendingDidRecovery",
+			Handler:    _Query_PendingDidRecovery_Handler,
+		},
+		{
+			MethodName: "PendingDidRecoveries",
+			Handler:    _Query_PendingDidRecoveries_Handler,
+		},
+		{
+			MethodName: "DidDocuments",
+			Handler:    _Query_DidDocuments_Handler,
+		},
+		{
+			MethodName: "DidDocumentsByController",
+			Handler:    _Query_DidDocumentsByController_Handler,
+		},
+		{
+			MethodName: "DidDocumentByBlockchainAccountId",
+			Handler:    _Query_DidDocumentByBlockchainAccountId_Handler,
+		},
+		{
+			MethodName: "CredentialSchemasByAuthor",
+			Handler:    _Query_CredentialSchemasByAuthor_Handler,
+		},
+		{
+			MethodName: "CredentialStatusesByIssuer",
+			Handler:    _Query_CredentialStatusesByIssuer_Handler,
+		},
+		{
+			MethodName: "CredentialStatusByID",
+			Handler:    _Query_CredentialStatusByID_Handler,
+		},
+		{
+			MethodName: "CredentialStatuses",
+			Handler:    _Query_CredentialStatuses_Handler,
+		},
+		{
+			MethodName: "CredentialStatusBatchByID",
+			Handler:    _Query_CredentialStatusBatchByID_Handler,
+		},
+		{
+			MethodName: "CredentialStatusListByID",
+			Handler:    _Query_CredentialStatusListByID_Handler,
+		},
+		{
+			MethodName: "AccreditationByID",
+			Handler:    _Query_AccreditationByID_Handler,
+		},
+		{
+			MethodName: "IssuerAccreditation",
+			Handler:    _Query_IssuerAccreditation_Handler,
+		},
+		{
+			MethodName: "VerifyDocumentProof",
+			Handler:    _Query_VerifyDocumentProof_Handler,
+		},
+		{
+			MethodName: "ValidateSSIMsg",
+			Handler:    _Query_ValidateSSIMsg_Handler,
+		},
+		{
+			MethodName: "VerifyCredential",
+			Handler:    _Query_VerifyCredential_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "QuerySSIFee",
+			Handler:    _Query_QuerySSIFee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hypersign/ssi/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySSIFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySSIFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySSIFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySSIFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySSIFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySSIFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegisterCredentialStatusBatchItemFee != nil {
+		{
+			size, err := m.RegisterCredentialStatusBatchItemFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.UpdateCredentialStatusFee != nil {
+		{
+			size, err := m.UpdateCredentialStatusFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RegisterCredentialStatusFee != nil {
+		{
+			size, err := m.RegisterCredentialStatusFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UpdateCredentialSchemaFee != nil {
+		{
+			size, err := m.UpdateCredentialSchemaFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RegisterCredentialSchemaFee != nil {
+		{
+			size, err := m.RegisterCredentialSchemaFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DeactivateDidFee != nil {
+		{
+			size, err := m.DeactivateDidFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UpdateDidFee != nil {
+		{
+			size, err := m.UpdateDidFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RegisterDidFee != nil {
+		{
+			size, err := m.RegisterDidFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Latest {
+		i--
+		if m.Latest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SchemaId) > 0 {
+		i -= len(m.SchemaId)
+		copy(dAtA[i:], m.SchemaId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SchemaId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredentialSchemas) > 0 {
+		for iNdEx := len(m.CredentialSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredentialSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialSchemasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialSchemasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialSchemasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialSchemasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialSchemasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialSchemasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredentialSchemas) > 0 {
+		for iNdEx := len(m.CredentialSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredentialSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredId) > 0 {
+		i -= len(m.CredId)
+		copy(dAtA[i:], m.CredId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CredentialStatus != nil {
+		{
+			size, err := m.CredentialStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredentialStatuses) > 0 {
+		for iNdEx := len(m.CredentialStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredentialStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialStatusBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialStatusBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialStatusBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialStatusBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialStatusBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialStatusBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CredentialStatusBatch != nil {
+		{
+			size, err := m.CredentialStatusBatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialStatusListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialStatusListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialStatusListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialStatusListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialStatusListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialStatusListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CredentialStatusList != nil {
+		{
+			size, err := m.CredentialStatusList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BitstringStatusListCredential != nil {
+		{
+			size, err := m.BitstringStatusListCredential.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccreditationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccreditationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccreditationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccreditationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccreditationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccreditationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Accreditation != nil {
+		{
+			size, err := m.Accreditation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuerAccreditationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuerAccreditationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuerAccreditationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Time) > 0 {
+		i -= len(m.Time)
+		copy(dAtA[i:], m.Time)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Time)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CredentialSchemaId) > 0 {
+		i -= len(m.CredentialSchemaId)
+		copy(dAtA[i:], m.CredentialSchemaId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialSchemaId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIssuerAccreditationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIssuerAccreditationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIssuerAccreditationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccreditationChain) > 0 {
+		for iNdEx := len(m.AccreditationChain) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccreditationChain[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Accredited {
+		i--
+		if m.Accredited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Prove {
+		i--
+		if m.Prove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.VersionTime) > 0 {
+		i -= len(m.VersionTime)
+		copy(dAtA[i:], m.VersionTime)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VersionTime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidId) > 0 {
+		i -= len(m.DidId)
+		copy(dAtA[i:], m.DidId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DidId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DidDocumentStateValue) > 0 {
+		i -= len(m.DidDocumentStateValue)
+		copy(dAtA[i:], m.DidDocumentStateValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DidDocumentStateValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DidDocumentMetadata != nil {
+		{
+			size, err := m.DidDocumentMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DidDocument != nil {
+		{
+			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocumentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocumentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DidDocuments) > 0 {
+		for iNdEx := len(m.DidDocuments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocuments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveDidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveDidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveDidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accept) > 0 {
+		i -= len(m.Accept)
+		copy(dAtA[i:], m.Accept)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Accept)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VersionTime) > 0 {
+		i -= len(m.VersionTime)
+		copy(dAtA[i:], m.VersionTime)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VersionTime)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidId) > 0 {
+		i -= len(m.DidId)
+		copy(dAtA[i:], m.DidId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DidId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveDidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveDidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveDidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DidDocumentMetadata != nil {
+		{
+			size, err := m.DidDocumentMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DidDocument != nil {
+		{
+			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DidResolutionMetadata != nil {
+		{
+			size, err := m.DidResolutionMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Context) > 0 {
+		for iNdEx := len(m.Context) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Context[iNdEx])
+			copy(dAtA[i:], m.Context[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Context[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDereferenceDidUrlRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDereferenceDidUrlRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDereferenceDidUrlRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accept) > 0 {
+		i -= len(m.Accept)
+		copy(dAtA[i:], m.Accept)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Accept)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidUrl) > 0 {
+		i -= len(m.DidUrl)
+		copy(dAtA[i:], m.DidUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DidUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDereferenceDidUrlResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDereferenceDidUrlResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDereferenceDidUrlResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContentMetadata != nil {
+		{
+			size, err := m.ContentMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ContentUrl) > 0 {
+		i -= len(m.ContentUrl)
+		copy(dAtA[i:], m.ContentUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentUrl)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Service != nil {
+		{
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.VerificationMethod != nil {
+		{
+			size, err := m.VerificationMethod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DidDocument != nil {
+		{
+			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DereferencingMetadata != nil {
+		{
+			size, err := m.DereferencingMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Context) > 0 {
+		for iNdEx := len(m.Context) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Context[iNdEx])
+			copy(dAtA[i:], m.Context[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Context[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocumentVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocumentVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocumentVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidId) > 0 {
+		i -= len(m.DidId)
+		copy(dAtA[i:], m.DidId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DidId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocumentVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocumentVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocumentVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidDocumentVersions) > 0 {
+		for iNdEx := len(m.DidDocumentVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocumentVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDidRecoveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDidRecoveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDidRecoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DidId) > 0 {
+		i -= len(m.DidId)
+		copy(dAtA[i:], m.DidId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DidId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDidRecoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDidRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDidRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingDidRecovery != nil {
+		{
+			size, err := m.PendingDidRecovery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDidRecoveriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDidRecoveriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDidRecoveriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingDidRecoveriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingDidRecoveriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingDidRecoveriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingDidRecoveries) > 0 {
+		for iNdEx := len(m.PendingDidRecoveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDidRecoveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocumentsByControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocumentsByControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocumentsByControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocumentsByControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocumentsByControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocumentsByControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidDocuments) > 0 {
+		for iNdEx := len(m.DidDocuments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocuments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocumentByBlockchainAccountIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocumentByBlockchainAccountIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocumentByBlockchainAccountIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockchainAccountId) > 0 {
+		i -= len(m.BlockchainAccountId)
+		copy(dAtA[i:], m.BlockchainAccountId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockchainAccountId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDidDocumentByBlockchainAccountIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDidDocumentByBlockchainAccountIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDidDocumentByBlockchainAccountIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DidDocumentMetadata != nil {
+		{
+			size, err := m.DidDocumentMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DidDocument != nil {
+		{
+			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialSchemasByAuthorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialSchemasByAuthorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialSchemasByAuthorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Author) > 0 {
+		i -= len(m.Author)
+		copy(dAtA[i:], m.Author)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Author)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialSchemasByAuthorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialSchemasByAuthorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialSchemasByAuthorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CredentialSchemas) > 0 {
+		for iNdEx := len(m.CredentialSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredentialSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialStatusesByIssuerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialStatusesByIssuerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialStatusesByIssuerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCredentialStatusesByIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCredentialStatusesByIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCredentialStatusesByIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CredentialStatuses) > 0 {
+		for iNdEx := len(m.CredentialStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CredentialStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyDocumentProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyDocumentProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyDocumentProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DocumentProof != nil {
+		{
+			size, err := m.DocumentProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CredentialStatusDocument != nil {
+		{
+			size, err := m.CredentialStatusDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CredentialSchemaDocument != nil {
+		{
+			size, err := m.CredentialSchemaDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DidDocument != nil {
+		{
+			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyDocumentProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyDocumentProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyDocumentProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SigningInput) > 0 {
+		i -= len(m.SigningInput)
+		copy(dAtA[i:], m.SigningInput)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SigningInput)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Checks) > 0 {
+		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofVerificationCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofVerificationCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofVerificationCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateSSIMsgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateSSIMsgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateSSIMsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateSSIMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateSSIMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateSSIMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Violations) > 0 {
+		for iNdEx := len(m.Violations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Violations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SSIMsgViolation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSIMsgViolation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSIMsgViolation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Code != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyCredentialRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyCredentialRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyCredentialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Credential) > 0 {
+		i -= len(m.Credential)
+		copy(dAtA[i:], m.Credential)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Credential)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checks) > 0 {
+		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySSIFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySSIFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RegisterDidFee != nil {
+		l = m.RegisterDidFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UpdateDidFee != nil {
+		l = m.UpdateDidFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DeactivateDidFee != nil {
+		l = m.DeactivateDidFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RegisterCredentialSchemaFee != nil {
+		l = m.RegisterCredentialSchemaFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UpdateCredentialSchemaFee != nil {
+		l = m.UpdateCredentialSchemaFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RegisterCredentialStatusFee != nil {
+		l = m.RegisterCredentialStatusFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UpdateCredentialStatusFee != nil {
+		l = m.UpdateCredentialStatusFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RegisterCredentialStatusBatchItemFee != nil {
+		l = m.RegisterCredentialStatusBatchItemFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SchemaId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Latest {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCredentialSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CredentialSchemas) > 0 {
+		for _, e := range m.CredentialSchemas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCredentialSchemasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialSchemasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if len(m.CredentialSchemas) > 0 {
+		for _, e := range m.CredentialSchemas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCredentialStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CredId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredentialStatus != nil {
+		l = m.CredentialStatus.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if len(m.CredentialStatuses) > 0 {
+		for _, e := range m.CredentialStatuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCredentialStatusBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialStatusBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredentialStatusBatch != nil {
+		l = m.CredentialStatusBatch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialStatusListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialStatusListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BitstringStatusListCredential != nil {
+		l = m.BitstringStatusListCredential.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CredentialStatusList != nil {
+		l = m.CredentialStatusList.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccreditationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccreditationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accreditation != nil {
+		l = m.Accreditation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuerAccreditationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CredentialSchemaId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Time)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIssuerAccreditationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accredited {
+		n += 2
+	}
+	if len(m.AccreditationChain) > 0 {
+		for _, e := range m.AccreditationChain {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDidDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DidId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VersionTime)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Prove {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDidDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidDocument != nil {
+		l = m.DidDocument.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DidDocumentMetadata != nil {
+		l = m.DidDocumentMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.DidDocumentStateValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocumentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocumentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if len(m.DidDocuments) > 0 {
+		for _, e := range m.DidDocuments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryResolveDidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DidId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VersionTime)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Accept)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveDidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Context) > 0 {
+		for _, s := range m.Context {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.DidResolutionMetadata != nil {
+		l = m.DidResolutionMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DidDocument != nil {
+		l = m.DidDocument.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DidDocumentMetadata != nil {
+		l = m.DidDocumentMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDereferenceDidUrlRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DidUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Accept)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDereferenceDidUrlResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Context) > 0 {
+		for _, s := range m.Context {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.DereferencingMetadata != nil {
+		l = m.DereferencingMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DidDocument != nil {
+		l = m.DidDocument.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VerificationMethod != nil {
+		l = m.VerificationMethod.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContentUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ContentMetadata != nil {
+		l = m.ContentMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocumentVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DidId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocumentVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DidDocumentVersions) > 0 {
+		for _, e := range m.DidDocumentVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingDidRecoveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DidId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingDidRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingDidRecovery != nil {
+		l = m.PendingDidRecovery.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingDidRecoveriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingDidRecoveriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingDidRecoveries) > 0 {
+		for _, e := range m.PendingDidRecoveries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocumentsByControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocumentsByControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DidDocuments) > 0 {
+		for _, e := range m.DidDocuments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocumentByBlockchainAccountIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockchainAccountId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDidDocumentByBlockchainAccountIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidDocument != nil {
+		l = m.DidDocument.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DidDocumentMetadata != nil {
+		l = m.DidDocumentMetadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialSchemasByAuthorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialSchemasByAuthorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CredentialSchemas) > 0 {
+		for _, e := range m.CredentialSchemas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialStatusesByIssuerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCredentialStatusesByIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CredentialStatuses) > 0 {
+		for _, e := range m.CredentialStatuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyDocumentProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidDocument != nil {
+		l = m.DidDocument.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CredentialSchemaDocument != nil {
+		l = m.CredentialSchemaDocument.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CredentialStatusDocument != nil {
+		l = m.CredentialStatusDocument.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DocumentProof != nil {
+		l = m.DocumentProof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyDocumentProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Verified {
+		n += 2
+	}
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.SigningInput)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofVerificationCheck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Passed {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateSSIMsgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateSSIMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if len(m.Violations) > 0 {
+		for _, e := range m.Violations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SSIMsgViolation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovQuery(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyCredentialRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Credential)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyCredentialResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	var l int
+	_ = l
+	if m.Verified {
+		n += 2
+	}
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySSIFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySSIFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySSIFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySSIFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySSIFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySSIFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterDidFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegisterDidFee == nil {
+				m.RegisterDidFee = &types.Coin{}
+			}
+			if err := m.RegisterDidFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateDidFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateDidFee == nil {
+				m.UpdateDidFee = &types.Coin{}
+			}
+			if err := m.UpdateDidFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeactivateDidFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeactivateDidFee == nil {
+				m.DeactivateDidFee = &types.Coin{}
+			}
+			if err := m.DeactivateDidFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterCredentialSchemaFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegisterCredentialSchemaFee == nil {
+				m.RegisterCredentialSchemaFee = &types.Coin{}
+			}
+			if err := m.RegisterCredentialSchemaFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateCredentialSchemaFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateCredentialSchemaFee == nil {
+				m.UpdateCredentialSchemaFee = &types.Coin{}
+			}
+			if err := m.UpdateCredentialSchemaFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterCredentialStatusFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegisterCredentialStatusFee == nil {
+				m.RegisterCredentialStatusFee = &types.Coin{}
+			}
+			if err := m.RegisterCredentialStatusFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateCredentialStatusFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateCredentialStatusFee == nil {
+				m.UpdateCredentialStatusFee = &types.Coin{}
+			}
+			if err := m.UpdateCredentialStatusFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterCredentialStatusBatchItemFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegisterCredentialStatusBatchItemFee == nil {
+				m.RegisterCredentialStatusBatchItemFee = &types.Coin{}
+			}
+			if err := m.RegisterCredentialStatusBatchItemFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialSchemaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialSchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Latest = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialSchemas = append(m.CredentialSchemas, &CredentialSchemaState{})
+			if err := m.CredentialSchemas[len(m.CredentialSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialSchemasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialSchemasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialSchemasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialSchemasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialSchemasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialSchemasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialSchemas = append(m.CredentialSchemas, &CredentialSchemaState{})
+			if err := m.CredentialSchemas[len(m.CredentialSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialStatus == nil {
+				m.CredentialStatus = &CredentialStatusState{}
+			}
+			if err := m.CredentialStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialStatusesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialStatusesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialStatusesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialStatusesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialStatuses = append(m.CredentialStatuses, &CredentialStatusState{})
+			if err := m.CredentialStatuses[len(m.CredentialStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialStatusBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialStatusBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialStatusBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialStatusBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialStatusBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialStatusBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusBatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialStatusBatch == nil {
+				m.CredentialStatusBatch = &CredentialStatusBatchState{}
+			}
+			if err := m.CredentialStatusBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialStatusListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialStatusListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialStatusListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialStatusListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialStatusListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialStatusListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitstringStatusListCredential", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BitstringStatusListCredential == nil {
+				m.BitstringStatusListCredential = &BitstringStatusListCredential{}
+			}
+			if err := m.BitstringStatusListCredential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialStatusList == nil {
+				m.CredentialStatusList = &CredentialStatusListState{}
+			}
+			if err := m.CredentialStatusList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccreditationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccreditationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccreditationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccreditationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccreditationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccreditationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accreditation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Accreditation == nil {
+				m.Accreditation = &AccreditationState{}
+			}
+			if err := m.Accreditation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIssuerAccreditationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIssuerAccreditationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIssuerAccreditationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialSchemaId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Time = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIssuerAccreditationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIssuerAccreditationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIssuerAccreditationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accredited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accredited = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditationChain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccreditationChain = append(m.AccreditationChain, &AccreditationState{})
+			if err := m.AccreditationChain[len(m.AccreditationChain)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocument == nil {
+				m.DidDocument = &DidDocument{}
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocumentMetadata == nil {
+				m.DidDocumentMetadata = &DidDocumentMetadata{}
+			}
+			if err := m.DidDocumentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.ProofOps{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentStateValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocumentStateValue = append(m.DidDocumentStateValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.DidDocumentStateValue == nil {
+				m.DidDocumentStateValue = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocumentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocumentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocumentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocumentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocuments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocuments = append(m.DidDocuments, &DidDocumentState{})
+			if err := m.DidDocuments[len(m.DidDocuments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveDidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveDidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveDidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accept", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accept = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveDidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveDidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveDidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = append(m.Context, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidResolutionMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidResolutionMetadata == nil {
+				m.DidResolutionMetadata = &DidResolutionMetadata{}
+			}
+			if err := m.DidResolutionMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocument == nil {
+				m.DidDocument = &DidDocument{}
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocumentMetadata == nil {
+				m.DidDocumentMetadata = &DidDocumentMetadata{}
+			}
+			if err := m.DidDocumentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDereferenceDidUrlRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDereferenceDidUrlRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDereferenceDidUrlRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accept", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accept = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDereferenceDidUrlResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDereferenceDidUrlResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDereferenceDidUrlResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = append(m.Context, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DereferencingMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DereferencingMetadata == nil {
+				m.DereferencingMetadata = &DidResolutionMetadata{}
+			}
+			if err := m.DereferencingMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocument == nil {
+				m.DidDocument = &DidDocument{}
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationMethod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VerificationMethod == nil {
+				m.VerificationMethod = &VerificationMethod{}
+			}
+			if err := m.VerificationMethod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContentMetadata == nil {
+				m.ContentMetadata = &DidDocumentMetadata{}
+			}
+			if err := m.ContentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocumentVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocumentVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocumentVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocumentVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocumentVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocumentVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocumentVersions = append(m.DidDocumentVersions, &DidDocumentState{})
+			if err := m.DidDocumentVersions[len(m.DidDocumentVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingDidRecoveryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDidRecoveryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDidRecoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingDidRecoveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDidRecoveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDidRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDidRecovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingDidRecovery == nil {
+				m.PendingDidRecovery = &PendingDidRecovery{}
+			}
+			if err := m.PendingDidRecovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryDidDocumentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingDidRecoveriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDidRecoveriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDidRecoveriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovQuery(uint64(m.Count))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.DidDocuments) > 0 {
-		for _, e := range m.DidDocuments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryPendingDidRecoveriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingDidRecoveriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingDidRecoveriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDidRecoveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingDidRecoveries = append(m.PendingDidRecoveries, &PendingDidRecovery{})
+			if err := m.PendingDidRecoveries[len(m.PendingDidRecoveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySSIFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDidDocumentsByControllerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1930,25 +11068,93 @@ func (m *QuerySSIFeeRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocumentsByControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocumentsByControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySSIFeeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySSIFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1970,7 +11176,7 @@ func (m *QuerySSIFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySSIFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDidDocumentsByControllerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1993,15 +11199,15 @@ func (m *QuerySSIFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySSIFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDidDocumentsByControllerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySSIFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDidDocumentsByControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisterDidFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocuments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2028,16 +11234,14 @@ func (m *QuerySSIFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RegisterDidFee == nil {
-				m.RegisterDidFee = &types.Coin{}
-			}
-			if err := m.RegisterDidFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DidDocuments = append(m.DidDocuments, &DidDocumentState{})
+			if err := m.DidDocuments[len(m.DidDocuments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateDidFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2064,18 +11268,68 @@ func (m *QuerySSIFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdateDidFee == nil {
-				m.UpdateDidFee = &types.Coin{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.UpdateDidFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocumentByBlockchainAccountIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocumentByBlockchainAccountIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocumentByBlockchainAccountIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeactivateDidFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockchainAccountId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2085,31 +11339,77 @@ func (m *QuerySSIFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeactivateDidFee == nil {
-				m.DeactivateDidFee = &types.Coin{}
-			}
-			if err := m.DeactivateDidFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlockchainAccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDidDocumentByBlockchainAccountIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDidDocumentByBlockchainAccountIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDidDocumentByBlockchainAccountIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisterCredentialSchemaFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2136,16 +11436,16 @@ func (m *QuerySSIFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RegisterCredentialSchemaFee == nil {
-				m.RegisterCredentialSchemaFee = &types.Coin{}
+			if m.DidDocument == nil {
+				m.DidDocument = &DidDocument{}
 			}
-			if err := m.RegisterCredentialSchemaFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateCredentialSchemaFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2172,18 +11472,68 @@ func (m *QuerySSIFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdateCredentialSchemaFee == nil {
-				m.UpdateCredentialSchemaFee = &types.Coin{}
+			if m.DidDocumentMetadata == nil {
+				m.DidDocumentMetadata = &DidDocumentMetadata{}
 			}
-			if err := m.UpdateCredentialSchemaFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DidDocumentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCredentialSchemasByAuthorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCredentialSchemasByAuthorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCredentialSchemasByAuthorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisterCredentialStatusFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2193,31 +11543,27 @@ func (m *QuerySSIFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RegisterCredentialStatusFee == nil {
-				m.RegisterCredentialStatusFee = &types.Coin{}
-			}
-			if err := m.RegisterCredentialStatusFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateCredentialStatusFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2244,10 +11590,10 @@ func (m *QuerySSIFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdateCredentialStatusFee == nil {
-				m.UpdateCredentialStatusFee = &types.Coin{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.UpdateCredentialStatusFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2272,7 +11618,7 @@ func (m *QuerySSIFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCredentialSchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCredentialSchemasByAuthorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2295,17 +11641,17 @@ func (m *QueryCredentialSchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCredentialSchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCredentialSchemasByAuthorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCredentialSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCredentialSchemasByAuthorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemas", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2315,23 +11661,61 @@ func (m *QueryCredentialSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SchemaId = string(dAtA[iNdEx:postIndex])
+			m.CredentialSchemas = append(m.CredentialSchemas, &CredentialSchemaState{})
+			if err := m.CredentialSchemas[len(m.CredentialSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2354,7 +11738,7 @@ func (m *QueryCredentialSchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCredentialStatusesByIssuerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2377,15 +11761,47 @@ func (m *QueryCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCredentialSchemaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCredentialStatusesByIssuerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCredentialSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCredentialStatusesByIssuerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2412,8 +11828,10 @@ func (m *QueryCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialSchemas = append(m.CredentialSchemas, &CredentialSchemaState{})
-			if err := m.CredentialSchemas[len(m.CredentialSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2438,7 +11856,7 @@ func (m *QueryCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCredentialSchemasRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCredentialStatusesByIssuerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2461,13 +11879,47 @@ func (m *QueryCredentialSchemasRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCredentialSchemasRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCredentialStatusesByIssuerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCredentialSchemasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCredentialStatusesByIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialStatuses = append(m.CredentialStatuses, &CredentialStatusState{})
+			if err := m.CredentialStatuses[len(m.CredentialStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2497,7 +11949,7 @@ func (m *QueryCredentialSchemasRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2524,7 +11976,7 @@ func (m *QueryCredentialSchemasRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCredentialSchemasResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyDocumentProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2547,17 +11999,17 @@ func (m *QueryCredentialSchemasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCredentialSchemasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyDocumentProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCredentialSchemasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyDocumentProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2567,14 +12019,103 @@ func (m *QueryCredentialSchemasResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocument == nil {
+				m.DidDocument = &DidDocument{}
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialSchemaDocument == nil {
+				m.CredentialSchemaDocument = &CredentialSchemaDocument{}
+			}
+			if err := m.CredentialSchemaDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialStatusDocument == nil {
+				m.CredentialStatusDocument = &CredentialStatusDocument{}
+			}
+			if err := m.CredentialStatusDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2601,8 +12142,10 @@ func (m *QueryCredentialSchemasResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialSchemas = append(m.CredentialSchemas, &CredentialSchemaState{})
-			if err := m.CredentialSchemas[len(m.CredentialSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DocumentProof == nil {
+				m.DocumentProof = &DocumentProof{}
+			}
+			if err := m.DocumentProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2627,7 +12170,7 @@ func (m *QueryCredentialSchemasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCredentialStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyDocumentProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2650,15 +12193,69 @@ func (m *QueryCredentialStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCredentialStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyDocumentProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCredentialStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyDocumentProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checks = append(m.Checks, &ProofVerificationCheck{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInput", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2686,7 +12283,7 @@ func (m *QueryCredentialStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredId = string(dAtA[iNdEx:postIndex])
+			m.SigningInput = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2709,7 +12306,7 @@ func (m *QueryCredentialStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCredentialStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *ProofVerificationCheck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2732,17 +12329,17 @@ func (m *QueryCredentialStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCredentialStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProofVerificationCheck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCredentialStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProofVerificationCheck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2752,27 +12349,75 @@ func (m *QueryCredentialStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CredentialStatus == nil {
-				m.CredentialStatus = &CredentialStatusState{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
 			}
-			if err := m.CredentialStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2795,7 +12440,7 @@ func (m *QueryCredentialStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCredentialStatusesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidateSSIMsgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2818,15 +12463,15 @@ func (m *QueryCredentialStatusesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCredentialStatusesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidateSSIMsgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCredentialStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidateSSIMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2853,10 +12498,10 @@ func (m *QueryCredentialStatusesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Msg == nil {
+				m.Msg = &types1.Any{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2881,7 +12526,7 @@ func (m *QueryCredentialStatusesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCredentialStatusesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidateSSIMsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2904,17 +12549,17 @@ func (m *QueryCredentialStatusesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCredentialStatusesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidateSSIMsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCredentialStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidateSSIMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			m.Count = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2924,14 +12569,15 @@ func (m *QueryCredentialStatusesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Valid = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatuses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Violations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2958,8 +12604,8 @@ func (m *QueryCredentialStatusesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CredentialStatuses = append(m.CredentialStatuses, &CredentialStatusState{})
-			if err := m.CredentialStatuses[len(m.CredentialStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Violations = append(m.Violations, &SSIMsgViolation{})
+			if err := m.Violations[len(m.Violations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2984,7 +12630,7 @@ func (m *QueryCredentialStatusesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDidDocumentRequest) Unmarshal(dAtA []byte) error {
+func (m *SSIMsgViolation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3007,15 +12653,15 @@ func (m *QueryDidDocumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDidDocumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SSIMsgViolation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDidDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SSIMsgViolation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3043,63 +12689,13 @@ func (m *QueryDidDocumentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DidId = string(dAtA[iNdEx:postIndex])
+			m.Codespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDidDocumentResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDidDocumentResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDidDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var msglen int
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3109,33 +12705,16 @@ func (m *QueryDidDocumentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Code |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DidDocument == nil {
-				m.DidDocument = &DidDocument{}
-			}
-			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3145,27 +12724,23 @@ func (m *QueryDidDocumentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DidDocumentMetadata == nil {
-				m.DidDocumentMetadata = &DidDocumentMetadata{}
-			}
-			if err := m.DidDocumentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3188,7 +12763,7 @@ func (m *QueryDidDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDidDocumentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyCredentialRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3211,17 +12786,17 @@ func (m *QueryDidDocumentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDidDocumentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyCredentialRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDidDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3231,27 +12806,23 @@ func (m *QueryDidDocumentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Credential = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3274,7 +12845,7 @@ func (m *QueryDidDocumentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDidDocumentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyCredentialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3297,17 +12868,17 @@ func (m *QueryDidDocumentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDidDocumentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyCredentialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDidDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
 			}
-			m.Count = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3317,14 +12888,15 @@ func (m *QueryDidDocumentsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Verified = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocuments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3351,8 +12923,8 @@ func (m *QueryDidDocumentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DidDocuments = append(m.DidDocuments, &DidDocumentState{})
-			if err := m.DidDocuments[len(m.DidDocuments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Checks = append(m.Checks, &ProofVerificationCheck{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
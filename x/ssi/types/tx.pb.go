@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -331,6 +334,318 @@ func (m *MsgDeactivateDIDResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeactivateDIDResponse proto.InternalMessageInfo
 
+// MsgInitiateDidRecovery proposes a replacement DID Document, signed by one of the recovery keys of
+// registered DID Document
+type MsgInitiateDidRecovery struct {
+	DidDocument       *DidDocument     `protobuf:"bytes,1,opt,name=didDocument,proto3" json:"didDocument,omitempty"`
+	DidDocumentProofs []*DocumentProof `protobuf:"bytes,2,rep,name=didDocumentProofs,proto3" json:"didDocumentProofs,omitempty"`
+	VersionId         string           `protobuf:"bytes,3,opt,name=versionId,proto3" json:"versionId,omitempty"`
+	TxAuthor          string           `protobuf:"bytes,4,opt,name=txAuthor,proto3" json:"txAuthor,omitempty"`
+}
+
+func (m *MsgInitiateDidRecovery) Reset()         { *m = MsgInitiateDidRecovery{} }
+func (m *MsgInitiateDidRecovery) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateDidRecovery) ProtoMessage()    {}
+func (*MsgInitiateDidRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{6}
+}
+func (m *MsgInitiateDidRecovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitiateDidRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateDidRecovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitiateDidRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateDidRecovery.Merge(m, src)
+}
+func (m *MsgInitiateDidRecovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitiateDidRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateDidRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitiateDidRecovery proto.InternalMessageInfo
+
+func (m *MsgInitiateDidRecovery) GetDidDocument() *DidDocument {
+	if m != nil {
+		return m.DidDocument
+	}
+	return nil
+}
+
+func (m *MsgInitiateDidRecovery) GetDidDocumentProofs() []*DocumentProof {
+	if m != nil {
+		return m.DidDocumentProofs
+	}
+	return nil
+}
+
+func (m *MsgInitiateDidRecovery) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *MsgInitiateDidRecovery) GetTxAuthor() string {
+	if m != nil {
+		return m.TxAuthor
+	}
+	return ""
+}
+
+type MsgInitiateDidRecoveryResponse struct {
+}
+
+func (m *MsgInitiateDidRecoveryResponse) Reset()         { *m = MsgInitiateDidRecoveryResponse{} }
+func (m *MsgInitiateDidRecoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateDidRecoveryResponse) ProtoMessage()    {}
+func (*MsgInitiateDidRecoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{7}
+}
+func (m *MsgInitiateDidRecoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitiateDidRecoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateDidRecoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitiateDidRecoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateDidRecoveryResponse.Merge(m, src)
+}
+func (m *MsgInitiateDidRecoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitiateDidRecoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateDidRecoveryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitiateDidRecoveryResponse proto.InternalMessageInfo
+
+// MsgCancelDidRecovery cancels a pending DID recovery. The proofs are signatures of the controllers of
+// registered DID Document over the replacement DID Document.
+type MsgCancelDidRecovery struct {
+	DidDocumentId     string           `protobuf:"bytes,1,opt,name=didDocumentId,proto3" json:"didDocumentId,omitempty"`
+	DidDocumentProofs []*DocumentProof `protobuf:"bytes,2,rep,name=didDocumentProofs,proto3" json:"didDocumentProofs,omitempty"`
+	TxAuthor          string           `protobuf:"bytes,3,opt,name=txAuthor,proto3" json:"txAuthor,omitempty"`
+}
+
+func (m *MsgCancelDidRecovery) Reset()         { *m = MsgCancelDidRecovery{} }
+func (m *MsgCancelDidRecovery) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDidRecovery) ProtoMessage()    {}
+func (*MsgCancelDidRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{8}
+}
+func (m *MsgCancelDidRecovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDidRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDidRecovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDidRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDidRecovery.Merge(m, src)
+}
+func (m *MsgCancelDidRecovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDidRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDidRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDidRecovery proto.InternalMessageInfo
+
+func (m *MsgCancelDidRecovery) GetDidDocumentId() string {
+	if m != nil {
+		return m.DidDocumentId
+	}
+	return ""
+}
+
+func (m *MsgCancelDidRecovery) GetDidDocumentProofs() []*DocumentProof {
+	if m != nil {
+		return m.DidDocumentProofs
+	}
+	return nil
+}
+
+func (m *MsgCancelDidRecovery) GetTxAuthor() string {
+	if m != nil {
+		return m.TxAuthor
+	}
+	return ""
+}
+
+type MsgCancelDidRecoveryResponse struct {
+}
+
+func (m *MsgCancelDidRecoveryResponse) Reset()         { *m = MsgCancelDidRecoveryResponse{} }
+func (m *MsgCancelDidRecoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDidRecoveryResponse) ProtoMessage()    {}
+func (*MsgCancelDidRecoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{9}
+}
+func (m *MsgCancelDidRecoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDidRecoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDidRecoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelDidRecoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDidRecoveryResponse.Merge(m, src)
+}
+func (m *MsgCancelDidRecoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDidRecoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDidRecoveryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelDidRecoveryResponse proto.InternalMessageInfo
+
+type MsgMarkVerificationMethodCompromised struct {
+	CompromiseDocument       *VerificationMethodCompromiseDocument `protobuf:"bytes,1,opt,name=compromiseDocument,proto3" json:"compromiseDocument,omitempty"`
+	CompromiseDocumentProofs []*DocumentProof                      `protobuf:"bytes,2,rep,name=compromiseDocumentProofs,proto3" json:"compromiseDocumentProofs,omitempty"`
+	VersionId                string                                `protobuf:"bytes,3,opt,name=versionId,proto3" json:"versionId,omitempty"`
+	TxAuthor                 string                                `protobuf:"bytes,4,opt,name=txAuthor,proto3" json:"txAuthor,omitempty"`
+}
+
+func (m *MsgMarkVerificationMethodCompromised) Reset()         { *m = MsgMarkVerificationMethodCompromised{} }
+func (m *MsgMarkVerificationMethodCompromised) String() string { return proto.CompactTextString(m) }
+func (*MsgMarkVerificationMethodCompromised) ProtoMessage()    {}
+func (*MsgMarkVerificationMethodCompromised) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{10}
+}
+func (m *MsgMarkVerificationMethodCompromised) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMarkVerificationMethodCompromised) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMarkVerificationMethodCompromised.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMarkVerificationMethodCompromised) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarkVerificationMethodCompromised.Merge(m, src)
+}
+func (m *MsgMarkVerificationMethodCompromised) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMarkVerificationMethodCompromised) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarkVerificationMethodCompromised.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMarkVerificationMethodCompromised proto.InternalMessageInfo
+
+func (m *MsgMarkVerificationMethodCompromised) GetCompromiseDocument() *VerificationMethodCompromiseDocument {
+	if m != nil {
+		return m.CompromiseDocument
+	}
+	return nil
+}
+
+func (m *MsgMarkVerificationMethodCompromised) GetCompromiseDocumentProofs() []*DocumentProof {
+	if m != nil {
+		return m.CompromiseDocumentProofs
+	}
+	return nil
+}
+
+func (m *MsgMarkVerificationMethodCompromised) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *MsgMarkVerificationMethodCompromised) GetTxAuthor() string {
+	if m != nil {
+		return m.TxAuthor
+	}
+	return ""
+}
+
+type MsgMarkVerificationMethodCompromisedResponse struct {
+}
+
+func (m *MsgMarkVerificationMethodCompromisedResponse) Reset() {
+	*m = MsgMarkVerificationMethodCompromisedResponse{}
+}
+func (m *MsgMarkVerificationMethodCompromisedResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgMarkVerificationMethodCompromisedResponse) ProtoMessage() {}
+func (*MsgMarkVerificationMethodCompromisedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{11}
+}
+func (m *MsgMarkVerificationMethodCompromisedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMarkVerificationMethodCompromisedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMarkVerificationMethodCompromisedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMarkVerificationMethodCompromisedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarkVerificationMethodCompromisedResponse.Merge(m, src)
+}
+func (m *MsgMarkVerificationMethodCompromisedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMarkVerificationMethodCompromisedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarkVerificationMethodCompromisedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMarkVerificationMethodCompromisedResponse proto.InternalMessageInfo
+
 type MsgRegisterCredentialSchema struct {
 	CredentialSchemaDocument *CredentialSchemaDocument `protobuf:"bytes,1,opt,name=credentialSchemaDocument,proto3" json:"credentialSchemaDocument,omitempty"`
 	CredentialSchemaProof    *DocumentProof            `protobuf:"bytes,2,opt,name=credentialSchemaProof,proto3" json:"credentialSchemaProof,omitempty"`
@@ -341,7 +656,7 @@ func (m *MsgRegisterCredentialSchema) Reset()         { *m = MsgRegisterCredenti
 func (m *MsgRegisterCredentialSchema) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterCredentialSchema) ProtoMessage()    {}
 func (*MsgRegisterCredentialSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51540e93e450970a, []int{6}
+	return fileDescriptor_51540e93e450970a, []int{12}
 }
 func (m *MsgRegisterCredentialSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,7 +713,7 @@ func (m *MsgRegisterCredentialSchemaResponse) Reset()         { *m = MsgRegister
 func (m *MsgRegisterCredentialSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterCredentialSchemaResponse) ProtoMessage()    {}
 func (*MsgRegisterCredentialSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51540e93e450970a, []int{7}
+	return fileDescriptor_51540e93e450970a, []int{13}
 }
 func (m *MsgRegisterCredentialSchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +752,7 @@ func (m *MsgUpdateCredentialSchema) Reset()         { *m = MsgUpdateCredentialSc
 func (m *MsgUpdateCredentialSchema) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCredentialSchema) ProtoMessage()    {}
 func (*MsgUpdateCredentialSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51540e93e450970a, []int{8}
+	return fileDescriptor_51540e93e450970a, []int{14}
 }
 func (m *MsgUpdateCredentialSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -494,7 +809,7 @@ func (m *MsgUpdateCredentialSchemaResponse) Reset()         { *m = MsgUpdateCred
 func (m *MsgUpdateCredentialSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCredentialSchemaResponse) ProtoMessage()    {}
 func (*MsgUpdateCredentialSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51540e93e450970a, []int{9}
+	return fileDescriptor_51540e93e450970a, []int{15}
 }
 func (m *MsgUpdateCredentialSchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,24 +838,24 @@ func (m *MsgUpdateCredentialSchemaResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateCredentialSchemaResponse proto.InternalMessageInfo
 
-type MsgRegisterCredentialStatus struct {
-	CredentialStatusDocument *CredentialStatusDocument `protobuf:"bytes,1,opt,name=credentialStatusDocument,proto3" json:"credentialStatusDocument,omitempty"`
-	CredentialStatusProof    *DocumentProof            `protobuf:"bytes,2,opt,name=credentialStatusProof,proto3" json:"credentialStatusProof,omitempty"`
-	TxAuthor                 string                    `protobuf:"bytes,3,opt,name=txAuthor,proto3" json:"txAuthor,omitempty"`
+type MsgUpdateCredentialSchemaStatus struct {
+	CredentialSchemaStatusDocument *CredentialSchemaStatusDocument `protobuf:"bytes,1,opt,name=credentialSchemaStatusDocument,proto3" json:"credentialSchemaStatusDocument,omitempty"`
+	CredentialSchemaStatusProof    *DocumentProof                  `protobuf:"bytes,2,opt,name=credentialSchemaStatusProof,proto3" json:"credentialSchemaStatusProof,omitempty"`
+	TxAuthor                       string                          `protobuf:"bytes,3,opt,name=txAuthor,proto3" json:"txAuthor,omitempty"`
 }
 
-func (m *MsgRegisterCredentialStatus) Reset()         { *m = MsgRegisterCredentialStatus{} }
-func (m *MsgRegisterCredentialStatus) String() string { return proto.CompactTextString(m) }
-func (*MsgRegisterCredentialStatus) ProtoMessage()    {}
-func (*MsgRegisterCredentialStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51540e93e450970a, []int{10}
+func (m *MsgUpdateCredentialSchemaStatus) Reset()         { *m = MsgUpdateCredentialSchemaStatus{} }
+func (m *MsgUpdateCredentialSchemaStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCredentialSchemaStatus) ProtoMessage()    {}
+func (*MsgUpdateCredentialSchemaStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{16}
 }
-func (m *MsgRegisterCredentialStatus) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateCredentialSchemaStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRegisterCredentialStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateCredentialSchemaStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRegisterCredentialStatus.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateCredentialSchemaStatus.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -550,54 +865,56 @@ func (m *MsgRegisterCredentialStatus) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgRegisterCredentialStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRegisterCredentialStatus.Merge(m, src)
+func (m *MsgUpdateCredentialSchemaStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCredentialSchemaStatus.Merge(m, src)
 }
-func (m *MsgRegisterCredentialStatus) XXX_Size() int {
+func (m *MsgUpdateCredentialSchemaStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRegisterCredentialStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRegisterCredentialStatus.DiscardUnknown(m)
+func (m *MsgUpdateCredentialSchemaStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCredentialSchemaStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRegisterCredentialStatus proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateCredentialSchemaStatus proto.InternalMessageInfo
 
-func (m *MsgRegisterCredentialStatus) GetCredentialStatusDocument() *CredentialStatusDocument {
+func (m *MsgUpdateCredentialSchemaStatus) GetCredentialSchemaStatusDocument() *CredentialSchemaStatusDocument {
 	if m != nil {
-		return m.CredentialStatusDocument
+		return m.CredentialSchemaStatusDocument
 	}
 	return nil
 }
 
-func (m *MsgRegisterCredentialStatus) GetCredentialStatusProof() *DocumentProof {
+func (m *MsgUpdateCredentialSchemaStatus) GetCredentialSchemaStatusProof() *DocumentProof {
 	if m != nil {
-		return m.CredentialStatusProof
+		return m.CredentialSchemaStatusProof
 	}
 	return nil
 }
 
-func (m *MsgRegisterCredentialStatus) GetTxAuthor() string {
+func (m *MsgUpdateCredentialSchemaStatus) GetTxAuthor() string {
 	if m != nil {
 		return m.TxAuthor
 	}
 	return ""
 }
 
-type MsgRegisterCredentialStatusResponse struct {
+type MsgUpdateCredentialSchemaStatusResponse struct {
 }
 
-func (m *MsgRegisterCredentialStatusResponse) Reset()         { *m = MsgRegisterCredentialStatusResponse{} }
-func (m *MsgRegisterCredentialStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRegisterCredentialStatusResponse) ProtoMessage()    {}
-func (*MsgRegisterCredentialStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51540e93e450970a, []int{11}
+func (m *MsgUpdateCredentialSchemaStatusResponse) Reset() {
+	*m = MsgUpdateCredentialSchemaStatusResponse{}
 }
-func (m *MsgRegisterCredentialStatusResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateCredentialSchemaStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCredentialSchemaStatusResponse) ProtoMessage()    {}
+func (*MsgUpdateCredentialSchemaStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{17}
+}
+func (m *MsgUpdateCredentialSchemaStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRegisterCredentialStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateCredentialSchemaStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRegisterCredentialStatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateCredentialSchemaStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -607,29 +924,125 @@ func (m *MsgRegisterCredentialStatusResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *MsgRegisterCredentialStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRegisterCredentialStatusResponse.Merge(m, src)
+func (m *MsgUpdateCredentialSchemaStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCredentialSchemaStatusResponse.Merge(m, src)
 }
-func (m *MsgRegisterCredentialStatusResponse) XXX_Size() int {
+func (m *MsgUpdateCredentialSchemaStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRegisterCredentialStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRegisterCredentialStatusResponse.DiscardUnknown(m)
+func (m *MsgUpdateCredentialSchemaStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCredentialSchemaStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRegisterCredentialStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateCredentialSchemaStatusResponse proto.InternalMessageInfo
 
-type MsgUpdateCredentialStatus struct {
+type MsgRegisterCredentialStatus struct {
 	CredentialStatusDocument *CredentialStatusDocument `protobuf:"bytes,1,opt,name=credentialStatusDocument,proto3" json:"credentialStatusDocument,omitempty"`
 	CredentialStatusProof    *DocumentProof            `protobuf:"bytes,2,opt,name=credentialStatusProof,proto3" json:"credentialStatusProof,omitempty"`
 	TxAuthor                 string                    `protobuf:"bytes,3,opt,name=txAuthor,proto3" json:"txAuthor,omitempty"`
 }
 
-func (m *MsgUpdateCredentialStatus) Reset()         { *m = MsgUpdateCredentialStatus{} }
-func (m *MsgUpdateCredentialStatus) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateCredentialStatus) ProtoMessage()    {}
-func (*MsgUpdateCredentialStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51540e93e450970a, []int{12}
+func (m *MsgRegisterCredentialStatus) Reset()         { *m = MsgRegisterCredentialStatus{} }
+func (m *MsgRegisterCredentialStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCredentialStatus) ProtoMessage()    {}
+func (*MsgRegisterCredentialStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{18}
+}
+func (m *MsgRegisterCredentialStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCredentialStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCredentialStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterCredentialStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCredentialStatus.Merge(m, src)
+}
+func (m *MsgRegisterCredentialStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCredentialStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCredentialStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterCredentialStatus proto.InternalMessageInfo
+
+func (m *MsgRegisterCredentialStatus) GetCredentialStatusDocument() *CredentialStatusDocument {
+	if m != nil {
+		return m.CredentialStatusDocument
+	}
+	return nil
+}
+
+func (m *MsgRegisterCredentialStatus) GetCredentialStatusProof() *DocumentProof {
+	if m != nil {
+		return m.CredentialStatusProof
+	}
+	return nil
+}
+
+func (m *MsgRegisterCredentialStatus) GetTxAuthor() string {
+	if m != nil {
+		return m.TxAuthor
+	}
+	return ""
+}
+
+type MsgRegisterCredentialStatusResponse struct {
+}
+
+func (m *MsgRegisterCredentialStatusResponse) Reset()         { *m = MsgRegisterCredentialStatusResponse{} }
+func (m *MsgRegisterCredentialStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCredentialStatusResponse) ProtoMessage()    {}
+func (*MsgRegisterCredentialStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{19}
+}
+func (m *MsgRegisterCredentialStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCredentialStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCredentialStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterCredentialStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCredentialStatusResponse.Merge(m, src)
+}
+func (m *MsgRegisterCredentialStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCredentialStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCredentialStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterCredentialStatusResponse proto.InternalMessageInfo
+
+type MsgUpdateCredentialStatus struct {
+	CredentialStatusDocument *CredentialStatusDocument `protobuf:"bytes,1,opt,name=credentialStatusDocument,proto3" json:"credentialStatusDocument,omitempty"`
+	CredentialStatusProof    *DocumentProof            `protobuf:"bytes,2,opt,name=credentialStatusProof,proto3" json:"credentialStatusProof,omitempty"`
+	TxAuthor                 string                    `protobuf:"bytes,3,opt,name=txAuthor,proto3" json:"txAuthor,omitempty"`
+}
+
+func (m *MsgUpdateCredentialStatus) Reset()         { *m = MsgUpdateCredentialStatus{} }
+func (m *MsgUpdateCredentialStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCredentialStatus) ProtoMessage()    {}
+func (*MsgUpdateCredentialStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{20}
 }
 func (m *MsgUpdateCredentialStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +1099,7 @@ func (m *MsgUpdateCredentialStatusResponse) Reset()         { *m = MsgUpdateCred
 func (m *MsgUpdateCredentialStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCredentialStatusResponse) ProtoMessage()    {}
 func (*MsgUpdateCredentialStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_51540e93e450970a, []int{13}
+	return fileDescriptor_51540e93e450970a, []int{21}
 }
 func (m *MsgUpdateCredentialStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -715,1160 +1128,5369 @@ func (m *MsgUpdateCredentialStatusResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateCredentialStatusResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRegisterDID)(nil), "hypersign.ssi.v1.MsgRegisterDID")
-	proto.RegisterType((*MsgRegisterDIDResponse)(nil), "hypersign.ssi.v1.MsgRegisterDIDResponse")
-	proto.RegisterType((*MsgUpdateDID)(nil), "hypersign.ssi.v1.MsgUpdateDID")
-	proto.RegisterType((*MsgUpdateDIDResponse)(nil), "hypersign.ssi.v1.MsgUpdateDIDResponse")
-	proto.RegisterType((*MsgDeactivateDID)(nil), "hypersign.ssi.v1.MsgDeactivateDID")
-	proto.RegisterType((*MsgDeactivateDIDResponse)(nil), "hypersign.ssi.v1.MsgDeactivateDIDResponse")
-	proto.RegisterType((*MsgRegisterCredentialSchema)(nil), "hypersign.ssi.v1.MsgRegisterCredentialSchema")
-	proto.RegisterType((*MsgRegisterCredentialSchemaResponse)(nil), "hypersign.ssi.v1.MsgRegisterCredentialSchemaResponse")
-	proto.RegisterType((*MsgUpdateCredentialSchema)(nil), "hypersign.ssi.v1.MsgUpdateCredentialSchema")
-	proto.RegisterType((*MsgUpdateCredentialSchemaResponse)(nil), "hypersign.ssi.v1.MsgUpdateCredentialSchemaResponse")
-	proto.RegisterType((*MsgRegisterCredentialStatus)(nil), "hypersign.ssi.v1.MsgRegisterCredentialStatus")
-	proto.RegisterType((*MsgRegisterCredentialStatusResponse)(nil), "hypersign.ssi.v1.MsgRegisterCredentialStatusResponse")
-	proto.RegisterType((*MsgUpdateCredentialStatus)(nil), "hypersign.ssi.v1.MsgUpdateCredentialStatus")
-	proto.RegisterType((*MsgUpdateCredentialStatusResponse)(nil), "hypersign.ssi.v1.MsgUpdateCredentialStatusResponse")
-}
-
-func init() { proto.RegisterFile("hypersign/ssi/v1/tx.proto", fileDescriptor_51540e93e450970a) }
-
-var fileDescriptor_51540e93e450970a = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x96, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7b, 0x0d, 0xaa, 0xc8, 0x0b, 0x45, 0xc5, 0x82, 0xc8, 0x35, 0xc5, 0x84, 0x14, 0x50,
-	0x54, 0x14, 0x5b, 0x4d, 0xc4, 0x8c, 0xa0, 0x59, 0x22, 0x11, 0x09, 0xb9, 0xea, 0x00, 0x4b, 0x95,
-	0xda, 0x57, 0xe7, 0xa4, 0xc6, 0x67, 0xf9, 0x2e, 0x51, 0xca, 0xc4, 0x9f, 0xc0, 0xc0, 0x9f, 0xc3,
-	0xc2, 0xc6, 0xd8, 0xb1, 0x23, 0x4a, 0xfe, 0x07, 0xc4, 0x88, 0x7a, 0x49, 0x2f, 0xfe, 0x11, 0xdb,
-	0x49, 0xa7, 0x0a, 0x46, 0xdf, 0xfb, 0x7e, 0xdf, 0xbb, 0xcf, 0xf3, 0xf3, 0x9d, 0x61, 0xbb, 0x77,
-	0xee, 0xe3, 0x80, 0x11, 0xd7, 0x33, 0x19, 0x23, 0xe6, 0x70, 0xdf, 0xe4, 0x23, 0xc3, 0x0f, 0x28,
-	0xa7, 0xca, 0x96, 0x0c, 0x19, 0x8c, 0x11, 0x63, 0xb8, 0xaf, 0xd5, 0x12, 0x62, 0x3b, 0xc0, 0x0e,
-	0xf6, 0x38, 0xe9, 0x9e, 0x1d, 0x33, 0xbb, 0x87, 0xfb, 0xdd, 0xa9, 0x57, 0xd3, 0x12, 0x4a, 0x87,
-	0x38, 0xb3, 0x58, 0x76, 0x16, 0xde, 0xe5, 0x03, 0x36, 0x53, 0xee, 0x24, 0x94, 0x7e, 0x40, 0xe9,
-	0xe9, 0x34, 0x5a, 0xfd, 0x8e, 0xe0, 0x7e, 0x87, 0xb9, 0x16, 0x76, 0x09, 0xe3, 0x38, 0x68, 0xb5,
-	0x5b, 0xca, 0x1b, 0x28, 0x39, 0xc4, 0x69, 0x51, 0x7b, 0xd0, 0xc7, 0x1e, 0x57, 0x51, 0x05, 0xd5,
-	0x4a, 0x8d, 0x27, 0x46, 0x1c, 0xc4, 0x68, 0xcd, 0x45, 0x56, 0xd8, 0xa1, 0x74, 0xe0, 0x41, 0xe8,
-	0xf1, 0xc3, 0x55, 0x35, 0xa6, 0xae, 0x57, 0x0a, 0xb5, 0x52, 0xe3, 0xe9, 0x82, 0x34, 0x61, 0x9d,
-	0x95, 0x74, 0x2a, 0x1a, 0xdc, 0xe5, 0xa3, 0xb7, 0x03, 0xde, 0xa3, 0x81, 0x5a, 0xa8, 0xa0, 0x5a,
-	0xd1, 0x92, 0xcf, 0x55, 0x15, 0xca, 0xd1, 0xdd, 0x5b, 0x98, 0xf9, 0xd4, 0x63, 0xb8, 0x7a, 0x89,
-	0xe0, 0x5e, 0x87, 0xb9, 0x47, 0xbe, 0xd3, 0xe5, 0xf8, 0x36, 0x62, 0xed, 0x40, 0x71, 0x78, 0x65,
-	0xa1, 0x5e, 0xdb, 0x99, 0x71, 0xcd, 0x17, 0x22, 0xd0, 0x77, 0x62, 0xd0, 0x65, 0x78, 0x18, 0x26,
-	0x93, 0xc8, 0x3f, 0x10, 0x6c, 0x75, 0x98, 0xdb, 0xc2, 0x5d, 0x9b, 0x93, 0xe1, 0x0c, 0xfb, 0x39,
-	0x6c, 0x86, 0x6a, 0xb7, 0x1d, 0x01, 0x5e, 0xb4, 0xa2, 0x8b, 0xb7, 0x87, 0x4d, 0x03, 0x35, 0x8e,
-	0x20, 0xf9, 0xfe, 0x20, 0x78, 0x1c, 0x7a, 0xdb, 0x07, 0x72, 0xe0, 0x0f, 0xc5, 0x57, 0xa3, 0x9c,
-	0x82, 0x6a, 0xc7, 0xd6, 0x62, 0xaf, 0x7b, 0x2f, 0xc9, 0x72, 0x90, 0xe2, 0xb0, 0x52, 0x73, 0x29,
-	0x47, 0xf0, 0x28, 0x1e, 0x13, 0xdc, 0xea, 0xba, 0x28, 0x92, 0xdb, 0xb0, 0xc5, 0xee, 0xcc, 0x39,
-	0x7f, 0x01, 0xbb, 0x19, 0xe4, 0xb2, 0x43, 0xbf, 0x11, 0x6c, 0xcb, 0xd1, 0xf8, 0x9f, 0xfa, 0xb3,
-	0x0b, 0xcf, 0x52, 0xb9, 0x97, 0x98, 0x1f, 0x71, 0x5e, 0xc6, 0xfa, 0x23, 0xd6, 0x56, 0xea, 0x4f,
-	0xc4, 0x61, 0xa5, 0xe6, 0x8a, 0xf5, 0x47, 0xc4, 0x6e, 0xdc, 0x9f, 0xb9, 0xfb, 0x66, 0xf3, 0x23,
-	0xfc, 0xb9, 0xf3, 0xf3, 0xcf, 0xf7, 0x27, 0x65, 0x7e, 0x22, 0xdd, 0x69, 0x7c, 0xdb, 0x80, 0x42,
-	0x87, 0xb9, 0xca, 0x47, 0x28, 0x85, 0xef, 0xcb, 0x4a, 0x72, 0x3f, 0xd1, 0x3b, 0x49, 0xab, 0xe5,
-	0x29, 0xae, 0x4b, 0x28, 0x87, 0x50, 0x9c, 0xdf, 0x58, 0xfa, 0x42, 0x9b, 0x8c, 0x6b, 0x2f, 0xb3,
-	0xe3, 0x32, 0xe9, 0x31, 0x6c, 0x46, 0xef, 0x84, 0xea, 0x42, 0x63, 0x44, 0xa3, 0xed, 0xe5, 0x6b,
-	0x64, 0x81, 0x2f, 0x08, 0xd4, 0xd4, 0x53, 0xb9, 0x9e, 0x09, 0x1f, 0x97, 0x6b, 0xaf, 0x57, 0x92,
-	0xcb, 0x2d, 0x7c, 0x86, 0x72, 0xca, 0xa9, 0xf7, 0x2a, 0xa3, 0x4b, 0x89, 0xea, 0xcd, 0x15, 0xc4,
-	0x79, 0xf8, 0xd3, 0x8f, 0x66, 0x69, 0x7c, 0x21, 0x5f, 0x1e, 0x3f, 0x32, 0x9a, 0x0b, 0xf1, 0xa7,
-	0xf5, 0x97, 0xc4, 0x9f, 0x56, 0x6f, 0xae, 0x20, 0xbe, 0xae, 0xfd, 0xee, 0xfd, 0xcf, 0xb1, 0x8e,
-	0x2e, 0xc6, 0x3a, 0xfa, 0x35, 0xd6, 0xd1, 0xd7, 0x89, 0xbe, 0x76, 0x31, 0xd1, 0xd7, 0x2e, 0x27,
-	0xfa, 0xda, 0xa7, 0x86, 0x4b, 0x78, 0x6f, 0x70, 0x62, 0xd8, 0xb4, 0x6f, 0xca, 0xc4, 0x75, 0xf1,
-	0xe3, 0x69, 0xd3, 0x33, 0xb3, 0x47, 0x9c, 0xba, 0x47, 0x1d, 0x6c, 0x8e, 0xc4, 0x9f, 0x29, 0x3f,
-	0xf7, 0x31, 0x3b, 0xd9, 0x10, 0xe1, 0xe6, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x16, 0xbe,
-	0x62, 0x54, 0x0b, 0x00, 0x00,
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	RegisterDID(ctx context.Context, in *MsgRegisterDID, opts ...grpc.CallOption) (*MsgRegisterDIDResponse, error)
-	UpdateDID(ctx context.Context, in *MsgUpdateDID, opts ...grpc.CallOption) (*MsgUpdateDIDResponse, error)
-	DeactivateDID(ctx context.Context, in *MsgDeactivateDID, opts ...grpc.CallOption) (*MsgDeactivateDIDResponse, error)
-	RegisterCredentialSchema(ctx context.Context, in *MsgRegisterCredentialSchema, opts ...grpc.CallOption) (*MsgRegisterCredentialSchemaResponse, error)
-	UpdateCredentialSchema(ctx context.Context, in *MsgUpdateCredentialSchema, opts ...grpc.CallOption) (*MsgUpdateCredentialSchemaResponse, error)
-	RegisterCredentialStatus(ctx context.Context, in *MsgRegisterCredentialStatus, opts ...grpc.CallOption) (*MsgRegisterCredentialStatusResponse, error)
-	UpdateCredentialStatus(ctx context.Context, in *MsgUpdateCredentialStatus, opts ...grpc.CallOption) (*MsgUpdateCredentialStatusResponse, error)
+// MsgRegisterCredentialStatusBatch registers many Credential Statuses of an issuer under a single proof
+type MsgRegisterCredentialStatusBatch struct {
+	CredentialStatusBatchDocument *CredentialStatusBatchDocument `protobuf:"bytes,1,opt,name=credentialStatusBatchDocument,proto3" json:"credentialStatusBatchDocument,omitempty"`
+	CredentialStatusBatchProof    *DocumentProof                 `protobuf:"bytes,2,opt,name=credentialStatusBatchProof,proto3" json:"credentialStatusBatchProof,omitempty"`
+	TxAuthor                      string                         `protobuf:"bytes,3,opt,name=txAuthor,proto3" json:"txAuthor,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterCredentialStatusBatch) Reset()         { *m = MsgRegisterCredentialStatusBatch{} }
+func (m *MsgRegisterCredentialStatusBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCredentialStatusBatch) ProtoMessage()    {}
+func (*MsgRegisterCredentialStatusBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{22}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRegisterCredentialStatusBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) RegisterDID(ctx context.Context, in *MsgRegisterDID, opts ...grpc.CallOption) (*MsgRegisterDIDResponse, error) {
-	out := new(MsgRegisterDIDResponse)
-	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/RegisterDID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterCredentialStatusBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCredentialStatusBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) UpdateDID(ctx context.Context, in *MsgUpdateDID, opts ...grpc.CallOption) (*MsgUpdateDIDResponse, error) {
-	out := new(MsgUpdateDIDResponse)
-	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/UpdateDID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRegisterCredentialStatusBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCredentialStatusBatch.Merge(m, src)
 }
-
-func (c *msgClient) DeactivateDID(ctx context.Context, in *MsgDeactivateDID, opts ...grpc.CallOption) (*MsgDeactivateDIDResponse, error) {
-	out := new(MsgDeactivateDIDResponse)
-	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/DeactivateDID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRegisterCredentialStatusBatch) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) RegisterCredentialSchema(ctx context.Context, in *MsgRegisterCredentialSchema, opts ...grpc.CallOption) (*MsgRegisterCredentialSchemaResponse, error) {
-	out := new(MsgRegisterCredentialSchemaResponse)
-	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/RegisterCredentialSchema", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgRegisterCredentialStatusBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCredentialStatusBatch.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateCredentialSchema(ctx context.Context, in *MsgUpdateCredentialSchema, opts ...grpc.CallOption) (*MsgUpdateCredentialSchemaResponse, error) {
-	out := new(MsgUpdateCredentialSchemaResponse)
-	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/UpdateCredentialSchema", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgRegisterCredentialStatusBatch proto.InternalMessageInfo
 
-func (c *msgClient) RegisterCredentialStatus(ctx context.Context, in *MsgRegisterCredentialStatus, opts ...grpc.CallOption) (*MsgRegisterCredentialStatusResponse, error) {
-	out := new(MsgRegisterCredentialStatusResponse)
-	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/RegisterCredentialStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterCredentialStatusBatch) GetCredentialStatusBatchDocument() *CredentialStatusBatchDocument {
+	if m != nil {
+		return m.CredentialStatusBatchDocument
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) UpdateCredentialStatus(ctx context.Context, in *MsgUpdateCredentialStatus, opts ...grpc.CallOption) (*MsgUpdateCredentialStatusResponse, error) {
-	out := new(MsgUpdateCredentialStatusResponse)
-	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/UpdateCredentialStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterCredentialStatusBatch) GetCredentialStatusBatchProof() *DocumentProof {
+	if m != nil {
+		return m.CredentialStatusBatchProof
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	RegisterDID(context.Context, *MsgRegisterDID) (*MsgRegisterDIDResponse, error)
-	UpdateDID(context.Context, *MsgUpdateDID) (*MsgUpdateDIDResponse, error)
-	DeactivateDID(context.Context, *MsgDeactivateDID) (*MsgDeactivateDIDResponse, error)
-	RegisterCredentialSchema(context.Context, *MsgRegisterCredentialSchema) (*MsgRegisterCredentialSchemaResponse, error)
-	UpdateCredentialSchema(context.Context, *MsgUpdateCredentialSchema) (*MsgUpdateCredentialSchemaResponse, error)
-	RegisterCredentialStatus(context.Context, *MsgRegisterCredentialStatus) (*MsgRegisterCredentialStatusResponse, error)
-	UpdateCredentialStatus(context.Context, *MsgUpdateCredentialStatus) (*MsgUpdateCredentialStatusResponse, error)
+func (m *MsgRegisterCredentialStatusBatch) GetTxAuthor() string {
+	if m != nil {
+		return m.TxAuthor
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgRegisterCredentialStatusBatchResponse struct {
 }
 
-func (*UnimplementedMsgServer) RegisterDID(ctx context.Context, req *MsgRegisterDID) (*MsgRegisterDIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterDID not implemented")
+func (m *MsgRegisterCredentialStatusBatchResponse) Reset() {
+	*m = MsgRegisterCredentialStatusBatchResponse{}
 }
-func (*UnimplementedMsgServer) UpdateDID(ctx context.Context, req *MsgUpdateDID) (*MsgUpdateDIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDID not implemented")
+func (m *MsgRegisterCredentialStatusBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCredentialStatusBatchResponse) ProtoMessage()    {}
+func (*MsgRegisterCredentialStatusBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{23}
 }
-func (*UnimplementedMsgServer) DeactivateDID(ctx context.Context, req *MsgDeactivateDID) (*MsgDeactivateDIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeactivateDID not implemented")
+func (m *MsgRegisterCredentialStatusBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RegisterCredentialSchema(ctx context.Context, req *MsgRegisterCredentialSchema) (*MsgRegisterCredentialSchemaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterCredentialSchema not implemented")
+func (m *MsgRegisterCredentialStatusBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCredentialStatusBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateCredentialSchema(ctx context.Context, req *MsgUpdateCredentialSchema) (*MsgUpdateCredentialSchemaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialSchema not implemented")
+func (m *MsgRegisterCredentialStatusBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCredentialStatusBatchResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) RegisterCredentialStatus(ctx context.Context, req *MsgRegisterCredentialStatus) (*MsgRegisterCredentialStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterCredentialStatus not implemented")
+func (m *MsgRegisterCredentialStatusBatchResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateCredentialStatus(ctx context.Context, req *MsgUpdateCredentialStatus) (*MsgUpdateCredentialStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialStatus not implemented")
+func (m *MsgRegisterCredentialStatusBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCredentialStatusBatchResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRegisterCredentialStatusBatchResponse proto.InternalMessageInfo
+
+type MsgRegisterCredentialStatusList struct {
+	CredentialStatusListDocument *CredentialStatusListDocument `protobuf:"bytes,1,opt,name=credentialStatusListDocument,proto3" json:"credentialStatusListDocument,omitempty"`
+	CredentialStatusListProof    *DocumentProof                `protobuf:"bytes,2,opt,name=credentialStatusListProof,proto3" json:"credentialStatusListProof,omitempty"`
+	TxAuthor                     string                        `protobuf:"bytes,3,opt,name=txAuthor,proto3" json:"txAuthor,omitempty"`
 }
 
-func _Msg_RegisterDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterDID)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterDID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hypersign.ssi.v1.Msg/RegisterDID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterDID(ctx, req.(*MsgRegisterDID))
+func (m *MsgRegisterCredentialStatusList) Reset()         { *m = MsgRegisterCredentialStatusList{} }
+func (m *MsgRegisterCredentialStatusList) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCredentialStatusList) ProtoMessage()    {}
+func (*MsgRegisterCredentialStatusList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{24}
+}
+func (m *MsgRegisterCredentialStatusList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCredentialStatusList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCredentialStatusList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRegisterCredentialStatusList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCredentialStatusList.Merge(m, src)
+}
+func (m *MsgRegisterCredentialStatusList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCredentialStatusList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCredentialStatusList.DiscardUnknown(m)
 }
 
-func _Msg_UpdateDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateDID)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateDID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hypersign.ssi.v1.Msg/UpdateDID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateDID(ctx, req.(*MsgUpdateDID))
+var xxx_messageInfo_MsgRegisterCredentialStatusList proto.InternalMessageInfo
+
+func (m *MsgRegisterCredentialStatusList) GetCredentialStatusListDocument() *CredentialStatusListDocument {
+	if m != nil {
+		return m.CredentialStatusListDocument
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_DeactivateDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeactivateDID)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeactivateDID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hypersign.ssi.v1.Msg/DeactivateDID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeactivateDID(ctx, req.(*MsgDeactivateDID))
+func (m *MsgRegisterCredentialStatusList) GetCredentialStatusListProof() *DocumentProof {
+	if m != nil {
+		return m.CredentialStatusListProof
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_RegisterCredentialSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterCredentialSchema)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterCredentialSchema(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hypersign.ssi.v1.Msg/RegisterCredentialSchema",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterCredentialSchema(ctx, req.(*MsgRegisterCredentialSchema))
+func (m *MsgRegisterCredentialStatusList) GetTxAuthor() string {
+	if m != nil {
+		return m.TxAuthor
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateCredentialSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateCredentialSchema)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateCredentialSchema(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hypersign.ssi.v1.Msg/UpdateCredentialSchema",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateCredentialSchema(ctx, req.(*MsgUpdateCredentialSchema))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgRegisterCredentialStatusListResponse struct {
 }
 
-func _Msg_RegisterCredentialStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterCredentialStatus)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterCredentialStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hypersign.ssi.v1.Msg/RegisterCredentialStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterCredentialStatus(ctx, req.(*MsgRegisterCredentialStatus))
+func (m *MsgRegisterCredentialStatusListResponse) Reset() {
+	*m = MsgRegisterCredentialStatusListResponse{}
+}
+func (m *MsgRegisterCredentialStatusListResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCredentialStatusListResponse) ProtoMessage()    {}
+func (*MsgRegisterCredentialStatusListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{25}
+}
+func (m *MsgRegisterCredentialStatusListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCredentialStatusListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCredentialStatusListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRegisterCredentialStatusListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCredentialStatusListResponse.Merge(m, src)
+}
+func (m *MsgRegisterCredentialStatusListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCredentialStatusListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCredentialStatusListResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateCredentialStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateCredentialStatus)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterCredentialStatusListResponse proto.InternalMessageInfo
+
+type MsgUpdateCredentialStatusList struct {
+	CredentialStatusListDocument *CredentialStatusListDocument `protobuf:"bytes,1,opt,name=credentialStatusListDocument,proto3" json:"credentialStatusListDocument,omitempty"`
+	CredentialStatusListProof    *DocumentProof                `protobuf:"bytes,2,opt,name=credentialStatusListProof,proto3" json:"credentialStatusListProof,omitempty"`
+	TxAuthor                     string                        `protobuf:"bytes,3,opt,name=txAuthor,proto3" json:"txAuthor,omitempty"`
+}
+
+func (m *MsgUpdateCredentialStatusList) Reset()         { *m = MsgUpdateCredentialStatusList{} }
+func (m *MsgUpdateCredentialStatusList) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCredentialStatusList) ProtoMessage()    {}
+func (*MsgUpdateCredentialStatusList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{26}
+}
+func (m *MsgUpdateCredentialStatusList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCredentialStatusList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCredentialStatusList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateCredentialStatus(ctx, in)
+}
+func (m *MsgUpdateCredentialStatusList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCredentialStatusList.Merge(m, src)
+}
+func (m *MsgUpdateCredentialStatusList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCredentialStatusList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCredentialStatusList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCredentialStatusList proto.InternalMessageInfo
+
+func (m *MsgUpdateCredentialStatusList) GetCredentialStatusListDocument() *CredentialStatusListDocument {
+	if m != nil {
+		return m.CredentialStatusListDocument
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/hypersign.ssi.v1.Msg/UpdateCredentialStatus",
+	return nil
+}
+
+func (m *MsgUpdateCredentialStatusList) GetCredentialStatusListProof() *DocumentProof {
+	if m != nil {
+		return m.CredentialStatusListProof
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateCredentialStatus(ctx, req.(*MsgUpdateCredentialStatus))
+	return nil
+}
+
+func (m *MsgUpdateCredentialStatusList) GetTxAuthor() string {
+	if m != nil {
+		return m.TxAuthor
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "hypersign.ssi.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterDID",
-			Handler:    _Msg_RegisterDID_Handler,
-		},
-		{
-			MethodName: "UpdateDID",
-			Handler:    _Msg_UpdateDID_Handler,
-		},
-		{
-			MethodName: "DeactivateDID",
-			Handler:    _Msg_DeactivateDID_Handler,
-		},
-		{
-			MethodName: "RegisterCredentialSchema",
-			Handler:    _Msg_RegisterCredentialSchema_Handler,
-		},
-		{
-			MethodName: "UpdateCredentialSchema",
-			Handler:    _Msg_UpdateCredentialSchema_Handler,
-		},
-		{
-			MethodName: "RegisterCredentialStatus",
-			Handler:    _Msg_RegisterCredentialStatus_Handler,
-		},
-		{
-			MethodName: "UpdateCredentialStatus",
-			Handler:    _Msg_UpdateCredentialStatus_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "hypersign/ssi/v1/tx.proto",
+type MsgUpdateCredentialStatusListResponse struct {
 }
 
-func (m *MsgRegisterDID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCredentialStatusListResponse) Reset()         { *m = MsgUpdateCredentialStatusListResponse{} }
+func (m *MsgUpdateCredentialStatusListResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCredentialStatusListResponse) ProtoMessage()    {}
+func (*MsgUpdateCredentialStatusListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{27}
+}
+func (m *MsgUpdateCredentialStatusListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCredentialStatusListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCredentialStatusListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateCredentialStatusListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCredentialStatusListResponse.Merge(m, src)
+}
+func (m *MsgUpdateCredentialStatusListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCredentialStatusListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCredentialStatusListResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterDID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateCredentialStatusListResponse proto.InternalMessageInfo
+
+type MsgRegisterAccreditation struct {
+	AccreditationDocument *AccreditationDocument `protobuf:"bytes,1,opt,name=accreditationDocument,proto3" json:"accreditationDocument,omitempty"`
+	AccreditationProof    *DocumentProof         `protobuf:"bytes,2,opt,name=accreditationProof,proto3" json:"accreditationProof,omitempty"`
+	TxAuthor              string                 `protobuf:"bytes,3,opt,name=txAuthor,proto3" json:"txAuthor,omitempty"`
 }
 
-func (m *MsgRegisterDID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxAuthor) > 0 {
-		i -= len(m.TxAuthor)
-		copy(dAtA[i:], m.TxAuthor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgRegisterAccreditation) Reset()         { *m = MsgRegisterAccreditation{} }
+func (m *MsgRegisterAccreditation) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAccreditation) ProtoMessage()    {}
+func (*MsgRegisterAccreditation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{28}
+}
+func (m *MsgRegisterAccreditation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAccreditation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAccreditation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.DidDocumentProofs) > 0 {
-		for iNdEx := len(m.DidDocumentProofs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DidDocumentProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.DidDocument != nil {
-		{
-			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRegisterAccreditation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAccreditation.Merge(m, src)
+}
+func (m *MsgRegisterAccreditation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAccreditation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAccreditation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterAccreditation proto.InternalMessageInfo
+
+func (m *MsgRegisterAccreditation) GetAccreditationDocument() *AccreditationDocument {
+	if m != nil {
+		return m.AccreditationDocument
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgRegisterDIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterAccreditation) GetAccreditationProof() *DocumentProof {
+	if m != nil {
+		return m.AccreditationProof
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgRegisterDIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterAccreditation) GetTxAuthor() string {
+	if m != nil {
+		return m.TxAuthor
+	}
+	return ""
 }
 
-func (m *MsgRegisterDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgRegisterAccreditationResponse struct {
 }
 
-func (m *MsgUpdateDID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterAccreditationResponse) Reset()         { *m = MsgRegisterAccreditationResponse{} }
+func (m *MsgRegisterAccreditationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAccreditationResponse) ProtoMessage()    {}
+func (*MsgRegisterAccreditationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{29}
+}
+func (m *MsgRegisterAccreditationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAccreditationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAccreditationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRegisterAccreditationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAccreditationResponse.Merge(m, src)
+}
+func (m *MsgRegisterAccreditationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAccreditationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAccreditationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateDID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRegisterAccreditationResponse proto.InternalMessageInfo
+
+// MsgUpdateParams updates the x/ssi module parameters
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/ssi parameters to update. All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgUpdateDID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxAuthor) > 0 {
-		i -= len(m.TxAuthor)
-		copy(dAtA[i:], m.TxAuthor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.VersionId) > 0 {
-		i -= len(m.VersionId)
-		copy(dAtA[i:], m.VersionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VersionId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DidDocumentProofs) > 0 {
-		for iNdEx := len(m.DidDocumentProofs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DidDocumentProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.DidDocument != nil {
-		{
-			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{30}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateDIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateDIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func (m *MsgUpdateDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgDeactivateDID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{31}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeactivateDID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgRegisterGovernanceAccreditation registers an accreditation issued by the x/ssi module authority, which
+// does not require a Credential Schema author to sign it
+type MsgRegisterGovernanceAccreditation struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// accreditationDocument must have the authority as its accreditor, and no parent accreditation
+	AccreditationDocument *AccreditationDocument `protobuf:"bytes,2,opt,name=accreditationDocument,proto3" json:"accreditationDocument,omitempty"`
 }
 
-func (m *MsgDeactivateDID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxAuthor) > 0 {
-		i -= len(m.TxAuthor)
-		copy(dAtA[i:], m.TxAuthor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.VersionId) > 0 {
-		i -= len(m.VersionId)
-		copy(dAtA[i:], m.VersionId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VersionId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DidDocumentProofs) > 0 {
-		for iNdEx := len(m.DidDocumentProofs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DidDocumentProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgRegisterGovernanceAccreditation) Reset()         { *m = MsgRegisterGovernanceAccreditation{} }
+func (m *MsgRegisterGovernanceAccreditation) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterGovernanceAccreditation) ProtoMessage()    {}
+func (*MsgRegisterGovernanceAccreditation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{32}
+}
+func (m *MsgRegisterGovernanceAccreditation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterGovernanceAccreditation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterGovernanceAccreditation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.DidDocumentId) > 0 {
-		i -= len(m.DidDocumentId)
-		copy(dAtA[i:], m.DidDocumentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DidDocumentId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRegisterGovernanceAccreditation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterGovernanceAccreditation.Merge(m, src)
+}
+func (m *MsgRegisterGovernanceAccreditation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterGovernanceAccreditation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterGovernanceAccreditation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterGovernanceAccreditation proto.InternalMessageInfo
+
+func (m *MsgRegisterGovernanceAccreditation) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeactivateDIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterGovernanceAccreditation) GetAccreditationDocument() *AccreditationDocument {
+	if m != nil {
+		return m.AccreditationDocument
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDeactivateDIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRegisterGovernanceAccreditationResponse struct {
 }
 
-func (m *MsgDeactivateDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRegisterGovernanceAccreditationResponse) Reset() {
+	*m = MsgRegisterGovernanceAccreditationResponse{}
+}
+func (m *MsgRegisterGovernanceAccreditationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgRegisterGovernanceAccreditationResponse) ProtoMessage() {}
+func (*MsgRegisterGovernanceAccreditationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51540e93e450970a, []int{33}
+}
+func (m *MsgRegisterGovernanceAccreditationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterGovernanceAccreditationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterGovernanceAccreditationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterGovernanceAccreditationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterGovernanceAccreditationResponse.Merge(m, src)
+}
+func (m *MsgRegisterGovernanceAccreditationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterGovernanceAccreditationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterGovernanceAccreditationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterCredentialSchema) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRegisterGovernanceAccreditationResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterDID)(nil), "hypersign.ssi.v1.MsgRegisterDID")
+	proto.RegisterType((*MsgRegisterDIDResponse)(nil), "hypersign.ssi.v1.MsgRegisterDIDResponse")
+	proto.RegisterType((*MsgUpdateDID)(nil), "hypersign.ssi.v1.MsgUpdateDID")
+	proto.RegisterType((*MsgUpdateDIDResponse)(nil), "hypersign.ssi.v1.MsgUpdateDIDResponse")
+	proto.RegisterType((*MsgDeactivateDID)(nil), "hypersign.ssi.v1.MsgDeactivateDID")
+	proto.RegisterType((*MsgDeactivateDIDResponse)(nil), "hypersign.ssi.v1.MsgDeactivateDIDResponse")
+	proto.RegisterType((*MsgInitiateDidRecovery)(nil), "hypersign.ssi.v1.MsgInitiateDidRecovery")
+	proto.RegisterType((*MsgInitiateDidRecoveryResponse)(nil), "hypersign.ssi.v1.MsgInitiateDidRecoveryResponse")
+	proto.RegisterType((*MsgCancelDidRecovery)(nil), "hypersign.ssi.v1.MsgCancelDidRecovery")
+	proto.RegisterType((*MsgCancelDidRecoveryResponse)(nil), "hypersign.ssi.v1.MsgCancelDidRecoveryResponse")
+	proto.RegisterType((*MsgMarkVerificationMethodCompromised)(nil), "hypersign.ssi.v1.MsgMarkVerificationMethodCompromised")
+	proto.RegisterType((*MsgMarkVerificationMethodCompromisedResponse)(nil), "hypersign.ssi.v1.MsgMarkVerificationMethodCompromisedResponse")
+	proto.RegisterType((*MsgRegisterCredentialSchema)(nil), "hypersign.ssi.v1.MsgRegisterCredentialSchema")
+	proto.RegisterType((*MsgRegisterCredentialSchemaResponse)(nil), "hypersign.ssi.v1.MsgRegisterCredentialSchemaResponse")
+	proto.RegisterType((*MsgUpdateCredentialSchema)(nil), "hypersign.ssi.v1.MsgUpdateCredentialSchema")
+	proto.RegisterType((*MsgUpdateCredentialSchemaResponse)(nil), "hypersign.ssi.v1.MsgUpdateCredentialSchemaResponse")
+	proto.RegisterType((*MsgUpdateCredentialSchemaStatus)(nil), "hypersign.ssi.v1.MsgUpdateCredentialSchemaStatus")
+	proto.RegisterType((*MsgUpdateCredentialSchemaStatusResponse)(nil), "hypersign.ssi.v1.MsgUpdateCredentialSchemaStatusResponse")
+	proto.RegisterType((*MsgRegisterCredentialStatus)(nil), "hypersign.ssi.v1.MsgRegisterCredentialStatus")
+	proto.RegisterType((*MsgRegisterCredentialStatusResponse)(nil), "hypersign.ssi.v1.MsgRegisterCredentialStatusResponse")
+	proto.RegisterType((*MsgUpdateCredentialStatus)(nil), "hypersign.ssi.v1.MsgUpdateCredentialStatus")
+	proto.RegisterType((*MsgUpdateCredentialStatusResponse)(nil), "hypersign.ssi.v1.MsgUpdateCredentialStatusResponse")
+	proto.RegisterType((*MsgRegisterCredentialStatusBatch)(nil), "hypersign.ssi.v1.MsgRegisterCredentialStatusBatch")
+	proto.RegisterType((*MsgRegisterCredentialStatusBatchResponse)(nil), "hypersign.ssi.v1.MsgRegisterCredentialStatusBatchResponse")
+	proto.RegisterType((*MsgRegisterCredentialStatusList)(nil), "hypersign.ssi.v1.MsgRegisterCredentialStatusList")
+	proto.RegisterType((*MsgRegisterCredentialStatusListResponse)(nil), "hypersign.ssi.v1.MsgRegisterCredentialStatusListResponse")
+	proto.RegisterType((*MsgUpdateCredentialStatusList)(nil), "hypersign.ssi.v1.MsgUpdateCredentialStatusList")
+	proto.RegisterType((*MsgUpdateCredentialStatusListResponse)(nil), "hypersign.ssi.v1.MsgUpdateCredentialStatusListResponse")
+	proto.RegisterType((*MsgRegisterAccreditation)(nil), "hypersign.ssi.v1.MsgRegisterAccreditation")
+	proto.RegisterType((*MsgRegisterAccreditationResponse)(nil), "hypersign.ssi.v1.MsgRegisterAccreditationResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "hypersign.ssi.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "hypersign.ssi.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterGovernanceAccreditation)(nil), "hypersign.ssi.v1.MsgRegisterGovernanceAccreditation")
+	proto.RegisterType((*MsgRegisterGovernanceAccreditationResponse)(nil), "hypersign.ssi.v1.MsgRegisterGovernanceAccreditationResponse")
+}
+
+func init() { proto.RegisterFile("hypersign/ssi/v1/tx.proto", fileDescriptor_51540e93e450970a) }
+
+var fileDescriptor_51540e93e450970a = []byte{
+	// 1288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0x6c, 0xaa, 0x8a, 0xbc, 0xb4, 0x25, 0x35, 0x49, 0xea, 0xb8, 0x89, 0xb3, 0xd9, 0xa6,
+	0x64, 0xbb, 0x34, 0xbb, 0xcd, 0x86, 0x06, 0xb5, 0x42, 0xa0, 0xfc, 0x90, 0x50, 0xa4, 0xae, 0xa8,
+	0x1c, 0x15, 0x89, 0x1f, 0x55, 0xe4, 0xda, 0x8e, 0x77, 0xd4, 0xac, 0xbd, 0x78, 0x26, 0x21, 0xe1,
+	0x84, 0x38, 0x70, 0x42, 0x50, 0x0e, 0x70, 0x80, 0x33, 0xe2, 0xca, 0x81, 0x13, 0xe2, 0xc2, 0xad,
+	0x12, 0x97, 0x8a, 0x53, 0x4f, 0x08, 0x25, 0x12, 0xfc, 0x07, 0x88, 0x23, 0x5a, 0xdb, 0x99, 0xf8,
+	0xc7, 0x78, 0xbc, 0x0e, 0x48, 0x54, 0xed, 0x2d, 0xf6, 0xfb, 0xbe, 0xf7, 0xde, 0xf7, 0xbd, 0xb1,
+	0xe3, 0x99, 0x85, 0x89, 0xf6, 0x7e, 0xd7, 0xf2, 0x08, 0xb6, 0x9d, 0x06, 0x21, 0xb8, 0xb1, 0xbb,
+	0xd0, 0xa0, 0x7b, 0xf5, 0xae, 0xe7, 0x52, 0x57, 0x1a, 0x61, 0xa1, 0x3a, 0x21, 0xb8, 0xbe, 0xbb,
+	0xa0, 0x54, 0x53, 0x60, 0xc3, 0xb3, 0x4c, 0xcb, 0xa1, 0x58, 0xdf, 0xde, 0x24, 0x46, 0xdb, 0xea,
+	0xe8, 0x01, 0x57, 0x99, 0x4d, 0x21, 0x75, 0xa3, 0x87, 0xc5, 0x54, 0xa7, 0xd8, 0x75, 0x42, 0x94,
+	0x92, 0x42, 0x99, 0xd8, 0x0c, 0x63, 0xe2, 0x5a, 0x54, 0xa7, 0x3b, 0x24, 0x44, 0xce, 0xe7, 0x23,
+	0x37, 0xb7, 0x31, 0xa1, 0x21, 0x7c, 0x32, 0x05, 0xef, 0x7a, 0xae, 0xbb, 0x15, 0x46, 0xd5, 0x54,
+	0xd4, 0xb6, 0x1c, 0x8b, 0xe0, 0xa3, 0x62, 0xa3, 0xb6, 0x6b, 0xbb, 0xfe, 0x9f, 0x8d, 0xde, 0x5f,
+	0xe1, 0xdd, 0x0b, 0x86, 0x4b, 0x3a, 0x2e, 0x69, 0x74, 0x88, 0xdd, 0xa3, 0x74, 0x88, 0x1d, 0x06,
+	0x26, 0x82, 0xc0, 0x66, 0xc0, 0x08, 0x2e, 0x82, 0x50, 0xe5, 0x27, 0x04, 0xe7, 0x5a, 0xc4, 0xd6,
+	0x2c, 0x1b, 0x13, 0x6a, 0x79, 0x6b, 0xeb, 0x6b, 0xd2, 0xeb, 0x30, 0x6c, 0x62, 0x73, 0xcd, 0x35,
+	0x76, 0x3a, 0x96, 0x43, 0x65, 0x54, 0x46, 0xd5, 0xe1, 0xe6, 0x54, 0x3d, 0x39, 0x87, 0xfa, 0xda,
+	0x31, 0x48, 0x8b, 0x32, 0xa4, 0x16, 0x9c, 0x8f, 0x5c, 0xde, 0xee, 0xe9, 0x22, 0x72, 0xa9, 0x3c,
+	0x58, 0x1d, 0x6e, 0x4e, 0x73, 0xd2, 0x44, 0x71, 0x5a, 0x9a, 0x29, 0x29, 0xf0, 0x1c, 0xdd, 0x5b,
+	0xde, 0xa1, 0x6d, 0xd7, 0x93, 0x07, 0xcb, 0xa8, 0x3a, 0xa4, 0xb1, 0xeb, 0x8a, 0x0c, 0xe3, 0xf1,
+	0xee, 0x35, 0x8b, 0x74, 0x5d, 0x87, 0x58, 0x95, 0xc7, 0x08, 0xce, 0xb4, 0x88, 0x7d, 0xa7, 0x6b,
+	0xea, 0xd4, 0x7a, 0x12, 0x65, 0x4d, 0xc2, 0xd0, 0x6e, 0x8f, 0xe2, 0x3a, 0xeb, 0x66, 0xa8, 0xeb,
+	0xf8, 0x46, 0x4c, 0xf4, 0xa9, 0x84, 0xe8, 0x71, 0x18, 0x8d, 0x2a, 0x63, 0x92, 0x7f, 0x46, 0x30,
+	0xd2, 0x22, 0xf6, 0x9a, 0xa5, 0x1b, 0x14, 0xef, 0x86, 0xb2, 0x67, 0xe1, 0x6c, 0xa4, 0xf6, 0xba,
+	0xe9, 0x0b, 0x1f, 0xd2, 0xe2, 0x37, 0x9f, 0x1c, 0x6d, 0x0a, 0xc8, 0x49, 0x09, 0x4c, 0xdf, 0x01,
+	0xf2, 0xa7, 0xbd, 0xee, 0x60, 0x8a, 0x7b, 0x21, 0x6c, 0x6a, 0x96, 0xe1, 0xee, 0x5a, 0xde, 0xfe,
+	0x53, 0x34, 0xdc, 0x32, 0xa8, 0x7c, 0x8d, 0xcc, 0x86, 0xef, 0x90, 0x3f, 0xff, 0x55, 0xdd, 0x31,
+	0xac, 0xed, 0xa8, 0x09, 0xff, 0xcb, 0xa8, 0x45, 0x4f, 0xa7, 0x0a, 0x93, 0xbc, 0x46, 0x99, 0x92,
+	0x6f, 0x4b, 0x30, 0xdb, 0x22, 0x76, 0x4b, 0xf7, 0xee, 0xbf, 0x65, 0x79, 0x78, 0x0b, 0x1b, 0xfe,
+	0x7b, 0xb9, 0x65, 0xd1, 0xb6, 0x6b, 0xae, 0xba, 0x9d, 0xae, 0xe7, 0x76, 0x30, 0xb1, 0x4c, 0x69,
+	0x0b, 0x24, 0x83, 0x5d, 0x26, 0xa6, 0xbc, 0x94, 0x6e, 0x5a, 0x94, 0x8c, 0x8d, 0x9f, 0x93, 0x51,
+	0x7a, 0x17, 0xe4, 0xf4, 0xdd, 0x62, 0x16, 0x65, 0x26, 0xf8, 0x17, 0x6b, 0xa2, 0x0e, 0x57, 0xfb,
+	0xb1, 0x89, 0xf9, 0xfa, 0x37, 0x82, 0x8b, 0x91, 0xd7, 0xe2, 0x2a, 0xfb, 0x47, 0xb4, 0xe1, 0xff,
+	0x77, 0x94, 0xb6, 0x40, 0x36, 0x12, 0xf7, 0x12, 0xa6, 0xd6, 0xd2, 0x32, 0x57, 0x33, 0x18, 0x5a,
+	0x66, 0x2e, 0xe9, 0x0e, 0x8c, 0x25, 0x63, 0xbe, 0x17, 0x72, 0xa9, 0x8c, 0xfa, 0xf1, 0x92, 0xcf,
+	0x16, 0x2e, 0xb9, 0xcb, 0x70, 0x49, 0xa0, 0x9c, 0x39, 0xf4, 0x17, 0x82, 0x09, 0xf6, 0x0e, 0x7d,
+	0x96, 0xfc, 0xb9, 0x04, 0x33, 0x99, 0xba, 0x99, 0x3b, 0x5f, 0x97, 0x60, 0x3a, 0x13, 0xb5, 0xe1,
+	0x7f, 0xcb, 0x48, 0x7b, 0xa0, 0x1a, 0xdc, 0x48, 0xc2, 0xa9, 0x6b, 0xf9, 0x4e, 0xc5, 0x79, 0x5a,
+	0x4e, 0x5e, 0x49, 0x87, 0x8b, 0x7c, 0x44, 0x21, 0xef, 0x44, 0x39, 0x84, 0x0e, 0x5e, 0x81, 0xb9,
+	0x1c, 0x6f, 0xfa, 0x78, 0x0e, 0x03, 0x0f, 0xe3, 0xeb, 0x8c, 0xe7, 0x9e, 0x78, 0x9d, 0xc5, 0x7d,
+	0xcb, 0xcc, 0x95, 0x58, 0x67, 0xc5, 0xbd, 0xe2, 0xb3, 0x4f, 0xf6, 0x1c, 0xc6, 0x1d, 0xca, 0x7a,
+	0x0e, 0x9f, 0x7a, 0x7f, 0x32, 0x9e, 0xc3, 0xb8, 0x3b, 0x5f, 0x95, 0xa0, 0x2c, 0x70, 0x71, 0x45,
+	0xa7, 0x46, 0x5b, 0xda, 0x81, 0x29, 0x83, 0x17, 0x48, 0x38, 0xd5, 0xc8, 0x77, 0x2a, 0x46, 0xd3,
+	0xc4, 0x59, 0xa5, 0x4d, 0x50, 0xb8, 0x80, 0x42, 0xc6, 0x09, 0x52, 0x08, 0xdd, 0xab, 0x41, 0x35,
+	0xcf, 0x17, 0x66, 0xe2, 0x83, 0xe0, 0x65, 0x96, 0x05, 0xbe, 0x85, 0x09, 0x95, 0x3c, 0x98, 0x34,
+	0x38, 0xf7, 0x13, 0x16, 0xd6, 0xf3, 0x2d, 0x8c, 0xb2, 0x34, 0x61, 0x4e, 0xe9, 0x2e, 0x4c, 0xf0,
+	0xe2, 0x85, 0xfc, 0xcb, 0xce, 0xd0, 0xc7, 0x2b, 0x4c, 0xe4, 0x08, 0x73, 0xef, 0xb3, 0x12, 0x4c,
+	0x65, 0x2e, 0xd4, 0x67, 0xd1, 0xbb, 0x39, 0xb8, 0x2c, 0xf4, 0x83, 0x39, 0xf7, 0x07, 0x02, 0x39,
+	0xe2, 0xf2, 0x72, 0xf4, 0xe4, 0x41, 0xba, 0x0b, 0x63, 0xb1, 0xa3, 0x88, 0x84, 0x5b, 0x73, 0xe9,
+	0xe6, 0x97, 0x79, 0x70, 0x8d, 0x9f, 0x45, 0x7a, 0x13, 0xa4, 0x58, 0xa0, 0x90, 0x31, 0x1c, 0xaa,
+	0xd0, 0x91, 0x4a, 0xec, 0x25, 0x15, 0xeb, 0x93, 0x99, 0xf1, 0x05, 0x82, 0xe7, 0x99, 0x6d, 0xb7,
+	0x75, 0x4f, 0xef, 0x10, 0x69, 0x09, 0x86, 0x74, 0x3f, 0x03, 0xa6, 0xfb, 0xc1, 0x56, 0x65, 0x45,
+	0xfe, 0xf5, 0x87, 0xf9, 0xd1, 0xf0, 0x7c, 0x62, 0xd9, 0x34, 0x3d, 0x8b, 0x90, 0x0d, 0xea, 0x61,
+	0xc7, 0xd6, 0x8e, 0xa1, 0xd2, 0x12, 0x9c, 0xee, 0xfa, 0x19, 0x42, 0x41, 0x72, 0x5a, 0x50, 0x50,
+	0x61, 0xe5, 0xd4, 0xc3, 0xdf, 0xa6, 0x07, 0xb4, 0x10, 0x7d, 0xf3, 0xdc, 0xc7, 0x7f, 0x7e, 0x5f,
+	0x3b, 0xce, 0x53, 0x99, 0x80, 0x0b, 0x89, 0x96, 0x58, 0xbb, 0xbf, 0x20, 0xa8, 0x44, 0x34, 0xbd,
+	0xd1, 0xdb, 0xb6, 0x38, 0xbd, 0x6d, 0x4c, 0x7c, 0x8a, 0x27, 0x55, 0x90, 0x39, 0xfd, 0xd2, 0x7f,
+	0x31, 0xfd, 0x94, 0xd0, 0xab, 0x50, 0xcb, 0x17, 0x73, 0xa4, 0xbd, 0xf9, 0xe3, 0x08, 0x0c, 0xb6,
+	0x88, 0x2d, 0xbd, 0x0d, 0xc3, 0xd1, 0x53, 0xa1, 0x72, 0xba, 0xa9, 0xf8, 0xc9, 0x8b, 0x52, 0xcd,
+	0x43, 0x1c, 0x95, 0x90, 0x36, 0x60, 0xe8, 0xf8, 0x5c, 0x46, 0xe5, 0xd2, 0x58, 0x5c, 0x79, 0x51,
+	0x1c, 0x67, 0x49, 0x37, 0xe1, 0x6c, 0xfc, 0xe4, 0xa3, 0xc2, 0x25, 0xc6, 0x30, 0x4a, 0x2d, 0x1f,
+	0xc3, 0x0a, 0xbc, 0x0f, 0x2f, 0xf0, 0x8e, 0x1e, 0xf8, 0xb2, 0x39, 0x48, 0xe5, 0x5a, 0xbf, 0x48,
+	0x56, 0xf2, 0x3e, 0x9c, 0x4f, 0x6f, 0xf3, 0xf9, 0x86, 0xa4, 0x70, 0x4a, 0xbd, 0x3f, 0x1c, 0x2b,
+	0xf6, 0x0d, 0x82, 0x99, 0xfc, 0xad, 0xf8, 0x12, 0x37, 0x6b, 0x2e, 0x4f, 0x79, 0xed, 0x64, 0x3c,
+	0xd6, 0xdd, 0x47, 0x08, 0xe4, 0xcc, 0x0d, 0xed, 0xbc, 0x70, 0xe9, 0x25, 0xe1, 0xca, 0xf5, 0x42,
+	0x70, 0xd6, 0xc2, 0x87, 0x30, 0x9e, 0xb1, 0x61, 0x7c, 0x49, 0xb0, 0x46, 0x53, 0xd5, 0x17, 0x0b,
+	0x80, 0x59, 0xed, 0x4f, 0x11, 0x4c, 0x0a, 0xf7, 0x63, 0x0b, 0x05, 0xb2, 0x06, 0x14, 0xe5, 0x46,
+	0x61, 0x4a, 0xde, 0x34, 0x82, 0x56, 0xfa, 0x9e, 0x46, 0xd0, 0xc6, 0xf5, 0x42, 0x70, 0xe1, 0x34,
+	0x82, 0xfa, 0x7d, 0x4e, 0x23, 0xa8, 0xbe, 0x58, 0x00, 0xcc, 0x6a, 0x7f, 0x8e, 0x60, 0x4a, 0xfc,
+	0x55, 0xde, 0x2c, 0x24, 0xca, 0xe7, 0x28, 0x37, 0x8b, 0x73, 0x62, 0xeb, 0x43, 0xf8, 0x89, 0xbb,
+	0x50, 0x28, 0x79, 0x8f, 0xa2, 0xdc, 0x28, 0x4c, 0x61, 0xed, 0x7c, 0x82, 0x40, 0x11, 0x7c, 0x33,
+	0x36, 0x0a, 0x98, 0xee, 0xb7, 0xf2, 0x4a, 0x41, 0x02, 0x6b, 0xe4, 0x03, 0x18, 0xe3, 0x7f, 0x81,
+	0xd5, 0x84, 0xe2, 0x62, 0x58, 0xa5, 0xd9, 0x3f, 0x96, 0x15, 0x7e, 0x0f, 0xce, 0xc4, 0xbe, 0x76,
+	0x66, 0x04, 0x0a, 0x02, 0x88, 0x72, 0x25, 0x17, 0xc2, 0xb2, 0x7f, 0x89, 0x60, 0x3a, 0xef, 0xeb,
+	0xe4, 0x65, 0x61, 0xd7, 0x19, 0x2c, 0xe5, 0xd5, 0x93, 0xb0, 0x8e, 0xfa, 0x5a, 0xb9, 0xf5, 0xf0,
+	0x40, 0x45, 0x8f, 0x0e, 0x54, 0xf4, 0xfb, 0x81, 0x8a, 0x1e, 0x1c, 0xaa, 0x03, 0x8f, 0x0e, 0xd5,
+	0x81, 0xc7, 0x87, 0xea, 0xc0, 0x3b, 0x4d, 0x1b, 0xd3, 0xf6, 0xce, 0xbd, 0xba, 0xe1, 0x76, 0x1a,
+	0xac, 0xc2, 0xbc, 0xff, 0x23, 0x94, 0xe1, 0x6e, 0x37, 0xda, 0xd8, 0x9c, 0x77, 0x5c, 0xd3, 0x6a,
+	0xec, 0xf9, 0xbf, 0x78, 0xd1, 0xfd, 0xae, 0x45, 0xee, 0x9d, 0xf6, 0xc3, 0x8b, 0xff, 0x0c, 0x00,
+	0x84, 0xde, 0xa5, 0x65, 0x1f, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	RegisterDID(ctx context.Context, in *MsgRegisterDID, opts ...grpc.CallOption) (*MsgRegisterDIDResponse, error)
+	UpdateDID(ctx context.Context, in *MsgUpdateDID, opts ...grpc.CallOption) (*MsgUpdateDIDResponse, error)
+	DeactivateDID(ctx context.Context, in *MsgDeactivateDID, opts ...grpc.CallOption) (*MsgDeactivateDIDResponse, error)
+	InitiateDidRecovery(ctx context.Context, in *MsgInitiateDidRecovery, opts ...grpc.CallOption) (*MsgInitiateDidRecoveryResponse, error)
+	CancelDidRecovery(ctx context.Context, in *MsgCancelDidRecovery, opts ...grpc.CallOption) (*MsgCancelDidRecoveryResponse, error)
+	MarkVerificationMethodCompromised(ctx context.Context, in *MsgMarkVerificationMethodCompromised, opts ...grpc.CallOption) (*MsgMarkVerificationMethodCompromisedResponse, error)
+	RegisterCredentialSchema(ctx context.Context, in *MsgRegisterCredentialSchema, opts ...grpc.CallOption) (*MsgRegisterCredentialSchemaResponse, error)
+	UpdateCredentialSchema(ctx context.Context, in *MsgUpdateCredentialSchema, opts ...grpc.CallOption) (*MsgUpdateCredentialSchemaResponse, error)
+	UpdateCredentialSchemaStatus(ctx context.Context, in *MsgUpdateCredentialSchemaStatus, opts ...grpc.CallOption) (*MsgUpdateCredentialSchemaStatusResponse, error)
+	RegisterCredentialStatus(ctx context.Context, in *MsgRegisterCredentialStatus, opts ...grpc.CallOption) (*MsgRegisterCredentialStatusResponse, error)
+	UpdateCredentialStatus(ctx context.Context, in *MsgUpdateCredentialStatus, opts ...grpc.CallOption) (*MsgUpdateCredentialStatusResponse, error)
+	RegisterCredentialStatusBatch(ctx context.Context, in *MsgRegisterCredentialStatusBatch, opts ...grpc.CallOption) (*MsgRegisterCredentialStatusBatchResponse, error)
+	RegisterCredentialStatusList(ctx context.Context, in *MsgRegisterCredentialStatusList, opts ...grpc.CallOption) (*MsgRegisterCredentialStatusListResponse, error)
+	UpdateCredentialStatusList(ctx context.Context, in *MsgUpdateCredentialStatusList, opts ...grpc.CallOption) (*MsgUpdateCredentialStatusListResponse, error)
+	RegisterAccreditation(ctx context.Context, in *MsgRegisterAccreditation, opts ...grpc.CallOption) (*MsgRegisterAccreditationResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	RegisterGovernanceAccreditation(ctx context.Context, in *MsgRegisterGovernanceAccreditation, opts ...grpc.CallOption) (*MsgRegisterGovernanceAccreditationResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterDID(ctx context.Context, in *MsgRegisterDID, opts ...grpc.CallOption) (*MsgRegisterDIDResponse, error) {
+	out := new(MsgRegisterDIDResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/RegisterDID", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRegisterCredentialSchema) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateDID(ctx context.Context, in *MsgUpdateDID, opts ...grpc.CallOption) (*MsgUpdateDIDResponse, error) {
+	out := new(MsgUpdateDIDResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/UpdateDID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRegisterCredentialSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxAuthor) > 0 {
-		i -= len(m.TxAuthor)
-		copy(dAtA[i:], m.TxAuthor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.CredentialSchemaProof != nil {
-		{
-			size, err := m.CredentialSchemaProof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CredentialSchemaDocument != nil {
-		{
-			size, err := m.CredentialSchemaDocument.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) DeactivateDID(ctx context.Context, in *MsgDeactivateDID, opts ...grpc.CallOption) (*MsgDeactivateDIDResponse, error) {
+	out := new(MsgDeactivateDIDResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/DeactivateDID", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRegisterCredentialSchemaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) InitiateDidRecovery(ctx context.Context, in *MsgInitiateDidRecovery, opts ...grpc.CallOption) (*MsgInitiateDidRecoveryResponse, error) {
+	out := new(MsgInitiateDidRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/InitiateDidRecovery", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRegisterCredentialSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelDidRecovery(ctx context.Context, in *MsgCancelDidRecovery, opts ...grpc.CallOption) (*MsgCancelDidRecoveryResponse, error) {
+	out := new(MsgCancelDidRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/CancelDidRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRegisterCredentialSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) MarkVerificationMethodCompromised(ctx context.Context, in *MsgMarkVerificationMethodCompromised, opts ...grpc.CallOption) (*MsgMarkVerificationMethodCompromisedResponse, error) {
+	out := new(MsgMarkVerificationMethodCompromisedResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/MarkVerificationMethodCompromised", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateCredentialSchema) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterCredentialSchema(ctx context.Context, in *MsgRegisterCredentialSchema, opts ...grpc.CallOption) (*MsgRegisterCredentialSchemaResponse, error) {
+	out := new(MsgRegisterCredentialSchemaResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/RegisterCredentialSchema", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateCredentialSchema) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateCredentialSchema(ctx context.Context, in *MsgUpdateCredentialSchema, opts ...grpc.CallOption) (*MsgUpdateCredentialSchemaResponse, error) {
+	out := new(MsgUpdateCredentialSchemaResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/UpdateCredentialSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateCredentialSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxAuthor) > 0 {
-		i -= len(m.TxAuthor)
-		copy(dAtA[i:], m.TxAuthor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.CredentialSchemaProof != nil {
-		{
-			size, err := m.CredentialSchemaProof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CredentialSchemaDocument != nil {
-		{
-			size, err := m.CredentialSchemaDocument.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) UpdateCredentialSchemaStatus(ctx context.Context, in *MsgUpdateCredentialSchemaStatus, opts ...grpc.CallOption) (*MsgUpdateCredentialSchemaStatusResponse, error) {
+	out := new(MsgUpdateCredentialSchemaStatusResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/UpdateCredentialSchemaStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateCredentialSchemaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterCredentialStatus(ctx context.Context, in *MsgRegisterCredentialStatus, opts ...grpc.CallOption) (*MsgRegisterCredentialStatusResponse, error) {
+	out := new(MsgRegisterCredentialStatusResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/RegisterCredentialStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateCredentialSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateCredentialStatus(ctx context.Context, in *MsgUpdateCredentialStatus, opts ...grpc.CallOption) (*MsgUpdateCredentialStatusResponse, error) {
+	out := new(MsgUpdateCredentialStatusResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/UpdateCredentialStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateCredentialSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RegisterCredentialStatusBatch(ctx context.Context, in *MsgRegisterCredentialStatusBatch, opts ...grpc.CallOption) (*MsgRegisterCredentialStatusBatchResponse, error) {
+	out := new(MsgRegisterCredentialStatusBatchResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/RegisterCredentialStatusBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRegisterCredentialStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterCredentialStatusList(ctx context.Context, in *MsgRegisterCredentialStatusList, opts ...grpc.CallOption) (*MsgRegisterCredentialStatusListResponse, error) {
+	out := new(MsgRegisterCredentialStatusListResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/RegisterCredentialStatusList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRegisterCredentialStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateCredentialStatusList(ctx context.Context, in *MsgUpdateCredentialStatusList, opts ...grpc.CallOption) (*MsgUpdateCredentialStatusListResponse, error) {
+	out := new(MsgUpdateCredentialStatusListResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/UpdateCredentialStatusList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRegisterCredentialStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxAuthor) > 0 {
-		i -= len(m.TxAuthor)
-		copy(dAtA[i:], m.TxAuthor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.CredentialStatusProof != nil {
-		{
-			size, err := m.CredentialStatusProof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) RegisterAccreditation(ctx context.Context, in *MsgRegisterAccreditation, opts ...grpc.CallOption) (*MsgRegisterAccreditationResponse, error) {
+	out := new(MsgRegisterAccreditationResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/RegisterAccreditation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.CredentialStatusDocument != nil {
-		{
-			size, err := m.CredentialStatusDocument.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRegisterCredentialStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterGovernanceAccreditation(ctx context.Context, in *MsgRegisterGovernanceAccreditation, opts ...grpc.CallOption) (*MsgRegisterGovernanceAccreditationResponse, error) {
+	out := new(MsgRegisterGovernanceAccreditationResponse)
+	err := c.cc.Invoke(ctx, "/hypersign.ssi.v1.Msg/RegisterGovernanceAccreditation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRegisterCredentialStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	RegisterDID(context.Context, *MsgRegisterDID) (*MsgRegisterDIDResponse, error)
+	UpdateDID(context.Context, *MsgUpdateDID) (*MsgUpdateDIDResponse, error)
+	DeactivateDID(context.Context, *MsgDeactivateDID) (*MsgDeactivateDIDResponse, error)
+	InitiateDidRecovery(context.Context, *MsgInitiateDidRecovery) (*MsgInitiateDidRecoveryResponse, error)
+	CancelDidRecovery(context.Context, *MsgCancelDidRecovery) (*MsgCancelDidRecoveryResponse, error)
+	MarkVerificationMethodCompromised(context.Context, *MsgMarkVerificationMethodCompromised) (*MsgMarkVerificationMethodCompromisedResponse, error)
+	RegisterCredentialSchema(context.Context, *MsgRegisterCredentialSchema) (*MsgRegisterCredentialSchemaResponse, error)
+	UpdateCredentialSchema(context.Context, *MsgUpdateCredentialSchema) (*MsgUpdateCredentialSchemaResponse, error)
+	UpdateCredentialSchemaStatus(context.Context, *MsgUpdateCredentialSchemaStatus) (*MsgUpdateCredentialSchemaStatusResponse, error)
+	RegisterCredentialStatus(context.Context, *MsgRegisterCredentialStatus) (*MsgRegisterCredentialStatusResponse, error)
+	UpdateCredentialStatus(context.Context, *MsgUpdateCredentialStatus) (*MsgUpdateCredentialStatusResponse, error)
+	RegisterCredentialStatusBatch(context.Context, *MsgRegisterCredentialStatusBatch) (*MsgRegisterCredentialStatusBatchResponse, error)
+	RegisterCredentialStatusList(context.Context, *MsgRegisterCredentialStatusList) (*MsgRegisterCredentialStatusListResponse, error)
+	UpdateCredentialStatusList(context.Context, *MsgUpdateCredentialStatusList) (*MsgUpdateCredentialStatusListResponse, error)
+	RegisterAccreditation(context.Context, *MsgRegisterAccreditation) (*MsgRegisterAccreditationResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	RegisterGovernanceAccreditation(context.Context, *MsgRegisterGovernanceAccreditation) (*MsgRegisterGovernanceAccreditationResponse, error)
 }
 
-func (m *MsgRegisterCredentialStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateCredentialStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) RegisterDID(ctx context.Context, req *MsgRegisterDID) (*MsgRegisterDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDID not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDID(ctx context.Context, req *MsgUpdateDID) (*MsgUpdateDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDID not implemented")
+}
+func (*UnimplementedMsgServer) DeactivateDID(ctx context.Context, req *MsgDeactivateDID) (*MsgDeactivateDIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateDID not implemented")
+}
+func (*UnimplementedMsgServer) InitiateDidRecovery(ctx context.Context, req *MsgInitiateDidRecovery) (*MsgInitiateDidRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateDidRecovery not implemented")
+}
+func (*UnimplementedMsgServer) CancelDidRecovery(ctx context.Context, req *MsgCancelDidRecovery) (*MsgCancelDidRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDidRecovery not implemented")
+}
+func (*UnimplementedMsgServer) MarkVerificationMethodCompromised(ctx context.Context, req *MsgMarkVerificationMethodCompromised) (*MsgMarkVerificationMethodCompromisedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkVerificationMethodCompromised not implemented")
+}
+func (*UnimplementedMsgServer) RegisterCredentialSchema(ctx context.Context, req *MsgRegisterCredentialSchema) (*MsgRegisterCredentialSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCredentialSchema not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCredentialSchema(ctx context.Context, req *MsgUpdateCredentialSchema) (*MsgUpdateCredentialSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialSchema not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCredentialSchemaStatus(ctx context.Context, req *MsgUpdateCredentialSchemaStatus) (*MsgUpdateCredentialSchemaStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialSchemaStatus not implemented")
+}
+func (*UnimplementedMsgServer) RegisterCredentialStatus(ctx context.Context, req *MsgRegisterCredentialStatus) (*MsgRegisterCredentialStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCredentialStatus not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCredentialStatus(ctx context.Context, req *MsgUpdateCredentialStatus) (*MsgUpdateCredentialStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialStatus not implemented")
+}
+func (*UnimplementedMsgServer) RegisterCredentialStatusBatch(ctx context.Context, req *MsgRegisterCredentialStatusBatch) (*MsgRegisterCredentialStatusBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCredentialStatusBatch not implemented")
+}
+func (*UnimplementedMsgServer) RegisterCredentialStatusList(ctx context.Context, req *MsgRegisterCredentialStatusList) (*MsgRegisterCredentialStatusListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCredentialStatusList not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCredentialStatusList(ctx context.Context, req *MsgUpdateCredentialStatusList) (*MsgUpdateCredentialStatusListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialStatusList not implemented")
+}
+func (*UnimplementedMsgServer) RegisterAccreditation(ctx context.Context, req *MsgRegisterAccreditation) (*MsgRegisterAccreditationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccreditation not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterGovernanceAccreditation(ctx context.Context, req *MsgRegisterGovernanceAccreditation) (*MsgRegisterGovernanceAccreditationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterGovernanceAccreditation not implemented")
 }
 
-func (m *MsgUpdateCredentialStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgUpdateCredentialStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxAuthor) > 0 {
-		i -= len(m.TxAuthor)
-		copy(dAtA[i:], m.TxAuthor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_RegisterDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDID)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CredentialStatusProof != nil {
-		{
-			size, err := m.CredentialStatusProof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDID(ctx, in)
 	}
-	if m.CredentialStatusDocument != nil {
-		{
-			size, err := m.CredentialStatusDocument.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/RegisterDID",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDID(ctx, req.(*MsgRegisterDID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateCredentialStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDID)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateCredentialStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/UpdateDID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDID(ctx, req.(*MsgUpdateDID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateCredentialStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_DeactivateDID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeactivateDID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeactivateDID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/DeactivateDID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeactivateDID(ctx, req.(*MsgDeactivateDID))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_InitiateDidRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateDidRecovery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateDidRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/InitiateDidRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateDidRecovery(ctx, req.(*MsgInitiateDidRecovery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *MsgRegisterDID) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Msg_CancelDidRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDidRecovery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.DidDocument != nil {
-		l = m.DidDocument.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDidRecovery(ctx, in)
 	}
-	if len(m.DidDocumentProofs) > 0 {
-		for _, e := range m.DidDocumentProofs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/CancelDidRecovery",
 	}
-	l = len(m.TxAuthor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDidRecovery(ctx, req.(*MsgCancelDidRecovery))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterDIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_MarkVerificationMethodCompromised_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarkVerificationMethodCompromised)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).MarkVerificationMethodCompromised(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/MarkVerificationMethodCompromised",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarkVerificationMethodCompromised(ctx, req.(*MsgMarkVerificationMethodCompromised))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateDID) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterCredentialSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCredentialSchema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCredentialSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/RegisterCredentialSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCredentialSchema(ctx, req.(*MsgRegisterCredentialSchema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCredentialSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCredentialSchema)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCredentialSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/UpdateCredentialSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCredentialSchema(ctx, req.(*MsgUpdateCredentialSchema))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCredentialSchemaStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCredentialSchemaStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCredentialSchemaStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/UpdateCredentialSchemaStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCredentialSchemaStatus(ctx, req.(*MsgUpdateCredentialSchemaStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterCredentialStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCredentialStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCredentialStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/RegisterCredentialStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCredentialStatus(ctx, req.(*MsgRegisterCredentialStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCredentialStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCredentialStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCredentialStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/UpdateCredentialStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCredentialStatus(ctx, req.(*MsgUpdateCredentialStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterCredentialStatusBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCredentialStatusBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCredentialStatusBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/RegisterCredentialStatusBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCredentialStatusBatch(ctx, req.(*MsgRegisterCredentialStatusBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterCredentialStatusList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCredentialStatusList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCredentialStatusList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/RegisterCredentialStatusList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCredentialStatusList(ctx, req.(*MsgRegisterCredentialStatusList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCredentialStatusList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCredentialStatusList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCredentialStatusList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/UpdateCredentialStatusList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCredentialStatusList(ctx, req.(*MsgUpdateCredentialStatusList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterAccreditation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAccreditation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAccreditation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/RegisterAccreditation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAccreditation(ctx, req.(*MsgRegisterAccreditation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterGovernanceAccreditation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterGovernanceAccreditation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterGovernanceAccreditation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hypersign.ssi.v1.Msg/RegisterGovernanceAccreditation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterGovernanceAccreditation(ctx, req.(*MsgRegisterGovernanceAccreditation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hypersign.ssi.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterDID",
+			Handler:    _Msg_RegisterDID_Handler,
+		},
+		{
+			MethodName: "UpdateDID",
+			Handler:    _Msg_UpdateDID_Handler,
+		},
+		{
+			MethodName: "DeactivateDID",
+			Handler:    _Msg_DeactivateDID_Handler,
+		},
+		{
+			MethodName: "InitiateDidRecovery",
+			Handler:    _Msg_InitiateDidRecovery_Handler,
+		},
+		{
+			MethodName: "CancelDidRecovery",
+			Handler:    _Msg_CancelDidRecovery_Handler,
+		},
+		{
+			MethodName: "MarkVerificationMethodCompromised",
+			Handler:    _Msg_MarkVerificationMethodCompromised_Handler,
+		},
+		{
+			MethodName: "RegisterCredentialSchema",
+			Handler:    _Msg_RegisterCredentialSchema_Handler,
+		},
+		{
+			MethodName: "UpdateCredentialSchema",
+			Handler:    _Msg_UpdateCredentialSchema_Handler,
+		},
+		{
+			MethodName: "UpdateCredentialSchemaStatus",
+			Handler:    _Msg_UpdateCredentialSchemaStatus_Handler,
+		},
+		{
+			MethodName: "RegisterCredentialStatus",
+			Handler:    _Msg_RegisterCredentialStatus_Handler,
+		},
+		{
+			MethodName: "UpdateCredentialStatus",
+			Handler:    _Msg_UpdateCredentialStatus_Handler,
+		},
+		{
+			MethodName: "RegisterCredentialStatusBatch",
+			Handler:    _Msg_RegisterCredentialStatusBatch_Handler,
+		},
+		{
+			MethodName: "RegisterCredentialStatusList",
+			Handler:    _Msg_RegisterCredentialStatusList_Handler,
+		},
+		{
+			MethodName: "UpdateCredentialStatusList",
+			Handler:    _Msg_UpdateCredentialStatusList_Handler,
+		},
+		{
+			MethodName: "RegisterAccreditation",
+			Handler:    _Msg_RegisterAccreditation_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterGovernanceAccreditation",
+			Handler:    _Msg_RegisterGovernanceAccreditation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hypersign/ssi/v1/tx.proto",
+}
+
+func (m *MsgRegisterDID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DidDocument != nil {
-		l = m.DidDocument.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.DidDocumentProofs) > 0 {
-		for _, e := range m.DidDocumentProofs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.DidDocumentProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocumentProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DidDocument != nil {
+		{
+			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DidDocumentProofs) > 0 {
+		for iNdEx := len(m.DidDocumentProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocumentProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DidDocument != nil {
+		{
+			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivateDID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateDID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateDID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DidDocumentProofs) > 0 {
+		for iNdEx := len(m.DidDocumentProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocumentProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DidDocumentId) > 0 {
+		i -= len(m.DidDocumentId)
+		copy(dAtA[i:], m.DidDocumentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DidDocumentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivateDIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateDIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateDIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateDidRecovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateDidRecovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateDidRecovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DidDocumentProofs) > 0 {
+		for iNdEx := len(m.DidDocumentProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocumentProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.DidDocument != nil {
+		{
+			size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateDidRecoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateDidRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateDidRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDidRecovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDidRecovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDidRecovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DidDocumentProofs) > 0 {
+		for iNdEx := len(m.DidDocumentProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocumentProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DidDocumentId) > 0 {
+		i -= len(m.DidDocumentId)
+		copy(dAtA[i:], m.DidDocumentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DidDocumentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDidRecoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDidRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDidRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarkVerificationMethodCompromised) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarkVerificationMethodCompromised) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarkVerificationMethodCompromised) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VersionId) > 0 {
+		i -= len(m.VersionId)
+		copy(dAtA[i:], m.VersionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VersionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CompromiseDocumentProofs) > 0 {
+		for iNdEx := len(m.CompromiseDocumentProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompromiseDocumentProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CompromiseDocument != nil {
+		{
+			size, err := m.CompromiseDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarkVerificationMethodCompromisedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarkVerificationMethodCompromisedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarkVerificationMethodCompromisedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCredentialSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCredentialSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCredentialSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CredentialSchemaProof != nil {
+		{
+			size, err := m.CredentialSchemaProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CredentialSchemaDocument != nil {
+		{
+			size, err := m.CredentialSchemaDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCredentialSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCredentialSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCredentialSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCredentialSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCredentialSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCredentialSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CredentialSchemaProof != nil {
+		{
+			size, err := m.CredentialSchemaProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CredentialSchemaDocument != nil {
+		{
+			size, err := m.CredentialSchemaDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCredentialSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCredentialSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCredentialSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCredentialSchemaStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCredentialSchemaStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCredentialSchemaStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CredentialSchemaStatusProof != nil {
+		{
+			size, err := m.CredentialSchemaStatusProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CredentialSchemaStatusDocument != nil {
+		{
+			size, err := m.CredentialSchemaStatusDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCredentialSchemaStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCredentialSchemaStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCredentialSchemaStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCredentialStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCredentialStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCredentialStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CredentialStatusProof != nil {
+		{
+			size, err := m.CredentialStatusProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CredentialStatusDocument != nil {
+		{
+			size, err := m.CredentialStatusDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCredentialStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCredentialStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCredentialStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCredentialStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCredentialStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCredentialStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CredentialStatusProof != nil {
+		{
+			size, err := m.CredentialStatusProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CredentialStatusDocument != nil {
+		{
+			size, err := m.CredentialStatusDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCredentialStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCredentialStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCredentialStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCredentialStatusBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCredentialStatusBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCredentialStatusBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CredentialStatusBatchProof != nil {
+		{
+			size, err := m.CredentialStatusBatchProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CredentialStatusBatchDocument != nil {
+		{
+			size, err := m.CredentialStatusBatchDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCredentialStatusBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCredentialStatusBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCredentialStatusBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCredentialStatusList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCredentialStatusList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCredentialStatusList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CredentialStatusListProof != nil {
+		{
+			size, err := m.CredentialStatusListProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CredentialStatusListDocument != nil {
+		{
+			size, err := m.CredentialStatusListDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCredentialStatusListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCredentialStatusListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCredentialStatusListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCredentialStatusList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCredentialStatusList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCredentialStatusList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CredentialStatusListProof != nil {
+		{
+			size, err := m.CredentialStatusListProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CredentialStatusListDocument != nil {
+		{
+			size, err := m.CredentialStatusListDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCredentialStatusListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCredentialStatusListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCredentialStatusListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAccreditation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccreditation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccreditation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxAuthor) > 0 {
+		i -= len(m.TxAuthor)
+		copy(dAtA[i:], m.TxAuthor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxAuthor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AccreditationProof != nil {
+		{
+			size, err := m.AccreditationProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AccreditationDocument != nil {
+		{
+			size, err := m.AccreditationDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAccreditationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccreditationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccreditationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterGovernanceAccreditation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterGovernanceAccreditation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterGovernanceAccreditation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccreditationDocument != nil {
+		{
+			size, err := m.AccreditationDocument.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterGovernanceAccreditationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterGovernanceAccreditationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterGovernanceAccreditationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterDID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidDocument != nil {
+		l = m.DidDocument.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DidDocumentProofs) > 0 {
+		for _, e := range m.DidDocumentProofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterDIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidDocument != nil {
+		l = m.DidDocument.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DidDocumentProofs) > 0 {
+		for _, e := range m.DidDocumentProofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeactivateDID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DidDocumentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DidDocumentProofs) > 0 {
+		for _, e := range m.DidDocumentProofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeactivateDIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInitiateDidRecovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DidDocument != nil {
+		l = m.DidDocument.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DidDocumentProofs) > 0 {
+		for _, e := range m.DidDocumentProofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInitiateDidRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelDidRecovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DidDocumentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DidDocumentProofs) > 0 {
+		for _, e := range m.DidDocumentProofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelDidRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarkVerificationMethodCompromised) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompromiseDocument != nil {
+		l = m.CompromiseDocument.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CompromiseDocumentProofs) > 0 {
+		for _, e := range m.CompromiseDocumentProofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.VersionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMarkVerificationMethodCompromisedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCredentialSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredentialSchemaDocument != nil {
+		l = m.CredentialSchemaDocument.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CredentialSchemaProof != nil {
+		l = m.CredentialSchemaProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterCredentialSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCredentialSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredentialSchemaDocument != nil {
+		l = m.CredentialSchemaDocument.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CredentialSchemaProof != nil {
+		l = m.CredentialSchemaProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCredentialSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCredentialSchemaStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredentialSchemaStatusDocument != nil {
+		l = m.CredentialSchemaStatusDocument.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CredentialSchemaStatusProof != nil {
+		l = m.CredentialSchemaStatusProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCredentialSchemaStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCredentialStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredentialStatusDocument != nil {
+		l = m.CredentialStatusDocument.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CredentialStatusProof != nil {
+		l = m.CredentialStatusProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterCredentialStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCredentialStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredentialStatusDocument != nil {
+		l = m.CredentialStatusDocument.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CredentialStatusProof != nil {
+		l = m.CredentialStatusProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCredentialStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCredentialStatusBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredentialStatusBatchDocument != nil {
+		l = m.CredentialStatusBatchDocument.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CredentialStatusBatchProof != nil {
+		l = m.CredentialStatusBatchProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterCredentialStatusBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCredentialStatusList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredentialStatusListDocument != nil {
+		l = m.CredentialStatusListDocument.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CredentialStatusListProof != nil {
+		l = m.CredentialStatusListProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterCredentialStatusListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCredentialStatusList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CredentialStatusListDocument != nil {
+		l = m.CredentialStatusListDocument.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CredentialStatusListProof != nil {
+		l = m.CredentialStatusListProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCredentialStatusListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterAccreditation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccreditationDocument != nil {
+		l = m.AccreditationDocument.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AccreditationProof != nil {
+		l = m.AccreditationProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxAuthor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterAccreditationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterGovernanceAccreditation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AccreditationDocument != nil {
+		l = m.AccreditationDocument.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterGovernanceAccreditationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterDID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocument == nil {
+				m.DidDocument = &DidDocument{}
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocumentProofs = append(m.DidDocumentProofs, &DocumentProof{})
+			if err := m.DidDocumentProofs[len(m.DidDocumentProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocument == nil {
+				m.DidDocument = &DidDocument{}
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocumentProofs = append(m.DidDocumentProofs, &DocumentProof{})
+			if err := m.DidDocumentProofs[len(m.DidDocumentProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeactivateDID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeactivateDID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeactivateDID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocumentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocumentProofs = append(m.DidDocumentProofs, &DocumentProof{})
+			if err := m.DidDocumentProofs[len(m.DidDocumentProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeactivateDIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeactivateDIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeactivateDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateDidRecovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateDidRecovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateDidRecovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DidDocument == nil {
+				m.DidDocument = &DidDocument{}
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocumentProofs = append(m.DidDocumentProofs, &DocumentProof{})
+			if err := m.DidDocumentProofs[len(m.DidDocumentProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateDidRecoveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateDidRecoveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateDidRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDidRecovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDidRecovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDidRecovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocumentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocumentProofs = append(m.DidDocumentProofs, &DocumentProof{})
+			if err := m.DidDocumentProofs[len(m.DidDocumentProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDidRecoveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDidRecoveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDidRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMarkVerificationMethodCompromised) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarkVerificationMethodCompromised: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarkVerificationMethodCompromised: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompromiseDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompromiseDocument == nil {
+				m.CompromiseDocument = &VerificationMethodCompromiseDocument{}
+			}
+			if err := m.CompromiseDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompromiseDocumentProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompromiseDocumentProofs = append(m.CompromiseDocumentProofs, &DocumentProof{})
+			if err := m.CompromiseDocumentProofs[len(m.CompromiseDocumentProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMarkVerificationMethodCompromisedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarkVerificationMethodCompromisedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarkVerificationMethodCompromisedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCredentialSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCredentialSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCredentialSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialSchemaDocument == nil {
+				m.CredentialSchemaDocument = &CredentialSchemaDocument{}
+			}
+			if err := m.CredentialSchemaDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialSchemaProof == nil {
+				m.CredentialSchemaProof = &DocumentProof{}
+			}
+			if err := m.CredentialSchemaProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCredentialSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCredentialSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCredentialSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCredentialSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCredentialSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialSchemaDocument == nil {
+				m.CredentialSchemaDocument = &CredentialSchemaDocument{}
+			}
+			if err := m.CredentialSchemaDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialSchemaProof == nil {
+				m.CredentialSchemaProof = &DocumentProof{}
+			}
+			if err := m.CredentialSchemaProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCredentialSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCredentialSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCredentialSchemaStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCredentialSchemaStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCredentialSchemaStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaStatusDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialSchemaStatusDocument == nil {
+				m.CredentialSchemaStatusDocument = &CredentialSchemaStatusDocument{}
+			}
+			if err := m.CredentialSchemaStatusDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaStatusProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialSchemaStatusProof == nil {
+				m.CredentialSchemaStatusProof = &DocumentProof{}
+			}
+			if err := m.CredentialSchemaStatusProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCredentialSchemaStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCredentialSchemaStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCredentialSchemaStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCredentialStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCredentialStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCredentialStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialStatusDocument == nil {
+				m.CredentialStatusDocument = &CredentialStatusDocument{}
+			}
+			if err := m.CredentialStatusDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialStatusProof == nil {
+				m.CredentialStatusProof = &DocumentProof{}
+			}
+			if err := m.CredentialStatusProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.VersionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TxAuthor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgUpdateDIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgDeactivateDID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DidDocumentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.DidDocumentProofs) > 0 {
-		for _, e := range m.DidDocumentProofs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgRegisterCredentialStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCredentialStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCredentialStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.VersionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TxAuthor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgDeactivateDIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgRegisterCredentialSchema) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CredentialSchemaDocument != nil {
-		l = m.CredentialSchemaDocument.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CredentialSchemaProof != nil {
-		l = m.CredentialSchemaProof.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TxAuthor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgRegisterCredentialSchemaResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateCredentialSchema) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CredentialSchemaDocument != nil {
-		l = m.CredentialSchemaDocument.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CredentialSchemaProof != nil {
-		l = m.CredentialSchemaProof.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TxAuthor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgUpdateCredentialSchemaResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgRegisterCredentialStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CredentialStatusDocument != nil {
-		l = m.CredentialStatusDocument.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CredentialStatusProof != nil {
-		l = m.CredentialStatusProof.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TxAuthor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgRegisterCredentialStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUpdateCredentialStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CredentialStatusDocument != nil {
-		l = m.CredentialStatusDocument.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.CredentialStatusProof != nil {
-		l = m.CredentialStatusProof.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TxAuthor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgUpdateCredentialStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterDID) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCredentialStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1891,15 +6513,15 @@ func (m *MsgRegisterDID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterDID: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCredentialStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterDID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCredentialStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusDocument", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1926,16 +6548,16 @@ func (m *MsgRegisterDID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DidDocument == nil {
-				m.DidDocument = &DidDocument{}
+			if m.CredentialStatusDocument == nil {
+				m.CredentialStatusDocument = &CredentialStatusDocument{}
 			}
-			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CredentialStatusDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentProofs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1962,8 +6584,10 @@ func (m *MsgRegisterDID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DidDocumentProofs = append(m.DidDocumentProofs, &DocumentProof{})
-			if err := m.DidDocumentProofs[len(m.DidDocumentProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CredentialStatusProof == nil {
+				m.CredentialStatusProof = &DocumentProof{}
+			}
+			if err := m.CredentialStatusProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2020,7 +6644,7 @@ func (m *MsgRegisterDID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterDIDResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCredentialStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2043,10 +6667,10 @@ func (m *MsgRegisterDIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterDIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCredentialStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCredentialStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2070,7 +6694,7 @@ func (m *MsgRegisterDIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDID) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterCredentialStatusBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2093,15 +6717,15 @@ func (m *MsgUpdateDID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDID: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterCredentialStatusBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterCredentialStatusBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusBatchDocument", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2128,16 +6752,16 @@ func (m *MsgUpdateDID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DidDocument == nil {
-				m.DidDocument = &DidDocument{}
+			if m.CredentialStatusBatchDocument == nil {
+				m.CredentialStatusBatchDocument = &CredentialStatusBatchDocument{}
 			}
-			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CredentialStatusBatchDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentProofs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusBatchProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2164,44 +6788,14 @@ func (m *MsgUpdateDID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DidDocumentProofs = append(m.DidDocumentProofs, &DocumentProof{})
-			if err := m.DidDocumentProofs[len(m.DidDocumentProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CredentialStatusBatchProof == nil {
+				m.CredentialStatusBatchProof = &DocumentProof{}
+			}
+			if err := m.CredentialStatusBatchProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VersionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthor", wireType)
 			}
@@ -2254,7 +6848,7 @@ func (m *MsgUpdateDID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDIDResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterCredentialStatusBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2277,10 +6871,10 @@ func (m *MsgUpdateDIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterCredentialStatusBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterCredentialStatusBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2304,7 +6898,7 @@ func (m *MsgUpdateDIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeactivateDID) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterCredentialStatusList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2327,17 +6921,17 @@ func (m *MsgDeactivateDID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeactivateDID: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterCredentialStatusList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeactivateDID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterCredentialStatusList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusListDocument", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2347,27 +6941,31 @@ func (m *MsgDeactivateDID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DidDocumentId = string(dAtA[iNdEx:postIndex])
+			if m.CredentialStatusListDocument == nil {
+				m.CredentialStatusListDocument = &CredentialStatusListDocument{}
+			}
+			if err := m.CredentialStatusListDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocumentProofs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusListProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2394,44 +6992,14 @@ func (m *MsgDeactivateDID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DidDocumentProofs = append(m.DidDocumentProofs, &DocumentProof{})
-			if err := m.DidDocumentProofs[len(m.DidDocumentProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CredentialStatusListProof == nil {
+				m.CredentialStatusListProof = &DocumentProof{}
+			}
+			if err := m.CredentialStatusListProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VersionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthor", wireType)
 			}
@@ -2484,7 +7052,7 @@ func (m *MsgDeactivateDID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeactivateDIDResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterCredentialStatusListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2507,10 +7075,10 @@ func (m *MsgDeactivateDIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeactivateDIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterCredentialStatusListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeactivateDIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterCredentialStatusListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2534,7 +7102,7 @@ func (m *MsgDeactivateDIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCredentialSchema) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCredentialStatusList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2557,15 +7125,15 @@ func (m *MsgRegisterCredentialSchema) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCredentialSchema: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCredentialStatusList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCredentialSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCredentialStatusList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaDocument", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusListDocument", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2592,16 +7160,16 @@ func (m *MsgRegisterCredentialSchema) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CredentialSchemaDocument == nil {
-				m.CredentialSchemaDocument = &CredentialSchemaDocument{}
+			if m.CredentialStatusListDocument == nil {
+				m.CredentialStatusListDocument = &CredentialStatusListDocument{}
 			}
-			if err := m.CredentialSchemaDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CredentialStatusListDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusListProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2628,10 +7196,10 @@ func (m *MsgRegisterCredentialSchema) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CredentialSchemaProof == nil {
-				m.CredentialSchemaProof = &DocumentProof{}
+			if m.CredentialStatusListProof == nil {
+				m.CredentialStatusListProof = &DocumentProof{}
 			}
-			if err := m.CredentialSchemaProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CredentialStatusListProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2688,7 +7256,7 @@ func (m *MsgRegisterCredentialSchema) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCredentialStatusListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2711,10 +7279,10 @@ func (m *MsgRegisterCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCredentialSchemaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCredentialStatusListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCredentialSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCredentialStatusListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2738,7 +7306,7 @@ func (m *MsgRegisterCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCredentialSchema) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterAccreditation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2761,15 +7329,15 @@ func (m *MsgUpdateCredentialSchema) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCredentialSchema: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterAccreditation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCredentialSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterAccreditation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaDocument", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditationDocument", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2796,16 +7364,16 @@ func (m *MsgUpdateCredentialSchema) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CredentialSchemaDocument == nil {
-				m.CredentialSchemaDocument = &CredentialSchemaDocument{}
+			if m.AccreditationDocument == nil {
+				m.AccreditationDocument = &AccreditationDocument{}
 			}
-			if err := m.CredentialSchemaDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccreditationDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSchemaProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditationProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2832,10 +7400,10 @@ func (m *MsgUpdateCredentialSchema) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CredentialSchemaProof == nil {
-				m.CredentialSchemaProof = &DocumentProof{}
+			if m.AccreditationProof == nil {
+				m.AccreditationProof = &DocumentProof{}
 			}
-			if err := m.CredentialSchemaProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccreditationProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2892,7 +7460,7 @@ func (m *MsgUpdateCredentialSchema) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterAccreditationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2915,10 +7483,10 @@ func (m *MsgUpdateCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCredentialSchemaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterAccreditationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCredentialSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterAccreditationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2942,7 +7510,7 @@ func (m *MsgUpdateCredentialSchemaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCredentialStatus) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2965,17 +7533,17 @@ func (m *MsgRegisterCredentialStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCredentialStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCredentialStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusDocument", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2985,31 +7553,27 @@ func (m *MsgRegisterCredentialStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CredentialStatusDocument == nil {
-				m.CredentialStatusDocument = &CredentialStatusDocument{}
-			}
-			if err := m.CredentialStatusDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3036,45 +7600,10 @@ func (m *MsgRegisterCredentialStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CredentialStatusProof == nil {
-				m.CredentialStatusProof = &DocumentProof{}
-			}
-			if err := m.CredentialStatusProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthor", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TxAuthor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3096,7 +7625,7 @@ func (m *MsgRegisterCredentialStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCredentialStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3119,10 +7648,10 @@ func (m *MsgRegisterCredentialStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCredentialStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCredentialStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3146,7 +7675,7 @@ func (m *MsgRegisterCredentialStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCredentialStatus) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterGovernanceAccreditation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3169,17 +7698,17 @@ func (m *MsgUpdateCredentialStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCredentialStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterGovernanceAccreditation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCredentialStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterGovernanceAccreditation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusDocument", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3189,31 +7718,27 @@ func (m *MsgUpdateCredentialStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CredentialStatusDocument == nil {
-				m.CredentialStatusDocument = &CredentialStatusDocument{}
-			}
-			if err := m.CredentialStatusDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialStatusProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccreditationDocument", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3240,45 +7765,13 @@ func (m *MsgUpdateCredentialStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CredentialStatusProof == nil {
-				m.CredentialStatusProof = &DocumentProof{}
+			if m.AccreditationDocument == nil {
+				m.AccreditationDocument = &AccreditationDocument{}
 			}
-			if err := m.CredentialStatusProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccreditationDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxAuthor", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TxAuthor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3300,7 +7793,7 @@ func (m *MsgUpdateCredentialStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCredentialStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterGovernanceAccreditationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3323,10 +7816,10 @@ func (m *MsgUpdateCredentialStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCredentialStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterGovernanceAccreditationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCredentialStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterGovernanceAccreditationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
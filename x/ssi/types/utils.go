@@ -120,3 +120,12 @@ func isMSIBlockchainAccountId(didId string) bool {
 	didIdElements := strings.Split(didId, ":")
 	return (len(didIdElements) == 5 || len(didIdElements) == 6)
 }
+
+// GetDidDocumentControllers returns the controllers of a DID Document. DID Subject is assumed
+// to be the sole controller if the controller list is empty.
+func GetDidDocumentControllers(didDocument *DidDocument) []string {
+	if len(didDocument.Controller) == 0 {
+		return []string{didDocument.Id}
+	}
+	return GetUniqueElements(didDocument.Controller)
+}
@@ -0,0 +1,113 @@
+package vc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+const verifiableCredentialType = "VerifiableCredential"
+
+// Credential is a W3C Verifiable Credential, with the attributes required for its verification extracted
+// from the JSON document. The complete document is retained, as it is signed as a whole.
+type Credential struct {
+	Document           map[string]interface{}
+	Id                 string
+	Issuer             string
+	CredentialSubject  map[string]interface{}
+	CredentialSchemaId string
+	Proof              *types.DocumentProof
+}
+
+// ParseCredential parses a JSON encoded Verifiable Credential
+func ParseCredential(credentialJson []byte) (*Credential, error) {
+	var document map[string]interface{}
+	if err := json.Unmarshal(credentialJson, &document); err != nil {
+		return nil, fmt.Errorf("invalid credential JSON: %v", err)
+	}
+
+	if _, ok := document["@context"]; !ok {
+		return nil, fmt.Errorf("'@context' attribute of credential cannot be empty")
+	}
+
+	credential := &Credential{Document: document}
+
+	id, ok := document["id"].(string)
+	if !ok || id == "" {
+		return nil, fmt.Errorf("'id' attribute of credential must be a non-empty string")
+	}
+	credential.Id = id
+
+	if !hasCredentialType(document["type"]) {
+		return nil, fmt.Errorf("'type' attribute of credential must include %v", verifiableCredentialType)
+	}
+
+	issuer, err := getObjectId(document["issuer"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'issuer' attribute of credential: %v", err)
+	}
+	credential.Issuer = issuer
+
+	credentialSubject, ok := document["credentialSubject"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("'credentialSubject' attribute of credential must be a JSON object")
+	}
+	credential.CredentialSubject = credentialSubject
+
+	credentialSchemaId, err := getObjectId(document["credentialSchema"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'credentialSchema' attribute of credential: %v", err)
+	}
+	credential.CredentialSchemaId = credentialSchemaId
+
+	proof, ok := document["proof"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("'proof' attribute of credential must be a JSON object")
+	}
+	credential.Proof = &types.DocumentProof{
+		Type:               getString(proof, "type"),
+		Created:            getString(proof, "created"),
+		VerificationMethod: getString(proof, "verificationMethod"),
+		ProofPurpose:       getString(proof, "proofPurpose"),
+		ProofValue:         getString(proof, "proofValue"),
+	}
+
+	return credential, nil
+}
+
+// hasCredentialType checks if the type of credential includes VerifiableCredential
+func hasCredentialType(credentialType interface{}) bool {
+	switch credentialType := credentialType.(type) {
+	case string:
+		return credentialType == verifiableCredentialType
+	case []interface{}:
+		for _, t := range credentialType {
+			if t == verifiableCredentialType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getObjectId returns the identifier of an attribute, which is either the identifier itself or a JSON object
+// having it as `id`
+func getObjectId(attribute interface{}) (string, error) {
+	switch attribute := attribute.(type) {
+	case string:
+		if attribute != "" {
+			return attribute, nil
+		}
+	case map[string]interface{}:
+		if id, ok := attribute["id"].(string); ok && id != "" {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("expected a non-empty string or a JSON object with a non-empty 'id'")
+}
+
+func getString(obj map[string]interface{}, attribute string) string {
+	value, _ := obj[attribute].(string)
+	return value
+}
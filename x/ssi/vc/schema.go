@@ -0,0 +1,32 @@
+package vc
+
+import (
+	"fmt"
+
+	"github.com/hypersign-protocol/hid-node/x/ssi/jsonschema"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// credentialSubjectIdAttribute is the identifier of credential subject, which is not a claim and hence
+// not described by the schema
+const credentialSubjectIdAttribute = "id"
+
+// ValidateCredentialSubject validates the claims of credential subject against the JSON Schema of Credential Schema
+func ValidateCredentialSubject(schema *types.CredentialSchemaProperty, credentialSubject map[string]interface{}) error {
+	validator, err := jsonschema.NewCredentialSchemaValidator(schema)
+	if err != nil {
+		return fmt.Errorf("invalid credential schema: %v", err)
+	}
+
+	claims := map[string]interface{}{}
+	for claimName, claimValue := range credentialSubject {
+		if claimName != credentialSubjectIdAttribute {
+			claims[claimName] = claimValue
+		}
+	}
+
+	if err := validator.Validate(claims); err != nil {
+		return fmt.Errorf("invalid credentialSubject: %v", err)
+	}
+	return nil
+}
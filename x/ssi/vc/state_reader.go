@@ -0,0 +1,61 @@
+package vc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// StateReader provides the registered state of x/ssi module, against which a Verifiable Credential is verified
+type StateReader interface {
+	GetDidDocumentState(didId string) (*types.DidDocumentState, error)
+	GetCredentialStatusState(credentialId string) (*types.CredentialStatusState, error)
+	GetCredentialSchemaState(credentialSchemaId string) (*types.CredentialSchemaState, error)
+}
+
+// queryClientStateReader reads the state of x/ssi module through its gRPC query service, which allows
+// the Verifiable Credentials to be verified outside of hid-node
+type queryClientStateReader struct {
+	ctx         context.Context
+	queryClient types.QueryClient
+}
+
+// NewQueryClientStateReader returns a StateReader backed by the gRPC query client of x/ssi module
+func NewQueryClientStateReader(ctx context.Context, queryClient types.QueryClient) StateReader {
+	return &queryClientStateReader{
+		ctx:         ctx,
+		queryClient: queryClient,
+	}
+}
+
+func (r *queryClientStateReader) GetDidDocumentState(didId string) (*types.DidDocumentState, error) {
+	res, err := r.queryClient.DidDocumentByID(r.ctx, &types.QueryDidDocumentRequest{DidId: didId})
+	if err != nil {
+		return nil, err
+	}
+	return &types.DidDocumentState{
+		DidDocument:         res.DidDocument,
+		DidDocumentMetadata: res.DidDocumentMetadata,
+	}, nil
+}
+
+func (r *queryClientStateReader) GetCredentialStatusState(credentialId string) (*types.CredentialStatusState, error) {
+	res, err := r.queryClient.CredentialStatusByID(r.ctx, &types.QueryCredentialStatusRequest{CredId: credentialId})
+	if err != nil {
+		return nil, err
+	}
+	return res.CredentialStatus, nil
+}
+
+func (r *queryClientStateReader) GetCredentialSchemaState(credentialSchemaId string) (*types.CredentialSchemaState, error) {
+	res, err := r.queryClient.CredentialSchemaByID(r.ctx, &types.QueryCredentialSchemaRequest{SchemaId: credentialSchemaId})
+	if err != nil {
+		return nil, err
+	}
+	// Every version of the schema is returned for an id without version
+	if len(res.CredentialSchemas) != 1 {
+		return nil, fmt.Errorf("credential schema id %v must refer to a single version of schema", credentialSchemaId)
+	}
+	return res.CredentialSchemas[0], nil
+}
@@ -0,0 +1,194 @@
+package vc
+
+import (
+	"fmt"
+
+	ldcontext "github.com/hypersign-protocol/hid-node/x/ssi/ld-context"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/verification"
+)
+
+// Checks performed while verifying a Verifiable Credential, in addition to the ones performed on its proof
+const (
+	CheckCredential               = "credential"
+	CheckIssuer                   = "issuer"
+	CheckCredentialMerkleRootHash = "credentialMerkleRootHash"
+	CheckCredentialStatus         = "credentialStatus"
+	CheckCredentialSchema         = "credentialSchema"
+)
+
+const assertionMethodProofPurpose = "assertionMethod"
+
+// VerificationResult reports the checks performed on a Verifiable Credential, in the order they were performed.
+// Verification stops at the first failed check.
+type VerificationResult struct {
+	Verified bool
+	Checks   []*types.ProofVerificationCheck
+}
+
+// VerifyCredential verifies a JSON encoded Verifiable Credential against the state of x/ssi module. The issuer DID
+// must be active, the proof must be created by one of its assertion methods, the Credential Status registered for
+// the credential id must carry its Merkle root and be neither revoked, suspended nor expired, and the credential
+// subject must be valid as per the Credential Schema it refers to, which must not be revoked.
+func VerifyCredential(reader StateReader, credentialJson []byte) *VerificationResult {
+	result := &VerificationResult{}
+
+	credential, err := ParseCredential(credentialJson)
+	if err == nil {
+		err = credential.Proof.Validate()
+	}
+	if !result.addCheck(CheckCredential, err) {
+		return result
+	}
+
+	issuerDidDocumentState, err := reader.GetDidDocumentState(credential.Issuer)
+	if err == nil && issuerDidDocumentState.DidDocumentMetadata.Deactivated {
+		err = fmt.Errorf("issuer DID Document %v is deactivated", credential.Issuer)
+	}
+	if !result.addCheck(CheckIssuer, err) {
+		return result
+	}
+
+	issuerVm, err := getIssuerAssertionMethod(issuerDidDocumentState.DidDocument, credential.Proof)
+	if !result.addCheck(types.ProofCheckVerificationMethod, err) {
+		return result
+	}
+
+	err = verification.CheckProofVerificationMethodCompromise(issuerDidDocumentState.DidDocumentMetadata, credential.Proof)
+	if !result.addCheck(types.ProofCheckVerificationMethodCompromise, err) {
+		return result
+	}
+
+	if !result.addCheck(types.ProofCheckProofType, verification.CheckProofType(issuerVm, credential.Proof)) {
+		return result
+	}
+
+	signingInput, err := ldcontext.NormalizeCredentialByProofType(credential.Document, credential.Proof)
+	if !result.addCheck(types.ProofCheckCanonicalization, err) {
+		return result
+	}
+
+	err = verification.VerifySignature(issuerVm, credential.Proof, signingInput)
+	if !result.addCheck(types.ProofCheckSignature, err) {
+		return result
+	}
+
+	credentialStatusState, err := reader.GetCredentialStatusState(credential.Id)
+	if err == nil {
+		err = checkCredentialMerkleRootHash(credential, credentialStatusState.CredentialStatusDocument)
+	}
+	if !result.addCheck(CheckCredentialMerkleRootHash, err) {
+		return result
+	}
+
+	if !result.addCheck(CheckCredentialStatus, checkCredentialStatus(credentialStatusState)) {
+		return result
+	}
+
+	credentialSchemaState, err := reader.GetCredentialSchemaState(credential.CredentialSchemaId)
+	if err == nil && credentialSchemaState.Status == types.CREDENTIAL_SCHEMA_STATUS_REVOKED {
+		err = fmt.Errorf("credential schema %v is revoked", credential.CredentialSchemaId)
+	}
+	if err == nil {
+		err = ValidateCredentialSubject(credentialSchemaState.CredentialSchemaDocument.Schema, credential.CredentialSubject)
+	}
+	if !result.addCheck(CheckCredentialSchema, err) {
+		return result
+	}
+
+	result.Verified = true
+	return result
+}
+
+// getIssuerAssertionMethod returns the Verification Method of issuer DID Document which created the credential proof.
+// It must be one of the assertion methods of issuer, as the proof asserts the claims of credential.
+func getIssuerAssertionMethod(issuerDidDocument *types.DidDocument, credentialProof *types.DocumentProof) (*types.VerificationMethod, error) {
+	if didId, _ := types.SplitDidUrl(credentialProof.VerificationMethod); didId != issuerDidDocument.Id {
+		return nil, fmt.Errorf(
+			"verificationMethod %v of credential proof does not belong to issuer %v",
+			credentialProof.VerificationMethod,
+			issuerDidDocument.Id,
+		)
+	}
+
+	if credentialProof.ProofPurpose != assertionMethodProofPurpose {
+		return nil, fmt.Errorf("expected proof purpose to be %v, recieved %v", assertionMethodProofPurpose, credentialProof.ProofPurpose)
+	}
+
+	isAssertionMethod := false
+	for _, assertionMethod := range issuerDidDocument.AssertionMethod {
+		if assertionMethod == credentialProof.VerificationMethod {
+			isAssertionMethod = true
+			break
+		}
+	}
+	if !isAssertionMethod {
+		return nil, fmt.Errorf(
+			"verificationMethod %v is not an assertion method of issuer %v",
+			credentialProof.VerificationMethod,
+			issuerDidDocument.Id,
+		)
+	}
+
+	for _, vm := range issuerDidDocument.VerificationMethod {
+		if vm.Id == credentialProof.VerificationMethod {
+			return vm, nil
+		}
+	}
+	return nil, fmt.Errorf("verificationMethod %s is not present in DID document %s", credentialProof.VerificationMethod, issuerDidDocument.Id)
+}
+
+// checkCredentialMerkleRootHash checks if the Credential Status is registered by the issuer of credential,
+// for the Merkle root of credential
+func checkCredentialMerkleRootHash(credential *Credential, credentialStatus *types.CredentialStatusDocument) error {
+	if credentialStatus.Issuer != credential.Issuer {
+		return fmt.Errorf(
+			"credential status %v is registered by %v, while the credential is issued by %v",
+			credentialStatus.Id,
+			credentialStatus.Issuer,
+			credential.Issuer,
+		)
+	}
+
+	credentialMerkleRootHash, err := ldcontext.GetCredentialMerkleRootHash(credential.Document)
+	if err != nil {
+		return err
+	}
+	if credentialMerkleRootHash != credentialStatus.CredentialMerkleRootHash {
+		return fmt.Errorf(
+			"merkle root hash %v of credential does not match the registered merkle root hash %v",
+			credentialMerkleRootHash,
+			credentialStatus.CredentialMerkleRootHash,
+		)
+	}
+	return nil
+}
+
+// checkCredentialStatus checks if the Credential Status is neither revoked, suspended nor expired
+func checkCredentialStatus(credentialStatusState *types.CredentialStatusState) error {
+	credentialStatus := credentialStatusState.CredentialStatusDocument
+	if credentialStatus.Revoked {
+		return fmt.Errorf("credential %v is revoked", credentialStatus.Id)
+	}
+	if credentialStatus.Suspended {
+		return fmt.Errorf("credential %v is suspended", credentialStatus.Id)
+	}
+	if credentialStatusState.Expired {
+		return fmt.Errorf("credential %v is expired", credentialStatus.Id)
+	}
+	return nil
+}
+
+// addCheck adds the outcome of a check to the verification result, and returns true if it passed
+func (result *VerificationResult) addCheck(name string, err error) bool {
+	check := &types.ProofVerificationCheck{
+		Name:   name,
+		Passed: err == nil,
+	}
+	if err != nil {
+		check.Message = err.Error()
+	}
+
+	result.Checks = append(result.Checks, check)
+	return check.Passed
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
 )
 
 func documentIdentifier(docType string) string {
@@ -16,6 +18,12 @@ func documentIdentifier(docType string) string {
 	if docType == "credDocument" {
 		return "vc"
 	}
+	if docType == "credStatusListDocument" {
+		return "sl"
+	}
+	if docType == "accreditationDocument" {
+		return "acc"
+	}
 	return ""
 }
 
@@ -35,9 +43,8 @@ func schemaVersionNumberFormatCheck(docElementsList []string, namespace string)
 	}
 
 	versionNum := docElementsList[verNumIdx]
-	versionNumPattern := regexp.MustCompile(`^(\d+\.)?(\d+)$`)
-	if !versionNumPattern.MatchString(versionNum) {
-		return fmt.Errorf("input version id: %s is invalid", versionNum)
+	if _, err := types.ParseSchemaVersion(versionNum); err != nil {
+		return fmt.Errorf("input version id: %s is invalid: %v", versionNum, err)
 	}
 
 	return nil
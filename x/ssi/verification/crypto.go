@@ -33,26 +33,17 @@ func verifyAll(extendedVmList []*types.ExtendedVerificationMethod, ssiMsg types.
 	return nil
 }
 
-func verifyAny(extendedVmList []*types.ExtendedVerificationMethod, ssiMsg types.SsiMsg) bool {
-	found := false
-
-	for _, extendedVm := range extendedVmList {
-		err := verify(extendedVm, ssiMsg)
-		if err == nil {
-			found = true
-			break
-		}
-	}
-
-	return found
-}
-
 func verify(extendedVm *types.ExtendedVerificationMethod, ssiMsg types.SsiMsg) error {
 	docBytes, err := getDocBytesByClientSpec(ssiMsg, extendedVm)
 	if err != nil {
 		return err
 	}
 
+	return verifySignature(extendedVm, docBytes)
+}
+
+// verifySignature verifies the proof value of Extended Verification Method against the input signing bytes
+func verifySignature(extendedVm *types.ExtendedVerificationMethod, docBytes []byte) error {
 	switch extendedVm.Type {
 	case types.Ed25519VerificationKey2020:
 		return verifyEd25519Signature2020(extendedVm, docBytes)
@@ -68,6 +59,12 @@ func verify(extendedVm *types.ExtendedVerificationMethod, ssiMsg types.SsiMsg) e
 		return verifyBbsBlsSignature2020(extendedVm, docBytes)
 	case types.BabyJubJubKey2021:
 		return verifyBJJSignature2021(extendedVm, docBytes)
+	case types.CosmWasmContractMethod2024:
+		return fmt.Errorf(
+			"verification method %s of type %s authorises by being the transaction sender, and cannot sign documents",
+			extendedVm.Id,
+			extendedVm.Type,
+		)
 	default:
 		return fmt.Errorf("unsupported verification method: %s", extendedVm.Type)
 	}
@@ -0,0 +1,96 @@
+package verification
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/errors"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// CheckProofVerificationMethodCompromise checks that the document proof was created before the compromise of its
+// Verification Method, if the latter is marked as compromised. It is meant for off-chain verification of documents,
+// as the created date of proof is chosen by the signer.
+func CheckProofVerificationMethodCompromise(metadata *types.DidDocumentMetadata, inputDocProof *types.DocumentProof) error {
+	compromisedVm := GetCompromisedVerificationMethod(metadata, inputDocProof.GetVerificationMethod())
+	if compromisedVm == nil {
+		return nil
+	}
+
+	proofCreated, err := time.Parse(time.RFC3339, inputDocProof.GetCreated())
+	if err != nil {
+		return fmt.Errorf("invalid proof created date %v: %v", inputDocProof.GetCreated(), err)
+	}
+	compromisedSince, err := time.Parse(time.RFC3339, compromisedVm.CompromisedSince)
+	if err != nil {
+		return err
+	}
+	if !proofCreated.Before(compromisedSince) {
+		return errors.Wrapf(
+			types.ErrVerificationMethodCompromised,
+			"proof created at %v by %v, which is compromised since %v",
+			inputDocProof.GetCreated(),
+			inputDocProof.GetVerificationMethod(),
+			compromisedVm.CompromisedSince,
+		)
+	}
+	return nil
+}
+
+// CheckTxProofVerificationMethodCompromise checks the document proof of a transaction against the compromise of its
+// Verification Method. Since the created date of proof is chosen by the signer, a proof submitted at or after the
+// compromise time is rejected, irrespective of its created date.
+func CheckTxProofVerificationMethodCompromise(metadata *types.DidDocumentMetadata, inputDocProof *types.DocumentProof, blockTime time.Time) error {
+	compromisedVm := GetCompromisedVerificationMethod(metadata, inputDocProof.GetVerificationMethod())
+	if compromisedVm == nil {
+		return nil
+	}
+
+	compromisedSince, err := time.Parse(time.RFC3339, compromisedVm.CompromisedSince)
+	if err != nil {
+		return err
+	}
+	if !blockTime.Before(compromisedSince) {
+		return errors.Wrapf(
+			types.ErrVerificationMethodCompromised,
+			"proof submitted at %v by %v, which is compromised since %v",
+			blockTime.Format(time.RFC3339),
+			inputDocProof.GetVerificationMethod(),
+			compromisedVm.CompromisedSince,
+		)
+	}
+	return CheckProofVerificationMethodCompromise(metadata, inputDocProof)
+}
+
+// CheckProofType checks if the type of document proof corresponds to the type of its Verification Method
+func CheckProofType(docVm *types.VerificationMethod, inputDocProof *types.DocumentProof) error {
+	// VerificationKeySignatureMap has X25519KeyAgreementKey2020 and X25519KeyAgreementKeyEIP5630 as supported Verification Type.
+	// However, they are not allowed to be used for Authentication or Assertion purposes. Since, their corresponding values in the map
+	// are empty string, the following check is in place.
+	if types.VerificationKeySignatureMap[docVm.Type] == "" {
+		return fmt.Errorf("proof type must be specified")
+	}
+
+	// Check if the Proof Type is correct
+	if types.VerificationKeySignatureMap[docVm.Type] != inputDocProof.GetType() {
+		return fmt.Errorf(
+			"expected proof type to be %v as the verificationMethod type of %v is %v, recieved %v",
+			types.VerificationKeySignatureMap[docVm.Type],
+			docVm.Id,
+			docVm.Type,
+			inputDocProof.GetType(),
+		)
+	}
+	return nil
+}
+
+// GetCompromisedVerificationMethod returns the compromise record of Verification Method from DID Document metadata,
+// and nil if it is not marked as compromised
+func GetCompromisedVerificationMethod(metadata *types.DidDocumentMetadata, vmId string) *types.CompromisedVerificationMethod {
+	for _, compromisedVm := range metadata.GetCompromisedVerificationMethods() {
+		if compromisedVm.VerificationMethodId == vmId {
+			return compromisedVm
+		}
+	}
+	return nil
+}
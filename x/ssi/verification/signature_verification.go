@@ -9,9 +9,21 @@ import (
 // VerifySignatureOfEveryController verifies every required verification method of every controller
 func VerifySignatureOfEveryController(
 	didDocMsg types.SsiMsg, VmMap map[string][]*types.ExtendedVerificationMethod,
+) error {
+	return VerifyAuthorizationOfEveryController(didDocMsg, VmMap, nil)
+}
+
+// VerifyAuthorizationOfEveryController verifies that every controller has authorised the DID Document. A controller
+// present in authorizedControllers, whose contract is the transaction sender, needs signatures only for the
+// verification methods listed against it.
+func VerifyAuthorizationOfEveryController(
+	didDocMsg types.SsiMsg, VmMap map[string][]*types.ExtendedVerificationMethod, authorizedControllers map[string]bool,
 ) error {
 	for controller, vmList := range VmMap {
 		if len(vmList) == 0 {
+			if authorizedControllers[controller] {
+				continue
+			}
 			return fmt.Errorf("require atleast one valid signature for controller %s", controller)
 		}
 		err := verifyAll(vmList, didDocMsg)
@@ -22,24 +34,55 @@ func VerifySignatureOfEveryController(
 	return nil
 }
 
-// VerifySignatureOfEveryController verifies any required0 verification8
+// VerifySignatureOfAnyController verifies that atleast one of the controllers has a valid signature. If
+// controllerThreshold is set, valid signatures are required from atleast controllerThreshold distinct controllers.
 func VerifySignatureOfAnyController(
-	didDocMsg types.SsiMsg, VmMap map[string][]*types.ExtendedVerificationMethod,
+	didDocMsg types.SsiMsg, VmMap map[string][]*types.ExtendedVerificationMethod, controllerThreshold uint32,
+) error {
+	return VerifyAuthorizationOfAnyController(didDocMsg, VmMap, controllerThreshold, nil)
+}
+
+// VerifyAuthorizationOfAnyController verifies that atleast one of the controllers, or atleast controllerThreshold
+// distinct controllers if set, has authorised the DID Document. A controller authorises either through a valid
+// signature of a verification method it controls, or by being present in authorizedControllers as its contract
+// is the transaction sender. Signatures are counted against the controller of their verification method, so that
+// a controller signing through several verification methods is counted only once.
+func VerifyAuthorizationOfAnyController(
+	didDocMsg types.SsiMsg, VmMap map[string][]*types.ExtendedVerificationMethod, controllerThreshold uint32,
+	authorizedControllers map[string]bool,
 ) error {
-	found := false
-	for _, vmList := range VmMap {
-		found = verifyAny(vmList, didDocMsg)
-		if found {
-			break
+	requiredControllers := controllerThreshold
+	if requiredControllers == 0 {
+		requiredControllers = 1
+	}
+
+	signedControllers := map[string]bool{}
+	for controller, vmList := range VmMap {
+		if authorizedControllers[controller] {
+			signedControllers[controller] = true
+		}
+		for _, vm := range vmList {
+			if signedControllers[vm.Controller] {
+				continue
+			}
+			if err := verify(vm, didDocMsg); err == nil {
+				signedControllers[vm.Controller] = true
+			}
+		}
+		if uint32(len(signedControllers)) >= requiredControllers {
+			return nil
 		}
 	}
 
-	if !found {
+	if requiredControllers == 1 {
 		return fmt.Errorf(
 			"need atleast one valid signature from any of the existing controllers in the registered didDoc")
 	}
-
-	return nil
+	return fmt.Errorf(
+		"need valid signatures from atleast %v of the existing controllers in the registered didDoc, got %v",
+		requiredControllers,
+		len(signedControllers),
+	)
 }
 
 // VerifyDocumentProofSignature verfies the proof of the SSI Document such as Credential Schema and Credential Status
@@ -50,3 +93,17 @@ func VerifyDocumentProofSignature(ssiMsg types.SsiMsg, vm *types.VerificationMet
 	}
 	return nil
 }
+
+// GetDocumentSigningInput returns the bytes of SSI Document, as per the proof type and client spec of its proof,
+// over which the signature is expected to be created
+func GetDocumentSigningInput(ssiMsg types.SsiMsg, vm *types.VerificationMethod, documentProof *types.DocumentProof) ([]byte, error) {
+	vmExtended := types.CreateExtendedVerificationMethod(vm, documentProof)
+	return getDocBytesByClientSpec(ssiMsg, vmExtended)
+}
+
+// VerifySignature verifies the proof value of the document proof against the bytes over which it was created.
+// It is meant for documents, such as Verifiable Credentials, which are canonized outside of this package.
+func VerifySignature(vm *types.VerificationMethod, documentProof *types.DocumentProof, signingInput []byte) error {
+	vmExtended := types.CreateExtendedVerificationMethod(vm, documentProof)
+	return verifySignature(vmExtended, signingInput)
+}
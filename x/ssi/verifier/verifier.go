@@ -0,0 +1,58 @@
+// Package verifier checks the ICS-23 Merkle proofs of x/ssi query responses against a trusted app hash.
+// It allows the x/ssi state to be read through untrusted RPC providers, as long as the app hash is
+// obtained from a trusted source, such as a light client following the hid-node block headers.
+package verifier
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// VerifyDidDocumentResponse checks that the DID Document of a query response, requested with a proof, is the
+// state of the input DID committed in the app hash. The app hash of the response height is committed in the
+// header of the next block.
+func VerifyDidDocumentResponse(didId string, res *types.QueryDidDocumentResponse, appHash []byte) error {
+	if res == nil || res.DidDocument == nil || res.DidDocumentMetadata == nil {
+		return fmt.Errorf("DID Document is absent in the response")
+	}
+	if res.DidDocument.Id != didId {
+		return fmt.Errorf("expected DID Document %s, got %s", didId, res.DidDocument.Id)
+	}
+	if res.Proof == nil || len(res.DidDocumentStateValue) == 0 {
+		return fmt.Errorf("proof of DID Document %s is absent in the response", didId)
+	}
+	if len(appHash) == 0 {
+		return fmt.Errorf("app hash cannot be empty")
+	}
+
+	// The proof is verified over the stored value as is, since encoding the decoded DID Document state again
+	// is not guaranteed to reproduce it. The DID Document of response must then be the one it encodes.
+	var provenDidDocumentState types.DidDocumentState
+	if err := provenDidDocumentState.Unmarshal(res.DidDocumentStateValue); err != nil {
+		return fmt.Errorf("unable to decode the proven state of DID Document %s: %w", didId, err)
+	}
+	if !proto.Equal(provenDidDocumentState.DidDocument, res.DidDocument) ||
+		!proto.Equal(provenDidDocumentState.DidDocumentMetadata, res.DidDocumentMetadata) {
+		return fmt.Errorf("DID Document %s of the response differs from its proven state", didId)
+	}
+
+	merkleProof, err := commitmenttypes.ConvertProofs(res.Proof)
+	if err != nil {
+		return fmt.Errorf("invalid proof of DID Document %s: %w", didId, err)
+	}
+
+	merklePath := commitmenttypes.NewMerklePath(types.StoreKey, string(types.GetDidDocumentKey(didId)))
+	if err := merkleProof.VerifyMembership(
+		commitmenttypes.GetSDKSpecs(),
+		commitmenttypes.NewMerkleRoot(appHash),
+		merklePath,
+		res.DidDocumentStateValue,
+	); err != nil {
+		return fmt.Errorf("proof of DID Document %s does not match the app hash at height %d: %w", didId, res.Height, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/hypersign-protocol/hid-node/x/ssi/keeper"
+	"github.com/hypersign-protocol/hid-node/x/ssi/types"
+)
+
+// RegisterCustomPlugins returns the wasm keeper options which expose the x/ssi state to CosmWasm contracts
+func RegisterCustomPlugins(k *keeper.Keeper, cdc codec.JSONCodec) []wasmkeeper.Option {
+	return []wasmkeeper.Option{
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+			Custom: CustomQuerier(k, cdc),
+		}),
+	}
+}
+
+// CustomQuerier dispatches the custom queries of CosmWasm contracts to the x/ssi gRPC queries. Requests
+// and responses are JSON encoded the same way as they are on the gRPC gateway.
+func CustomQuerier(k *keeper.Keeper, cdc codec.JSONCodec) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		if len(request) > MaxQueryRequestSize {
+			return nil, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"ssi custom query size %v exceeds the maximum size of %v bytes",
+				len(request),
+				MaxQueryRequestSize,
+			)
+		}
+
+		var query SSIQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+
+		goCtx := sdk.WrapSDKContext(ctx)
+
+		switch {
+		case query.ResolveDid != nil:
+			var req types.QueryDidDocumentRequest
+			if err := unmarshalQueryRequest(ctx, cdc, query.ResolveDid, &req, ResolveDidGas, "resolve_did"); err != nil {
+				return nil, err
+			}
+			if req.Prove {
+				return nil, wasmvmtypes.UnsupportedRequest{Kind: "resolve_did with proof, which is only available to off-chain clients"}
+			}
+			res, err := k.DidDocumentByID(goCtx, &req)
+			return marshalQueryResponse(cdc, res, err)
+		case query.CredentialStatus != nil:
+			var req types.QueryCredentialStatusRequest
+			if err := unmarshalQueryRequest(ctx, cdc, query.CredentialStatus, &req, CredentialStatusGas, "credential_status"); err != nil {
+				return nil, err
+			}
+			res, err := k.CredentialStatusByID(goCtx, &req)
+			return marshalQueryResponse(cdc, res, err)
+		case query.CredentialSchema != nil:
+			var req types.QueryCredentialSchemaRequest
+			if err := unmarshalQueryRequest(ctx, cdc, query.CredentialSchema, &req, CredentialSchemaGas, "credential_schema"); err != nil {
+				return nil, err
+			}
+			res, err := k.CredentialSchemaByID(goCtx, &req)
+			return marshalQueryResponse(cdc, res, err)
+		case query.VerifyDocumentProof != nil:
+			var req types.QueryVerifyDocumentProofRequest
+			if err := unmarshalQueryRequest(ctx, cdc, query.VerifyDocumentProof, &req, VerifyDocumentProofGas, "verify_document_proof"); err != nil {
+				return nil, err
+			}
+			res, err := k.VerifyDocumentProof(goCtx, &req)
+			return marshalQueryResponse(cdc, res, err)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown ssi custom query"}
+		}
+	}
+}
+
+// unmarshalQueryRequest charges the gas of custom query and of every byte of its request, and decodes the request
+func unmarshalQueryRequest(ctx sdk.Context, cdc codec.JSONCodec, request json.RawMessage, req proto.Message, gas uint64, queryName string) error {
+	ctx.GasMeter().ConsumeGas(gas, "ssi custom query: "+queryName)
+	ctx.GasMeter().ConsumeGas(uint64(len(request))*QueryRequestGasPerByte, "ssi custom query request size: "+queryName)
+
+	if err := cdc.UnmarshalJSON(request, req); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrJSONUnmarshal, "invalid %s query: %s", queryName, err.Error())
+	}
+	return nil
+}
+
+func marshalQueryResponse(cdc codec.JSONCodec, res proto.Message, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	return cdc.MarshalJSON(res)
+}
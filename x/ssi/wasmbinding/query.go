@@ -0,0 +1,39 @@
+package wasmbinding
+
+import "encoding/json"
+
+// SSIQuery is the custom query of CosmWasm contracts to the x/ssi module. Exactly one of its fields
+// must be set, carrying the JSON encoded request of the respective x/ssi gRPC query.
+//
+// For example, a contract resolves a DID Document by sending the following custom query:
+//
+//	{"resolve_did": {"didId": "did:hid:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK"}}
+type SSIQuery struct {
+	// Resolves a DID Document, carrying QueryDidDocumentRequest
+	ResolveDid json.RawMessage `json:"resolve_did,omitempty"`
+	// Looks up a Credential Status, carrying QueryCredentialStatusRequest
+	CredentialStatus json.RawMessage `json:"credential_status,omitempty"`
+	// Looks up a Credential Schema, carrying QueryCredentialSchemaRequest
+	CredentialSchema json.RawMessage `json:"credential_schema,omitempty"`
+	// Verifies the proof of an x/ssi document, carrying QueryVerifyDocumentProofRequest
+	VerifyDocumentProof json.RawMessage `json:"verify_document_proof,omitempty"`
+
+	// Verifiable Credentials are not verified for contracts, since the validation of credential subjects
+	// against their Credential Schema is not metered
+}
+
+// Gas charged to contracts for every custom query, in addition to the gas consumed by reading the store.
+// Proof verification is charged more as it normalizes the document and verifies its signature.
+const (
+	ResolveDidGas          uint64 = 10_000
+	CredentialStatusGas    uint64 = 10_000
+	CredentialSchemaGas    uint64 = 10_000
+	VerifyDocumentProofGas uint64 = 50_000
+)
+
+// QueryRequestGasPerByte is the gas charged to contracts for every byte of a custom query request, since
+// the normalization of documents grows with their size
+const QueryRequestGasPerByte uint64 = 20
+
+// MaxQueryRequestSize is the maximum size of a custom query request in bytes
+const MaxQueryRequestSize = 32 * 1024